@@ -60,6 +60,18 @@ pub struct WalletTransactionOutput {
     pub is_our_address: bool,
 }
 
+#[derive(uniffi::Record)]
+pub struct WalletPsbt {
+    // base64 encoded psbt
+    pub psbt: String,
+    pub fee: u64,
+    pub send_amount: u64,
+    // whether all signatures required to finalize the psbt are present
+    pub is_complete: bool,
+    // the outputs which do not belong to the wallet
+    pub recipients: Vec<WalletTransactionOutput>,
+}
+
 #[derive(uniffi::Record)]
 pub struct WalletSendResult {
     pub tx_hex: String,
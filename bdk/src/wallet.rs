@@ -14,7 +14,7 @@ use miniscript::{
     bitcoin::{
         bip32::{ChildNumber, Xpriv, Xpub},
         consensus::encode::{self, deserialize_hex},
-        Address, Amount, FeeRate, Transaction, Txid,
+        Address, Amount, FeeRate, Psbt, ScriptBuf, Transaction, Txid,
     },
     descriptor::{DerivPaths, DescriptorMultiXKey, DescriptorXKey, Wildcard, Wpkh},
     DescriptorPublicKey, ForEachKey,
@@ -33,29 +33,31 @@ fn mnemonic_to_xprv(mnemonic: String, network: BdkNetwork) -> Result<Xpriv, anyh
     Ok(xprv)
 }
 
+// returns None if the key does not belong to the given xprv,
+// which is the case for cosigner keys of multisig descriptors
 fn derive_xpriv(
     public_key: DescriptorPublicKey,
     xprv: Xpriv,
-) -> Result<DescriptorSecretKey, anyhow::Error> {
+) -> Result<Option<DescriptorSecretKey>, anyhow::Error> {
     let secp = Secp256k1::new();
     match public_key {
         DescriptorPublicKey::XPub(x) => match x.clone().origin.clone() {
             Some((fingerprint, origin_path)) => {
                 if fingerprint == xprv.fingerprint(&secp) {
                     let xpriv = xprv.derive_priv(&secp, &origin_path)?;
-                    Ok(DescriptorSecretKey::XPrv(DescriptorXKey::<Xpriv> {
+                    Ok(Some(DescriptorSecretKey::XPrv(DescriptorXKey::<Xpriv> {
                         origin: x.origin.clone(),
                         xkey: xpriv,
                         derivation_path: x.derivation_path,
                         wildcard: x.wildcard,
-                    }))
+                    })))
                 } else {
-                    Err(anyhow::anyhow!("fingerprint does not match"))
+                    Ok(None)
                 }
             }
-            None => Err(anyhow::anyhow!("no origin")),
+            None => Ok(None),
         },
-        _ => Err(anyhow::anyhow!("unsupported key")),
+        _ => Ok(None),
     }
 }
 
@@ -122,7 +124,14 @@ impl WalletCredentials {
                         });
 
                         for public_key in public_keys {
-                            keymap.insert(public_key.clone(), derive_xpriv(public_key, xprv)?);
+                            if let Some(secret) = derive_xpriv(public_key.clone(), xprv)? {
+                                keymap.insert(public_key, secret);
+                            }
+                        }
+                        if keymap.is_empty() {
+                            return Err(anyhow::anyhow!(
+                                "mnemonic does not match any key of the descriptor"
+                            ));
                         }
                     };
                 }
@@ -149,6 +158,9 @@ impl ChainClient {
     }
 }
 
+const MISSING_SIGNATURES: &str =
+    "transaction requires signatures of other cosigners, use the psbt workflow instead";
+
 fn parse_fee_rate(sat_per_vbyte: f64) -> FeeRate {
     FeeRate::from_sat_per_kwu((sat_per_vbyte * 1000.0 / 4.0) as u64)
 }
@@ -245,9 +257,161 @@ impl Wallet {
         builder.fee_rate(fee_rate);
 
         let mut psbt = builder.finish().context("finish")?;
-        wallet
+        let finalized = wallet
+            .sign(&mut psbt, SignOptions::default())
+            .context("sign tx")?;
+        if !finalized {
+            return Err(Error::Generic(MISSING_SIGNATURES.to_string()));
+        }
+
+        let tx = psbt.extract_tx().context("extract tx")?;
+        Ok(encode::serialize_hex(&tx))
+    }
+
+    pub fn confirmed_utxo_count(&self) -> Result<u64, Error> {
+        let wallet = self.get_wallet()?;
+        Ok(wallet
+            .list_unspent()
+            .filter(|utxo| utxo.chain_position.is_confirmed())
+            .count() as u64)
+    }
+
+    // spends the `max_utxos` (all if 0) smallest confirmed utxos into a single output of the wallet
+    pub fn consolidate(&self, max_utxos: u64, sat_per_vbyte: f64) -> Result<WalletSendResult, Error> {
+        if max_utxos == 1 {
+            return Err(Error::Generic(
+                "at least 2 utxos are required for a consolidation".to_string(),
+            ));
+        }
+        let fee_rate = parse_fee_rate(sat_per_vbyte);
+        let mut wallet = self.get_wallet()?;
+
+        let mut utxos: Vec<_> = wallet
+            .list_unspent()
+            .filter(|utxo| utxo.chain_position.is_confirmed())
+            .collect();
+        if utxos.len() < 2 {
+            return Err(Error::Generic(
+                "not enough confirmed utxos to consolidate".to_string(),
+            ));
+        }
+        // the small outputs are the ones worth merging, the previous consolidation output is left alone
+        utxos.sort_by(|a, b| a.txout.value.cmp(&b.txout.value));
+        if max_utxos > 0 {
+            utxos.truncate(max_utxos as usize);
+        }
+        let outpoints: Vec<_> = utxos.iter().map(|utxo| utxo.outpoint).collect();
+
+        // only reveal the change address once the transaction is signed, so failed attempts don't use up addresses
+        let index = wallet.next_derivation_index(KeychainKind::Internal);
+        let address = wallet
+            .peek_address(KeychainKind::Internal, index)
+            .address
+            .script_pubkey();
+
+        let mut builder = wallet.build_tx();
+        builder
+            .fee_rate(fee_rate)
+            .add_utxos(&outpoints)
+            .context("add utxos")?
+            .manually_selected_only()
+            .drain_to(address.clone());
+
+        let mut psbt = builder.finish().context("finish tx")?;
+        let finalized = wallet
             .sign(&mut psbt, SignOptions::default())
             .context("sign tx")?;
+        if !finalized {
+            return Err(Error::Generic(MISSING_SIGNATURES.to_string()));
+        }
+        wallet.reveal_next_address(KeychainKind::Internal);
+        self.persist(&mut wallet)?;
+
+        let fee = psbt.fee().context("get fee")?.to_sat();
+        let tx = psbt.extract_tx().context("extract tx")?;
+        let send_amount = tx
+            .output
+            .iter()
+            .find(|o| o.script_pubkey == address)
+            .map(|o| o.value.to_sat())
+            .unwrap_or_default();
+
+        Ok(WalletSendResult {
+            tx_hex: encode::serialize_hex(&tx),
+            send_amount,
+            fee,
+        })
+    }
+
+    pub fn create_psbt(
+        &self,
+        address: String,
+        amount: u64,
+        sat_per_vbyte: f64,
+        send_all: bool,
+    ) -> Result<WalletPsbt, Error> {
+        let address = self.parse_address(address)?;
+        let mut wallet = self.get_wallet()?;
+        let (psbt, finalized) =
+            self.build_and_sign(&mut wallet, address.clone(), amount, sat_per_vbyte, send_all)?;
+
+        let fee = psbt.fee().context("get fee")?.to_sat();
+        let send_amount = psbt
+            .unsigned_tx
+            .output
+            .iter()
+            .find(|o| o.script_pubkey == address)
+            .map(|o| o.value.to_sat())
+            .unwrap_or_default();
+
+        Ok(WalletPsbt {
+            recipients: self.recipients(&wallet, &psbt),
+            psbt: psbt.to_string(),
+            fee,
+            send_amount,
+            is_complete: finalized,
+        })
+    }
+
+    pub fn sign_psbt(&self, psbt: String) -> Result<WalletPsbt, Error> {
+        let mut psbt = Psbt::from_str(&psbt).context("parse psbt")?;
+        let wallet = self.get_wallet()?;
+        let finalized = wallet
+            .sign(&mut psbt, SignOptions::default())
+            .context("sign psbt")?;
+
+        let fee = psbt.fee().context("get fee")?.to_sat();
+        let recipients = self.recipients(&wallet, &psbt);
+        let send_amount = recipients.iter().map(|o| o.amount).sum();
+
+        Ok(WalletPsbt {
+            recipients,
+            psbt: psbt.to_string(),
+            fee,
+            send_amount,
+            is_complete: finalized,
+        })
+    }
+
+    pub fn finalize_psbt(&self, psbts: Vec<String>) -> Result<String, Error> {
+        let mut psbts = psbts.iter().map(|psbt| Psbt::from_str(psbt));
+        let mut psbt = psbts
+            .next()
+            .ok_or(Error::Generic("no psbt provided".to_string()))?
+            .context("parse psbt")?;
+        for other in psbts {
+            psbt.combine(other.context("parse psbt")?)
+                .context("combine psbt")?;
+        }
+
+        let wallet = self.get_wallet()?;
+        // also adds our own signatures in case they are still missing
+        let finalized = wallet
+            .sign(&mut psbt, SignOptions::default())
+            .context("sign psbt")?;
+        if !finalized {
+            return Err(Error::Generic(MISSING_SIGNATURES.to_string()));
+        }
 
         let tx = psbt.extract_tx().context("extract tx")?;
         Ok(encode::serialize_hex(&tx))
@@ -276,27 +440,15 @@ impl Wallet {
         sat_per_vbyte: f64,
         send_all: bool,
     ) -> Result<WalletSendResult, Error> {
-        let fee_rate = parse_fee_rate(sat_per_vbyte);
-        let address = Address::from_str(&address)
-            .context("parse address")?
-            .require_network(self.network)
-            .context("require network")?
-            .script_pubkey();
+        let address = self.parse_address(address)?;
 
         let mut wallet = self.get_wallet()?;
-        let mut builder = wallet.build_tx();
-        builder.fee_rate(fee_rate);
-        if send_all {
-            builder.drain_wallet().drain_to(address.clone());
-        } else {
-            builder.add_recipient(address.clone(), Amount::from_sat(amount));
+        let (psbt, finalized) =
+            self.build_and_sign(&mut wallet, address.clone(), amount, sat_per_vbyte, send_all)?;
+        if !finalized {
+            return Err(Error::Generic(MISSING_SIGNATURES.to_string()));
         }
 
-        let mut psbt = builder.finish().context("finish tx")?;
-        wallet
-            .sign(&mut psbt, SignOptions::default())
-            .context("sign tx")?;
-
         let fee = psbt.fee().context("get fee")?.to_sat();
         let tx = psbt.extract_tx().context("extract tx")?;
 
@@ -356,13 +508,66 @@ impl Wallet {
                     ChainPosition::Unconfirmed { .. } => 0,
                 },
                 balance_change: details.balance_delta.to_sat(),
-                is_consolidation: false,
+                is_consolidation: details.sent.to_sat() > 0
+                    && details.tx.input.len() > 1
+                    && details
+                        .tx
+                        .output
+                        .iter()
+                        .all(|out| wallet.is_mine(out.script_pubkey.clone())),
             })
             .collect())
     }
 }
 
 impl Wallet {
+    fn recipients(&self, wallet: &BdkWallet, psbt: &Psbt) -> Vec<WalletTransactionOutput> {
+        psbt.unsigned_tx
+            .output
+            .iter()
+            .filter(|o| !wallet.is_mine(o.script_pubkey.clone()))
+            .map(|o| WalletTransactionOutput {
+                address: Address::from_script(&o.script_pubkey, &self.network)
+                    .map(|a| a.to_string())
+                    .unwrap_or_default(),
+                amount: o.value.to_sat(),
+                is_our_address: false,
+            })
+            .collect()
+    }
+
+    fn parse_address(&self, address: String) -> Result<ScriptBuf, Error> {
+        Ok(Address::from_str(&address)
+            .context("parse address")?
+            .require_network(self.network)
+            .context("require network")?
+            .script_pubkey())
+    }
+
+    fn build_and_sign(
+        &self,
+        wallet: &mut MutexGuard<'_, PersistedWallet<Connection>>,
+        address: ScriptBuf,
+        amount: u64,
+        sat_per_vbyte: f64,
+        send_all: bool,
+    ) -> Result<(Psbt, bool), Error> {
+        let fee_rate = parse_fee_rate(sat_per_vbyte);
+        let mut builder = wallet.build_tx();
+        builder.fee_rate(fee_rate);
+        if send_all {
+            builder.drain_wallet().drain_to(address.clone());
+        } else {
+            builder.add_recipient(address, Amount::from_sat(amount));
+        }
+
+        let mut psbt = builder.finish().context("finish tx")?;
+        let finalized = wallet
+            .sign(&mut psbt, SignOptions::default())
+            .context("sign tx")?;
+        Ok((psbt, finalized))
+    }
+
     fn get_wallet(&self) -> Result<MutexGuard<'_, PersistedWallet<Connection>>, Error> {
         self.inner.lock().map_err(|e| Error::Generic(e.to_string()))
     }
@@ -104,6 +104,7 @@ func main() {
 		getSwapCommand,
 		swapInfoStreamCommand,
 		listSwapsCommand,
+		listSwapPaymentsCommand,
 		getStatsCommand,
 
 		createSwapCommand,
@@ -111,12 +112,14 @@ func main() {
 		createChainSwapCommand,
 		refundSwapCommand,
 		claimSwapsCommand,
+		offerCommands,
 
 		autoSwapCommands,
 
 		walletCommands,
 		bakeMacaroonCommand,
 		tenantCommands,
+		policyCommands,
 		swapMnemonicCommands,
 
 		formatMacaroonCommand,
@@ -8,8 +8,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -94,6 +96,7 @@ Examples:
 			Name:  "to",
 			Usage: "Currency to swap to (BTC or LBTC). For submarine swaps, always BTC.",
 		},
+		lightningNodeFlag,
 	},
 }
 
@@ -174,6 +177,10 @@ func getSwapQuote(ctx *cli.Context) error {
 		return fmt.Errorf("specify either --send or --receive")
 	}
 
+	if node := ctx.String("node"); node != "" {
+		request.LightningNode = &node
+	}
+
 	if sendAmount > 0 {
 		request.Amount = &boltzrpc.GetSwapQuoteRequest_SendAmount{SendAmount: sendAmount}
 	} else {
@@ -200,6 +207,13 @@ func getSwapQuote(ctx *cli.Context) error {
 	fmt.Printf("  Boltz Fee:   %s (%.2f%%)\n", utils.Satoshis(quote.BoltzFee), quote.PairInfo.Fees.Percentage)
 	fmt.Printf("  Network Fee: %s\n", utils.Satoshis(quote.NetworkFee))
 	fmt.Printf("  Total Fee:   %s\n", utils.Satoshis(quote.BoltzFee+quote.NetworkFee))
+	if routingFee := quote.RoutingFee; routingFee != nil {
+		fmt.Printf("  Routing Fee: ~%s sats", utils.FormatMilliSat(int64(routingFee.FeeMsat)))
+		if routingFee.SuccessProbability != nil {
+			fmt.Printf(" (%.0f%% success probability)", *routingFee.SuccessProbability*100)
+		}
+		fmt.Println()
+	}
 	fmt.Println()
 	fmt.Printf("Limits: %s - %s\n", utils.Satoshis(quote.PairInfo.Limits.Minimal), utils.Satoshis(quote.PairInfo.Limits.Maximal))
 
@@ -285,6 +299,16 @@ var jsonFlag = &cli.BoolFlag{
 	Usage: "Prints the output as JSON",
 }
 
+var chainRuleFlag = &cli.StringFlag{
+	Name:  "rule",
+	Usage: "Name of the chain rule. Uses the default rule if not set",
+}
+
+var lightningNodeFlag = &cli.StringFlag{
+	Name:  "node",
+	Usage: "Name of the lightning node. Uses the main node if not set",
+}
+
 var showPrivateKeysFlag = &cli.BoolFlag{
 	Name:  "show-private-keys",
 	Usage: "Include swap private keys in JSON output",
@@ -452,6 +476,69 @@ func swapInfo(ctx *cli.Context) error {
 	return nil
 }
 
+var listSwapPaymentsCommand = &cli.Command{
+	Name:      "listswappayments",
+	Category:  "Infos",
+	Usage:     "Lists the lightning payments of swaps",
+	ArgsUsage: "[id]",
+	Description: "Lists every attempt to pay the invoice of a reverse swap and the settlements of the invoices of submarine swaps, the latest first.\n" +
+		"The route and the hop at which a payment failed are only included in the json output.",
+	Action: listSwapPayments,
+	Flags: []cli.Flag{
+		jsonFlag,
+		&cli.Uint64Flag{Name: "limit", Usage: "Maximum number of payments to return"},
+		&cli.Uint64Flag{Name: "offset", Usage: "Number of payments to skip"},
+	},
+}
+
+func listSwapPayments(ctx *cli.Context) error {
+	client := getClient(ctx)
+	request := &boltzrpc.ListSwapPaymentsRequest{}
+	if id := ctx.Args().First(); id != "" {
+		request.SwapId = &id
+	}
+	if ctx.IsSet("limit") {
+		limit := ctx.Uint64("limit")
+		request.Limit = &limit
+	}
+	if ctx.IsSet("offset") {
+		offset := ctx.Uint64("offset")
+		request.Offset = &offset
+	}
+	response, err := client.ListSwapPayments(request)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		printJson(response)
+		return nil
+	}
+	if len(response.Payments) == 0 {
+		fmt.Println("No payments found")
+		return nil
+	}
+
+	headerFmt := color.New(color.FgGreen, color.Underline).SprintfFunc()
+	columnFmt := color.New(color.FgYellow).SprintfFunc()
+
+	tbl := table.New("Swap ID", "Direction", "Attempt", "State", "Amount", "Fee (msat)", "Hops", "Error", "Created At")
+	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
+	for _, payment := range response.Payments {
+		direction := "outgoing"
+		if payment.Incoming {
+			direction = "incoming"
+		}
+		paymentError := payment.Error
+		if payment.FailureReason != "" {
+			paymentError = payment.FailureReason
+		}
+		tbl.AddRow(payment.SwapId, direction, payment.Attempt, payment.State, payment.Amount, optionalInt(payment.FeeMsat), len(payment.Hops), paymentError, parseDate(payment.CreatedAt))
+	}
+	tbl.Print()
+	return nil
+}
+
 var swapInfoStreamCommand = &cli.Command{
 	Name:      "swapinfostream",
 	Category:  "Infos",
@@ -616,6 +703,76 @@ var autoSwapCommands = &cli.Command{
 			Usage:  "List recommended swaps",
 			Action: listSwapRecommendations,
 		},
+		{
+			Name:  "history",
+			Usage: "Show past autoswap evaluation cycles",
+			Description: "Shows which swaps autoswap recommended, why they were dismissed and which swaps were created.\n" +
+				"Cycles in which no swap was recommended are hidden unless `--all` is set.",
+			Action: autoSwapHistory,
+			Flags: []cli.Flag{
+				jsonFlag,
+				&cli.StringFlag{
+					Name:  "swapper",
+					Usage: "Only show cycles of the given swapper (lightning or chain)",
+				},
+				&cli.DurationFlag{
+					Name:  "since",
+					Usage: "Only show cycles of the given time span, for example 12h",
+				},
+				&cli.BoolFlag{
+					Name:  "all",
+					Usage: "Include cycles in which no swap was recommended",
+				},
+				&cli.Uint64Flag{
+					Name:  "limit",
+					Usage: "Maximum number of cycles to show",
+					Value: 50,
+				},
+			},
+		},
+		{
+			Name:  "approvals",
+			Usage: "List recommendations which are waiting for approval",
+			Description: "Recommendations above the `approvalThreshold` of a config are not executed automatically.\n" +
+				"Instead, they are queued until they are approved with `approve` or rejected with `reject`, or until they expire.",
+			Action: autoSwapApprovals,
+			Flags:  []cli.Flag{jsonFlag},
+		},
+		{
+			Name:      "approve",
+			Usage:     "Approve a pending recommendation",
+			ArgsUsage: "id",
+			Action:    requireNArgs(1, autoSwapApprove),
+		},
+		{
+			Name:      "reject",
+			Usage:     "Reject a pending recommendation",
+			ArgsUsage: "id",
+			Action:    requireNArgs(1, autoSwapReject),
+		},
+		{
+			Name:  "snapshot",
+			Usage: "Print a snapshot of the current balances, fees and pairs as a single line of JSON",
+			Description: "Snapshots can be recorded periodically and replayed with the `simulate` command later on.\n" +
+				"Example: boltzcli autoswap snapshot >> snapshots.jsonl",
+			Action: autoSwapSnapshot,
+		},
+		{
+			Name:      "simulate",
+			Usage:     "Simulate autoswap against recorded snapshots",
+			ArgsUsage: "<snapshots file>",
+			Description: "Replays snapshots recorded with the `snapshot` command, one per line, through the autoswap decision logic " +
+				"and shows which swaps would have been executed. No swaps are created.\n" +
+				"Swaps are assumed to complete instantly at their estimated fee and their effect on balances is carried over to following snapshots.",
+			Action: autoSwapSimulate,
+			Flags: []cli.Flag{
+				jsonFlag,
+				&cli.StringFlag{
+					Name:  "config",
+					Usage: "Path to a JSON file containing the configuration to simulate. Defaults to the current configuration",
+				},
+			},
+		},
 		{
 			Name:  "setup",
 			Usage: "Setup autoswap interactively",
@@ -636,6 +793,7 @@ var autoSwapCommands = &cli.Command{
 					Action: func(context *cli.Context) error {
 						return autoSwapSetup(context, &chain)
 					},
+					Flags: []cli.Flag{chainRuleFlag},
 				},
 			},
 		},
@@ -683,6 +841,7 @@ var autoSwapCommands = &cli.Command{
 							Name:  "reset",
 							Usage: "Resets to the default configuration",
 						},
+						lightningNodeFlag,
 					},
 				},
 				{
@@ -714,6 +873,7 @@ var autoSwapCommands = &cli.Command{
 							Name:  "reset",
 							Usage: "Resets to the default configuration",
 						},
+						chainRuleFlag,
 					},
 				},
 			},
@@ -727,12 +887,14 @@ var autoSwapCommands = &cli.Command{
 					Action: func(ctx *cli.Context) error {
 						return enableAutoSwap(ctx, true, &lightning)
 					},
+					Flags: []cli.Flag{lightningNodeFlag},
 				},
 				{
 					Name: "chain",
 					Action: func(ctx *cli.Context) error {
 						return enableAutoSwap(ctx, true, &chain)
 					},
+					Flags: []cli.Flag{chainRuleFlag},
 				},
 			},
 		},
@@ -745,12 +907,14 @@ var autoSwapCommands = &cli.Command{
 					Action: func(ctx *cli.Context) error {
 						return disableAutoSwap(ctx, lightning)
 					},
+					Flags: []cli.Flag{lightningNodeFlag},
 				},
 				{
 					Name: "chain",
 					Action: func(ctx *cli.Context) error {
 						return disableAutoSwap(ctx, chain)
 					},
+					Flags: []cli.Flag{chainRuleFlag},
 				},
 			},
 		},
@@ -770,6 +934,223 @@ func listSwapRecommendations(ctx *cli.Context) error {
 	return nil
 }
 
+func autoSwapHistory(ctx *cli.Context) error {
+	limit := ctx.Uint64("limit")
+	request := &autoswaprpc.ListAutoSwapEventsRequest{
+		ExcludeEmpty: !ctx.Bool("all"),
+		Limit:        &limit,
+	}
+	if swapper := ctx.String("swapper"); swapper != "" {
+		value, ok := autoswaprpc.SwapperType_value[strings.ToUpper(swapper)]
+		if !ok {
+			return errors.New("invalid swapper")
+		}
+		swapperType := autoswaprpc.SwapperType(value)
+		request.Swapper = &swapperType
+	}
+	if since := ctx.Duration("since"); since != 0 {
+		timestamp := time.Now().Add(-since).Unix()
+		request.Since = &timestamp
+	}
+
+	client := getAutoSwapClient(ctx)
+	response, err := client.ListAutoSwapEvents(request)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		printJson(response)
+		return nil
+	}
+	if len(response.Events) == 0 {
+		fmt.Println("No autoswap events found")
+		return nil
+	}
+
+	headerFmt := color.New(color.FgGreen, color.Underline).SprintfFunc()
+	columnFmt := color.New(color.FgYellow).SprintfFunc()
+	tbl := table.New("Time", "Swapper", "Type", "Amount", "Fee Estimate", "Channel", "Outcome")
+	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
+
+	for _, event := range response.Events {
+		createdAt := parseDate(event.CreatedAt)
+		swapper := strings.ToLower(event.Swapper.String())
+		if len(event.Swaps) == 0 && event.Error == nil {
+			tbl.AddRow(createdAt, swapper, "", "", "", "", "no swap recommended")
+		}
+		for _, swap := range event.Swaps {
+			var channel string
+			if swap.ChannelId != nil {
+				channel = swap.ChannelId.Cln
+			}
+			outcome := "not executed"
+			if swap.SwapId != nil {
+				outcome = "created swap " + swap.GetSwapId()
+			} else if len(swap.DismissedReasons) > 0 {
+				outcome = "dismissed: " + strings.Join(swap.DismissedReasons, ", ")
+			}
+			tbl.AddRow(createdAt, swapper, swap.Type, swap.Amount, swap.FeeEstimate, channel, outcome)
+		}
+		if event.Error != nil {
+			tbl.AddRow(createdAt, swapper, "", "", "", "", "error: "+event.GetError())
+		}
+	}
+	tbl.Print()
+	return nil
+}
+
+func autoSwapApprovals(ctx *cli.Context) error {
+	client := getAutoSwapClient(ctx)
+	response, err := client.ListPendingApprovals()
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		printJson(response)
+		return nil
+	}
+	if len(response.Approvals) == 0 {
+		fmt.Println("No pending approvals")
+		return nil
+	}
+
+	headerFmt := color.New(color.FgGreen, color.Underline).SprintfFunc()
+	columnFmt := color.New(color.FgYellow).SprintfFunc()
+	tbl := table.New("ID", "Swapper", "Type", "Amount", "Fee Estimate", "Channel", "Created At", "Expires At")
+	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
+	for _, approval := range response.Approvals {
+		var channel string
+		if approval.ChannelId != nil {
+			channel = approval.ChannelId.Cln
+		}
+		swapper := strings.ToLower(approval.Swapper.String())
+		tbl.AddRow(approval.Id, swapper, approval.Type, approval.Amount, approval.FeeEstimate, channel, parseDate(approval.CreatedAt), parseDate(approval.ExpiresAt))
+	}
+	tbl.Print()
+	return nil
+}
+
+func autoSwapApprove(ctx *cli.Context) error {
+	client := getAutoSwapClient(ctx)
+	response, err := client.ApproveRecommendation(parseUint64(ctx.Args().First(), "id"))
+	if err != nil {
+		return err
+	}
+	fmt.Println("Created swap " + response.SwapId)
+	return nil
+}
+
+func autoSwapReject(ctx *cli.Context) error {
+	client := getAutoSwapClient(ctx)
+	if err := client.RejectRecommendation(parseUint64(ctx.Args().First(), "id")); err != nil {
+		return err
+	}
+	fmt.Println("Rejected recommendation")
+	return nil
+}
+
+func autoSwapSnapshot(ctx *cli.Context) error {
+	client := getAutoSwapClient(ctx)
+	snapshot, err := client.GetSnapshot()
+	if err != nil {
+		return err
+	}
+	marshalled, err := protojson.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(marshalled))
+	return nil
+}
+
+func readSnapshots(path string) ([]*autoswaprpc.Snapshot, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var snapshots []*autoswaprpc.Snapshot
+	for i, line := range strings.Split(string(raw), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		snapshot := &autoswaprpc.Snapshot{}
+		if err := protojson.Unmarshal([]byte(line), snapshot); err != nil {
+			return nil, fmt.Errorf("invalid snapshot in line %d: %w", i+1, err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func printSimulationResult(prefix string, result *autoswaprpc.SimulationResult) {
+	colorPrintln(yellowBold, prefix)
+	dismissed := make(map[string]int)
+	var executed int
+	for _, swap := range result.Swaps {
+		if len(swap.DismissedReasons) == 0 {
+			executed++
+			fmt.Printf(" - %s: %s swap of %s (fee %s)\n", parseDate(swap.Timestamp), swap.Type, utils.Satoshis(swap.Amount), utils.Satoshis(swap.FeeEstimate))
+		}
+		for _, reason := range swap.DismissedReasons {
+			dismissed[reason]++
+		}
+	}
+	fmt.Printf("Executed %d swaps for a total fee of %s\n", executed, utils.Satoshis(result.TotalFees))
+	for _, reason := range slices.Sorted(maps.Keys(dismissed)) {
+		fmt.Printf("Dismissed %d times: %s\n", dismissed[reason], reason)
+	}
+	for _, budget := range result.Budgets {
+		fmt.Printf("Budget from %s until %s: %s of %s remaining\n", parseDate(budget.StartDate), parseDate(budget.EndDate), utils.Satoshis(budget.Remaining), utils.Satoshis(budget.Total))
+	}
+	for _, err := range result.Errors {
+		fmt.Println("Error: " + err)
+	}
+}
+
+func autoSwapSimulate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return errors.New("snapshots file required")
+	}
+	snapshots, err := readSnapshots(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	request := &autoswaprpc.SimulateRequest{Snapshots: snapshots}
+	if path := ctx.String("config"); path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		request.Config = &autoswaprpc.Config{}
+		if err := protojson.Unmarshal(raw, request.Config); err != nil {
+			return fmt.Errorf("invalid config: %w", err)
+		}
+	}
+
+	client := getAutoSwapClient(ctx)
+	response, err := client.Simulate(request)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		printJson(response)
+		return nil
+	}
+	if response.Lightning != nil {
+		printSimulationResult("LN", response.Lightning)
+	}
+	for i, result := range response.Chain {
+		if i > 0 || response.Lightning != nil {
+			fmt.Println()
+		}
+		printSimulationResult("Chain", result)
+	}
+	return nil
+}
+
 func printStatus(prefix string, status *autoswaprpc.Status) {
 	prefix += ": "
 	if status.Running {
@@ -792,6 +1173,19 @@ func printStatus(prefix string, status *autoswaprpc.Status) {
 
 		printStats(budget.Stats)
 	}
+	if len(status.Budgets) > 0 {
+		colorPrintln(yellowBold, "Additional Budgets")
+	}
+	for _, budget := range status.Budgets {
+		var scope []string
+		if budget.Currency != nil {
+			scope = append(scope, budget.Currency.String())
+		}
+		if budget.Type != nil {
+			scope = append(scope, budget.Type.String())
+		}
+		fmt.Printf(" - %s: %s of %s remaining\n", strings.Join(scope, " "), utils.Satoshis(budget.Remaining), utils.Satoshis(budget.Total))
+	}
 }
 
 func printStats(stats *boltzrpc.SwapStats) {
@@ -821,6 +1215,14 @@ func autoSwapStatus(ctx *cli.Context) error {
 		if response.Chain != nil {
 			printStatus("Chain", response.Chain)
 		}
+		for _, status := range response.ChainRules {
+			fmt.Println()
+			printStatus("Chain ("+status.Name+")", status)
+		}
+		for _, status := range response.LightningNodes {
+			fmt.Println()
+			printStatus("LN ("+status.Name+")", status)
+		}
 	}
 
 	return nil
@@ -835,9 +1237,9 @@ func printConfig(ctx *cli.Context, autoSwapType *client.AutoSwapType, key string
 	if autoSwapType == nil {
 		message, err = client.GetConfig()
 	} else if *autoSwapType == lightning {
-		message, err = client.GetLightningConfig()
+		message, err = client.GetLightningNodeConfig(ctx.String("node"))
 	} else {
-		message, err = client.GetChainConfig()
+		message, err = client.GetChainRuleConfig(ctx.String("rule"))
 	}
 	if err != nil {
 		return err
@@ -1100,13 +1502,14 @@ func autoSwapChainSetup(ctx *cli.Context) error {
 	autoSwap := getAutoSwapClient(ctx)
 	client := getClient(ctx)
 
-	_, err := autoSwap.GetChainConfig()
+	rule := ctx.String("rule")
+	_, err := autoSwap.GetChainRuleConfig(rule)
 	if err == nil {
 		if !prompt("You already have an autoswap configuration. Do you want to reset it?") {
 			return nil
 		}
 	}
-	config := &autoswaprpc.ChainConfig{}
+	config := &autoswaprpc.ChainConfig{Name: rule}
 
 	fromWallet, err := askForWallet(ctx, "Select source wallet", nil, false)
 	if err != nil {
@@ -1192,8 +1595,14 @@ func autoSwapConfig(ctx *cli.Context, swapper *client.AutoSwapType) (err error)
 		}
 	}
 
+	rule := ctx.String("rule")
 	if ctx.Bool("reset") && swapper != nil {
-		if _, err := client.ResetConfig(*swapper); err != nil {
+		if *swapper == chain {
+			_, err = client.ResetChainRule(rule)
+		} else {
+			_, err = client.ResetLightningNode(ctx.String("node"))
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -1203,7 +1612,12 @@ func autoSwapConfig(ctx *cli.Context, swapper *client.AutoSwapType) (err error)
 		key = ctx.Args().First()
 		if ctx.NArg() == 2 {
 			args := ctx.Args()
-			if _, err := client.SetConfigValue(*swapper, args.Get(0), args.Get(1)); err != nil {
+			if *swapper == chain {
+				_, err = client.SetChainRuleConfigValue(rule, args.Get(0), args.Get(1))
+			} else {
+				_, err = client.SetLightningNodeConfigValue(ctx.String("node"), args.Get(0), args.Get(1))
+			}
+			if err != nil {
 				return err
 			}
 		}
@@ -1239,12 +1653,12 @@ func enableAutoSwap(ctx *cli.Context, showConfig bool, swapper *client.AutoSwapT
 	}
 
 	if swapper == nil || *swapper == lightning {
-		if _, err := client.SetConfigValue(lightning, "enabled", true); err != nil {
+		if _, err := client.SetLightningNodeConfigValue(ctx.String("node"), "enabled", true); err != nil {
 			return err
 		}
 	}
 	if swapper == nil || *swapper == chain {
-		if _, err := client.SetConfigValue(chain, "enabled", true); err != nil {
+		if _, err := client.SetChainRuleConfigValue(ctx.String("rule"), "enabled", true); err != nil {
 			return err
 		}
 	}
@@ -1253,7 +1667,11 @@ func enableAutoSwap(ctx *cli.Context, showConfig bool, swapper *client.AutoSwapT
 
 func disableAutoSwap(ctx *cli.Context, autoSwapType client.AutoSwapType) error {
 	client := getAutoSwapClient(ctx)
-	_, err := client.SetConfigValue(autoSwapType, "enabled", false)
+	if autoSwapType == chain {
+		_, err := client.SetChainRuleConfigValue(ctx.String("rule"), "enabled", false)
+		return err
+	}
+	_, err := client.SetLightningNodeConfigValue(ctx.String("node"), "enabled", false)
 	return err
 }
 
@@ -1294,6 +1712,15 @@ var createSwapCommand = &cli.Command{
 			Name:  "invoice",
 			Usage: "Invoice which should be paid",
 		},
+		&cli.BoolFlag{
+			Name:  "route-hints",
+			Usage: "Include route hints for private channels in the invoice created by the daemon",
+		},
+		&cli.StringFlag{
+			Name:  "splice-channel",
+			Usage: "Fund the swap by splicing out of this channel of the lightning node instead of a wallet. Only supported by CLN",
+		},
+		lightningNodeFlag,
 	},
 }
 
@@ -1314,6 +1741,7 @@ func createSwap(ctx *cli.Context) error {
 	invoice := ctx.String("invoice")
 	refundAddress := ctx.String("refund")
 	externalPay := ctx.Bool("external-pay")
+	spliceChannel := ctx.String("splice-channel")
 	var amount uint64
 	if rawAmount := ctx.Args().Get(1); rawAmount != "" {
 		amount = parseUint64(rawAmount, "amount")
@@ -1340,14 +1768,24 @@ func createSwap(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	swap, err := client.CreateSwap(&boltzrpc.CreateSwapRequest{
+	request := &boltzrpc.CreateSwapRequest{
 		Amount:           amount,
 		Pair:             pair,
 		RefundAddress:    &refundAddress,
-		SendFromInternal: !externalPay,
+		SendFromInternal: !externalPay && spliceChannel == "",
 		WalletId:         walletId,
 		Invoice:          &invoice,
-	})
+	}
+	if node := ctx.String("node"); node != "" {
+		request.LightningNode = &node
+	}
+	if spliceChannel != "" {
+		request.SpliceChannelId = &spliceChannel
+	}
+	if routeHints := ctx.Bool("route-hints"); routeHints {
+		request.RouteHints = &routeHints
+	}
+	swap, err := client.CreateSwap(request)
 	if err != nil {
 		return err
 	}
@@ -1634,6 +2072,15 @@ var createReverseSwapCommand = &cli.Command{
 			Name: "chan-id",
 		},
 		routingFeeLimitFlag,
+		&cli.Uint64Flag{
+			Name:  "routing-fee-limit-ceiling-ppm",
+			Usage: "If paying the invoice fails, it is retried with a routing fee limit escalating up to this ceiling in ppm",
+		},
+		&cli.UintFlag{
+			Name:  "max-parts",
+			Usage: "Maximal number of parts the payment is split into (LND only)",
+		},
+		lightningNodeFlag,
 	},
 }
 
@@ -1651,6 +2098,85 @@ func parseCurrency(currency string) (boltzrpc.Currency, error) {
 	return boltzrpc.Currency_BTC, fmt.Errorf("invalid currency: %s, allowed values: BTC, LBTC", currency)
 }
 
+var offerCommands = &cli.Command{
+	Name:     "offer",
+	Category: "Swaps",
+	Usage:    "Manage BOLT12 offers which are swapped onchain",
+	Description: "Offers are created by the lightning node. Every payment to an offer is swapped into the wallet of the offer\n" +
+		"with a reverse swap which is paid by the node that received the payment.",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "create",
+			Usage:     "Create a new offer",
+			ArgsUsage: "wallet [description]",
+			Flags: []cli.Flag{
+				&cli.Uint64Flag{Name: "amount", Usage: "Amount of the offer in satoshis. The payer can choose the amount if not set"},
+				lightningNodeFlag,
+			},
+			Action: requireNArgs(1, func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				walletId, err := getWalletId(ctx, ctx.Args().First())
+				if err != nil {
+					return err
+				}
+				request := &boltzrpc.CreateOfferRequest{
+					WalletId:    *walletId,
+					Description: ctx.Args().Get(1),
+				}
+				if ctx.IsSet("amount") {
+					amount := ctx.Uint64("amount")
+					request.Amount = &amount
+				}
+				if ctx.IsSet("node") {
+					node := ctx.String("node")
+					request.LightningNode = &node
+				}
+				offer, err := client.CreateOffer(request)
+				if err != nil {
+					return err
+				}
+				printJson(offer)
+				return nil
+			}),
+		},
+		{
+			Name:  "list",
+			Usage: "List offers and their payments",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{Name: "active", Usage: "Only show offers which can still be paid"},
+			},
+			Action: func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				request := &boltzrpc.ListOffersRequest{}
+				if ctx.Bool("active") {
+					active := true
+					request.Active = &active
+				}
+				response, err := client.ListOffers(request)
+				if err != nil {
+					return err
+				}
+				printJson(response)
+				return nil
+			},
+		},
+		{
+			Name:      "disable",
+			Usage:     "Disable an offer so that it can not be paid anymore",
+			ArgsUsage: "id",
+			Action: requireNArgs(1, func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				offer, err := client.DisableOffer(ctx.Args().First())
+				if err != nil {
+					return err
+				}
+				printJson(offer)
+				return nil
+			}),
+		},
+	},
+}
+
 func getWalletId(ctx *cli.Context, name string) (*uint64, error) {
 	if name != "" {
 		client := getClient(ctx)
@@ -1711,11 +2237,22 @@ func createReverseSwap(ctx *cli.Context) error {
 	if externalPay := ctx.Bool("external-pay"); externalPay {
 		request.ExternalPay = &externalPay
 	}
+	if node := ctx.String("node"); node != "" {
+		request.LightningNode = &node
+	}
 
 	if ctx.IsSet(routingFeeLimitFlag.Name) {
 		routingFeeLimitPpm := ctx.Uint64(routingFeeLimitFlag.Name)
 		request.RoutingFeeLimitPpm = &routingFeeLimitPpm
 	}
+	if ctx.IsSet("routing-fee-limit-ceiling-ppm") {
+		ceilingPpm := ctx.Uint64("routing-fee-limit-ceiling-ppm")
+		request.RoutingFeeLimitCeilingPpm = &ceilingPpm
+	}
+	if ctx.IsSet("max-parts") {
+		maxParts := uint32(ctx.Uint("max-parts"))
+		request.MaxParts = &maxParts
+	}
 
 	response, err := client.CreateReverseSwap(request)
 	if err != nil {
@@ -1788,6 +2325,13 @@ var walletCommands = &cli.Command{
 			Action:    requireNArgs(1, listTransactions),
 			Flags:     []cli.Flag{jsonFlag, &cli.BoolFlag{Name: "exclude-swap-related", Usage: "Exclude swap related transactions"}},
 		},
+		{
+			Name:      "subscribe",
+			ArgsUsage: "[name]",
+			Usage:     "Streams new, confirmed and replaced transactions of a wallet or of all wallets",
+			Action:    subscribeTransactions,
+			Flags:     []cli.Flag{&cli.BoolFlag{Name: "exclude-swap-related", Usage: "Exclude swap related transactions"}},
+		},
 		{
 			Name:   "bumpfee",
 			Usage:  "Bump the fee of a transaction",
@@ -1798,6 +2342,18 @@ var walletCommands = &cli.Command{
 				&cli.Float64Flag{Name: "fee-rate", Usage: "Fee rate in sat/vbyte. Will be queried by the configured provider if not set."},
 			},
 		},
+		{
+			Name:      "consolidate",
+			Usage:     "Consolidate the utxos of a wallet",
+			ArgsUsage: "name",
+			Description: "Consolidates the largest confirmed utxos of a wallet into a single output of the same wallet.\n" +
+				"This reduces the fees of future transactions and is best done while fee rates are low.",
+			Action: requireNArgs(1, consolidateWallet),
+			Flags: []cli.Flag{
+				&cli.Uint64Flag{Name: "max-utxos", Usage: "Maximum number of utxos to consolidate. Defaults to all confirmed utxos"},
+				&cli.Float64Flag{Name: "sat-per-vbyte", Usage: "Fee rate in sat/vbyte. Will be queried by the configured provider if not set."},
+			},
+		},
 		{
 			Name:      "remove",
 			Usage:     "Remove a wallet",
@@ -1824,6 +2380,39 @@ var walletCommands = &cli.Command{
 			ArgsUsage: "name",
 			Action:    requireNArgs(1, walletReceive),
 		},
+		{
+			Name:  "psbt",
+			Usage: "Partially signed transactions for wallets which require signatures of other cosigners",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "create",
+					Usage:     "Create a psbt spending from a wallet, signed with the keys held by the daemon",
+					ArgsUsage: "name destination amount",
+					Flags: []cli.Flag{
+						&cli.Float64Flag{
+							Name: "sat-per-vbyte",
+						},
+						&cli.BoolFlag{
+							Name: "sweep",
+						},
+					},
+					Action: requireNArgs(2, walletCreatePsbt),
+				},
+				{
+					Name:      "sign",
+					Usage:     "Sign a psbt with the keys held by the daemon",
+					ArgsUsage: "name psbt",
+					Action:    requireNArgs(2, walletSignPsbt),
+				},
+				{
+					Name:        "finalize",
+					Usage:       "Combine, finalize and broadcast psbts",
+					ArgsUsage:   "name psbt [psbt...]",
+					Description: "Combines the signatures of all given psbts, finalizes the transaction and broadcasts it.",
+					Action:      requireNArgs(2, walletFinalizePsbt),
+				},
+			},
+		},
 	},
 }
 
@@ -2001,7 +2590,7 @@ func importWallet(ctx *cli.Context, params *boltzrpc.WalletParams, readonly bool
 	if readonly {
 		prompt := &survey.Select{
 			Message: "Which import type do you want to use?",
-			Options: []string{"mnemonic", "core descriptor"},
+			Options: []string{"mnemonic", "core descriptor", "multisig descriptor"},
 			Default: "mnemonic",
 		}
 		if err := survey.AskOne(prompt, &importType); err != nil {
@@ -2022,6 +2611,19 @@ func importWallet(ctx *cli.Context, params *boltzrpc.WalletParams, readonly bool
 		credentials.Mnemonic = &mnemonic
 	case "core descriptor":
 		credentials.CoreDescriptor = &mnemonic
+	case "multisig descriptor":
+		descriptor := mnemonic
+		credentials.CoreDescriptor = &descriptor
+		mnemonic = ""
+		prompt := &survey.Input{
+			Message: "Please type the mnemonic of the key held by the daemon (leave empty for watch-only)",
+		}
+		if err := survey.AskOne(prompt, &mnemonic); err != nil {
+			return nil, err
+		}
+		if mnemonic != "" {
+			credentials.Mnemonic = &mnemonic
+		}
 	}
 
 	params.Password, err = askPassword(ctx, true)
@@ -2094,6 +2696,88 @@ func walletSend(ctx *cli.Context) error {
 	return nil
 }
 
+func consolidateWallet(ctx *cli.Context) error {
+	client := getClient(ctx)
+	walletId, err := getWalletId(ctx, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	request := &boltzrpc.ConsolidateWalletRequest{Id: *walletId}
+	if maxUtxos := ctx.Uint64("max-utxos"); maxUtxos != 0 {
+		request.MaxUtxos = &maxUtxos
+	}
+	if satPerVbyte := ctx.Float64("sat-per-vbyte"); satPerVbyte != 0 {
+		request.SatPerVbyte = &satPerVbyte
+	}
+	response, err := client.ConsolidateWallet(request)
+	if err != nil {
+		return err
+	}
+	printJson(response)
+	return nil
+}
+
+func walletCreatePsbt(ctx *cli.Context) error {
+	client := getClient(ctx)
+	walletId, err := getWalletId(ctx, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	request := &boltzrpc.WalletSendRequest{
+		Id:      *walletId,
+		Address: ctx.Args().Get(1),
+	}
+
+	if ctx.NArg() >= 3 {
+		request.Amount = parseUint64(ctx.Args().Get(2), "amount")
+	} else if ctx.Bool("sweep") {
+		sweep := true
+		request.SendAll = &sweep
+	} else {
+		return errors.New("amount or sweep flag is required")
+	}
+
+	if satPerVbyte := ctx.Float64("sat-per-vbyte"); satPerVbyte != 0 {
+		request.SatPerVbyte = &satPerVbyte
+	}
+
+	response, err := client.CreateWalletPsbt(request)
+	if err != nil {
+		return err
+	}
+	printJson(response)
+	return nil
+}
+
+func walletSignPsbt(ctx *cli.Context) error {
+	client := getClient(ctx)
+	walletId, err := getWalletId(ctx, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	response, err := client.SignWalletPsbt(*walletId, ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	printJson(response)
+	return nil
+}
+
+func walletFinalizePsbt(ctx *cli.Context) error {
+	client := getClient(ctx)
+	walletId, err := getWalletId(ctx, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	response, err := client.FinalizeWalletPsbt(*walletId, ctx.Args().Tail())
+	if err != nil {
+		return err
+	}
+	printJson(response)
+	return nil
+}
+
 func walletReceive(ctx *cli.Context) error {
 	client := getClient(ctx)
 	walletId, err := getWalletId(ctx, ctx.Args().First())
@@ -2185,6 +2869,32 @@ func listTransactions(ctx *cli.Context) error {
 	return nil
 }
 
+func subscribeTransactions(ctx *cli.Context) error {
+	client := getClient(ctx)
+	walletId, err := getWalletId(ctx, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	exclude := ctx.Bool("exclude-swap-related")
+	stream, err := client.SubscribeWalletTransactions(&boltzrpc.SubscribeWalletTransactionsRequest{
+		Id:                 walletId,
+		ExcludeSwapRelated: &exclude,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		printJson(event)
+	}
+}
+
 func bumpFee(ctx *cli.Context) error {
 	client := getClient(ctx)
 	request := &boltzrpc.BumpTransactionRequest{}
@@ -2408,6 +3118,187 @@ var tenantCommands = &cli.Command{
 	},
 }
 
+func getPolicyTenantId(ctx *cli.Context) (*uint64, error) {
+	if tenantName := ctx.String("tenant"); tenantName != "" {
+		client := getClient(ctx)
+		tenant, err := client.GetTenant(tenantName)
+		if err != nil {
+			return nil, err
+		}
+		return &tenant.Id, nil
+	}
+	return nil, nil
+}
+
+var policyTenantFlag = &cli.StringFlag{
+	Name:  "tenant",
+	Usage: "Name of the tenant. Defaults to the tenant of the macaroon",
+}
+
+var policyWalletFlag = &cli.StringFlag{
+	Name:  "wallet",
+	Usage: "Name of the wallet the policy applies to. Applies to all spendings of the tenant if not set",
+}
+
+var policyCommands = &cli.Command{
+	Name:     "policy",
+	Category: "Tenant",
+	Usage:    "Manage spending policies and address allowlists",
+	Description: "Spending policies limit how funds of a tenant can be sent by wallet sends, swaps paid from internal wallets\n" +
+		"and reverse swaps paid by the lightning node.",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "set",
+			Usage: "Create or replace a spending policy",
+			Flags: []cli.Flag{
+				policyTenantFlag,
+				policyWalletFlag,
+				&cli.Uint64Flag{Name: "daily-limit", Usage: "Maximum amount in satoshis which can be spent within 24 hours"},
+				&cli.Uint64Flag{Name: "weekly-limit", Usage: "Maximum amount in satoshis which can be spent within 7 days"},
+				&cli.Uint64Flag{Name: "max-amount", Usage: "Maximum amount in satoshis of a single spending"},
+				&cli.BoolFlag{Name: "require-allowlist", Usage: "Only allow sending to addresses of the allowlist"},
+				&cli.DurationFlag{Name: "address-cooldown", Usage: "Time which has to pass before a new address can be used"},
+			},
+			Action: func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				tenantId, err := getPolicyTenantId(ctx)
+				if err != nil {
+					return err
+				}
+				request := &boltzrpc.SpendingPolicy{
+					TenantId:         tenantId,
+					RequireAllowlist: ctx.Bool("require-allowlist"),
+					AddressCooldown:  uint64(ctx.Duration("address-cooldown").Seconds()),
+				}
+				if ctx.IsSet("wallet") {
+					request.WalletId, err = getWalletId(ctx, ctx.String("wallet"))
+					if err != nil {
+						return err
+					}
+				}
+				for name, limit := range map[string]**uint64{
+					"daily-limit":  &request.DailyLimit,
+					"weekly-limit": &request.WeeklyLimit,
+					"max-amount":   &request.MaxAmount,
+				} {
+					if ctx.IsSet(name) {
+						value := ctx.Uint64(name)
+						*limit = &value
+					}
+				}
+				policy, err := client.SetSpendingPolicy(request)
+				if err != nil {
+					return err
+				}
+				printJson(policy)
+				return nil
+			},
+		},
+		{
+			Name:  "list",
+			Usage: "List spending policies",
+			Flags: []cli.Flag{policyTenantFlag},
+			Action: func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				tenantId, err := getPolicyTenantId(ctx)
+				if err != nil {
+					return err
+				}
+				response, err := client.ListSpendingPolicies(tenantId)
+				if err != nil {
+					return err
+				}
+				printJson(response)
+				return nil
+			},
+		},
+		{
+			Name:  "remove",
+			Usage: "Remove a spending policy",
+			Flags: []cli.Flag{policyTenantFlag, policyWalletFlag},
+			Action: func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				tenantId, err := getPolicyTenantId(ctx)
+				if err != nil {
+					return err
+				}
+				request := &boltzrpc.RemoveSpendingPolicyRequest{TenantId: tenantId}
+				if ctx.IsSet("wallet") {
+					request.WalletId, err = getWalletId(ctx, ctx.String("wallet"))
+					if err != nil {
+						return err
+					}
+				}
+				if err := client.RemoveSpendingPolicy(request); err != nil {
+					return err
+				}
+				fmt.Println("Spending policy removed")
+				return nil
+			},
+		},
+		{
+			Name:      "allow",
+			Usage:     "Add an address to the allowlist",
+			ArgsUsage: "address [label]",
+			Flags:     []cli.Flag{policyTenantFlag},
+			Action: requireNArgs(1, func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				tenantId, err := getPolicyTenantId(ctx)
+				if err != nil {
+					return err
+				}
+				request := &boltzrpc.AddAllowedAddressRequest{Address: ctx.Args().First(), TenantId: tenantId}
+				if label := ctx.Args().Get(1); label != "" {
+					request.Label = &label
+				}
+				address, err := client.AddAllowedAddress(request)
+				if err != nil {
+					return err
+				}
+				printJson(address)
+				return nil
+			}),
+		},
+		{
+			Name:  "allowlist",
+			Usage: "Show the address allowlist",
+			Flags: []cli.Flag{policyTenantFlag},
+			Action: func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				tenantId, err := getPolicyTenantId(ctx)
+				if err != nil {
+					return err
+				}
+				response, err := client.ListAllowedAddresses(tenantId)
+				if err != nil {
+					return err
+				}
+				printJson(response)
+				return nil
+			},
+		},
+		{
+			Name:      "disallow",
+			Usage:     "Remove an address from the allowlist",
+			ArgsUsage: "address",
+			Flags:     []cli.Flag{policyTenantFlag},
+			Action: requireNArgs(1, func(ctx *cli.Context) error {
+				client := getClient(ctx)
+				tenantId, err := getPolicyTenantId(ctx)
+				if err != nil {
+					return err
+				}
+				request := &boltzrpc.RemoveAllowedAddressRequest{Address: ctx.Args().First(), TenantId: tenantId}
+				if err := client.RemoveAllowedAddress(request); err != nil {
+					return err
+				}
+				fmt.Println("Address removed from allowlist")
+				return nil
+			}),
+		},
+	},
+}
+
 var swapMnemonicCommands = &cli.Command{
 	Name: "swapmnemonic",
 	Description: "The swap mnemonic is used to derive the private keys for each swap.\n" +
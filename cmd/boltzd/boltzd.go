@@ -1,8 +1,10 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"github.com/BoltzExchange/boltz-client/v2/internal/build"
+	"github.com/BoltzExchange/boltz-client/v2/internal/clnplugin"
 	"github.com/BoltzExchange/boltz-client/v2/internal/config"
 	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
 	"github.com/BoltzExchange/boltz-client/v2/internal/rpcserver"
@@ -24,12 +26,42 @@ func main() {
 		os.Exit(1)
 	}
 
+	sigc := make(chan os.Signal, 1)
+
+	var plugin *clnplugin.Plugin
+	var pluginConfig clnplugin.Config
+	if clnplugin.IsPlugin() {
+		// stdout is reserved for the plugin protocol of lightningd
+		plugin = clnplugin.New(os.Stdin, os.Stdout, defaultDataDir)
+		os.Stdout = os.Stderr
+		logger.SetConsoleOutput(os.Stderr)
+
+		go func() {
+			err := plugin.Run()
+			if !errors.Is(err, clnplugin.ErrStdinClosed) {
+				logger.Error("CLN plugin failed: " + err.Error())
+			}
+			sigc <- syscall.SIGTERM
+		}()
+
+		select {
+		case pluginConfig = <-plugin.Init():
+		case <-sigc:
+			os.Exit(0)
+		}
+		defaultDataDir = utils.ExpandHomeDir(pluginConfig.DataDir)
+	}
+
 	cfg, err := config.LoadConfig(defaultDataDir)
 	if err != nil {
 		fmt.Println("Could not load config: " + err.Error())
 		os.Exit(1)
 	}
 
+	if plugin != nil {
+		pluginConfig.Apply(cfg)
+	}
+
 	logger.Init(cfg.Log)
 	logger.Infof("Starting version %s compiled with %s", build.GetVersion(), runtime.Version())
 
@@ -50,7 +82,13 @@ func main() {
 	}
 	errChannel := rpc.Start()
 
-	sigc := make(chan os.Signal, 1)
+	if plugin != nil {
+		if err := plugin.Connect(rpc); err != nil {
+			logger.Fatalf("Could not connect CLN plugin: %v", err)
+		}
+		logger.Info("Running as CLN plugin")
+	}
+
 	signal.Notify(sigc, os.Interrupt, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGHUP)
 	go func() {
 		<-sigc
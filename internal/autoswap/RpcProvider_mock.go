@@ -40,21 +40,102 @@ func (_m *MockRpcProvider) EXPECT() *MockRpcProvider_Expecter {
 	return &MockRpcProvider_Expecter{mock: &_m.Mock}
 }
 
+// AutoWalletSend provides a mock function for the type MockRpcProvider
+func (_mock *MockRpcProvider) AutoWalletSend(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error) {
+	ret := _mock.Called(tenant, request, toWalletId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AutoWalletSend")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.WalletSendRequest, *database.Id) (string, error)); ok {
+		return returnFunc(tenant, request, toWalletId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.WalletSendRequest, *database.Id) string); ok {
+		r0 = returnFunc(tenant, request, toWalletId)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*database.Tenant, *boltzrpc.WalletSendRequest, *database.Id) error); ok {
+		r1 = returnFunc(tenant, request, toWalletId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRpcProvider_AutoWalletSend_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AutoWalletSend'
+type MockRpcProvider_AutoWalletSend_Call struct {
+	*mock.Call
+}
+
+// AutoWalletSend is a helper method to define mock.On call
+//   - tenant *database.Tenant
+//   - request *boltzrpc.WalletSendRequest
+//   - toWalletId *database.Id
+func (_e *MockRpcProvider_Expecter) AutoWalletSend(tenant interface{}, request interface{}, toWalletId interface{}) *MockRpcProvider_AutoWalletSend_Call {
+	return &MockRpcProvider_AutoWalletSend_Call{Call: _e.mock.On("AutoWalletSend", tenant, request, toWalletId)}
+}
+
+func (_c *MockRpcProvider_AutoWalletSend_Call) Run(run func(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id)) *MockRpcProvider_AutoWalletSend_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *database.Tenant
+		if args[0] != nil {
+			arg0 = args[0].(*database.Tenant)
+		}
+		var arg1 *boltzrpc.WalletSendRequest
+		if args[1] != nil {
+			arg1 = args[1].(*boltzrpc.WalletSendRequest)
+		}
+		var arg2 *database.Id
+		if args[2] != nil {
+			arg2 = args[2].(*database.Id)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRpcProvider_AutoWalletSend_Call) Return(txId string, err error) *MockRpcProvider_AutoWalletSend_Call {
+	_c.Call.Return(txId, err)
+	return _c
+}
+
+func (_c *MockRpcProvider_AutoWalletSend_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error)) *MockRpcProvider_AutoWalletSend_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAutoChainSwap provides a mock function for the type MockRpcProvider
-func (_mock *MockRpcProvider) CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest) error {
-	ret := _mock.Called(tenant, request)
+func (_mock *MockRpcProvider) CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin) (string, error) {
+	ret := _mock.Called(tenant, request, origin)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAutoChainSwap")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateChainSwapRequest) error); ok {
-		r0 = returnFunc(tenant, request)
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateChainSwapRequest, ChainSwapOrigin) (string, error)); ok {
+		return returnFunc(tenant, request, origin)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateChainSwapRequest, ChainSwapOrigin) string); ok {
+		r0 = returnFunc(tenant, request, origin)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*database.Tenant, *boltzrpc.CreateChainSwapRequest, ChainSwapOrigin) error); ok {
+		r1 = returnFunc(tenant, request, origin)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
-	return r0
+	return r0, r1
 }
 
 // MockRpcProvider_CreateAutoChainSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAutoChainSwap'
@@ -65,11 +146,12 @@ type MockRpcProvider_CreateAutoChainSwap_Call struct {
 // CreateAutoChainSwap is a helper method to define mock.On call
 //   - tenant *database.Tenant
 //   - request *boltzrpc.CreateChainSwapRequest
-func (_e *MockRpcProvider_Expecter) CreateAutoChainSwap(tenant interface{}, request interface{}) *MockRpcProvider_CreateAutoChainSwap_Call {
-	return &MockRpcProvider_CreateAutoChainSwap_Call{Call: _e.mock.On("CreateAutoChainSwap", tenant, request)}
+//   - origin ChainSwapOrigin
+func (_e *MockRpcProvider_Expecter) CreateAutoChainSwap(tenant interface{}, request interface{}, origin interface{}) *MockRpcProvider_CreateAutoChainSwap_Call {
+	return &MockRpcProvider_CreateAutoChainSwap_Call{Call: _e.mock.On("CreateAutoChainSwap", tenant, request, origin)}
 }
 
-func (_c *MockRpcProvider_CreateAutoChainSwap_Call) Run(run func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest)) *MockRpcProvider_CreateAutoChainSwap_Call {
+func (_c *MockRpcProvider_CreateAutoChainSwap_Call) Run(run func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin)) *MockRpcProvider_CreateAutoChainSwap_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 *database.Tenant
 		if args[0] != nil {
@@ -79,39 +161,53 @@ func (_c *MockRpcProvider_CreateAutoChainSwap_Call) Run(run func(tenant *databas
 		if args[1] != nil {
 			arg1 = args[1].(*boltzrpc.CreateChainSwapRequest)
 		}
+		var arg2 ChainSwapOrigin
+		if args[2] != nil {
+			arg2 = args[2].(ChainSwapOrigin)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockRpcProvider_CreateAutoChainSwap_Call) Return(err error) *MockRpcProvider_CreateAutoChainSwap_Call {
-	_c.Call.Return(err)
+func (_c *MockRpcProvider_CreateAutoChainSwap_Call) Return(s string, err error) *MockRpcProvider_CreateAutoChainSwap_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockRpcProvider_CreateAutoChainSwap_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest) error) *MockRpcProvider_CreateAutoChainSwap_Call {
+func (_c *MockRpcProvider_CreateAutoChainSwap_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin) (string, error)) *MockRpcProvider_CreateAutoChainSwap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // CreateAutoReverseSwap provides a mock function for the type MockRpcProvider
-func (_mock *MockRpcProvider) CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) error {
+func (_mock *MockRpcProvider) CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
 	ret := _mock.Called(tenant, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAutoReverseSwap")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateReverseSwapRequest) error); ok {
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateReverseSwapRequest) (string, error)); ok {
+		return returnFunc(tenant, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateReverseSwapRequest) string); ok {
 		r0 = returnFunc(tenant, request)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*database.Tenant, *boltzrpc.CreateReverseSwapRequest) error); ok {
+		r1 = returnFunc(tenant, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockRpcProvider_CreateAutoReverseSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAutoReverseSwap'
@@ -144,31 +240,40 @@ func (_c *MockRpcProvider_CreateAutoReverseSwap_Call) Run(run func(tenant *datab
 	return _c
 }
 
-func (_c *MockRpcProvider_CreateAutoReverseSwap_Call) Return(err error) *MockRpcProvider_CreateAutoReverseSwap_Call {
-	_c.Call.Return(err)
+func (_c *MockRpcProvider_CreateAutoReverseSwap_Call) Return(s string, err error) *MockRpcProvider_CreateAutoReverseSwap_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockRpcProvider_CreateAutoReverseSwap_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) error) *MockRpcProvider_CreateAutoReverseSwap_Call {
+func (_c *MockRpcProvider_CreateAutoReverseSwap_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error)) *MockRpcProvider_CreateAutoReverseSwap_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // CreateAutoSwap provides a mock function for the type MockRpcProvider
-func (_mock *MockRpcProvider) CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) error {
+func (_mock *MockRpcProvider) CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) (string, error) {
 	ret := _mock.Called(tenant, request)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAutoSwap")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateSwapRequest) error); ok {
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateSwapRequest) (string, error)); ok {
+		return returnFunc(tenant, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*database.Tenant, *boltzrpc.CreateSwapRequest) string); ok {
 		r0 = returnFunc(tenant, request)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(*database.Tenant, *boltzrpc.CreateSwapRequest) error); ok {
+		r1 = returnFunc(tenant, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockRpcProvider_CreateAutoSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAutoSwap'
@@ -201,12 +306,72 @@ func (_c *MockRpcProvider_CreateAutoSwap_Call) Run(run func(tenant *database.Ten
 	return _c
 }
 
-func (_c *MockRpcProvider_CreateAutoSwap_Call) Return(err error) *MockRpcProvider_CreateAutoSwap_Call {
-	_c.Call.Return(err)
+func (_c *MockRpcProvider_CreateAutoSwap_Call) Return(s string, err error) *MockRpcProvider_CreateAutoSwap_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockRpcProvider_CreateAutoSwap_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) (string, error)) *MockRpcProvider_CreateAutoSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EstimateFee provides a mock function for the type MockRpcProvider
+func (_mock *MockRpcProvider) EstimateFee(currency boltz.Currency) (float64, error) {
+	ret := _mock.Called(currency)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EstimateFee")
+	}
+
+	var r0 float64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(boltz.Currency) (float64, error)); ok {
+		return returnFunc(currency)
+	}
+	if returnFunc, ok := ret.Get(0).(func(boltz.Currency) float64); ok {
+		r0 = returnFunc(currency)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(boltz.Currency) error); ok {
+		r1 = returnFunc(currency)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRpcProvider_EstimateFee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EstimateFee'
+type MockRpcProvider_EstimateFee_Call struct {
+	*mock.Call
+}
+
+// EstimateFee is a helper method to define mock.On call
+//   - currency boltz.Currency
+func (_e *MockRpcProvider_Expecter) EstimateFee(currency interface{}) *MockRpcProvider_EstimateFee_Call {
+	return &MockRpcProvider_EstimateFee_Call{Call: _e.mock.On("EstimateFee", currency)}
+}
+
+func (_c *MockRpcProvider_EstimateFee_Call) Run(run func(currency boltz.Currency)) *MockRpcProvider_EstimateFee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 boltz.Currency
+		if args[0] != nil {
+			arg0 = args[0].(boltz.Currency)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRpcProvider_EstimateFee_Call) Return(f float64, err error) *MockRpcProvider_EstimateFee_Call {
+	_c.Call.Return(f, err)
 	return _c
 }
 
-func (_c *MockRpcProvider_CreateAutoSwap_Call) RunAndReturn(run func(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) error) *MockRpcProvider_CreateAutoSwap_Call {
+func (_c *MockRpcProvider_EstimateFee_Call) RunAndReturn(run func(currency boltz.Currency) (float64, error)) *MockRpcProvider_EstimateFee_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -279,6 +444,61 @@ func (_c *MockRpcProvider_GetAutoSwapPairInfo_Call) RunAndReturn(run func(swapTy
 	return _c
 }
 
+// GetAutoSwapPairs provides a mock function for the type MockRpcProvider
+func (_mock *MockRpcProvider) GetAutoSwapPairs() (*boltzrpc.GetPairsResponse, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAutoSwapPairs")
+	}
+
+	var r0 *boltzrpc.GetPairsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (*boltzrpc.GetPairsResponse, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() *boltzrpc.GetPairsResponse); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*boltzrpc.GetPairsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRpcProvider_GetAutoSwapPairs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAutoSwapPairs'
+type MockRpcProvider_GetAutoSwapPairs_Call struct {
+	*mock.Call
+}
+
+// GetAutoSwapPairs is a helper method to define mock.On call
+func (_e *MockRpcProvider_Expecter) GetAutoSwapPairs() *MockRpcProvider_GetAutoSwapPairs_Call {
+	return &MockRpcProvider_GetAutoSwapPairs_Call{Call: _e.mock.On("GetAutoSwapPairs")}
+}
+
+func (_c *MockRpcProvider_GetAutoSwapPairs_Call) Run(run func()) *MockRpcProvider_GetAutoSwapPairs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRpcProvider_GetAutoSwapPairs_Call) Return(getPairsResponse *boltzrpc.GetPairsResponse, err error) *MockRpcProvider_GetAutoSwapPairs_Call {
+	_c.Call.Return(getPairsResponse, err)
+	return _c
+}
+
+func (_c *MockRpcProvider_GetAutoSwapPairs_Call) RunAndReturn(run func() (*boltzrpc.GetPairsResponse, error)) *MockRpcProvider_GetAutoSwapPairs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetBlockUpdates provides a mock function for the type MockRpcProvider
 func (_mock *MockRpcProvider) GetBlockUpdates(currency boltz.Currency) (<-chan *onchain.BlockEpoch, func()) {
 	ret := _mock.Called(currency)
@@ -344,8 +564,8 @@ func (_c *MockRpcProvider_GetBlockUpdates_Call) RunAndReturn(run func(currency b
 }
 
 // GetLightningChannels provides a mock function for the type MockRpcProvider
-func (_mock *MockRpcProvider) GetLightningChannels() ([]*lightning.LightningChannel, error) {
-	ret := _mock.Called()
+func (_mock *MockRpcProvider) GetLightningChannels(name string) ([]*lightning.LightningChannel, error) {
+	ret := _mock.Called(name)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetLightningChannels")
@@ -353,18 +573,18 @@ func (_mock *MockRpcProvider) GetLightningChannels() ([]*lightning.LightningChan
 
 	var r0 []*lightning.LightningChannel
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]*lightning.LightningChannel, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*lightning.LightningChannel, error)); ok {
+		return returnFunc(name)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []*lightning.LightningChannel); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(string) []*lightning.LightningChannel); ok {
+		r0 = returnFunc(name)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*lightning.LightningChannel)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -377,13 +597,20 @@ type MockRpcProvider_GetLightningChannels_Call struct {
 }
 
 // GetLightningChannels is a helper method to define mock.On call
-func (_e *MockRpcProvider_Expecter) GetLightningChannels() *MockRpcProvider_GetLightningChannels_Call {
-	return &MockRpcProvider_GetLightningChannels_Call{Call: _e.mock.On("GetLightningChannels")}
+//   - name string
+func (_e *MockRpcProvider_Expecter) GetLightningChannels(name interface{}) *MockRpcProvider_GetLightningChannels_Call {
+	return &MockRpcProvider_GetLightningChannels_Call{Call: _e.mock.On("GetLightningChannels", name)}
 }
 
-func (_c *MockRpcProvider_GetLightningChannels_Call) Run(run func()) *MockRpcProvider_GetLightningChannels_Call {
+func (_c *MockRpcProvider_GetLightningChannels_Call) Run(run func(name string)) *MockRpcProvider_GetLightningChannels_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -393,7 +620,69 @@ func (_c *MockRpcProvider_GetLightningChannels_Call) Return(lightningChannels []
 	return _c
 }
 
-func (_c *MockRpcProvider_GetLightningChannels_Call) RunAndReturn(run func() ([]*lightning.LightningChannel, error)) *MockRpcProvider_GetLightningChannels_Call {
+func (_c *MockRpcProvider_GetLightningChannels_Call) RunAndReturn(run func(name string) ([]*lightning.LightningChannel, error)) *MockRpcProvider_GetLightningChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLightningNode provides a mock function for the type MockRpcProvider
+func (_mock *MockRpcProvider) GetLightningNode(name string) (lightning.LightningNode, error) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLightningNode")
+	}
+
+	var r0 lightning.LightningNode
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (lightning.LightningNode, error)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) lightning.LightningNode); ok {
+		r0 = returnFunc(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(lightning.LightningNode)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRpcProvider_GetLightningNode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLightningNode'
+type MockRpcProvider_GetLightningNode_Call struct {
+	*mock.Call
+}
+
+// GetLightningNode is a helper method to define mock.On call
+//   - name string
+func (_e *MockRpcProvider_Expecter) GetLightningNode(name interface{}) *MockRpcProvider_GetLightningNode_Call {
+	return &MockRpcProvider_GetLightningNode_Call{Call: _e.mock.On("GetLightningNode", name)}
+}
+
+func (_c *MockRpcProvider_GetLightningNode_Call) Run(run func(name string)) *MockRpcProvider_GetLightningNode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRpcProvider_GetLightningNode_Call) Return(lightningNode lightning.LightningNode, err error) *MockRpcProvider_GetLightningNode_Call {
+	_c.Call.Return(lightningNode, err)
+	return _c
+}
+
+func (_c *MockRpcProvider_GetLightningNode_Call) RunAndReturn(run func(name string) (lightning.LightningNode, error)) *MockRpcProvider_GetLightningNode_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,230 @@
+package autoswap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
+)
+
+const defaultApprovalExpiry = 24 * time.Hour
+
+var ErrApprovalNotFound = errors.New("approval not found")
+var errNoLongerRecommended = errors.New("swap is no longer recommended")
+
+// awaitingApproval returns true if the approval threshold is the only reason a recommendation was dismissed
+func awaitingApproval(reasons []string) bool {
+	return len(reasons) == 1 && reasons[0] == ReasonApprovalRequired
+}
+
+// checkApprovable ignores deferrals since an explicit approval should be executed right away
+func checkApprovable(reasons []string) error {
+	reasons = slices.DeleteFunc(slices.Clone(reasons), func(reason string) bool {
+		return reason == ReasonApprovalRequired || isDeferralReason(reason)
+	})
+	if len(reasons) > 0 {
+		return fmt.Errorf("recommendation is dismissed: %s", strings.Join(reasons, ", "))
+	}
+	return nil
+}
+
+func approvalMatches(approval *database.AutoSwapApproval, swap *database.AutoSwapEventSwap) bool {
+	if approval.Type != swap.Type || approval.Currency != swap.Currency {
+		return false
+	}
+	if approval.ChannelId == nil || swap.ChannelId == nil {
+		return approval.ChannelId == swap.ChannelId
+	}
+	return *approval.ChannelId == *swap.ChannelId
+}
+
+// requestApproval queues the swap for approval unless the same swap is already pending or was rejected recently
+func (c *shared) requestApproval(swapper SwapperType, tenantId database.Id, rule string, swap *database.AutoSwapEventSwap, expiry uint64) error {
+	now := c.now()
+	swapperName := string(swapper)
+	existing, err := c.database.QueryAutoSwapApprovals(database.AutoSwapApprovalQuery{
+		TenantId:     &tenantId,
+		Swapper:      &swapperName,
+		Rule:         &rule,
+		States:       []database.AutoSwapApprovalState{database.ApprovalPending, database.ApprovalRejected},
+		ExpiresAfter: now,
+	})
+	if err != nil {
+		return err
+	}
+	for _, approval := range existing {
+		if approvalMatches(approval, swap) {
+			return nil
+		}
+	}
+
+	duration := defaultApprovalExpiry
+	if expiry != 0 {
+		duration = time.Duration(expiry) * time.Second
+	}
+	approval := &database.AutoSwapApproval{
+		Swapper:     swapperName,
+		TenantId:    tenantId,
+		Type:        swap.Type,
+		Amount:      swap.Amount,
+		FeeEstimate: swap.FeeEstimate,
+		ChannelId:   swap.ChannelId,
+		Currency:    swap.Currency,
+		Rule:        rule,
+		State:       database.ApprovalPending,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(duration),
+	}
+	if err := c.database.CreateAutoSwapApproval(approval); err != nil {
+		return err
+	}
+	logger.Infof("Swap recommendation %+v requires approval (id %d)", swap, approval.Id)
+	return nil
+}
+
+func (cfg *LightningConfig) executeApproval(approval *database.AutoSwapApproval) (string, error) {
+	cfg.executeLock.Lock()
+	defer cfg.executeLock.Unlock()
+	recommendations, err := cfg.getSwapRecommendations(false)
+	if err != nil {
+		return "", fmt.Errorf("could not fetch swap recommendations: %w", err)
+	}
+	for _, recommendation := range recommendations {
+		swap := recommendation.Swap
+		current := &database.AutoSwapEventSwap{
+			Type:     serializers.ParseSwapType(swap.Type),
+			Currency: serializers.ParseCurrency(&swap.Currency),
+		}
+		if chanId := recommendation.Channel.GetId().GetLnd(); chanId != 0 {
+			current.ChannelId = (*lightning.ChanId)(&chanId)
+		}
+		if !approvalMatches(approval, current) {
+			continue
+		}
+		if err := checkApprovable(swap.DismissedReasons); err != nil {
+			return "", err
+		}
+		// the balances might have changed in the meantime, but we never swap more than what was approved.
+		// the currency always matches the approved one, since recommendations in another currency do not match
+		swap.Amount = min(swap.Amount, approval.Amount)
+		return cfg.execute(recommendation, true)
+	}
+	return "", errNoLongerRecommended
+}
+
+func (cfg *ChainConfig) executeApproval(approval *database.AutoSwapApproval) (string, error) {
+	cfg.executeLock.Lock()
+	defer cfg.executeLock.Unlock()
+	recommendation, err := cfg.getRecommendation()
+	if err != nil {
+		return "", fmt.Errorf("could not get swap recommendation: %w", err)
+	}
+	swap := recommendation.Swap
+	if swap == nil || !approvalMatches(approval, &database.AutoSwapEventSwap{
+		Type:     approval.Type,
+		Currency: serializers.ParsePair(swap.Pair).From,
+	}) {
+		return "", errNoLongerRecommended
+	}
+	if err := checkApprovable(swap.DismissedReasons); err != nil {
+		return "", err
+	}
+	swap.Amount = min(swap.Amount, approval.Amount)
+	return cfg.execute(swap, nil, true)
+}
+
+// ListPendingApprovals returns the approvals of a tenant, or of all tenants if tenantId is nil, which can still be approved.
+func (autoSwap *AutoSwap) ListPendingApprovals(tenantId *database.Id) ([]*database.AutoSwapApproval, error) {
+	return autoSwap.database.QueryAutoSwapApprovals(database.AutoSwapApprovalQuery{
+		TenantId:     tenantId,
+		States:       []database.AutoSwapApprovalState{database.ApprovalPending},
+		ExpiresAfter: autoSwap.now(),
+	})
+}
+
+func (autoSwap *AutoSwap) getPendingApproval(id database.Id, tenantId *database.Id) (*database.AutoSwapApproval, error) {
+	approval, err := autoSwap.database.GetAutoSwapApproval(id)
+	if err != nil {
+		return nil, err
+	}
+	if approval == nil || (tenantId != nil && approval.TenantId != *tenantId) {
+		return nil, ErrApprovalNotFound
+	}
+	if approval.State != database.ApprovalPending {
+		return nil, fmt.Errorf("approval was already %s", approval.State)
+	}
+	if !autoSwap.now().Before(approval.ExpiresAt) {
+		return nil, errors.New("approval expired")
+	}
+	return approval, nil
+}
+
+// ApproveRecommendation executes a pending approval and returns the id of the created swap.
+// tenantId restricts the approvals which can be approved, nil allows all.
+func (autoSwap *AutoSwap) ApproveRecommendation(id database.Id, tenantId *database.Id) (string, error) {
+	autoSwap.approvalLock.Lock()
+	defer autoSwap.approvalLock.Unlock()
+
+	approval, err := autoSwap.getPendingApproval(id, tenantId)
+	if err != nil {
+		return "", err
+	}
+
+	var swapId string
+	switch SwapperType(approval.Swapper) {
+	case Lightning:
+		lnSwapper, ok := autoSwap.lnSwappers[approval.Rule]
+		if !ok {
+			return "", errors.New("lightning autoswap is not configured")
+		}
+		swapId, err = lnSwapper.cfg.executeApproval(approval)
+	case Chain:
+		chainSwapper, ok := autoSwap.chainSwappers[chainRule{tenantId: approval.TenantId, name: approval.Rule}]
+		if !ok {
+			return "", errors.New("chain autoswap is not configured")
+		}
+		swapId, err = chainSwapper.cfg.executeApproval(approval)
+	default:
+		return "", fmt.Errorf("unknown swapper: %s", approval.Swapper)
+	}
+
+	autoSwap.recordEvent(&database.AutoSwapEvent{
+		Swapper:  approval.Swapper,
+		TenantId: approval.TenantId,
+		Rule:     approval.Rule,
+		Swaps: []*database.AutoSwapEventSwap{{
+			Type:        approval.Type,
+			Amount:      approval.Amount,
+			FeeEstimate: approval.FeeEstimate,
+			ChannelId:   approval.ChannelId,
+			Currency:    approval.Currency,
+			SwapId:      swapId,
+		}},
+	}, err)
+	if err != nil {
+		return "", err
+	}
+	if err := autoSwap.database.SetAutoSwapApprovalState(approval.Id, database.ApprovalApproved, swapId); err != nil {
+		return "", fmt.Errorf("could not update approval: %w", err)
+	}
+	return swapId, nil
+}
+
+// RejectRecommendation rejects a pending approval.
+// tenantId restricts the approvals which can be rejected, nil allows all.
+func (autoSwap *AutoSwap) RejectRecommendation(id database.Id, tenantId *database.Id) error {
+	autoSwap.approvalLock.Lock()
+	defer autoSwap.approvalLock.Unlock()
+
+	approval, err := autoSwap.getPendingApproval(id, tenantId)
+	if err != nil {
+		return err
+	}
+	return autoSwap.database.SetAutoSwapApprovalState(approval.Id, database.ApprovalRejected, "")
+}
@@ -0,0 +1,135 @@
+package autoswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovals(t *testing.T) {
+	const threshold = 100_000
+
+	setup := func(t *testing.T) (*AutoSwap, *MockRpcProvider, *time.Time) {
+		swapper, rpc := getSwapper(t)
+		now := time.Now()
+		swapper.clock = func() time.Time { return now }
+		swapper.onchain.AddWallet(mockedWallet{
+			info:    onchain.WalletInfo{Id: 1, Name: "test", Currency: boltz.CurrencyBtc},
+			balance: &onchain.Balance{},
+		}.Create(t))
+		err := swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
+			Config: &SerializedLnConfig{
+				InboundBalancePercent: 30,
+				SwapType:              "reverse",
+				PerChannel:            true,
+				Currency:              boltzrpc.Currency_BTC,
+				Wallet:                "test",
+				MaxFeePercent:         2,
+				ApprovalThreshold:     threshold,
+			},
+		})
+		require.NoError(t, err)
+
+		channels := []*lightning.LightningChannel{{OutboundSat: 800_000, InboundSat: 200_000, Capacity: 1_000_000, Id: 1}}
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil).Maybe()
+		rpc.EXPECT().GetAutoSwapPairInfo(mock.Anything, mock.Anything).Return(newPairInfo(), nil).Maybe()
+		return swapper, rpc, &now
+	}
+
+	check := func(t *testing.T, swapper *AutoSwap) []*database.AutoSwapApproval {
+		require.NoError(t, swapper.GetLnSwapper().cfg.CheckAndExecute(nil, false))
+		approvals, err := swapper.ListPendingApprovals(nil)
+		require.NoError(t, err)
+		return approvals
+	}
+
+	t.Run("Approve", func(t *testing.T) {
+		swapper, rpc, _ := setup(t)
+
+		approvals := check(t, swapper)
+		require.Len(t, approvals, 1)
+		approval := approvals[0]
+		require.Equal(t, boltz.ReverseSwap, approval.Type)
+		require.Equal(t, lightning.ChanId(1), *approval.ChannelId)
+		require.Equal(t, boltz.CurrencyBtc, approval.Currency)
+		require.Greater(t, approval.Amount, uint64(threshold))
+
+		// the same recommendation is not queued twice
+		require.Len(t, check(t, swapper), 1)
+
+		otherTenant := database.Id(2)
+		_, err := swapper.ApproveRecommendation(approval.Id, &otherTenant)
+		require.ErrorIs(t, err, ErrApprovalNotFound)
+
+		rpc.EXPECT().CreateAutoReverseSwap(mock.Anything, mock.Anything).RunAndReturn(
+			func(_ *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
+				require.LessOrEqual(t, request.Amount, approval.Amount)
+				require.Equal(t, boltzrpc.Currency_BTC, request.Pair.To)
+				return "swapId", nil
+			},
+		).Once()
+		swapId, err := swapper.ApproveRecommendation(approval.Id, nil)
+		require.NoError(t, err)
+		require.Equal(t, "swapId", swapId)
+
+		_, err = swapper.ApproveRecommendation(approval.Id, nil)
+		require.Error(t, err)
+
+		approvals, err = swapper.ListPendingApprovals(nil)
+		require.NoError(t, err)
+		require.Empty(t, approvals)
+	})
+
+	t.Run("CurrencyChanged", func(t *testing.T) {
+		swapper, _, _ := setup(t)
+
+		approvals := check(t, swapper)
+		require.Len(t, approvals, 1)
+		approval := approvals[0]
+
+		// an approval is only executed in the currency it was requested for
+		approval.Currency = boltz.CurrencyLiquid
+		approval.State = database.ApprovalPending
+		require.NoError(t, swapper.database.CreateAutoSwapApproval(approval))
+		_, err := swapper.ApproveRecommendation(approval.Id, nil)
+		require.ErrorIs(t, err, errNoLongerRecommended)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		swapper, _, now := setup(t)
+
+		approvals := check(t, swapper)
+		require.Len(t, approvals, 1)
+		require.NoError(t, swapper.RejectRecommendation(approvals[0].Id, nil))
+		require.Error(t, swapper.RejectRecommendation(approvals[0].Id, nil))
+
+		// rejected recommendations are not queued again until they expire
+		require.Empty(t, check(t, swapper))
+
+		*now = now.Add(defaultApprovalExpiry + time.Minute)
+		require.Len(t, check(t, swapper), 1)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		swapper, _, now := setup(t)
+
+		approvals := check(t, swapper)
+		require.Len(t, approvals, 1)
+
+		*now = now.Add(defaultApprovalExpiry)
+		pending, err := swapper.ListPendingApprovals(nil)
+		require.NoError(t, err)
+		require.Empty(t, pending)
+
+		_, err = swapper.ApproveRecommendation(approvals[0].Id, nil)
+		require.ErrorContains(t, err, "expired")
+	})
+}
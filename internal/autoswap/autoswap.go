@@ -2,6 +2,7 @@ package autoswap
 
 import (
 	"bytes"
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
@@ -14,14 +15,60 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
 	"github.com/BurntSushi/toml"
 	"google.golang.org/protobuf/encoding/protojson"
+	"maps"
 	"os"
 	"slices"
+	"sync"
+	"time"
 )
 
 type shared struct {
 	onchain  *onchain.Onchain
 	database *database.Database
 	rpc      RpcProvider
+	// clock overrides the current time, used when simulating
+	clock func() time.Time
+}
+
+func (c *shared) now() time.Time {
+	if c.clock != nil {
+		return c.clock()
+	}
+	return time.Now()
+}
+
+// autoWalletSend sends funds directly instead of swapping them and records the transaction,
+// so that its fee counts towards the budget of the swapper. The swapper, rule, currency and fee of send have to be set.
+func (c *shared) autoWalletSend(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id, send database.AutoSwapSend) (string, error) {
+	txId, err := c.rpc.AutoWalletSend(tenant, request, toWalletId)
+	if err != nil {
+		return "", err
+	}
+	send.TxId = txId
+	send.TenantId = tenant.Id
+	send.Amount = request.Amount
+	send.CreatedAt = c.now()
+	if err := c.database.CreateAutoSwapSend(&send); err != nil {
+		return txId, fmt.Errorf("could not save send %s: %w", txId, err)
+	}
+	return txId, nil
+}
+
+// eventRetention is how long evaluation cycles are kept in the database
+const eventRetention = 30 * 24 * time.Hour
+
+func (c *shared) recordEvent(event *database.AutoSwapEvent, err error) {
+	event.CreatedAt = c.now()
+	if err != nil {
+		event.Error = err.Error()
+	}
+	if err := c.database.CreateAutoSwapEvent(event); err != nil {
+		logger.Warnf("Could not record autoswap event: %v", err)
+		return
+	}
+	if err := c.database.DeleteAutoSwapEvents(event.TenantId, event.Swapper, event.CreatedAt.Add(-eventRetention)); err != nil {
+		logger.Warnf("Could not delete old autoswap events: %v", err)
+	}
 }
 
 type swapper[T commonConfig] struct {
@@ -42,13 +89,26 @@ type commonConfig interface {
 
 type RpcProvider interface {
 	GetAutoSwapPairInfo(swapType boltzrpc.SwapType, pair *boltzrpc.Pair) (*boltzrpc.PairInfo, error)
-	GetLightningChannels() ([]*lightning.LightningChannel, error)
+	GetAutoSwapPairs() (*boltzrpc.GetPairsResponse, error)
+	GetLightningChannels(name string) ([]*lightning.LightningChannel, error)
+	GetLightningNode(name string) (lightning.LightningNode, error)
 	GetBlockUpdates(currency boltz.Currency) (<-chan *onchain.BlockEpoch, func())
 	WalletSendFee(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendFee, error)
+	EstimateFee(currency boltz.Currency) (float64, error)
 
-	CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) error
-	CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) error
-	CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest) error
+	CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) (string, error)
+	CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error)
+	CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin) (string, error)
+	// AutoWalletSend sends funds directly instead of swapping them. If toWalletId is set, a new address of that wallet is used.
+	AutoWalletSend(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error)
+}
+
+// ChainSwapOrigin identifies the swapper a chain swap is created by, so that its fees count towards the right budget
+type ChainSwapOrigin struct {
+	// Rule is the name of the chain rule which creates the swap
+	Rule string
+	// LightningNode is set if the swap funds a channel opening of the lightning swapper of that node
+	LightningNode *string
 }
 
 type SwapperType string
@@ -60,13 +120,21 @@ const (
 
 type Config = autoswaprpc.Config
 
+// chainRule identifies a chain swapper, a tenant can have multiple rules with distinct names
+type chainRule struct {
+	tenantId database.Id
+	name     string
+}
+
 type AutoSwap struct {
 	cfg        *Config
 	configPath string
 
-	lnSwapper     *LightningSwapper
-	chainSwappers map[database.Id]*ChainSwapper
+	// lnSwappers are keyed by the name of the lightning node they rebalance, the main node has an empty name
+	lnSwappers    map[string]*LightningSwapper
+	chainSwappers map[chainRule]*ChainSwapper
 	err           error
+	approvalLock  sync.Mutex
 
 	shared
 }
@@ -78,14 +146,15 @@ func (autoSwap *AutoSwap) Init(db *database.Database, onchain *onchain.Onchain,
 		rpc:      rpc,
 	}
 	autoSwap.configPath = configPath
-	autoSwap.chainSwappers = make(map[database.Id]*ChainSwapper)
+	autoSwap.lnSwappers = make(map[string]*LightningSwapper)
+	autoSwap.chainSwappers = make(map[chainRule]*ChainSwapper)
 	autoSwap.cfg = &Config{}
 
 	if onchain != nil {
 		go func() {
 			for range onchain.OnWalletChange.Get() {
 				logger.Debugf("Restarting all auto swappers because of wallet change")
-				if swapper := autoSwap.lnSwapper; swapper != nil {
+				for _, swapper := range autoSwap.lnSwappers {
 					swapper.start()
 				}
 
@@ -103,20 +172,31 @@ func (autoSwap *AutoSwap) handleErr(err error) error {
 }
 
 func (autoSwap *AutoSwap) UpdateLightningConfig(request *autoswaprpc.UpdateLightningConfigRequest) error {
+	node := request.GetConfig().GetLightningNode()
+	if node != "" {
+		// checked here instead of when initializing the config, since simulations don't have access to the nodes
+		if _, err := autoSwap.rpc.GetLightningNode(node); err != nil {
+			return fmt.Errorf("invalid lightning node: %w", err)
+		}
+	}
 	config := request.Config
 	if request.GetReset_() {
 		config = DefaultLightningConfig()
 	}
-	lnSwapper := autoSwap.lnSwapper
+	lnSwapper, ok := autoSwap.lnSwappers[node]
 	var base *SerializedLnConfig
-	if lnSwapper == nil || request.GetReset_() {
-		autoSwap.lnSwapper = &LightningSwapper{
+	if !ok || request.GetReset_() {
+		if ok {
+			lnSwapper.Stop()
+		}
+		lnSwapper = &LightningSwapper{
 			shared:      autoSwap.shared,
 			swapperType: Lightning,
 		}
+		autoSwap.lnSwappers[node] = lnSwapper
 		base = DefaultLightningConfig()
 	} else {
-		base = autoSwap.lnSwapper.cfg.SerializedLnConfig
+		base = lnSwapper.cfg.SerializedLnConfig
 	}
 	if config == nil {
 		config = base
@@ -127,19 +207,21 @@ func (autoSwap *AutoSwap) UpdateLightningConfig(request *autoswaprpc.UpdateLight
 		}
 		config = updated.(*SerializedLnConfig)
 	}
+	config.LightningNode = node
 
-	if err := autoSwap.lnSwapper.setConfig(NewLightningConfig(config, autoSwap.shared)); err != nil {
+	if err := lnSwapper.setConfig(NewLightningConfig(config, autoSwap.shared)); err != nil {
 		return err
 	}
 	return autoSwap.saveConfig()
 }
 
 func (autoSwap *AutoSwap) UpdateChainConfig(request *autoswaprpc.UpdateChainConfigRequest, tenant database.Tenant) error {
-	chainSwapper, ok := autoSwap.chainSwappers[tenant.Id]
+	rule := chainRule{tenantId: tenant.Id, name: request.GetConfig().GetName()}
+	chainSwapper, ok := autoSwap.chainSwappers[rule]
 	if request.GetReset_() {
 		if ok {
 			chainSwapper.Stop()
-			delete(autoSwap.chainSwappers, tenant.Id)
+			delete(autoSwap.chainSwappers, rule)
 		}
 	} else {
 		config := request.Config
@@ -161,15 +243,22 @@ func (autoSwap *AutoSwap) UpdateChainConfig(request *autoswaprpc.UpdateChainConf
 			return err
 		}
 		config = updated.(*SerializedChainConfig)
+		config.Name = rule.name
 		if tenant.Name != database.DefaultTenantName {
 			config.Tenant = &tenant.Name
 		}
+		// rules sending from the same wallet would compete for its funds
+		for other, otherSwapper := range autoSwap.chainSwappers {
+			if other != rule && other.tenantId == rule.tenantId && otherSwapper.cfg.FromWallet == config.FromWallet {
+				return fmt.Errorf("wallet %s is already used by chain rule %q", config.FromWallet, other.name)
+			}
+		}
 
 		if err := chainSwapper.setConfig(NewChainConfig(config, autoSwap.shared)); err != nil {
 			return err
 		}
 
-		autoSwap.chainSwappers[tenant.Id] = chainSwapper
+		autoSwap.chainSwappers[rule] = chainSwapper
 	}
 	return autoSwap.saveConfig()
 }
@@ -199,19 +288,18 @@ func (autoSwap *AutoSwap) LoadConfig() error {
 		return autoSwap.handleErr(fmt.Errorf("could not load config: %w", err))
 	}
 
-	for tenant, chainSwapper := range autoSwap.chainSwappers {
+	for rule, chainSwapper := range autoSwap.chainSwappers {
 		chainSwapper.Stop()
-		delete(autoSwap.chainSwappers, tenant)
+		delete(autoSwap.chainSwappers, rule)
 	}
 
-	if autoSwap.lnSwapper != nil {
-		autoSwap.lnSwapper.Stop()
-		autoSwap.lnSwapper = nil
+	for node, lnSwapper := range autoSwap.lnSwappers {
+		lnSwapper.Stop()
+		delete(autoSwap.lnSwappers, node)
 	}
 
-	request := &autoswaprpc.UpdateLightningConfigRequest{}
-	if len(serialized.Lightning) > 0 {
-		request.Config = serialized.Lightning[0]
+	for _, lightningConfig := range serialized.Lightning {
+		request := &autoswaprpc.UpdateLightningConfigRequest{Config: lightningConfig}
 		if err := autoSwap.UpdateLightningConfig(request); err != nil {
 			logger.Errorf("could not update lightning config: %v", err)
 		}
@@ -241,10 +329,10 @@ func (autoSwap *AutoSwap) saveConfig() error {
 	}
 
 	cfg := &Config{}
-	if autoSwap.lnSwapper != nil {
-		cfg.Lightning = append(cfg.Lightning, autoSwap.lnSwapper.cfg.SerializedLnConfig)
+	for _, lnSwapper := range autoSwap.GetLnSwappers() {
+		cfg.Lightning = append(cfg.Lightning, lnSwapper.cfg.SerializedLnConfig)
 	}
-	for _, chainSwapper := range autoSwap.chainSwappers {
+	for _, chainSwapper := range autoSwap.sortedChainSwappers(nil) {
 		cfg.Chain = append(cfg.Chain, chainSwapper.cfg.SerializedChainConfig)
 	}
 	marshalled, _ := marshaler.Marshal(cfg)
@@ -262,8 +350,8 @@ func (autoSwap *AutoSwap) saveConfig() error {
 }
 
 func (autoSwap *AutoSwap) WalletUsed(id database.Id) bool {
-	if autoSwap.lnSwapper != nil {
-		used := autoSwap.lnSwapper.cfg.walletId()
+	for _, lnSwapper := range autoSwap.lnSwappers {
+		used := lnSwapper.cfg.walletId()
 		if used != nil && *used == id {
 			return true
 		}
@@ -281,23 +369,65 @@ func (autoSwap *AutoSwap) GetConfig(tenantId *database.Id) *Config {
 		return autoSwap.cfg
 	}
 	scoped := &Config{}
-	for tenant, chainSwapper := range autoSwap.chainSwappers {
-		if *tenantId == tenant {
-			scoped.Chain = append(scoped.Chain, chainSwapper.cfg.SerializedChainConfig)
-		}
+	for _, chainSwapper := range autoSwap.sortedChainSwappers(tenantId) {
+		scoped.Chain = append(scoped.Chain, chainSwapper.cfg.SerializedChainConfig)
 	}
-	if autoSwap.lnSwapper != nil && *tenantId == database.DefaultTenantId {
-		scoped.Lightning = []*SerializedLnConfig{autoSwap.lnSwapper.cfg.SerializedLnConfig}
+	if *tenantId == database.DefaultTenantId {
+		for _, lnSwapper := range autoSwap.GetLnSwappers() {
+			scoped.Lightning = append(scoped.Lightning, lnSwapper.cfg.SerializedLnConfig)
+		}
 	}
 	return scoped
 }
 
+// GetLnSwapper returns the lightning swapper of the main node
 func (autoSwap *AutoSwap) GetLnSwapper() *LightningSwapper {
-	return autoSwap.lnSwapper
+	return autoSwap.GetLnSwapperFor("")
 }
 
-func (autoSwap *AutoSwap) GetChainSwapper(tenantId database.Id) *ChainSwapper {
-	return autoSwap.chainSwappers[tenantId]
+// GetLnSwapperFor returns the lightning swapper of the node with the given name, an empty name refers to the main node
+func (autoSwap *AutoSwap) GetLnSwapperFor(node string) *LightningSwapper {
+	return autoSwap.lnSwappers[node]
+}
+
+// GetLnSwappers returns the lightning swappers of all nodes, ordered by the name of their node
+func (autoSwap *AutoSwap) GetLnSwappers() []*LightningSwapper {
+	nodes := slices.Sorted(maps.Keys(autoSwap.lnSwappers))
+	swappers := make([]*LightningSwapper, len(nodes))
+	for i, node := range nodes {
+		swappers[i] = autoSwap.lnSwappers[node]
+	}
+	return swappers
+}
+
+// GetChainSwapper returns the chain swapper of the rule with the given name, an empty name refers to the default rule
+func (autoSwap *AutoSwap) GetChainSwapper(tenantId database.Id, name string) *ChainSwapper {
+	return autoSwap.chainSwappers[chainRule{tenantId: tenantId, name: name}]
+}
+
+// GetChainSwappers returns all chain swappers of a tenant, ordered by the name of their rule
+func (autoSwap *AutoSwap) GetChainSwappers(tenantId database.Id) []*ChainSwapper {
+	return autoSwap.sortedChainSwappers(&tenantId)
+}
+
+func (autoSwap *AutoSwap) sortedChainSwappers(tenantId *database.Id) []*ChainSwapper {
+	var rules []chainRule
+	for rule := range autoSwap.chainSwappers {
+		if tenantId == nil || rule.tenantId == *tenantId {
+			rules = append(rules, rule)
+		}
+	}
+	slices.SortFunc(rules, func(a, b chainRule) int {
+		if a.tenantId != b.tenantId {
+			return cmp.Compare(a.tenantId, b.tenantId)
+		}
+		return cmp.Compare(a.name, b.name)
+	})
+	swappers := make([]*ChainSwapper, len(rules))
+	for i, rule := range rules {
+		swappers[i] = autoSwap.chainSwappers[rule]
+	}
+	return swappers
 }
 
 func (autoSwap *AutoSwap) Error() string {
@@ -2,83 +2,198 @@ package autoswap
 
 import (
 	"errors"
+	"fmt"
+	"time"
+
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
-	"time"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
 )
 
+// Budget is evaluated over a rolling window which ends now,
+// so fees are only taken into account for the duration of the budget interval after the swap was created
 type Budget struct {
-	database.BudgetInterval
-	Amount uint64
-	Total  uint64
-	Stats  *boltzrpc.SwapStats
+	StartDate time.Time
+	EndDate   time.Time
+	Amount    uint64
+	Total     uint64
+	Stats     *boltzrpc.SwapStats
+	// Currency and SwapType are set if the budget only applies to some swaps
+	Currency *boltz.Currency
+	SwapType *boltz.SwapType
+	// Limits are the additional budgets of the config, all of which have to allow a swap
+	Limits []*Budget
 }
 
 type budgetConfig interface {
 	GetBudgetInterval() uint64
 	GetBudget() uint64
+	GetBudgets() []*autoswaprpc.BudgetLimit
+}
+
+func (budget *Budget) matches(swapType boltz.SwapType, currency boltz.Currency) bool {
+	return (budget.SwapType == nil || *budget.SwapType == swapType) && (budget.Currency == nil || *budget.Currency == currency)
+}
+
+// Available returns how much can be spent on the fees of a swap of the given type and currency
+func (budget *Budget) Available(swapType boltz.SwapType, currency boltz.Currency) uint64 {
+	available := budget.Amount
+	for _, limit := range budget.Limits {
+		if limit.matches(swapType, currency) {
+			available = min(available, limit.Amount)
+		}
+	}
+	return available
+}
+
+// Spend deducts the fee of a swap from the budget and every additional budget the swap counts towards
+func (budget *Budget) Spend(swapType boltz.SwapType, currency boltz.Currency, fee uint64) {
+	budget.Amount -= min(budget.Amount, fee)
+	for _, limit := range budget.Limits {
+		if limit.matches(swapType, currency) {
+			limit.Amount -= min(limit.Amount, fee)
+		}
+	}
+}
+
+func swapperTypes(swapperType SwapperType) []boltz.SwapType {
+	if swapperType == Lightning {
+		return []boltz.SwapType{boltz.NormalSwap, boltz.ReverseSwap}
+	}
+	return []boltz.SwapType{boltz.ChainSwap}
+}
+
+func validateBudgets(cfg budgetConfig, swapperType SwapperType) error {
+	for _, limit := range cfg.GetBudgets() {
+		if limit.Budget == 0 {
+			return errors.New("budget of additional budget must be set")
+		}
+		if limit.Type != nil {
+			swapType := serializers.ParseSwapType(limit.GetType())
+			valid := false
+			for _, allowed := range swapperTypes(swapperType) {
+				valid = valid || allowed == swapType
+			}
+			if !valid {
+				return fmt.Errorf("%s swaps are not created by the %s swapper", swapType, swapperType)
+			}
+		}
+		if limit.GetBudgetInterval() == 0 && cfg.GetBudgetInterval() == 0 {
+			return errors.New("budget interval of additional budget must be set")
+		}
+	}
+	return nil
 }
 
 func (c *shared) GetCurrentBudget(
-	createIfMissing bool,
 	swapperType SwapperType,
 	cfg budgetConfig,
 	tenantId database.Id,
+	rule string,
 ) (*Budget, error) {
-	budgetDuration := time.Duration(cfg.GetBudgetInterval()) * time.Second
-	totalBudget := cfg.GetBudget()
+	query := database.SwapQuery{
+		Include:  boltzrpc.IncludeSwaps_AUTO,
+		TenantId: &tenantId,
+	}
+	if swapperType == Chain {
+		// every chain rule has its own budget
+		query.AutoSwapRule = &rule
+	} else {
+		// as does every lightning node
+		query.LightningNode = &rule
+	}
+	swapTypes := swapperTypes(swapperType)
+	if swapperType == Lightning {
+		// the lightning swapper also creates chain swaps to fund channel openings
+		swapTypes = append(swapTypes, boltz.ChainSwap)
+	}
+	// fees of wallet transactions which were sent instead of swaps count towards the budget too
+	sends := database.AutoSwapSendQuery{TenantId: tenantId, Swapper: string(swapperType), Rule: rule}
 
-	currentInterval, err := c.database.QueryCurrentBudgetInterval(string(swapperType), tenantId)
+	budget, err := c.currentBudget(query, swapTypes, nil, &sends, cfg.GetBudget(), cfg.GetBudgetInterval())
 	if err != nil {
-		return nil, errors.New("Could not get budget period: " + err.Error())
+		return nil, err
 	}
+	for _, serialized := range cfg.GetBudgets() {
+		interval := serialized.GetBudgetInterval()
+		if interval == 0 {
+			interval = cfg.GetBudgetInterval()
+		}
+		limitTypes, limitSends := swapTypes, &sends
+		var swapType *boltz.SwapType
+		if serialized.Type != nil {
+			parsed := serializers.ParseSwapType(serialized.GetType())
+			swapType, limitTypes, limitSends = &parsed, []boltz.SwapType{parsed}, nil
+		}
+		var currency *boltz.Currency
+		if serialized.Currency != nil {
+			parsed := serializers.ParseCurrency(serialized.Currency)
+			currency = &parsed
+		}
+		limit, err := c.currentBudget(query, limitTypes, currency, limitSends, serialized.Budget, interval)
+		if err != nil {
+			return nil, err
+		}
+		limit.SwapType = swapType
+		limit.Currency = currency
+		budget.Limits = append(budget.Limits, limit)
+	}
+	return budget, nil
+}
 
-	now := time.Now()
-	if currentInterval == nil || now.After(currentInterval.EndDate) {
-		if createIfMissing {
-			if currentInterval == nil {
-				currentInterval = &database.BudgetInterval{
-					StartDate: now,
-					EndDate:   now.Add(budgetDuration),
-					Name:      string(swapperType),
-					TenantId:  tenantId,
-				}
-			}
-			for now.After(currentInterval.EndDate) {
-				currentInterval.StartDate = currentInterval.EndDate
-				currentInterval.EndDate = currentInterval.EndDate.Add(budgetDuration)
-			}
-			if err := c.database.CreateBudget(*currentInterval); err != nil {
-				return nil, errors.New("Could not create budget period: " + err.Error())
+func (c *shared) currentBudget(
+	query database.SwapQuery,
+	swapTypes []boltz.SwapType,
+	currency *boltz.Currency,
+	sends *database.AutoSwapSendQuery,
+	total uint64,
+	interval uint64,
+) (*Budget, error) {
+	now := c.now()
+	query.Since = now.Add(-time.Duration(interval) * time.Second)
+
+	stats := &boltzrpc.SwapStats{}
+	for _, swapType := range swapTypes {
+		typeQuery := query
+		if currency != nil {
+			// the onchain currency of reverse swaps is the one they are paid out in
+			if swapType == boltz.ReverseSwap {
+				typeQuery.To = currency
+			} else {
+				typeQuery.From = currency
 			}
-		} else {
-			return nil, nil
 		}
+		typeStats, err := c.database.QueryStats(typeQuery, []boltz.SwapType{swapType})
+		if err != nil {
+			return nil, errors.New("Could not get past fees: " + err.Error())
+		}
+		stats.TotalFees += typeStats.TotalFees
+		stats.TotalAmount += typeStats.TotalAmount
+		stats.Count += typeStats.Count
+		stats.SuccessCount += typeStats.SuccessCount
 	}
-
-	var swapTypes []boltz.SwapType
-	if swapperType == Lightning {
-		swapTypes = []boltz.SwapType{boltz.NormalSwap, boltz.ReverseSwap}
-	} else {
-		swapTypes = []boltz.SwapType{boltz.ChainSwap}
+	if stats.SuccessCount != 0 {
+		stats.AvgFees = stats.TotalFees / int64(stats.SuccessCount)
+		stats.AvgAmount = stats.TotalAmount / stats.SuccessCount
 	}
-	stats, err := c.database.QueryStats(database.SwapQuery{
-		Since:    currentInterval.StartDate,
-		Include:  boltzrpc.IncludeSwaps_AUTO,
-		TenantId: &tenantId,
-	}, swapTypes)
-	if err != nil {
-		return nil, errors.New("Could not get past fees: " + err.Error())
+	if sends != nil {
+		sendQuery := *sends
+		sendQuery.Since, sendQuery.Currency = query.Since, currency
+		sendFees, err := c.database.QueryAutoSwapSendFees(sendQuery)
+		if err != nil {
+			return nil, err
+		}
+		stats.TotalFees += int64(sendFees)
 	}
 
-	budget := max(0, int64(totalBudget)-int64(stats.TotalFees))
-
 	return &Budget{
-		BudgetInterval: *currentInterval,
-		Amount:         uint64(budget),
-		Total:          totalBudget,
-		Stats:          stats,
+		StartDate: query.Since,
+		EndDate:   now,
+		Amount:    uint64(max(0, int64(total)-stats.TotalFees)),
+		Total:     total,
+		Stats:     stats,
 	}, nil
 }
@@ -3,6 +3,7 @@ package autoswap
 import (
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
@@ -23,11 +24,15 @@ type SerializedChainConfig = autoswaprpc.ChainConfig
 
 type ChainRecommendation struct {
 	Swap        *ChainSwap
+	Pair        boltz.Pair
 	FromBalance *onchain.Balance
+	ToBalance   *onchain.Balance
 }
 
 const MinReserve = uint64(10000)
 
+const defaultTargetBand = boltz.Percentage(10)
+
 type ChainConfig struct {
 	*SerializedChainConfig
 	shared
@@ -37,7 +42,12 @@ type ChainConfig struct {
 	fromWallet    onchain.Wallet
 	toWallet      onchain.Wallet
 	pair          boltz.Pair
+	targetRatio   boltz.Percentage
+	targetBand    boltz.Percentage
+	deferral      *deferral
 	description   string
+	// directSend is set if both sides use the same currency, in which case funds are sent with a plain wallet transaction
+	directSend bool
 
 	executeLock sync.Mutex
 }
@@ -72,12 +82,31 @@ func (cfg *ChainConfig) Init() (err error) {
 		}
 	}
 	cfg.maxFeePercent = boltz.Percentage(cfg.MaxFeePercent)
-	if cfg.MaxBalance == 0 {
-		return errors.New("MaxBalance must be set")
+	if err := validateBudgets(cfg, Chain); err != nil {
+		return fmt.Errorf("invalid budgets: %w", err)
 	}
+	if cfg.TargetRatio != nil {
+		cfg.targetRatio = boltz.Percentage(cfg.GetTargetRatio())
+		if cfg.targetRatio <= 0 || cfg.targetRatio >= 100 {
+			return errors.New("TargetRatio must be between 0 and 100")
+		}
+		cfg.targetBand = boltz.Percentage(cfg.TargetBand)
+		if cfg.targetBand < 0 {
+			return errors.New("TargetBand must not be negative")
+		} else if cfg.targetBand == 0 {
+			cfg.targetBand = defaultTargetBand
+		}
+		if cfg.ToWallet == "" {
+			return errors.New("TargetRatio requires ToWallet to be set")
+		}
+	} else {
+		if cfg.MaxBalance == 0 {
+			return errors.New("MaxBalance must be set")
+		}
 
-	if cfg.MaxBalance < cfg.ReserveBalance {
-		return fmt.Errorf("reserve balance %d is greater than max balance %d", cfg.ReserveBalance, cfg.MaxBalance)
+		if cfg.MaxBalance < cfg.ReserveBalance {
+			return fmt.Errorf("reserve balance %d is greater than max balance %d", cfg.ReserveBalance, cfg.MaxBalance)
+		}
 	}
 
 	cfg.fromWallet, err = cfg.onchain.GetAnyWallet(onchain.WalletChecker{
@@ -92,9 +121,18 @@ func (cfg *ChainConfig) Init() (err error) {
 	fromInfo := cfg.fromWallet.GetWalletInfo()
 	cfg.pair.From = fromInfo.Currency
 
-	cfg.description = fmt.Sprintf("From wallet %s (%s, max balance %d sats) to ", fromInfo.Name, fromInfo.Currency, cfg.MaxBalance)
+	if cfg.Name != "" {
+		cfg.description = fmt.Sprintf("Rule %s: ", cfg.Name)
+	} else {
+		cfg.description = ""
+	}
+	if cfg.TargetRatio != nil {
+		cfg.description += fmt.Sprintf("Between wallet %s (%s, target ratio %s ± %s) and ", fromInfo.Name, fromInfo.Currency, cfg.targetRatio, cfg.targetBand)
+	} else {
+		cfg.description += fmt.Sprintf("From wallet %s (%s, max balance %d sats) to ", fromInfo.Name, fromInfo.Currency, cfg.MaxBalance)
+	}
 
-	if cfg.ToAddress != "" {
+	if cfg.TargetRatio == nil && cfg.ToAddress != "" {
 		cfg.pair.To, err = boltz.GetAddressCurrency(cfg.onchain.Network, cfg.ToAddress)
 		if err != nil {
 			return fmt.Errorf("configured ToAddress %s is not a valid BTC or Liquid address: %w", cfg.ToAddress, err)
@@ -102,14 +140,18 @@ func (cfg *ChainConfig) Init() (err error) {
 		cfg.description += fmt.Sprintf("static %s address %s", cfg.pair.To, cfg.ToAddress)
 	} else if cfg.ToWallet != "" {
 		cfg.toWallet, err = cfg.onchain.GetAnyWallet(onchain.WalletChecker{
-			Name:          &cfg.ToWallet,
-			AllowReadonly: true,
+			Name: &cfg.ToWallet,
+			// funds are also sent from the to wallet when keeping a target ratio
+			AllowReadonly: cfg.TargetRatio == nil,
 			TenantId:      &cfg.tenant.Id,
 		})
 		if err != nil {
 			return fmt.Errorf("could not get to wallet: %w", err)
 		}
 		toInfo := cfg.toWallet.GetWalletInfo()
+		if toInfo.Id == fromInfo.Id {
+			return errors.New("from and to wallet must be different")
+		}
 		cfg.pair.To = toInfo.Currency
 		cfg.description += fmt.Sprintf("wallet %s (%s)", toInfo.Name, toInfo.Currency)
 	} else {
@@ -117,14 +159,25 @@ func (cfg *ChainConfig) Init() (err error) {
 	}
 
 	if cfg.pair.From == cfg.pair.To {
-		return fmt.Errorf("from and to currency must be different")
+		if cfg.TargetRatio != nil {
+			return errors.New("TargetRatio requires wallets of different currencies")
+		}
+		// a wallet transaction is cheaper than any swap when the currency stays the same
+		cfg.directSend = true
+		cfg.description += " (direct send)"
 	}
 
+	cfg.deferral, err = newDeferral(cfg.MaxFeeRate, cfg.SwapWindows)
+	if err != nil {
+		return err
+	}
+	cfg.description += cfg.deferral.String()
+
 	return nil
 }
 
-func (cfg *ChainConfig) GetCurrentBudget(createIfMissing bool) (*Budget, error) {
-	return cfg.shared.GetCurrentBudget(createIfMissing, Chain, cfg, cfg.tenant.Id)
+func (cfg *ChainConfig) GetCurrentBudget() (*Budget, error) {
+	return cfg.shared.GetCurrentBudget(Chain, cfg, cfg.tenant.Id, cfg.Name)
 }
 
 func (cfg *ChainConfig) GetRecommendation() (*autoswaprpc.ChainRecommendation, error) {
@@ -133,27 +186,89 @@ func (cfg *ChainConfig) GetRecommendation() (*autoswaprpc.ChainRecommendation, e
 	return cfg.getRecommendation()
 }
 
+func (cfg *ChainConfig) reversePair() boltz.Pair {
+	return boltz.Pair{From: cfg.pair.To, To: cfg.pair.From}
+}
+
+// ratioSwap returns the amount and direction of the swap which moves the balances towards the target ratio,
+// or an amount of 0 if the share of the from wallet is within the target band.
+// Unconfirmed funds and the amounts of pending swaps count towards the wallet they end up in,
+// but only confirmed funds of the sending wallet are swapped.
+func (cfg *ChainConfig) ratioSwap(from, to *onchain.Balance, pending []*database.ChainSwap) (uint64, boltz.Pair) {
+	fromTotal, toTotal := from.Total, to.Total
+	for _, swap := range pending {
+		if swap.ToData == nil {
+			continue
+		}
+		if swap.Pair == cfg.pair {
+			toTotal += swap.ToData.Amount
+		} else {
+			fromTotal += swap.ToData.Amount
+		}
+	}
+	total := fromTotal + toTotal
+	if total == 0 {
+		return 0, cfg.pair
+	}
+	share := boltz.Percentage(float64(fromTotal) / float64(total) * 100)
+	target := cfg.targetRatio.Calculate(total)
+	if share > cfg.targetRatio+cfg.targetBand {
+		return min(fromTotal-target, from.Confirmed), cfg.pair
+	}
+	if share < cfg.targetRatio-cfg.targetBand {
+		return min(target-fromTotal, to.Confirmed), cfg.reversePair()
+	}
+	return 0, cfg.pair
+}
+
 func (cfg *ChainConfig) getRecommendation() (*autoswaprpc.ChainRecommendation, error) {
 	balance, err := cfg.fromWallet.GetBalance()
 	if err != nil {
 		return nil, fmt.Errorf("could not get wallet balance: %w", err)
 	}
 
-	pairInfo, err := cfg.rpc.GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, serializers.SerializePair(cfg.pair))
+	pendingSwaps, err := cfg.database.QueryChainSwaps(database.SwapQuery{
+		States:       []boltzrpc.SwapState{boltzrpc.SwapState_PENDING},
+		TenantId:     &cfg.tenant.Id,
+		AutoSwapRule: &cfg.Name,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("could not get pair info: %w", err)
+		return nil, fmt.Errorf("could not query pending swaps: %w", err)
+	}
+
+	recommendation := &ChainRecommendation{FromBalance: balance, Pair: cfg.pair}
+	sendWallet := cfg.fromWallet
+	var amount uint64
+	var sendAll bool
+	if cfg.TargetRatio != nil {
+		recommendation.ToBalance, err = cfg.toWallet.GetBalance()
+		if err != nil {
+			return nil, fmt.Errorf("could not get to wallet balance: %w", err)
+		}
+		amount, recommendation.Pair = cfg.ratioSwap(balance, recommendation.ToBalance, pendingSwaps)
+		if recommendation.Pair != cfg.pair {
+			sendWallet = cfg.toWallet
+		}
+	} else if balance.Confirmed > cfg.MaxBalance {
+		sendAll = cfg.ReserveBalance == 0
+		amount = balance.Confirmed - cfg.ReserveBalance
 	}
 
-	budget, err := cfg.GetCurrentBudget(true)
+	var pairInfo *boltzrpc.PairInfo
+	if !cfg.directSend {
+		pairInfo, err = cfg.rpc.GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, serializers.SerializePair(recommendation.Pair))
+		if err != nil {
+			return nil, fmt.Errorf("could not get pair info: %w", err)
+		}
+	}
+
+	budget, err := cfg.GetCurrentBudget()
 	if err != nil {
 		return nil, fmt.Errorf("could not get current budget: %w", err)
 	}
 
-	recommendation := &ChainRecommendation{FromBalance: balance}
-	if balance.Confirmed > cfg.MaxBalance {
-		sendAll := cfg.ReserveBalance == 0
-		amount := balance.Confirmed - cfg.ReserveBalance
-		sendFee, err := cfg.rpc.WalletSendFee(&boltzrpc.WalletSendRequest{SendAll: &sendAll, Amount: amount, Id: cfg.fromWallet.GetWalletInfo().Id})
+	if amount > 0 {
+		sendFee, err := cfg.rpc.WalletSendFee(&boltzrpc.WalletSendRequest{SendAll: &sendAll, Amount: amount, Id: sendWallet.GetWalletInfo().Id})
 		if err != nil {
 			if status.Code(err) == codes.InvalidArgument {
 				if sendAll {
@@ -165,67 +280,155 @@ func (cfg *ChainConfig) getRecommendation() (*autoswaprpc.ChainRecommendation, e
 		} else {
 			amount = sendFee.Amount
 		}
+		if cfg.directSend {
+			pairInfo = directSendPair(sendFee)
+		}
 
-		checked := check(amount, checkParams{Pair: pairInfo, MaxFeePercent: cfg.maxFeePercent, Budget: &budget.Amount})
-
-		pendingSwaps, err := cfg.database.QueryChainSwaps(database.SwapQuery{
-			States:   []boltzrpc.SwapState{boltzrpc.SwapState_PENDING},
-			TenantId: &cfg.tenant.Id,
+		available := budget.Available(boltz.ChainSwap, recommendation.Pair.From)
+		checked := check(amount, checkParams{
+			Pair:              pairInfo,
+			MaxFeePercent:     cfg.maxFeePercent,
+			MaxSwapFee:        cfg.MaxSwapFee,
+			Budget:            &available,
+			ApprovalThreshold: cfg.ApprovalThreshold,
 		})
-		if err != nil {
-			return nil, fmt.Errorf("could not query pending swaps: %w", err)
-		}
+
 		if len(pendingSwaps) > 0 {
 			checked.Dismiss(ReasonPendingSwap)
 		}
+		deferred, err := cfg.checkDeferral(cfg.deferral, recommendation.Pair.From)
+		if err != nil {
+			return nil, err
+		}
+		for _, reason := range deferred {
+			checked.Dismiss(reason)
+		}
 		recommendation.Swap = &checked
 	}
 	return &autoswaprpc.ChainRecommendation{
-		Swap:          serializeAutoChainSwap(recommendation.Swap),
-		WalletBalance: serializers.SerializeWalletBalance(recommendation.FromBalance),
-		MaxBalance:    cfg.MaxBalance,
+		Swap:            serializeAutoChainSwap(recommendation.Swap, recommendation.Pair),
+		WalletBalance:   serializers.SerializeWalletBalance(recommendation.FromBalance),
+		MaxBalance:      cfg.MaxBalance,
+		ToWalletBalance: serializers.SerializeWalletBalance(recommendation.ToBalance),
+		Name:            cfg.Name,
 	}, nil
 }
 
+// directSendPair describes a direct wallet send like a pair, so that it goes through the same checks as a swap.
+// The only cost of a direct send is the network fee, which is unknown if the fee could not be estimated.
+func directSendPair(sendFee *boltzrpc.WalletSendFee) *boltzrpc.PairInfo {
+	pairInfo := &boltzrpc.PairInfo{
+		Fees:   &boltzrpc.SwapFees{},
+		Limits: &boltzrpc.Limits{Maximal: math.MaxUint64},
+	}
+	if sendFee != nil {
+		pairInfo.Fees.MinerFees = sendFee.Fee
+	}
+	return pairInfo
+}
+
 func (cfg *ChainConfig) CheckAndExecute(accepted *autoswaprpc.ChainSwap, force bool) error {
 	cfg.executeLock.Lock()
 	defer cfg.executeLock.Unlock()
 	logger.Debugf("Checking for chain swap recommendation")
+	event := &database.AutoSwapEvent{Swapper: string(Chain), TenantId: cfg.tenant.Id, Rule: cfg.Name}
+	err := cfg.checkAndExecute(event, accepted, force)
+	cfg.recordEvent(event, err)
+	return err
+}
+
+func (cfg *ChainConfig) checkAndExecute(event *database.AutoSwapEvent, accepted *autoswaprpc.ChainSwap, force bool) error {
 	recommendation, err := cfg.getRecommendation()
 	if err != nil {
 		return fmt.Errorf("could not get swap recommendation: %w", err)
 	}
-	return cfg.execute(recommendation.Swap, accepted, force)
+	if recommendation.Swap == nil {
+		return nil
+	}
+	eventSwap := &database.AutoSwapEventSwap{
+		Type:             boltz.ChainSwap,
+		Currency:         serializers.ParsePair(recommendation.Swap.Pair).From,
+		Amount:           recommendation.Swap.Amount,
+		FeeEstimate:      recommendation.Swap.FeeEstimate,
+		DismissedReasons: recommendation.Swap.DismissedReasons,
+	}
+	event.Swaps = append(event.Swaps, eventSwap)
+	if accepted == nil && !force && awaitingApproval(recommendation.Swap.DismissedReasons) {
+		if err := cfg.requestApproval(Chain, cfg.tenant.Id, cfg.Name, eventSwap, cfg.ApprovalExpiry); err != nil {
+			return fmt.Errorf("could not request approval: %w", err)
+		}
+		return nil
+	}
+	eventSwap.SwapId, err = cfg.execute(recommendation.Swap, accepted, force)
+	return err
 }
 
-func (cfg *ChainConfig) execute(swap *autoswaprpc.ChainSwap, accepted *autoswaprpc.ChainSwap, force bool) error {
+func (cfg *ChainConfig) execute(swap *autoswaprpc.ChainSwap, accepted *autoswaprpc.ChainSwap, force bool) (string, error) {
 	if swap != nil {
 		if accepted != nil {
 			if err := checkAcceptedReasons(accepted.DismissedReasons, swap.DismissedReasons); err != nil {
-				return err
+				return "", err
+			}
+			if accepted.Pair != nil && serializers.ParsePair(accepted.Pair) != serializers.ParsePair(swap.Pair) {
+				return "", errors.New("direction of recommended swap changed")
 			}
 		}
 		if !force && len(swap.DismissedReasons) > 0 {
 			logger.Debugf("Skipping swap recommendation %+v", swap)
-			return nil
+			return "", nil
+		}
+		pair, fromWallet, toWallet := cfg.pair, cfg.fromWallet, cfg.toWallet
+		if swap.Pair != nil && serializers.ParsePair(swap.Pair) != cfg.pair {
+			if cfg.TargetRatio == nil || serializers.ParsePair(swap.Pair) != cfg.reversePair() {
+				return "", fmt.Errorf("invalid pair for chain swap: %s", swap.Pair)
+			}
+			pair, fromWallet, toWallet = cfg.reversePair(), cfg.toWallet, cfg.fromWallet
+		}
+		if cfg.directSend {
+			return cfg.send(swap)
 		}
 		logger.Infof("Executing Swap recommendation: %+v", swap)
-		fromWalletId := cfg.fromWallet.GetWalletInfo().Id
+		fromWalletId := fromWallet.GetWalletInfo().Id
 		request := &boltzrpc.CreateChainSwapRequest{
 			Amount:       &swap.Amount,
-			Pair:         serializers.SerializePair(cfg.pair),
+			Pair:         serializers.SerializePair(pair),
 			FromWalletId: &fromWalletId,
 		}
-		if cfg.ToAddress != "" {
+		if toWallet == nil {
 			request.ToAddress = &cfg.ToAddress
 		} else {
-			toWalletId := cfg.toWallet.GetWalletInfo().Id
+			toWalletId := toWallet.GetWalletInfo().Id
 			request.ToWalletId = &toWalletId
 		}
 
-		return cfg.rpc.CreateAutoChainSwap(cfg.tenant, request)
+		return cfg.rpc.CreateAutoChainSwap(cfg.tenant, request, ChainSwapOrigin{Rule: cfg.Name})
 	}
-	return nil
+	return "", nil
+}
+
+// send executes a recommendation of a rule with direct sends and returns the id of the transaction
+func (cfg *ChainConfig) send(swap *autoswaprpc.ChainSwap) (string, error) {
+	logger.Infof("Executing direct send recommendation: %+v", swap)
+	// the recommended amount already has the fee deducted when the whole balance is sent
+	sendAll := cfg.ReserveBalance == 0
+	request := &boltzrpc.WalletSendRequest{
+		Id:      cfg.fromWallet.GetWalletInfo().Id,
+		Amount:  swap.Amount,
+		SendAll: &sendAll,
+	}
+	var toWalletId *database.Id
+	if cfg.toWallet == nil {
+		request.Address = cfg.ToAddress
+	} else {
+		id := cfg.toWallet.GetWalletInfo().Id
+		toWalletId = &id
+	}
+	return cfg.autoWalletSend(cfg.tenant, request, toWalletId, database.AutoSwapSend{
+		Swapper:  string(Chain),
+		Rule:     cfg.Name,
+		Currency: cfg.pair.From,
+		Fee:      swap.FeeEstimate,
+	})
 }
 
 func (cfg *ChainConfig) run(stop <-chan struct{}) {
@@ -11,6 +11,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -70,7 +71,7 @@ func TestChainSwapper(t *testing.T) {
 		err := swapper.UpdateChainConfig(&autoswaprpc.UpdateChainConfigRequest{Config: baseConfig()}, database.DefaultTenant)
 		require.NoError(t, err)
 
-		return swapper, swapper.GetChainSwapper(database.DefaultTenantId), mockProvider, fromWallet
+		return swapper, swapper.GetChainSwapper(database.DefaultTenantId, ""), mockProvider, fromWallet
 	}
 
 	test.InitLogger()
@@ -227,34 +228,279 @@ func TestChainSwapper(t *testing.T) {
 		}
 	})
 
+	t.Run("TargetRatio", func(t *testing.T) {
+		toInfo := onchain.WalletInfo{Id: 2, Name: "cold", Currency: boltz.CurrencyBtc}
+		ratio := float32(20)
+
+		forward := boltz.Pair{From: boltz.CurrencyLiquid, To: boltz.CurrencyBtc}
+		backward := boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyLiquid}
+
+		tests := []struct {
+			name            string
+			from            uint64
+			fromUnconfirmed uint64
+			to              uint64
+			toUnconfirmed   uint64
+			pending         []database.ChainSwap
+			expectedAmount  uint64
+			expectedPair    boltz.Pair
+			expectedReasons []string
+			sendWallet      database.Id
+		}{
+			{name: "Forward", from: 500_000, to: 500_000, expectedAmount: 300_000, expectedPair: forward, sendWallet: walletInfo.Id},
+			{name: "Backward", from: 50_000, to: 950_000, expectedAmount: 150_000, expectedPair: backward, sendWallet: toInfo.Id},
+			{name: "WithinBand", from: 250_000, to: 750_000},
+			{name: "Unconfirmed", from: 500_000, toUnconfirmed: 500_000, expectedAmount: 300_000, expectedPair: forward, sendWallet: walletInfo.Id},
+			{name: "OnlyConfirmedSent", from: 100_000, fromUnconfirmed: 400_000, to: 500_000, expectedAmount: 100_000, expectedPair: forward, sendWallet: walletInfo.Id},
+			{
+				name:            "Pending",
+				from:            500_000,
+				to:              400_000,
+				pending:         []database.ChainSwap{{Pair: forward, State: boltzrpc.SwapState_PENDING, ToData: &database.ChainSwapData{Amount: 100_000}}},
+				expectedAmount:  300_000,
+				expectedPair:    forward,
+				expectedReasons: []string{ReasonPendingSwap},
+				sendWallet:      walletInfo.Id,
+			},
+			{
+				name:    "PendingWithinBand",
+				from:    250_000,
+				to:      650_000,
+				pending: []database.ChainSwap{{Pair: backward, State: boltzrpc.SwapState_PENDING, ToData: &database.ChainSwapData{Amount: 100_000}}},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				shared := getShared(t)
+				fromBalance := &onchain.Balance{Confirmed: tc.from, Unconfirmed: tc.fromUnconfirmed, Total: tc.from + tc.fromUnconfirmed}
+				toBalance := &onchain.Balance{Confirmed: tc.to, Unconfirmed: tc.toUnconfirmed, Total: tc.to + tc.toUnconfirmed}
+				shared.onchain.AddWallet(mockedWallet{info: walletInfo, balance: fromBalance}.Create(t))
+				shared.onchain.AddWallet(mockedWallet{info: toInfo, balance: toBalance}.Create(t))
+				test.FakeSwaps{ChainSwaps: tc.pending}.Create(t, shared.database)
+
+				mockRpc(shared).EXPECT().GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, mock.Anything).Return(newPairInfo(), nil)
+				mockRpc(shared).EXPECT().WalletSendFee(mock.Anything).RunAndReturn(func(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendFee, error) {
+					require.Equal(t, tc.sendWallet, request.GetId())
+					return &boltzrpc.WalletSendFee{Amount: request.Amount}, nil
+				}).Maybe()
+
+				chainConfig := NewChainConfig(&SerializedChainConfig{
+					FromWallet:    walletInfo.Name,
+					ToWallet:      toInfo.Name,
+					TargetRatio:   &ratio,
+					MaxFeePercent: 10,
+					Budget:        1_000_000,
+				}, shared)
+				require.NoError(t, chainConfig.Init())
+
+				result, err := chainConfig.GetRecommendation()
+				require.NoError(t, err)
+				require.Equal(t, tc.to, result.ToWalletBalance.Confirmed)
+				if tc.expectedAmount == 0 {
+					require.Nil(t, result.Swap)
+					return
+				}
+				require.Equal(t, tc.expectedAmount, result.Swap.Amount)
+				require.Equal(t, tc.expectedReasons, result.Swap.DismissedReasons)
+				if len(tc.expectedReasons) > 0 {
+					return
+				}
+
+				mockRpc(shared).EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ *database.Tenant, request *boltzrpc.CreateChainSwapRequest, _ ChainSwapOrigin) (string, error) {
+					require.Equal(t, tc.expectedPair, serializers.ParsePair(request.Pair))
+					require.Equal(t, tc.sendWallet, request.GetFromWalletId())
+					require.NotNil(t, request.ToWalletId)
+					return "swapId", nil
+				}).Once()
+				swapId, err := chainConfig.execute(result.Swap, nil, false)
+				require.NoError(t, err)
+				require.Equal(t, "swapId", swapId)
+			})
+		}
+
+		t.Run("Invalid", func(t *testing.T) {
+			shared := getShared(t)
+			invalid := float32(100)
+			chainConfig := NewChainConfig(&SerializedChainConfig{
+				FromWallet:  walletInfo.Name,
+				ToAddress:   "bcrt1q2q5f9te4va7xet4c93awrurux04h0pfwcuzzcu",
+				TargetRatio: &ratio,
+			}, shared)
+			require.ErrorContains(t, chainConfig.Init(), "ToWallet")
+
+			chainConfig = NewChainConfig(&SerializedChainConfig{
+				FromWallet:  walletInfo.Name,
+				ToWallet:    toInfo.Name,
+				TargetRatio: &invalid,
+			}, shared)
+			require.Error(t, chainConfig.Init())
+		})
+	})
+
 	t.Run("Execute", func(t *testing.T) {
 		_, chainSwapper, rpcMock, _ := setup(t)
 
 		var amount uint64 = 750
 
-		rpcMock.EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything).RunAndReturn(func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest) error {
+		rpcMock.EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything, ChainSwapOrigin{}).RunAndReturn(func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, _ ChainSwapOrigin) (string, error) {
 			require.Equal(t, database.DefaultTenantId, tenant.Id)
 			require.Equal(t, amount, request.GetAmount())
 			require.NotNil(t, request.FromWalletId)
 			require.NotZero(t, request.ToAddress)
-			return nil
+			return "swapId", nil
 		}).Times(3)
 
+		execute := func(swap, accepted *autoswaprpc.ChainSwap, force bool) (string, error) {
+			return chainSwapper.cfg.execute(swap, accepted, force)
+		}
+
 		swap := &autoswaprpc.ChainSwap{Amount: amount}
-		require.NoError(t, chainSwapper.cfg.execute(swap, nil, false))
-		require.NoError(t, chainSwapper.cfg.execute(nil, nil, false))
+		id, err := execute(swap, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, "swapId", id)
+		id, err = execute(nil, nil, false)
+		require.NoError(t, err)
+		require.Empty(t, id)
 		swap.DismissedReasons = []string{ReasonBudgetExceeded}
-		require.NoError(t, chainSwapper.cfg.execute(swap, nil, false))
-		require.NoError(t, chainSwapper.cfg.execute(swap, nil, true))
+		id, err = execute(swap, nil, false)
+		require.NoError(t, err)
+		require.Empty(t, id)
+		_, err = execute(swap, nil, true)
+		require.NoError(t, err)
 
 		accepted := &autoswaprpc.ChainSwap{
 			DismissedReasons: swap.DismissedReasons,
 			Amount:           amount + 50,
 			FeeEstimate:      swap.FeeEstimate + 1,
 		}
-		require.NoError(t, chainSwapper.cfg.execute(swap, accepted, true))
+		_, err = execute(swap, accepted, true)
+		require.NoError(t, err)
 		accepted.DismissedReasons = []string{}
-		require.Error(t, chainSwapper.cfg.execute(swap, accepted, true))
+		_, err = execute(swap, accepted, true)
+		require.Error(t, err)
+	})
+
+	t.Run("DirectSend", func(t *testing.T) {
+		shared := getShared(t)
+		hotInfo := onchain.WalletInfo{Id: 1, Name: "hot", Currency: boltz.CurrencyBtc}
+		coldInfo := onchain.WalletInfo{Id: 2, Name: "cold", Currency: boltz.CurrencyBtc}
+		shared.onchain.AddWallet(mockedWallet{info: hotInfo, balance: &defaultBalance}.Create(t))
+		shared.onchain.AddWallet(mockedWallet{info: coldInfo}.Create(t))
+
+		chainConfig := NewChainConfig(&SerializedChainConfig{
+			Name:          "cold",
+			FromWallet:    hotInfo.Name,
+			ToWallet:      coldInfo.Name,
+			MaxBalance:    100000,
+			MaxFeePercent: 10,
+			Budget:        100000,
+		}, shared)
+		require.NoError(t, chainConfig.Init())
+
+		sendFee := uint64(500)
+		mockRpc(shared).EXPECT().WalletSendFee(mock.Anything).RunAndReturn(func(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendFee, error) {
+			require.Equal(t, hotInfo.Id, request.Id)
+			return &boltzrpc.WalletSendFee{Amount: request.Amount - sendFee, Fee: sendFee}, nil
+		})
+
+		result, err := chainConfig.GetRecommendation()
+		require.NoError(t, err)
+		require.NotNil(t, result.Swap)
+		require.Equal(t, defaultBalance.Confirmed-sendFee, result.Swap.Amount)
+		require.Equal(t, sendFee, result.Swap.FeeEstimate)
+		require.Empty(t, result.Swap.DismissedReasons)
+
+		mockRpc(shared).EXPECT().AutoWalletSend(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error) {
+				require.Equal(t, hotInfo.Id, request.Id)
+				require.True(t, request.GetSendAll())
+				require.Empty(t, request.Address)
+				require.Equal(t, coldInfo.Id, *toWalletId)
+				return "txId", nil
+			},
+		).Once()
+
+		id, err := chainConfig.execute(result.Swap, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, "txId", id)
+
+		budget, err := chainConfig.GetCurrentBudget()
+		require.NoError(t, err)
+		require.Equal(t, budget.Total-sendFee, budget.Amount)
+
+		ratio := float32(50)
+		chainConfig = NewChainConfig(&SerializedChainConfig{
+			FromWallet:  hotInfo.Name,
+			ToWallet:    coldInfo.Name,
+			TargetRatio: &ratio,
+		}, shared)
+		require.ErrorContains(t, chainConfig.Init(), "different currencies")
+	})
+
+	t.Run("Rules", func(t *testing.T) {
+		swapper, defaultSwapper, rpcMock, _ := setup(t)
+		otherWallet := onchain.WalletInfo{Id: 2, Name: "other", Currency: boltz.CurrencyLiquid}
+		swapper.onchain.AddWallet(mockedWallet{info: otherWallet}.Create(t))
+
+		config := baseConfig()
+		config.Name = "other"
+		config.FromWallet = otherWallet.Name
+		config.Budget = 5000
+		err := swapper.UpdateChainConfig(&autoswaprpc.UpdateChainConfigRequest{Config: config}, database.DefaultTenant)
+		require.NoError(t, err)
+
+		swappers := swapper.GetChainSwappers(database.DefaultTenantId)
+		require.Len(t, swappers, 2)
+		require.Equal(t, defaultSwapper, swappers[0])
+		ruleSwapper := swappers[1]
+		require.Equal(t, ruleSwapper, swapper.GetChainSwapper(database.DefaultTenantId, config.Name))
+		require.Len(t, swapper.GetConfig(nil).Chain, 2)
+
+		defaultBudget, err := defaultSwapper.cfg.GetCurrentBudget()
+		require.NoError(t, err)
+		ruleBudget, err := ruleSwapper.cfg.GetCurrentBudget()
+		require.NoError(t, err)
+		require.Equal(t, uint64(5000), ruleBudget.Total)
+		require.NotEqual(t, defaultBudget.Total, ruleBudget.Total)
+
+		rpcMock.EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything, ChainSwapOrigin{Rule: config.Name}).RunAndReturn(func(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin) (string, error) {
+			require.Equal(t, otherWallet.Id, request.GetFromWalletId())
+			fakeSwaps := test.FakeSwaps{ChainSwaps: []database.ChainSwap{
+				{Id: "ruleSwap", State: boltzrpc.SwapState_PENDING, IsAuto: true, CreatedAt: time.Now(), AutoSwapRule: origin.Rule},
+			}}
+			fakeSwaps.Create(t, swapper.database)
+			return "ruleSwap", nil
+		}).Once()
+		id, err := ruleSwapper.cfg.execute(&autoswaprpc.ChainSwap{Amount: 1000}, nil, false)
+		require.NoError(t, err)
+
+		swap, err := swapper.database.QueryChainSwap(id)
+		require.NoError(t, err)
+		require.Equal(t, config.Name, swap.AutoSwapRule)
+
+		// the swap only counts towards the budget of the rule which created it
+		defaultBudget, err = defaultSwapper.cfg.GetCurrentBudget()
+		require.NoError(t, err)
+		require.Zero(t, defaultBudget.Stats.Count)
+		ruleBudget, err = ruleSwapper.cfg.GetCurrentBudget()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), ruleBudget.Stats.Count)
+
+		shared := baseConfig()
+		shared.Name = "shared"
+		shared.FromWallet = otherWallet.Name
+		err = swapper.UpdateChainConfig(&autoswaprpc.UpdateChainConfigRequest{Config: shared}, database.DefaultTenant)
+		require.ErrorContains(t, err, "already used")
+		require.Len(t, swapper.GetChainSwappers(database.DefaultTenantId), 2)
+
+		reset := true
+		err = swapper.UpdateChainConfig(&autoswaprpc.UpdateChainConfigRequest{
+			Config: &autoswaprpc.ChainConfig{Name: config.Name},
+			Reset_: &reset,
+		}, database.DefaultTenant)
+		require.NoError(t, err)
+		require.Equal(t, []*ChainSwapper{defaultSwapper}, swapper.GetChainSwappers(database.DefaultTenantId))
 	})
 
 	t.Run("Start", func(t *testing.T) {
@@ -262,7 +508,7 @@ func TestChainSwapper(t *testing.T) {
 
 		pairInfo := newPairInfo()
 		rpcMock.EXPECT().GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, mock.Anything).Return(pairInfo, nil).Once()
-		rpcMock.EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything).Return(nil).Once()
+		rpcMock.EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything, mock.Anything).Return("swapId", nil).Once()
 		rpcMock.EXPECT().WalletSendFee(mock.Anything).RunAndReturn(func(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendFee, error) {
 			return &boltzrpc.WalletSendFee{Amount: request.Amount}, nil
 		}).Maybe()
@@ -0,0 +1,293 @@
+package autoswap
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/internal/utils"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// channelOpening grows the capacity of the node by moving funds of an onchain wallet to the lightning node
+// and opening channels with them
+type channelOpening struct {
+	*autoswaprpc.ChannelOpeningConfig
+	wallet onchain.Wallet
+}
+
+func (cfg *LightningConfig) initChannelOpening() error {
+	cfg.channelOpening = nil
+	serialized := cfg.ChannelOpening
+	if serialized == nil {
+		return nil
+	}
+	if serialized.MinOutbound == 0 {
+		return errors.New("channel opening: min outbound must be set")
+	}
+	if serialized.ChannelSize == 0 {
+		return errors.New("channel opening: channel size must be set")
+	}
+	if len(serialized.Peers) == 0 {
+		return errors.New("channel opening: at least one peer is required")
+	}
+	for _, peer := range serialized.Peers {
+		pubkey, err := hex.DecodeString(peer.Pubkey)
+		if err == nil {
+			_, err = btcec.ParsePubKey(pubkey)
+		}
+		if err != nil {
+			return fmt.Errorf("channel opening: invalid peer %s: %w", peer.Pubkey, err)
+		}
+	}
+	wallet, err := cfg.onchain.GetAnyWallet(onchain.WalletChecker{
+		Name:          &serialized.Wallet,
+		AllowReadonly: false,
+	})
+	if err != nil {
+		return fmt.Errorf("channel opening: could not find wallet: %w", err)
+	}
+	cfg.channelOpening = &channelOpening{ChannelOpeningConfig: serialized, wallet: wallet}
+	cfg.description += fmt.Sprintf(
+		", opening channels of %d sats with funds of wallet %s below %d sats outbound",
+		serialized.ChannelSize, serialized.Wallet, serialized.MinOutbound,
+	)
+	if serialized.Splice {
+		cfg.description += " or splicing into existing channels"
+	}
+	return nil
+}
+
+// pickPeer returns the configured peer we have the least capacity with
+func (opening *channelOpening) pickPeer(channels []*lightning.LightningChannel) *autoswaprpc.ChannelPeer {
+	capacity := make(map[string]uint64)
+	for _, channel := range channels {
+		capacity[strings.ToLower(channel.PeerId)] += channel.Capacity
+	}
+	var picked *autoswaprpc.ChannelPeer
+	for _, peer := range opening.Peers {
+		if picked == nil || capacity[strings.ToLower(peer.Pubkey)] < capacity[strings.ToLower(picked.Pubkey)] {
+			picked = peer
+		}
+	}
+	return picked
+}
+
+// spliceChannel returns the largest channel with the peer funds can be spliced into
+func (cfg *LightningConfig) spliceChannel(channels []*lightning.LightningChannel, peer *autoswaprpc.ChannelPeer) *lightning.LightningChannel {
+	var largest *lightning.LightningChannel
+	for _, channel := range channels {
+		if !strings.EqualFold(channel.PeerId, peer.Pubkey) || cfg.channelRule(channel).GetExclude() {
+			continue
+		}
+		if largest == nil || channel.Capacity > largest.Capacity {
+			largest = channel
+		}
+	}
+	return largest
+}
+
+// checkChannelOpening opens a channel if the outbound balance is too low and the lightning node holds enough funds,
+// otherwise the missing funds are moved to the node
+func (cfg *LightningConfig) checkChannelOpening(event *database.AutoSwapEvent) error {
+	opening := cfg.channelOpening
+	channels, err := cfg.rpc.GetLightningChannels(cfg.LightningNode)
+	if err != nil {
+		return fmt.Errorf("could not get channels: %w", err)
+	}
+	var outbound uint64
+	for _, channel := range channels {
+		if !cfg.channelRule(channel).GetExclude() {
+			outbound += channel.OutboundSat
+		}
+	}
+	if outbound >= opening.MinOutbound {
+		return nil
+	}
+
+	deferred, err := cfg.checkDeferral(cfg.deferral, boltz.CurrencyBtc)
+	if err != nil {
+		return err
+	}
+	if len(deferred) > 0 {
+		logger.Debugf("Deferring channel opening: %s", strings.Join(deferred, ", "))
+		return nil
+	}
+
+	node, err := cfg.rpc.GetLightningNode(cfg.LightningNode)
+	if err != nil {
+		return err
+	}
+	feeRate, err := cfg.rpc.EstimateFee(boltz.CurrencyBtc)
+	if err != nil {
+		return fmt.Errorf("could not estimate fee rate: %w", err)
+	}
+	// the funding transaction is paid by the node on top of the channel size
+	required := opening.ChannelSize + uint64(math.Ceil(feeRate*estimatedTxVsize))
+
+	balance, err := node.GetBalance()
+	if err != nil {
+		return fmt.Errorf("could not get node balance: %w", err)
+	}
+	if balance.Confirmed >= required {
+		peer := opening.pickPeer(channels)
+		if channel := cfg.spliceChannel(channels, peer); opening.Splice && channel != nil {
+			logger.Infof("Splicing %d sats into channel %s with %s", opening.ChannelSize, channel.Id, peer.Pubkey)
+			txId, err := node.SpliceChannel(lightning.SpliceRequest{
+				ChannelId:   channel.Id,
+				Amount:      int64(opening.ChannelSize),
+				SatPerVbyte: feeRate,
+			})
+			if err != nil {
+				return fmt.Errorf("could not splice into channel %s: %w", channel.Id, err)
+			}
+			logger.Infof("Spliced into channel %s: %s", channel.Id, txId)
+			return nil
+		}
+		logger.Infof("Opening channel of %d sats with %s", opening.ChannelSize, peer.Pubkey)
+		point, err := node.OpenChannel(lightning.OpenChannelRequest{
+			PeerId:      peer.Pubkey,
+			Host:        peer.GetHost(),
+			Amount:      opening.ChannelSize,
+			SatPerVbyte: feeRate,
+			Private:     opening.Private,
+		})
+		if err != nil {
+			return fmt.Errorf("could not open channel with %s: %w", peer.Pubkey, err)
+		}
+		logger.Infof("Opened channel with %s: %s:%d", peer.Pubkey, point.FundingTxId, point.OutputIndex)
+		return nil
+	}
+	if balance.Unconfirmed > 0 {
+		logger.Debugf("Waiting for %d sats of the lightning node to confirm before opening a channel", balance.Unconfirmed)
+		return nil
+	}
+
+	pending, err := cfg.pendingNodeFunding()
+	if err != nil {
+		return err
+	}
+	if pending {
+		logger.Debugf("Waiting for pending swap to the lightning node before opening a channel")
+		return nil
+	}
+	return cfg.fundNode(event, node, required-balance.Confirmed, feeRate)
+}
+
+func (cfg *LightningConfig) pendingNodeFunding() (bool, error) {
+	tenantId := database.DefaultTenantId
+	swaps, err := cfg.database.QueryChainSwaps(database.SwapQuery{
+		States:        []boltzrpc.SwapState{boltzrpc.SwapState_PENDING},
+		Include:       boltzrpc.IncludeSwaps_AUTO,
+		TenantId:      &tenantId,
+		LightningNode: &cfg.LightningNode,
+	})
+	if err != nil {
+		return false, fmt.Errorf("could not query pending swaps: %w", err)
+	}
+	return len(swaps) > 0, nil
+}
+
+// fundNode moves the given amount from the configured wallet to the onchain wallet of the lightning node
+func (cfg *LightningConfig) fundNode(event *database.AutoSwapEvent, node lightning.LightningNode, amount uint64, btcFeeRate float64) error {
+	opening := cfg.channelOpening
+	walletInfo := opening.wallet.GetWalletInfo()
+	balance, err := opening.wallet.GetBalance()
+	if err != nil {
+		return fmt.Errorf("could not get wallet balance: %w", err)
+	}
+	var available uint64
+	if balance.Confirmed > opening.ReserveBalance {
+		available = balance.Confirmed - opening.ReserveBalance
+	}
+
+	// funds of a BTC wallet are sent directly, which goes through the same checks as a swap
+	sendRequest := &boltzrpc.WalletSendRequest{Id: walletInfo.Id, Amount: amount, SatPerVbyte: &btcFeeRate}
+	var pairInfo *boltzrpc.PairInfo
+	pair := &boltzrpc.Pair{From: boltzrpc.Currency_LBTC, To: boltzrpc.Currency_BTC}
+	sendAmount := amount
+	if walletInfo.Currency == boltz.CurrencyBtc {
+		sendFee, err := cfg.rpc.WalletSendFee(sendRequest)
+		if err != nil {
+			if status.Code(err) == codes.InvalidArgument {
+				logger.Debugf("Not enough funds in wallet %s to fund channel opening: %v", walletInfo.Name, err)
+				return nil
+			}
+			return fmt.Errorf("could not get send fee: %w", err)
+		}
+		pairInfo = directSendPair(sendFee)
+		available -= min(available, sendFee.Fee)
+	} else {
+		pairInfo, err = cfg.rpc.GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, pair)
+		if err != nil {
+			return fmt.Errorf("could not get pair info: %w", err)
+		}
+		quote, err := utils.CalculateSwapQuote(boltz.ChainSwap, 0, amount, pairInfo.Fees)
+		if err != nil {
+			return err
+		}
+		sendAmount = quote.SendAmount
+	}
+	budget, err := cfg.GetCurrentBudget()
+	if err != nil {
+		return fmt.Errorf("could not get budget: %w", err)
+	}
+	remainingBudget := budget.Available(boltz.ChainSwap, walletInfo.Currency)
+	swap := check(sendAmount, checkParams{
+		Pair:          pairInfo,
+		MaxFeePercent: cfg.maxFeePercent,
+		MaxSwapFee:    cfg.MaxSwapFee,
+		Budget:        &remainingBudget,
+	})
+	if available < swap.Amount {
+		swap.Dismiss(ReasonInsufficientFunds)
+	}
+	eventSwap := &database.AutoSwapEventSwap{
+		Type:             boltz.ChainSwap,
+		Currency:         walletInfo.Currency,
+		Amount:           swap.Amount,
+		FeeEstimate:      swap.FeeEstimate,
+		DismissedReasons: swap.DismissedReasons,
+	}
+	event.Swaps = append(event.Swaps, eventSwap)
+	if swap.Dismissed() {
+		logger.Debugf("Not funding channel opening: %s", strings.Join(swap.DismissedReasons, ", "))
+		return nil
+	}
+	fromWalletId, toWalletId := walletInfo.Id, node.GetWalletInfo().Id
+	if walletInfo.Currency == boltz.CurrencyBtc {
+		eventSwap.SwapId, err = cfg.autoWalletSend(&database.DefaultTenant, sendRequest, &toWalletId, database.AutoSwapSend{
+			Swapper:  string(Lightning),
+			Rule:     cfg.LightningNode,
+			Currency: boltz.CurrencyBtc,
+			Fee:      swap.FeeEstimate,
+		})
+		if err != nil {
+			return fmt.Errorf("could not send funds to node: %w", err)
+		}
+		logger.Infof("Sent %d sats from wallet %s to lightning node for channel opening: %s", amount, walletInfo.Name, eventSwap.SwapId)
+		return nil
+	}
+	eventSwap.SwapId, err = cfg.rpc.CreateAutoChainSwap(&database.DefaultTenant, &boltzrpc.CreateChainSwapRequest{
+		Amount:       &swap.Amount,
+		Pair:         pair,
+		FromWalletId: &fromWalletId,
+		ToWalletId:   &toWalletId,
+	}, ChainSwapOrigin{LightningNode: &cfg.LightningNode})
+	if err != nil {
+		return fmt.Errorf("could not create chain swap to node: %w", err)
+	}
+	logger.Infof("Created chain swap %s to fund channel opening", eventSwap.SwapId)
+	return nil
+}
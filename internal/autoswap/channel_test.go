@@ -0,0 +1,229 @@
+package autoswap
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	lnmock "github.com/BoltzExchange/boltz-client/v2/internal/mocks/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/internal/test"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newPeer(t *testing.T) *autoswaprpc.ChannelPeer {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	return &autoswaprpc.ChannelPeer{Pubkey: hex.EncodeToString(key.PubKey().SerializeCompressed())}
+}
+
+func TestChannelOpening(t *testing.T) {
+	const nodeWalletId = database.Id(10)
+	peers := []*autoswaprpc.ChannelPeer{newPeer(t), newPeer(t)}
+
+	setup := func(t *testing.T, funds *onchain.Balance, currency boltz.Currency) (*LightningConfig, *MockRpcProvider) {
+		chain := getOnchain()
+		chain.AddWallet(mockedWallet{
+			info: onchain.WalletInfo{Id: 1, Name: "test", Currency: boltz.CurrencyBtc},
+		}.Create(t))
+		chain.AddWallet(mockedWallet{
+			info:    onchain.WalletInfo{Id: 2, Name: "funds", Currency: currency},
+			balance: funds,
+		}.Create(t))
+		return getLnConfig(t, &SerializedLnConfig{
+			InboundBalancePercent: 30,
+			SwapType:              "reverse",
+			Wallet:                "test",
+			Budget:                100_000,
+			MaxFeePercent:         2,
+			ChannelOpening: &autoswaprpc.ChannelOpeningConfig{
+				MinOutbound:    500_000,
+				ChannelSize:    200_000,
+				Peers:          peers,
+				Wallet:         "funds",
+				ReserveBalance: 10_000,
+			},
+		}, chain)
+	}
+
+	channels := []*lightning.LightningChannel{
+		{Id: 1, PeerId: peers[0].Pubkey, Capacity: 300_000, OutboundSat: 100_000, InboundSat: 200_000},
+	}
+
+	mockNode := func(t *testing.T, balance *onchain.Balance) *lnmock.MockLightningNode {
+		node := lnmock.NewMockLightningNode(t)
+		node.EXPECT().GetBalance().Return(balance, nil)
+		node.EXPECT().GetWalletInfo().Return(onchain.WalletInfo{Id: nodeWalletId, Currency: boltz.CurrencyBtc}).Maybe()
+		return node
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		cfg := NewLightningConfig(withThresholds(&SerializedLnConfig{
+			ChannelOpening: &autoswaprpc.ChannelOpeningConfig{
+				MinOutbound: 500_000,
+				ChannelSize: 200_000,
+				Peers:       []*autoswaprpc.ChannelPeer{{Pubkey: "invalid"}},
+			},
+		}), shared{onchain: getOnchain(), rpc: NewMockRpcProvider(t), database: getTestDb(t)})
+		require.ErrorContains(t, cfg.Init(), "invalid peer")
+	})
+
+	t.Run("SufficientOutbound", func(t *testing.T) {
+		cfg, rpc := setup(t, nil, boltz.CurrencyLiquid)
+		rpc.EXPECT().GetLightningChannels("").Return([]*lightning.LightningChannel{
+			{Id: 1, Capacity: 1_000_000, OutboundSat: 600_000, InboundSat: 400_000},
+		}, nil)
+		require.NoError(t, cfg.checkChannelOpening(&database.AutoSwapEvent{}))
+	})
+
+	t.Run("Open", func(t *testing.T) {
+		cfg, rpc := setup(t, nil, boltz.CurrencyLiquid)
+		node := mockNode(t, &onchain.Balance{Confirmed: 250_000})
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2, nil)
+		node.EXPECT().OpenChannel(lightning.OpenChannelRequest{
+			// the second peer is picked since we don't have any channels with it yet
+			PeerId:      peers[1].Pubkey,
+			Amount:      200_000,
+			SatPerVbyte: 2,
+		}).Return(&lightning.ChannelPoint{FundingTxId: "txid"}, nil)
+		require.NoError(t, cfg.checkChannelOpening(&database.AutoSwapEvent{}))
+	})
+
+	t.Run("Splice", func(t *testing.T) {
+		cfg, rpc := setup(t, nil, boltz.CurrencyLiquid)
+		cfg.channelOpening.Splice = true
+		node := mockNode(t, &onchain.Balance{Confirmed: 250_000})
+		rpc.EXPECT().GetLightningChannels("").Return([]*lightning.LightningChannel{
+			{Id: 1, PeerId: peers[0].Pubkey, Capacity: 400_000, OutboundSat: 100_000, InboundSat: 300_000},
+			{Id: 2, PeerId: peers[1].Pubkey, Capacity: 100_000, OutboundSat: 50_000, InboundSat: 50_000},
+			{Id: 3, PeerId: peers[1].Pubkey, Capacity: 200_000, OutboundSat: 50_000, InboundSat: 150_000},
+		}, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2, nil)
+		// the largest channel with the peer we have the least capacity with is grown
+		node.EXPECT().SpliceChannel(lightning.SpliceRequest{
+			ChannelId:   3,
+			Amount:      200_000,
+			SatPerVbyte: 2,
+		}).Return("txid", nil)
+		require.NoError(t, cfg.checkChannelOpening(&database.AutoSwapEvent{}))
+	})
+
+	t.Run("SpliceNewPeer", func(t *testing.T) {
+		cfg, rpc := setup(t, nil, boltz.CurrencyLiquid)
+		cfg.channelOpening.Splice = true
+		node := mockNode(t, &onchain.Balance{Confirmed: 250_000})
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2, nil)
+		node.EXPECT().OpenChannel(mock.Anything).Return(&lightning.ChannelPoint{FundingTxId: "txid"}, nil)
+		require.NoError(t, cfg.checkChannelOpening(&database.AutoSwapEvent{}))
+	})
+
+	t.Run("Unconfirmed", func(t *testing.T) {
+		cfg, rpc := setup(t, nil, boltz.CurrencyLiquid)
+		node := mockNode(t, &onchain.Balance{Unconfirmed: 250_000})
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2, nil)
+		require.NoError(t, cfg.checkChannelOpening(&database.AutoSwapEvent{}))
+	})
+
+	t.Run("ChainSwap", func(t *testing.T) {
+		cfg, rpc := setup(t, &onchain.Balance{Confirmed: 1_000_000}, boltz.CurrencyLiquid)
+		node := mockNode(t, &onchain.Balance{Confirmed: 50_000})
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2, nil)
+		rpc.EXPECT().GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, mock.Anything).Return(newPairInfo(), nil)
+		nodeName := ""
+		rpc.EXPECT().CreateAutoChainSwap(mock.Anything, mock.Anything, ChainSwapOrigin{LightningNode: &nodeName}).RunAndReturn(
+			func(_ *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin) (string, error) {
+				require.Equal(t, nodeWalletId, request.GetToWalletId())
+				require.Equal(t, database.Id(2), request.GetFromWalletId())
+				require.Equal(t, boltzrpc.Currency_LBTC, request.Pair.From)
+				fee := int64(100)
+				test.FakeSwaps{ChainSwaps: []database.ChainSwap{{
+					Id:            "swapId",
+					Pair:          boltz.Pair{From: boltz.CurrencyLiquid, To: boltz.CurrencyBtc},
+					State:         boltzrpc.SwapState_PENDING,
+					IsAuto:        true,
+					CreatedAt:     time.Now(),
+					ServiceFee:    &fee,
+					LightningNode: origin.LightningNode,
+				}}}.Create(t, cfg.database)
+				return "swapId", nil
+			},
+		)
+
+		event := &database.AutoSwapEvent{}
+		require.NoError(t, cfg.checkChannelOpening(event))
+		require.Len(t, event.Swaps, 1)
+		require.Equal(t, "swapId", event.Swaps[0].SwapId)
+		require.Equal(t, boltz.ChainSwap, event.Swaps[0].Type)
+		// the swap has to cover the missing funds, including the fee of the funding transaction
+		require.Greater(t, event.Swaps[0].Amount, uint64(150_000))
+
+		// the funding swap counts towards the budget of the lightning swapper
+		budget, err := cfg.GetCurrentBudget()
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), budget.Stats.Count)
+
+		// but not towards the one of the default chain rule
+		chainBudget, err := cfg.shared.GetCurrentBudget(Chain, &SerializedChainConfig{Budget: 100_000, BudgetInterval: 1000}, database.DefaultTenantId, "")
+		require.NoError(t, err)
+		require.Zero(t, chainBudget.Stats.Count)
+	})
+
+	t.Run("Send", func(t *testing.T) {
+		cfg, rpc := setup(t, &onchain.Balance{Confirmed: 1_000_000}, boltz.CurrencyBtc)
+		node := mockNode(t, &onchain.Balance{Confirmed: 50_000})
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2.5, nil)
+		sendFee := uint64(400)
+		rpc.EXPECT().WalletSendFee(mock.Anything).Return(&boltzrpc.WalletSendFee{Fee: sendFee}, nil)
+		rpc.EXPECT().AutoWalletSend(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+			func(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error) {
+				require.Equal(t, database.Id(2), request.Id)
+				require.Equal(t, 2.5, request.GetSatPerVbyte())
+				require.Equal(t, nodeWalletId, *toWalletId)
+				return "txId", nil
+			},
+		)
+
+		event := &database.AutoSwapEvent{}
+		require.NoError(t, cfg.checkChannelOpening(event))
+		require.Len(t, event.Swaps, 1)
+		require.Equal(t, "txId", event.Swaps[0].SwapId)
+		require.Equal(t, sendFee, event.Swaps[0].FeeEstimate)
+
+		budget, err := cfg.GetCurrentBudget()
+		require.NoError(t, err)
+		require.Equal(t, budget.Total-sendFee, budget.Amount)
+	})
+
+	t.Run("InsufficientFunds", func(t *testing.T) {
+		cfg, rpc := setup(t, &onchain.Balance{Confirmed: 100_000}, boltz.CurrencyLiquid)
+		node := mockNode(t, &onchain.Balance{})
+		rpc.EXPECT().GetLightningChannels("").Return(channels, nil)
+		rpc.EXPECT().GetLightningNode("").Return(node, nil)
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(2, nil)
+		rpc.EXPECT().GetAutoSwapPairInfo(boltzrpc.SwapType_CHAIN, mock.Anything).Return(newPairInfo(), nil)
+
+		event := &database.AutoSwapEvent{}
+		require.NoError(t, cfg.checkChannelOpening(event))
+		require.Len(t, event.Swaps, 1)
+		require.Empty(t, event.Swaps[0].SwapId)
+		require.Contains(t, event.Swaps[0].DismissedReasons, ReasonInsufficientFunds)
+	})
+}
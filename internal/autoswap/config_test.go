@@ -170,6 +170,27 @@ func TestLightningConfig(t *testing.T) {
 			wallets: []onchain.WalletInfo{{Id: 1, Name: "test", Currency: boltz.CurrencyLiquid, Readonly: true}},
 			err:     true,
 		},
+		{
+			name: "Wallet/RequiresCosigners",
+			cfg: &SerializedLnConfig{
+				Wallet:   "test",
+				Currency: boltzrpc.Currency_LBTC,
+				Enabled:  true,
+			},
+			wallets: []onchain.WalletInfo{{Id: 1, Name: "test", Currency: boltz.CurrencyLiquid, RequiresCosigners: true}},
+			err:     true,
+		},
+		{
+			name: "Wallet/RequiresCosigners/Reverse",
+			cfg: &SerializedLnConfig{
+				Wallet:   "test",
+				Currency: boltzrpc.Currency_LBTC,
+				SwapType: "reverse",
+				Enabled:  true,
+			},
+			wallets: []onchain.WalletInfo{{Id: 1, Name: "test", Currency: boltz.CurrencyLiquid, RequiresCosigners: true}},
+			err:     false,
+		},
 	}
 
 	for _, tc := range tt {
@@ -266,7 +287,7 @@ func TestChainConfig(t *testing.T) {
 			err:     false,
 		},
 		{
-			name: "ToWallet/SameCurrency",
+			name: "ToWallet/SameWallet",
 			config: &SerializedChainConfig{
 				MaxBalance: 100000,
 				FromWallet: liquidWallet.Name,
@@ -294,7 +315,7 @@ func TestChainConfig(t *testing.T) {
 				ToAddress:  "bcrt1q2q5f9te4va7xet4c93awrurux04h0pfwcuzzcu",
 			},
 			wallets: []onchain.WalletInfo{btcWallet},
-			err:     true,
+			err:     false,
 		},
 		{
 			name: "ToAddress/Invalid",
@@ -341,7 +362,7 @@ func TestChainConfig(t *testing.T) {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				require.NotEqual(t, chainConfig.pair.From, chainConfig.pair.To)
+				require.Equal(t, chainConfig.pair.From == chainConfig.pair.To, chainConfig.directSend)
 				require.NotEmpty(t, chainConfig.description)
 				require.NotZero(t, chainConfig.maxFeePercent)
 				require.NotNil(t, chainConfig.tenant)
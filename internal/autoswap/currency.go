@@ -0,0 +1,95 @@
+package autoswap
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+// estimatedTxVsize is the assumed size of wallet transactions when estimating fees without building them
+const estimatedTxVsize = 140
+
+// currencyOption is a currency the onchain side of a lightning swap can use
+type currencyOption struct {
+	currency boltz.Currency
+	wallet   onchain.Wallet
+	balance  *onchain.Balance
+	// deferred contains the reasons for which swaps in this currency have to be deferred right now
+	deferred []string
+	// lockupFee is the estimated fee of sending the lockup transaction of a normal swap, only set if there are alternatives
+	lockupFee uint64
+}
+
+func (cfg *LightningConfig) initAlternativeWallets() error {
+	cfg.alternatives = nil
+	currencies := map[boltz.Currency]bool{cfg.currency: true}
+	for _, name := range cfg.AlternativeWallets {
+		wallet, err := cfg.onchain.GetAnyWallet(onchain.WalletChecker{
+			Name:          &name,
+			AllowReadonly: !cfg.AllowNormalSwaps(),
+		})
+		if err != nil {
+			return fmt.Errorf("could not find alternative wallet %s: %w", name, err)
+		}
+		currency := wallet.GetWalletInfo().Currency
+		if currencies[currency] {
+			return fmt.Errorf("alternative wallet %s: only one wallet per currency is allowed", name)
+		}
+		currencies[currency] = true
+		cfg.alternatives = append(cfg.alternatives, wallet)
+	}
+	return nil
+}
+
+// currencyOptions returns the configured currency first, followed by the currencies of the alternative wallets
+func (cfg *LightningConfig) currencyOptions() ([]*currencyOption, error) {
+	options := []*currencyOption{{currency: cfg.currency, wallet: cfg.wallet}}
+	for _, wallet := range cfg.alternatives {
+		options = append(options, &currencyOption{currency: wallet.GetWalletInfo().Currency, wallet: wallet})
+	}
+	for _, option := range options {
+		var err error
+		if option.wallet != nil {
+			option.balance, err = option.wallet.GetBalance()
+			if err != nil {
+				return nil, fmt.Errorf("could not get %s wallet balance: %w", option.currency, err)
+			}
+			logger.Debugf("%s wallet balance: %+v", option.currency, option.balance)
+		}
+		compareLockup := len(cfg.alternatives) > 0 && cfg.AllowNormalSwaps()
+		// the same fee rate is used for the deferral and the lockup cost, so it is only estimated once
+		var feeRate float64
+		if compareLockup || cfg.deferral.needsFeeRate() {
+			feeRate, err = cfg.rpc.EstimateFee(option.currency)
+			if err != nil {
+				return nil, fmt.Errorf("could not estimate %s fee rate: %w", option.currency, err)
+			}
+		}
+		option.deferred = cfg.deferralReasons(cfg.deferral, option.currency, feeRate)
+		if compareLockup {
+			option.lockupFee = uint64(math.Ceil(feeRate * estimatedTxVsize))
+		}
+	}
+	return options, nil
+}
+
+// cost is the total fee we expect to pay for a swap using this option
+func (option *currencyOption) cost(swap *LightningSwap) uint64 {
+	if swap.Type == boltz.NormalSwap {
+		return swap.FeeEstimate + option.lockupFee
+	}
+	return swap.FeeEstimate
+}
+
+// walletFor returns the wallet which is used for swaps in the given currency
+func (cfg *LightningConfig) walletFor(currency boltz.Currency) onchain.Wallet {
+	for _, wallet := range cfg.alternatives {
+		if wallet.GetWalletInfo().Currency == currency {
+			return wallet
+		}
+	}
+	return cfg.wallet
+}
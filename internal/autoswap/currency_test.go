@@ -0,0 +1,141 @@
+package autoswap
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlternativeWallets(t *testing.T) {
+	btcWallet := onchain.WalletInfo{Id: 1, Name: "btc", Currency: boltz.CurrencyBtc}
+	liquidWallet := onchain.WalletInfo{Id: 2, Name: "liquid", Currency: boltz.CurrencyLiquid}
+
+	pairInfo := func(minimal uint64, minerFees uint64) *boltzrpc.PairInfo {
+		info := newPairInfo()
+		info.Limits.Minimal = minimal
+		info.Fees.MinerFees = minerFees
+		return info
+	}
+
+	tests := []struct {
+		name             string
+		liquidPair       *boltzrpc.PairInfo
+		expectedCurrency boltz.Currency
+		expectedWallet   database.Id
+	}{
+		{
+			name:             "Cheaper",
+			liquidPair:       pairInfo(1000, 20),
+			expectedCurrency: boltz.CurrencyLiquid,
+			expectedWallet:   liquidWallet.Id,
+		},
+		{
+			name:             "MoreExpensive",
+			liquidPair:       pairInfo(1000, 5000),
+			expectedCurrency: boltz.CurrencyBtc,
+			expectedWallet:   btcWallet.Id,
+		},
+		{
+			name:             "Dismissed",
+			liquidPair:       pairInfo(1_000_000, 20),
+			expectedCurrency: boltz.CurrencyBtc,
+			expectedWallet:   btcWallet.Id,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := getOnchain()
+			chain.AddWallet(mockedWallet{info: btcWallet, balance: &onchain.Balance{}}.Create(t))
+			chain.AddWallet(mockedWallet{info: liquidWallet, balance: &onchain.Balance{}}.Create(t))
+
+			cfg, rpc := getLnConfig(t, &SerializedLnConfig{
+				InboundBalancePercent: 25,
+				SwapType:              "reverse",
+				PerChannel:            true,
+				MaxFeePercent:         5,
+				Budget:                100_000,
+				Currency:              boltzrpc.Currency_BTC,
+				Wallet:                btcWallet.Name,
+				AlternativeWallets:    []string{liquidWallet.Name},
+			}, chain)
+
+			rpc.EXPECT().GetAutoSwapPairInfo(boltzrpc.SwapType_REVERSE, getLightningPair(boltzrpc.SwapType_REVERSE, boltzrpc.Currency_BTC)).
+				Return(pairInfo(1000, 500), nil)
+			rpc.EXPECT().GetAutoSwapPairInfo(boltzrpc.SwapType_REVERSE, getLightningPair(boltzrpc.SwapType_REVERSE, boltzrpc.Currency_LBTC)).
+				Return(tc.liquidPair, nil)
+			rpc.EXPECT().GetLightningChannels("").Return([]*lightning.LightningChannel{
+				{OutboundSat: 900_000, InboundSat: 100_000, Capacity: 1_000_000, Id: 1},
+			}, nil)
+
+			recommendations, err := cfg.GetSwapRecommendations(false)
+			require.NoError(t, err)
+			require.Len(t, recommendations, 1)
+			swap := recommendations[0].Swap
+			require.Empty(t, swap.DismissedReasons)
+			require.Equal(t, tc.expectedCurrency, serializers.ParseCurrency(&swap.Currency))
+
+			rpc.EXPECT().CreateAutoReverseSwap(mock.Anything, mock.Anything).RunAndReturn(
+				func(_ *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
+					require.Equal(t, tc.expectedWallet, request.GetWalletId())
+					require.Equal(t, swap.Currency, request.Pair.To)
+					return "swapId", nil
+				},
+			).Once()
+			_, err = cfg.execute(&autoswaprpc.LightningRecommendation{
+				Swap:    swap,
+				Channel: recommendations[0].Channel,
+			}, false)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("FeeRateEstimatedOnce", func(t *testing.T) {
+		chain := getOnchain()
+		chain.AddWallet(mockedWallet{info: btcWallet, balance: &onchain.Balance{}}.Create(t))
+		chain.AddWallet(mockedWallet{info: liquidWallet, balance: &onchain.Balance{}}.Create(t))
+
+		cfg, rpc := getLnConfig(t, &SerializedLnConfig{
+			InboundBalancePercent:  25,
+			OutboundBalancePercent: 25,
+			MaxFeePercent:          5,
+			Currency:               boltzrpc.Currency_BTC,
+			Wallet:                 btcWallet.Name,
+			AlternativeWallets:     []string{liquidWallet.Name},
+			MaxFeeRate:             20,
+		}, chain)
+
+		rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(30, nil).Once()
+		rpc.EXPECT().EstimateFee(boltz.CurrencyLiquid).Return(0.1, nil).Once()
+
+		options, err := cfg.currencyOptions()
+		require.NoError(t, err)
+		require.Len(t, options, 2)
+		require.Equal(t, []string{ReasonFeeRateTooHigh}, options[0].deferred)
+		require.Equal(t, uint64(30*estimatedTxVsize), options[0].lockupFee)
+		require.Empty(t, options[1].deferred)
+		require.Equal(t, uint64(14), options[1].lockupFee)
+	})
+
+	t.Run("SameCurrency", func(t *testing.T) {
+		chain := getOnchain()
+		chain.AddWallet(mockedWallet{info: btcWallet}.Create(t))
+		other := onchain.WalletInfo{Id: 3, Name: "other", Currency: boltz.CurrencyBtc}
+		chain.AddWallet(mockedWallet{info: other}.Create(t))
+
+		cfg := NewLightningConfig(withThresholds(&SerializedLnConfig{
+			Currency:           boltzrpc.Currency_BTC,
+			Wallet:             btcWallet.Name,
+			AlternativeWallets: []string{other.Name},
+		}), shared{onchain: chain, rpc: NewMockRpcProvider(t), database: getTestDb(t)})
+		require.ErrorContains(t, cfg.Init(), "one wallet per currency")
+	})
+}
@@ -0,0 +1,137 @@
+package autoswap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+const (
+	ReasonFeeRateTooHigh    = "onchain fee rate too high"
+	ReasonOutsideSwapWindow = "outside of swap window"
+)
+
+// deferralReasons are only temporary, the swap will be executed once they no longer apply
+var deferralReasons = []string{ReasonFeeRateTooHigh, ReasonOutsideSwapWindow}
+
+// swapWindow is a time of day range in UTC, which wraps around midnight if end is before start
+type swapWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+func parseSwapWindow(value string) (*swapWindow, error) {
+	start, end, found := strings.Cut(value, "-")
+	if !found {
+		return nil, fmt.Errorf("invalid swap window %s: expected format HH:MM-HH:MM", value)
+	}
+	window := &swapWindow{}
+	var err error
+	if window.start, err = parseTimeOfDay(start); err != nil {
+		return nil, fmt.Errorf("invalid swap window %s: %w", value, err)
+	}
+	if window.end, err = parseTimeOfDay(end); err != nil {
+		return nil, fmt.Errorf("invalid swap window %s: %w", value, err)
+	}
+	if window.start == window.end {
+		return nil, fmt.Errorf("invalid swap window %s: start and end are equal", value)
+	}
+	return window, nil
+}
+
+func (window *swapWindow) contains(now time.Time) bool {
+	now = now.UTC()
+	offset := now.Sub(now.Truncate(24 * time.Hour))
+	if window.start < window.end {
+		return offset >= window.start && offset < window.end
+	}
+	return offset >= window.start || offset < window.end
+}
+
+// deferral holds the conditions under which recommended swaps are postponed instead of executed
+type deferral struct {
+	maxFeeRate float64
+	windows    []*swapWindow
+}
+
+func newDeferral(maxFeeRate float32, windows []string) (*deferral, error) {
+	if maxFeeRate < 0 {
+		return nil, errors.New("max fee rate must not be negative")
+	}
+	result := &deferral{maxFeeRate: float64(maxFeeRate)}
+	for _, value := range windows {
+		window, err := parseSwapWindow(value)
+		if err != nil {
+			return nil, err
+		}
+		result.windows = append(result.windows, window)
+	}
+	return result, nil
+}
+
+func (d *deferral) String() string {
+	var conditions []string
+	if d.maxFeeRate != 0 {
+		conditions = append(conditions, fmt.Sprintf("fee rate above %v sat/vbyte", d.maxFeeRate))
+	}
+	if len(d.windows) > 0 {
+		conditions = append(conditions, "outside of swap windows")
+	}
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " deferred if " + strings.Join(conditions, " or ")
+}
+
+// checkDeferral returns why swaps sending the given currency have to be deferred right now
+func (c *shared) checkDeferral(d *deferral, currency boltz.Currency) ([]string, error) {
+	var feeRate float64
+	if d.needsFeeRate() {
+		var err error
+		feeRate, err = c.rpc.EstimateFee(currency)
+		if err != nil {
+			return nil, fmt.Errorf("could not estimate %s fee rate: %w", currency, err)
+		}
+	}
+	return c.deferralReasons(d, currency, feeRate), nil
+}
+
+func (d *deferral) needsFeeRate() bool {
+	return d != nil && d.maxFeeRate != 0
+}
+
+// deferralReasons is like checkDeferral, but uses a fee rate which was already estimated
+func (c *shared) deferralReasons(d *deferral, currency boltz.Currency, feeRate float64) []string {
+	if d == nil {
+		return nil
+	}
+	var reasons []string
+	if len(d.windows) > 0 {
+		now := c.now()
+		if !slices.ContainsFunc(d.windows, func(window *swapWindow) bool { return window.contains(now) }) {
+			reasons = append(reasons, ReasonOutsideSwapWindow)
+		}
+	}
+	if d.maxFeeRate != 0 && feeRate > d.maxFeeRate {
+		logger.Debugf("Current %s fee rate of %v sat/vbyte is above maximum of %v", currency, feeRate, d.maxFeeRate)
+		reasons = append(reasons, ReasonFeeRateTooHigh)
+	}
+	return reasons
+}
+
+func isDeferralReason(reason string) bool {
+	return slices.Contains(deferralReasons, reason)
+}
@@ -0,0 +1,103 @@
+package autoswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapWindow(t *testing.T) {
+	at := func(value string) time.Time {
+		parsed, err := time.Parse(time.TimeOnly, value)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	tests := []struct {
+		window   string
+		time     string
+		contains bool
+		err      bool
+	}{
+		{window: "08:00-12:00", time: "10:00:00", contains: true},
+		{window: "08:00-12:00", time: "12:00:00", contains: false},
+		{window: "08:00-12:00", time: "07:59:59", contains: false},
+		{window: "22:00-06:00", time: "23:30:00", contains: true},
+		{window: "22:00-06:00", time: "05:00:00", contains: true},
+		{window: "22:00-06:00", time: "12:00:00", contains: false},
+		{window: "22:00", err: true},
+		{window: "25:00-06:00", err: true},
+		{window: "06:00-06:00", err: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.window+"/"+tc.time, func(t *testing.T) {
+			window, err := parseSwapWindow(tc.window)
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.contains, window.contains(at(tc.time)))
+		})
+	}
+}
+
+func TestDeferral(t *testing.T) {
+	channel := &lightning.LightningChannel{OutboundSat: 900_000, InboundSat: 100_000, Capacity: 1_000_000, Id: 1}
+	recommendation := func() []*LightningRecommendation {
+		return []*LightningRecommendation{{
+			Channel: channel,
+			Swap:    &LightningSwap{Type: boltz.ReverseSwap, checks: checks{Amount: 400_000}},
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		feeRate   float64
+		hardFloor float32
+		windows   []string
+		outcome   []string
+	}{
+		{name: "LowFees", feeRate: 5},
+		{name: "HighFees", feeRate: 50, outcome: []string{ReasonFeeRateTooHigh}},
+		{name: "HighFees/BelowHardFloor", feeRate: 50, hardFloor: 15},
+		{name: "HighFees/AboveHardFloor", feeRate: 50, hardFloor: 5, outcome: []string{ReasonFeeRateTooHigh}},
+		{name: "InsideWindow", feeRate: 5, windows: []string{"11:00-13:00"}},
+		{name: "OutsideWindow", feeRate: 5, windows: []string{"13:00-11:00", "22:00-23:00"}, outcome: []string{ReasonOutsideSwapWindow}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, rpc := getLnConfig(t, &SerializedLnConfig{
+				InboundBalancePercent: 25,
+				SwapType:              "reverse",
+				PerChannel:            true,
+				MaxFeePercent:         25,
+				Budget:                100_000,
+				MaxFeeRate:            10,
+				HardFloorPercent:      tc.hardFloor,
+				SwapWindows:           tc.windows,
+			}, getOnchain())
+			cfg.clock = func() time.Time { return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC) }
+
+			rpc.EXPECT().EstimateFee(boltz.CurrencyBtc).Return(tc.feeRate, nil)
+			rpc.EXPECT().GetAutoSwapPairInfo(mock.Anything, mock.Anything).Return(newPairInfo(), nil)
+
+			validated, err := cfg.validateRecommendations(recommendation(), &Budget{Amount: cfg.Budget}, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.outcome, validated[0].Swap.DismissedReasons)
+		})
+	}
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := newDeferral(-1, nil)
+		require.Error(t, err)
+		_, err = newDeferral(0, []string{"invalid"})
+		require.Error(t, err)
+	})
+}
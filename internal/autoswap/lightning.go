@@ -36,6 +36,12 @@ type LightningConfig struct {
 	strategy        Strategy
 	description     string
 	wallet          onchain.Wallet
+	rules           []*channelRule
+	defaultRule     *channelRule
+	deferral        *deferral
+	hardFloor       boltz.Percentage
+	alternatives    []onchain.Wallet
+	channelOpening  *channelOpening
 
 	executeLock sync.Mutex
 }
@@ -75,6 +81,9 @@ func (cfg *LightningConfig) Init() error {
 
 	cfg.currency = serializers.ParseCurrency(&cfg.Currency)
 	cfg.maxFeePercent = boltz.Percentage(cfg.MaxFeePercent)
+	if err := validateBudgets(cfg, Lightning); err != nil {
+		return fmt.Errorf("invalid budgets: %w", err)
+	}
 	cfg.outboundBalance = Balance{Absolute: cfg.OutboundBalance}
 	cfg.inboundBalance = Balance{Absolute: cfg.InboundBalance}
 
@@ -126,6 +135,20 @@ func (cfg *LightningConfig) Init() error {
 		cfg.description += fmt.Sprintf(" (outbound %s, inbound %s)", cfg.outboundBalance, cfg.inboundBalance)
 	}
 
+	if err := cfg.initRules(); err != nil {
+		return err
+	}
+
+	cfg.deferral, err = newDeferral(cfg.MaxFeeRate, cfg.SwapWindows)
+	if err != nil {
+		return err
+	}
+	cfg.description += cfg.deferral.String()
+	cfg.hardFloor = boltz.Percentage(cfg.HardFloorPercent)
+	if cfg.hardFloor < 0 || cfg.hardFloor >= 100 {
+		return errors.New("hard floor must be between 0 and 100")
+	}
+
 	if cfg.Wallet != "" {
 		cfg.description += fmt.Sprintf(" using wallet %s (%s)", cfg.Wallet, cfg.currency)
 	}
@@ -138,9 +161,144 @@ func (cfg *LightningConfig) Init() error {
 		return err
 	}
 
+	if err := cfg.initAlternativeWallets(); err != nil {
+		return err
+	}
+	for _, wallet := range cfg.alternatives {
+		info := wallet.GetWalletInfo()
+		cfg.description += fmt.Sprintf(", alternatively %s (%s)", info.Name, info.Currency)
+	}
+
+	return cfg.initChannelOpening()
+}
+
+// channelRule holds the effective settings for the channels matched by a rule
+type channelRule struct {
+	*autoswaprpc.LightningChannelRule
+
+	chanId          lightning.ChanId
+	swapType        boltz.SwapType
+	outboundBalance Balance
+	inboundBalance  Balance
+	maxSwapAmount   uint64
+}
+
+func (rule *channelRule) Allowed(swapType boltz.SwapType) bool {
+	return rule.swapType == swapType || rule.swapType == ""
+}
+
+func (rule *channelRule) hasOverrides() bool {
+	return rule.OutboundBalance != nil || rule.InboundBalance != nil ||
+		rule.OutboundBalancePercent != nil || rule.InboundBalancePercent != nil ||
+		rule.SwapType != nil || rule.MaxSwapAmount != nil
+}
+
+func (rule *channelRule) String() string {
+	if rule.ChannelId != nil {
+		return "channel " + rule.GetChannelId()
+	}
+	return "peer " + rule.GetPeerId()
+}
+
+func (cfg *LightningConfig) newChannelRule(serialized *autoswaprpc.LightningChannelRule) (*channelRule, error) {
+	rule := &channelRule{
+		LightningChannelRule: serialized,
+		swapType:             cfg.swapType,
+		outboundBalance:      cfg.outboundBalance,
+		inboundBalance:       cfg.inboundBalance,
+		maxSwapAmount:        cfg.MaxSwapAmount,
+	}
+	if serialized.GetPeerId() == "" && serialized.GetChannelId() == "" {
+		return nil, errors.New("peer or channel id must be set")
+	}
+	if serialized.ChannelId != nil {
+		var err error
+		rule.chanId, err = lightning.NewChanIdFromString(serialized.GetChannelId())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", rule, err)
+		}
+	}
+	if serialized.Exclude || !rule.hasOverrides() {
+		return rule, nil
+	}
+	if !cfg.PerChannel {
+		return nil, fmt.Errorf("%s: overrides are only supported for per channel rebalancing", rule)
+	}
+	if serialized.SwapType != nil {
+		var err error
+		rule.swapType, err = boltz.ParseSwapType(serialized.GetSwapType())
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid swap type: %w", rule, err)
+		}
+		if rule.Allowed(boltz.NormalSwap) {
+			return nil, fmt.Errorf("%s: per channel rebalancing only supported for reverse swaps", rule)
+		}
+	}
+	if serialized.OutboundBalance != nil {
+		rule.outboundBalance = Balance{Absolute: serialized.GetOutboundBalance()}
+	} else if serialized.OutboundBalancePercent != nil {
+		rule.outboundBalance = Balance{Relative: boltz.Percentage(serialized.GetOutboundBalancePercent())}
+	}
+	if serialized.InboundBalance != nil {
+		rule.inboundBalance = Balance{Absolute: serialized.GetInboundBalance()}
+	} else if serialized.InboundBalancePercent != nil {
+		rule.inboundBalance = Balance{Relative: boltz.Percentage(serialized.GetInboundBalancePercent())}
+	}
+	if !rule.outboundBalance.IsAbsolute() && !rule.inboundBalance.IsAbsolute() {
+		maxPercent := boltz.Percentage(100) - 2*cfg.reserve
+		if rule.outboundBalance.Relative+rule.inboundBalance.Relative > maxPercent {
+			return nil, fmt.Errorf("%s: sum of thresholds must be less than %s", rule, maxPercent)
+		}
+	}
+	if rule.inboundBalance.IsZero() && rule.Allowed(boltz.ReverseSwap) {
+		return nil, fmt.Errorf("%s: inbound balance must be set for reverse swaps", rule)
+	}
+	if serialized.MaxSwapAmount != nil {
+		rule.maxSwapAmount = serialized.GetMaxSwapAmount()
+	}
+	return rule, nil
+}
+
+func (cfg *LightningConfig) initRules() error {
+	cfg.defaultRule = &channelRule{
+		LightningChannelRule: &autoswaprpc.LightningChannelRule{},
+		swapType:             cfg.swapType,
+		outboundBalance:      cfg.outboundBalance,
+		inboundBalance:       cfg.inboundBalance,
+		maxSwapAmount:        cfg.MaxSwapAmount,
+	}
+	cfg.rules = nil
+	for _, serialized := range cfg.ChannelRules {
+		rule, err := cfg.newChannelRule(serialized)
+		if err != nil {
+			return fmt.Errorf("invalid channel rule: %w", err)
+		}
+		cfg.rules = append(cfg.rules, rule)
+	}
+	if len(cfg.rules) > 0 {
+		cfg.description += fmt.Sprintf(" with %d channel rules", len(cfg.rules))
+	}
 	return nil
 }
 
+// channelRule returns the rule which applies to the channel. Rules matching the channel id take precedence over
+// rules matching the peer, the default rule is returned if none match.
+func (cfg *LightningConfig) channelRule(channel *lightning.LightningChannel) *channelRule {
+	var peerRule *channelRule
+	for _, rule := range cfg.rules {
+		if rule.ChannelId != nil && rule.chanId == channel.Id {
+			return rule
+		}
+		if peerRule == nil && rule.PeerId != nil && rule.GetPeerId() == channel.PeerId {
+			peerRule = rule
+		}
+	}
+	if peerRule != nil {
+		return peerRule
+	}
+	return cfg.defaultRule
+}
+
 func (cfg *LightningConfig) InitWallet() (err error) {
 	if cfg.onchain == nil {
 		return errors.New("can not initialize wallet without onchain")
@@ -171,7 +329,10 @@ func (cfg *LightningConfig) Description() string {
 }
 
 func (cfg *LightningConfig) GetPair(swapType boltzrpc.SwapType) *boltzrpc.Pair {
-	currency := cfg.Currency
+	return getLightningPair(swapType, cfg.Currency)
+}
+
+func getLightningPair(swapType boltzrpc.SwapType, currency boltzrpc.Currency) *boltzrpc.Pair {
 	result := &boltzrpc.Pair{}
 	switch swapType {
 	case boltzrpc.SwapType_SUBMARINE:
@@ -201,6 +362,7 @@ func (cfg *LightningConfig) getDismissedChannels() (DismissedChannels, error) {
 
 	query := database.PendingSwapQuery
 	query.Include = boltzrpc.IncludeSwaps_AUTO
+	query.LightningNode = &cfg.LightningNode
 	swaps, err := cfg.database.QuerySwaps(query)
 	if err != nil {
 		return nil, errors.New("Could not query pending swaps: " + err.Error())
@@ -219,8 +381,9 @@ func (cfg *LightningConfig) getDismissedChannels() (DismissedChannels, error) {
 	}
 
 	query = database.FailedSwapQuery
-	query.Since = time.Now().Add(time.Duration(-cfg.FailureBackoff) * time.Second)
+	query.Since = cfg.now().Add(time.Duration(-cfg.FailureBackoff) * time.Second)
 	query.Include = boltzrpc.IncludeSwaps_AUTO
+	query.LightningNode = &cfg.LightningNode
 	failedSwaps, err := cfg.database.QuerySwaps(query)
 	if err != nil {
 		return nil, errors.New("Could not query failed swaps: " + err.Error())
@@ -242,7 +405,8 @@ func (cfg *LightningConfig) getDismissedChannels() (DismissedChannels, error) {
 
 type LightningSwap struct {
 	checks
-	Type boltz.SwapType
+	Type     boltz.SwapType
+	Currency boltz.Currency
 }
 
 func (lightningSwap *LightningSwap) GetAmount() uint64 {
@@ -260,7 +424,7 @@ type LightningRecommendation struct {
 
 func (cfg *LightningConfig) validateRecommendations(
 	recommendations []*LightningRecommendation,
-	budget uint64,
+	budget *Budget,
 	includeAll bool,
 ) ([]*LightningRecommendation, error) {
 	dismissedChannels, err := cfg.getDismissedChannels()
@@ -270,13 +434,9 @@ func (cfg *LightningConfig) validateRecommendations(
 
 	logger.Debugf("Dismissed channels: %v", dismissedChannels)
 
-	var balance *onchain.Balance
-	if cfg.wallet != nil {
-		balance, err = cfg.wallet.GetBalance()
-		if err != nil {
-			return nil, errors.New("Could not get wallet balance: " + err.Error())
-		}
-		logger.Debugf("Wallet balance: %+v", balance)
+	options, err := cfg.currencyOptions()
+	if err != nil {
+		return nil, err
 	}
 
 	// we might be able to fit more swaps in the budget if we sort by amount
@@ -289,22 +449,49 @@ func (cfg *LightningConfig) validateRecommendations(
 		swap := recommendation.Swap
 		if swap != nil {
 			swapType := serializers.SerializeSwapType(swap.Type)
-			pairInfo, err := cfg.rpc.GetAutoSwapPairInfo(swapType, cfg.GetPair(swapType))
-			if err != nil {
-				logger.Warn("Could not get pair info: " + err.Error())
-				continue
+			var best *LightningSwap
+			var bestCost, bestSpent uint64
+			for _, option := range options {
+				pairInfo, err := cfg.rpc.GetAutoSwapPairInfo(swapType, getLightningPair(swapType, serializers.SerializeCurrency(option.currency)))
+				if err != nil {
+					logger.Warnf("Could not get %s pair info: %s", option.currency, err)
+					continue
+				}
+
+				available := budget.Available(swap.Type, option.currency)
+				remaining := available
+				params := checkParams{
+					MaxFeePercent:     cfg.maxFeePercent,
+					MaxSwapFee:        cfg.MaxSwapFee,
+					Budget:            &remaining,
+					Pair:              pairInfo,
+					DismissedReasons:  slices.Clone(dismissedChannels[recommendation.Channel.GetId()]),
+					ApprovalThreshold: cfg.ApprovalThreshold,
+				}
+				candidate := &LightningSwap{checks: check(swap.GetAmount(), params), Type: swap.Type, Currency: option.currency}
+				if option.balance != nil && swap.Type == boltz.NormalSwap && candidate.Amount > option.balance.Confirmed {
+					candidate.Dismiss(ReasonInsufficientFunds)
+				}
+				if !cfg.belowHardFloor(recommendation) {
+					for _, reason := range option.deferred {
+						candidate.Dismiss(reason)
+					}
+				}
+
+				// the configured currency is used unless an alternative is cheaper and passes all checks
+				cost := option.cost(candidate)
+				if best == nil || (!candidate.Dismissed() && (best.Dismissed() || cost < bestCost)) {
+					best, bestCost, bestSpent = candidate, cost, available-remaining
+				}
 			}
-
-			params := checkParams{
-				MaxFeePercent:    cfg.maxFeePercent,
-				Budget:           &budget,
-				Pair:             pairInfo,
-				DismissedReasons: dismissedChannels[recommendation.Channel.GetId()],
+			if best == nil {
+				continue
 			}
-			swap.checks = check(swap.GetAmount(), params)
-			if balance != nil && swap.Type == boltz.NormalSwap && swap.Amount > balance.Confirmed {
-				swap.Dismiss(ReasonInsufficientFunds)
+			if best.Currency != cfg.currency {
+				logger.Debugf("Using %s instead of %s for %s swap since it is cheaper", best.Currency, cfg.currency, best.Type)
 			}
+			*swap = *best
+			budget.Spend(best.Type, best.Currency, bestSpent)
 		}
 
 		if includeAll || swap != nil {
@@ -315,6 +502,19 @@ func (cfg *LightningConfig) validateRecommendations(
 	return checked, nil
 }
 
+// belowHardFloor returns true if the balance restored by the swap is too low for the swap to be deferred
+func (cfg *LightningConfig) belowHardFloor(recommendation *LightningRecommendation) bool {
+	channel := recommendation.Channel
+	if cfg.hardFloor == 0 || channel == nil {
+		return false
+	}
+	balance := channel.OutboundSat
+	if recommendation.Swap.Type == boltz.ReverseSwap {
+		balance = channel.InboundSat
+	}
+	return balance < cfg.hardFloor.Calculate(channel.Capacity)
+}
+
 func (cfg *LightningConfig) GetSwapRecommendations(includeAll bool) ([]*autoswaprpc.LightningRecommendation, error) {
 	cfg.executeLock.Lock()
 	defer cfg.executeLock.Unlock()
@@ -322,21 +522,21 @@ func (cfg *LightningConfig) GetSwapRecommendations(includeAll bool) ([]*autoswap
 }
 
 func (cfg *LightningConfig) getSwapRecommendations(includeAll bool) ([]*autoswaprpc.LightningRecommendation, error) {
-	channels, err := cfg.rpc.GetLightningChannels()
+	channels, err := cfg.rpc.GetLightningChannels(cfg.LightningNode)
 	if err != nil {
 		return nil, err
 	}
 
 	recommendations := cfg.strategy(channels)
 
-	budget, err := cfg.GetCurrentBudget(true)
+	budget, err := cfg.GetCurrentBudget()
 	if err != nil {
 		return nil, errors.New("Could not get budget: " + err.Error())
 	}
 
 	logger.Debugf("Current autoswap budget: %+v", *budget)
 
-	validated, err := cfg.validateRecommendations(recommendations, budget.Amount, includeAll)
+	validated, err := cfg.validateRecommendations(recommendations, budget, includeAll)
 	if err != nil {
 		return nil, fmt.Errorf("could not validate recommendations: %w", err)
 	}
@@ -344,20 +544,21 @@ func (cfg *LightningConfig) getSwapRecommendations(includeAll bool) ([]*autoswap
 	var result []*autoswaprpc.LightningRecommendation
 	for _, recommendation := range validated {
 		result = append(result, &autoswaprpc.LightningRecommendation{
-			Swap:       serializeLightningSwap(recommendation.Swap),
-			Channel:    serializeLightningChannel(recommendation.Channel),
-			Thresholds: recommendation.Thresholds,
+			Swap:          serializeLightningSwap(recommendation.Swap),
+			Channel:       serializeLightningChannel(recommendation.Channel),
+			Thresholds:    recommendation.Thresholds,
+			LightningNode: cfg.LightningNode,
 		})
 	}
 	return result, nil
 }
 
-func (cfg *LightningConfig) GetCurrentBudget(createIfMissing bool) (*Budget, error) {
+func (cfg *LightningConfig) GetCurrentBudget() (*Budget, error) {
 	return cfg.shared.GetCurrentBudget(
-		createIfMissing,
 		Lightning,
 		cfg,
 		database.DefaultTenantId,
+		cfg.LightningNode,
 	)
 }
 
@@ -373,28 +574,62 @@ func (cfg *LightningConfig) CheckAndExecute(accepted []*autoswaprpc.LightningRec
 	cfg.executeLock.Lock()
 	defer cfg.executeLock.Unlock()
 	logger.Debugf("Checking for lightning swap recommendation")
+	event := &database.AutoSwapEvent{Swapper: string(Lightning), TenantId: database.DefaultTenantId, Rule: cfg.LightningNode}
+	err := cfg.checkAndExecute(event, accepted, force)
+	cfg.recordEvent(event, err)
+	return err
+}
+
+func (cfg *LightningConfig) checkAndExecute(event *database.AutoSwapEvent, accepted []*autoswaprpc.LightningRecommendation, force bool) error {
 	recommendations, err := cfg.getSwapRecommendations(false)
 	if err != nil {
 		return fmt.Errorf("could not fetch swap recommendations: %w", err)
 	}
 	for _, recommendation := range recommendations {
+		swap := recommendation.Swap
+		eventSwap := &database.AutoSwapEventSwap{
+			Type:             serializers.ParseSwapType(swap.Type),
+			Currency:         serializers.ParseCurrency(&swap.Currency),
+			Amount:           swap.Amount,
+			FeeEstimate:      swap.FeeEstimate,
+			DismissedReasons: swap.DismissedReasons,
+		}
+		if chanId := recommendation.Channel.GetId().GetLnd(); chanId != 0 {
+			eventSwap.ChannelId = (*lightning.ChanId)(&chanId)
+		}
 		if err := checkAccepted(recommendation, accepted); err != nil {
+			// recommendations which were not accepted are not part of this cycle
 			if errors.Is(err, errNotInAccepted) {
 				continue
 			}
+			event.Swaps = append(event.Swaps, eventSwap)
 			return err
 		}
-		if err := cfg.execute(recommendation, force); err != nil {
+		event.Swaps = append(event.Swaps, eventSwap)
+		if accepted == nil && !force && awaitingApproval(swap.DismissedReasons) {
+			if err := cfg.requestApproval(Lightning, database.DefaultTenantId, cfg.LightningNode, eventSwap, cfg.ApprovalExpiry); err != nil {
+				return fmt.Errorf("could not request approval: %w", err)
+			}
+			continue
+		}
+		eventSwap.SwapId, err = cfg.execute(recommendation, force)
+		if err != nil {
 			return fmt.Errorf("could not execute recommendation: %w", err)
 		}
 	}
+	// channels are only opened in the regular cycles, not when recommendations are executed manually
+	if cfg.channelOpening != nil && accepted == nil && !force {
+		if err := cfg.checkChannelOpening(event); err != nil {
+			return fmt.Errorf("could not check channel opening: %w", err)
+		}
+	}
 	return nil
 }
 
-func (cfg *LightningConfig) execute(recommendation *autoswaprpc.LightningRecommendation, force bool) error {
+func (cfg *LightningConfig) execute(recommendation *autoswaprpc.LightningRecommendation, force bool) (string, error) {
 	if !force && len(recommendation.Swap.DismissedReasons) > 0 {
 		logger.Infof("Skipping swap recommendation %+v", recommendation.Swap)
-		return nil
+		return "", nil
 	}
 
 	logger.Infof("Executing Swap recommendation: %+v", recommendation.Swap)
@@ -403,28 +638,40 @@ func (cfg *LightningConfig) execute(recommendation *autoswaprpc.LightningRecomme
 		chanIds = append(chanIds, recommendation.Channel.Id.Cln)
 	}
 	swap := recommendation.Swap
-	pair := cfg.GetPair(swap.Type)
-	var err error
+	pair := getLightningPair(swap.Type, swap.Currency)
+	walletId, address := cfg.walletId(), cfg.StaticAddress
+	if currency := serializers.ParseCurrency(&swap.Currency); currency != cfg.currency {
+		wallet := cfg.walletFor(currency)
+		if wallet == cfg.wallet {
+			return "", fmt.Errorf("no wallet configured for currency %s", currency)
+		}
+		id := wallet.GetWalletInfo().Id
+		walletId, address = &id, ""
+	}
 	switch swap.Type {
 	case boltzrpc.SwapType_REVERSE:
-		err = cfg.rpc.CreateAutoReverseSwap(&database.DefaultTenant, &boltzrpc.CreateReverseSwapRequest{
+		return cfg.rpc.CreateAutoReverseSwap(&database.DefaultTenant, &boltzrpc.CreateReverseSwapRequest{
 			Amount:         swap.Amount,
-			Address:        cfg.StaticAddress,
+			Address:        address,
 			AcceptZeroConf: cfg.AcceptZeroConf,
 			Pair:           pair,
 			ChanIds:        chanIds,
-			WalletId:       cfg.walletId(),
+			WalletId:       walletId,
+			LightningNode:  &cfg.LightningNode,
 		})
 	case boltzrpc.SwapType_SUBMARINE:
-		err = cfg.rpc.CreateAutoSwap(&database.DefaultTenant, &boltzrpc.CreateSwapRequest{
+		return cfg.rpc.CreateAutoSwap(&database.DefaultTenant, &boltzrpc.CreateSwapRequest{
 			Amount: swap.Amount,
 			Pair:   pair,
 			//ChanIds:          chanIds,
 			SendFromInternal: true,
-			WalletId:         cfg.walletId(),
+			WalletId:         walletId,
+			LightningNode:    &cfg.LightningNode,
+			RouteHints:       &cfg.RouteHints,
 		})
+	default:
+		return "", fmt.Errorf("unknown swap type: %s", swap.Type)
 	}
-	return err
 }
 
 func (cfg *LightningConfig) run(stop <-chan struct{}) {
@@ -450,6 +697,9 @@ func checkAccepted(recommendation *autoswaprpc.LightningRecommendation, accepted
 	if len(accepted) > 0 {
 		for _, check := range accepted {
 			if check.GetSwap() != nil && proto.Equal(recommendation.Channel.GetId(), check.Channel.GetId()) {
+				if check.Swap.Currency != recommendation.Swap.Currency {
+					return errors.New("currency of recommended swap changed")
+				}
 				return checkAcceptedReasons(check.Swap.DismissedReasons, recommendation.Swap.DismissedReasons)
 			}
 		}
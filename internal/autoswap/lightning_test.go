@@ -1,6 +1,7 @@
 package autoswap
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -9,11 +10,13 @@ import (
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	lnmock "github.com/BoltzExchange/boltz-client/v2/internal/mocks/lightning"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
@@ -38,9 +41,9 @@ func TestSetLnConfig(t *testing.T) {
 	reset := true
 	err := swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{Reset_: &reset})
 	require.NoError(t, err)
-	require.Empty(t, swapper.lnSwapper.err)
+	require.Empty(t, swapper.GetLnSwapper().err)
 
-	lnSwapper := swapper.lnSwapper
+	lnSwapper := swapper.GetLnSwapper()
 
 	err = swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
 		Config: &SerializedLnConfig{
@@ -56,6 +59,58 @@ func TestSetLnConfig(t *testing.T) {
 	require.Empty(t, lnSwapper.Error())
 }
 
+func TestLightningNodes(t *testing.T) {
+	swapper, rpc := getSwapper(t)
+	reset := true
+	rpc.EXPECT().GetLightningNode("other").Return(lnmock.NewMockLightningNode(t), nil)
+	rpc.EXPECT().GetLightningNode("unknown").Return(nil, errors.New("not found"))
+
+	require.NoError(t, swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{Reset_: &reset}))
+	require.NoError(t, swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
+		Config: &SerializedLnConfig{LightningNode: "other"},
+		Reset_: &reset,
+	}))
+	require.ErrorContains(t, swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
+		Config: &SerializedLnConfig{LightningNode: "unknown"},
+		Reset_: &reset,
+	}), "invalid lightning node")
+
+	err := swapper.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
+		Config:    &SerializedLnConfig{LightningNode: "other", MaxFeePercent: 5},
+		FieldMask: &fieldmaskpb.FieldMask{Paths: []string{"max_fee_percent"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, float32(5), swapper.GetLnSwapperFor("other").cfg.MaxFeePercent)
+	require.Equal(t, DefaultLightningConfig().MaxFeePercent, swapper.GetLnSwapper().cfg.MaxFeePercent)
+
+	// configs of unknown nodes are rejected before a swapper is created for them
+	require.Nil(t, swapper.GetLnSwapperFor("unknown"))
+	swappers := swapper.GetLnSwappers()
+	require.Len(t, swappers, 2)
+	require.Empty(t, swappers[0].cfg.LightningNode)
+	require.Equal(t, "other", swappers[1].cfg.LightningNode)
+
+	// every node has its own budget
+	fee := uint64(100)
+	test.FakeSwaps{
+		ReverseSwaps: []database.ReverseSwap{{
+			OnchainFee:    &fee,
+			IsAuto:        true,
+			LightningNode: "other",
+		}},
+	}.Create(t, swapper.database)
+	budget, err := swapper.GetLnSwapper().cfg.GetCurrentBudget()
+	require.NoError(t, err)
+	require.Equal(t, budget.Total, budget.Amount)
+	budget, err = swapper.GetLnSwapperFor("other").cfg.GetCurrentBudget()
+	require.NoError(t, err)
+	require.Equal(t, budget.Total-fee, budget.Amount)
+
+	require.NoError(t, swapper.LoadConfig())
+	require.Len(t, swapper.GetConfig(nil).Lightning, 2)
+	require.NotNil(t, swapper.GetLnSwapperFor("other"))
+}
+
 func TestBudget(t *testing.T) {
 	fee := func(amount uint64) *uint64 {
 		return &amount
@@ -111,13 +166,12 @@ func TestBudget(t *testing.T) {
 	}
 
 	tests := []struct {
-		name            string
-		budget          uint64
-		interval        time.Duration
-		fakeSwaps       test.FakeSwaps
-		expected        uint64
-		currentInterval *database.BudgetInterval
-		swapperType     SwapperType
+		name        string
+		budget      uint64
+		interval    time.Duration
+		fakeSwaps   test.FakeSwaps
+		expected    uint64
+		swapperType SwapperType
 	}{
 		{
 			name:        "All/Lightning",
@@ -205,7 +259,7 @@ func TestBudget(t *testing.T) {
 			swapperType: Lightning,
 		},
 		{
-			name:     "New/Lightning",
+			name:     "Rolling/Lightning",
 			budget:   100,
 			interval: 5 * time.Minute,
 			fakeSwaps: test.FakeSwaps{
@@ -236,15 +290,11 @@ func TestBudget(t *testing.T) {
 					},
 				},
 			},
-			currentInterval: &database.BudgetInterval{
-				StartDate: test.PastDate(8 * time.Minute),
-				EndDate:   test.PastDate(3 * time.Minute),
-			},
 			expected:    80,
 			swapperType: Lightning,
 		},
 		{
-			name:     "New/Chain",
+			name:     "Rolling/Chain",
 			budget:   100,
 			interval: 5 * time.Minute,
 			fakeSwaps: test.FakeSwaps{
@@ -277,10 +327,6 @@ func TestBudget(t *testing.T) {
 					},
 				},
 			},
-			currentInterval: &database.BudgetInterval{
-				StartDate: test.PastDate(8 * time.Minute),
-				EndDate:   test.PastDate(3 * time.Minute),
-			},
 			expected:    80,
 			swapperType: Chain,
 		},
@@ -293,7 +339,7 @@ func TestBudget(t *testing.T) {
 
 			c := shared{database: db}
 			var swapperType SwapperType
-			get := func(createIfMissing bool) (*Budget, error) {
+			get := func() (*Budget, error) {
 				var cfg budgetConfig
 				if tc.swapperType == Lightning {
 					cfg = &SerializedLnConfig{
@@ -310,42 +356,88 @@ func TestBudget(t *testing.T) {
 				}
 
 				return c.GetCurrentBudget(
-					createIfMissing,
 					swapperType,
 					cfg,
 					database.DefaultTenantId,
+					"",
 				)
 			}
 
-			budget, err := get(false)
-			require.NoError(t, err)
-			require.Nil(t, budget)
-
-			if tc.currentInterval != nil {
-				tc.currentInterval.TenantId = database.DefaultTenantId
-				tc.currentInterval.Name = string(tc.swapperType)
-				require.NoError(t, db.CreateBudget(*tc.currentInterval))
-			}
-
-			budget, err = get(true)
+			budget, err := get()
 			require.NoError(t, err)
 			require.Equal(t, tc.budget, budget.Amount)
 
 			tc.fakeSwaps.Create(t, db)
 
-			budget, err = get(true)
+			budget, err = get()
 			require.NoError(t, err)
 			require.Equal(t, tc.expected, budget.Amount)
+			require.Equal(t, tc.interval, budget.EndDate.Sub(budget.StartDate))
 		})
 	}
+	t.Run("Limits", func(t *testing.T) {
+		db := getTestDb(t)
+		test.FakeSwaps{
+			Swaps: []database.Swap{
+				{
+					Pair:       boltz.Pair{From: boltz.CurrencyLiquid, To: boltz.CurrencyBtc},
+					OnchainFee: fee(10),
+					ServiceFee: serviceFee(10),
+					IsAuto:     true,
+				},
+			},
+			ReverseSwaps: []database.ReverseSwap{
+				{
+					Pair:           boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyBtc},
+					OnchainFee:     fee(10),
+					ServiceFee:     serviceFee(10),
+					RoutingFeeMsat: fee(5000),
+					IsAuto:         true,
+				},
+				{
+					Pair:           boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyLiquid},
+					OnchainFee:     fee(10),
+					ServiceFee:     serviceFee(10),
+					RoutingFeeMsat: fee(5000),
+					IsAuto:         true,
+					CreatedAt:      test.PastDate(2 * time.Hour),
+				},
+			},
+		}.Create(t, db)
+
+		c := shared{database: db}
+		liquid := boltzrpc.Currency_LBTC
+		reverse := boltzrpc.SwapType_REVERSE
+		cfg := &SerializedLnConfig{
+			Budget:         1000,
+			BudgetInterval: uint64((24 * time.Hour).Seconds()),
+			Budgets: []*autoswaprpc.BudgetLimit{
+				{Currency: &liquid, Budget: 100},
+				{Type: &reverse, Budget: 60, BudgetInterval: uint64(time.Hour.Seconds())},
+			},
+		}
+		require.NoError(t, validateBudgets(cfg, Lightning))
 
-	t.Run("Missing", func(t *testing.T) {
-		cfg, _ := getLnConfig(t, &SerializedLnConfig{}, getOnchain())
-		budget, err := cfg.GetCurrentBudget(false)
+		budget, err := c.GetCurrentBudget(Lightning, cfg, database.DefaultTenantId, "")
 		require.NoError(t, err)
-		require.Nil(t, budget)
-	})
+		require.Equal(t, uint64(930), budget.Amount)
+		require.Len(t, budget.Limits, 2)
+		require.Equal(t, uint64(55), budget.Limits[0].Amount)
+		require.Equal(t, uint64(35), budget.Limits[1].Amount)
+
+		require.Equal(t, uint64(930), budget.Available(boltz.NormalSwap, boltz.CurrencyBtc))
+		require.Equal(t, uint64(55), budget.Available(boltz.NormalSwap, boltz.CurrencyLiquid))
+		require.Equal(t, uint64(35), budget.Available(boltz.ReverseSwap, boltz.CurrencyLiquid))
 
+		budget.Spend(boltz.ReverseSwap, boltz.CurrencyLiquid, 30)
+		require.Equal(t, uint64(900), budget.Amount)
+		require.Equal(t, uint64(5), budget.Available(boltz.ReverseSwap, boltz.CurrencyBtc))
+		require.Equal(t, uint64(25), budget.Available(boltz.NormalSwap, boltz.CurrencyLiquid))
+
+		chain := boltzrpc.SwapType_CHAIN
+		cfg.Budgets = []*autoswaprpc.BudgetLimit{{Type: &chain, Budget: 100}}
+		require.Error(t, validateBudgets(cfg, Lightning))
+	})
 }
 
 func recommendation(t boltz.SwapType, a uint64, c *lightning.LightningChannel) *LightningRecommendation {
@@ -366,18 +458,21 @@ func TestStrategies(t *testing.T) {
 			InboundSat:  100,
 			Capacity:    200,
 			Id:          1,
+			PeerId:      "a",
 		},
 		{
 			OutboundSat: 50,
 			InboundSat:  150,
 			Capacity:    200,
 			Id:          2,
+			PeerId:      "a",
 		},
 		{
 			OutboundSat: 500,
 			InboundSat:  100,
 			Capacity:    600,
 			Id:          3,
+			PeerId:      "b",
 		},
 	}
 
@@ -413,6 +508,67 @@ func TestStrategies(t *testing.T) {
 			},
 			outcome: nil,
 		},
+		{
+			name: "PerChannel/Exclude",
+			config: &SerializedLnConfig{
+				PerChannel:            true,
+				InboundBalancePercent: 40,
+				SwapType:              "reverse",
+				ChannelRules: []*autoswaprpc.LightningChannelRule{
+					{ChannelId: proto.String("3"), Exclude: true},
+				},
+			},
+			outcome: []*LightningRecommendation{
+				{Channel: channels[0]},
+				{Channel: channels[1]},
+			},
+		},
+		{
+			name: "PerChannel/Peer",
+			config: &SerializedLnConfig{
+				PerChannel:            true,
+				InboundBalancePercent: 10,
+				SwapType:              "reverse",
+				ChannelRules: []*autoswaprpc.LightningChannelRule{
+					{PeerId: proto.String("a"), InboundBalancePercent: proto.Float32(80)},
+				},
+			},
+			outcome: []*LightningRecommendation{
+				recommendation(boltz.ReverseSwap, 100, channels[0]),
+				recommendation(boltz.ReverseSwap, 50, channels[1]),
+				{Channel: channels[2]},
+			},
+		},
+		{
+			name: "PerChannel/ChannelOverPeer",
+			config: &SerializedLnConfig{
+				PerChannel:            true,
+				InboundBalancePercent: 10,
+				SwapType:              "reverse",
+				ChannelRules: []*autoswaprpc.LightningChannelRule{
+					{PeerId: proto.String("a"), InboundBalancePercent: proto.Float32(80)},
+					{ChannelId: proto.String("2"), InboundBalancePercent: proto.Float32(80), MaxSwapAmount: proto.Uint64(20)},
+				},
+			},
+			outcome: []*LightningRecommendation{
+				recommendation(boltz.ReverseSwap, 100, channels[0]),
+				recommendation(boltz.ReverseSwap, 20, channels[1]),
+				{Channel: channels[2]},
+			},
+		},
+		{
+			name: "TotalBalance/Exclude",
+			config: &SerializedLnConfig{
+				InboundBalancePercent:  40,
+				OutboundBalancePercent: 40,
+				ChannelRules: []*autoswaprpc.LightningChannelRule{
+					{PeerId: proto.String("b"), Exclude: true},
+				},
+			},
+			outcome: []*LightningRecommendation{
+				recommendation(boltz.NormalSwap, 50, nil),
+			},
+		},
 		{
 			name: "TotalBalance/Reverse",
 			config: &SerializedLnConfig{
@@ -576,6 +732,93 @@ func TestStrategies(t *testing.T) {
 	}
 }
 
+func TestChannelRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *SerializedLnConfig
+		err    string
+	}{
+		{
+			name: "MissingId",
+			config: &SerializedLnConfig{
+				ChannelRules: []*autoswaprpc.LightningChannelRule{{Exclude: true}},
+			},
+			err: "peer or channel id must be set",
+		},
+		{
+			name: "InvalidChannelId",
+			config: &SerializedLnConfig{
+				ChannelRules: []*autoswaprpc.LightningChannelRule{{ChannelId: proto.String("invalid"), Exclude: true}},
+			},
+			err: "invalid channel id",
+		},
+		{
+			name: "OverridesTotalBalance",
+			config: &SerializedLnConfig{
+				ChannelRules: []*autoswaprpc.LightningChannelRule{{PeerId: proto.String("a"), InboundBalancePercent: proto.Float32(50)}},
+			},
+			err: "only supported for per channel rebalancing",
+		},
+		{
+			name: "NormalSwapsPerChannel",
+			config: &SerializedLnConfig{
+				PerChannel:   true,
+				SwapType:     "reverse",
+				ChannelRules: []*autoswaprpc.LightningChannelRule{{PeerId: proto.String("a"), SwapType: proto.String("")}},
+			},
+			err: "only supported for reverse swaps",
+		},
+		{
+			name: "ThresholdsTooHigh",
+			config: &SerializedLnConfig{
+				PerChannel:   true,
+				SwapType:     "reverse",
+				ChannelRules: []*autoswaprpc.LightningChannelRule{{PeerId: proto.String("a"), InboundBalancePercent: proto.Float32(90)}},
+			},
+			err: "sum of thresholds",
+		},
+		{
+			name: "Valid",
+			config: &SerializedLnConfig{
+				PerChannel: true,
+				SwapType:   "reverse",
+				ChannelRules: []*autoswaprpc.LightningChannelRule{
+					{ChannelId: proto.String("100x1x0"), InboundBalance: proto.Uint64(100_000)},
+					{PeerId: proto.String("a"), Exclude: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := NewLightningConfig(withThresholds(tc.config), shared{onchain: getOnchain()})
+			err := cfg.Init()
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("Precedence", func(t *testing.T) {
+		cfg := NewLightningConfig(withThresholds(&SerializedLnConfig{
+			PerChannel: true,
+			SwapType:   "reverse",
+			ChannelRules: []*autoswaprpc.LightningChannelRule{
+				{PeerId: proto.String("a"), Exclude: true},
+				{ChannelId: proto.String("1")},
+			},
+		}), shared{onchain: getOnchain()})
+		require.NoError(t, cfg.Init())
+
+		require.Equal(t, cfg.rules[1], cfg.channelRule(&lightning.LightningChannel{Id: 1, PeerId: "a"}))
+		require.Equal(t, cfg.rules[0], cfg.channelRule(&lightning.LightningChannel{Id: 2, PeerId: "a"}))
+		require.Equal(t, cfg.defaultRule, cfg.channelRule(&lightning.LightningChannel{Id: 3, PeerId: "b"}))
+	})
+}
+
 func TestDismissedChannels(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -711,6 +954,24 @@ func TestCheckSwapRecommendation(t *testing.T) {
 			amount:  10000,
 			outcome: []string{ReasonMaxFeePercent},
 		},
+		{
+			name: "MaxSwapFee/High",
+			config: &SerializedLnConfig{
+				MaxFeePercent: 25,
+				MaxSwapFee:    1000,
+			},
+			amount:  10000,
+			outcome: nil,
+		},
+		{
+			name: "MaxSwapFee/Low",
+			config: &SerializedLnConfig{
+				MaxFeePercent: 25,
+				MaxSwapFee:    10,
+			},
+			amount:  10000,
+			outcome: []string{ReasonMaxSwapFee},
+		},
 		{
 			name: "LowAmount",
 			config: &SerializedLnConfig{
@@ -809,7 +1070,7 @@ func TestCheckSwapRecommendation(t *testing.T) {
 				checks: checks{
 					Amount: tc.amount,
 				},
-			}}}, tc.config.Budget, true)
+			}}}, &Budget{Amount: tc.config.Budget}, true)
 			require.NoError(t, err)
 			require.Equal(t, tc.outcome, validated[0].Swap.DismissedReasons)
 			require.Equal(t, tc.outcome, validated[0].Swap.DismissedReasons)
@@ -817,6 +1078,71 @@ func TestCheckSwapRecommendation(t *testing.T) {
 	}
 }
 
+func TestCheckAndExecute(t *testing.T) {
+	chain := getOnchain()
+	chain.AddWallet(mockedWallet{
+		info:    onchain.WalletInfo{Id: 1, Name: "test", Currency: boltz.CurrencyBtc},
+		balance: &onchain.Balance{},
+	}.Create(t))
+	cfg, rpc := getLnConfig(t, &SerializedLnConfig{
+		InboundBalancePercent: 30,
+		SwapType:              "reverse",
+		PerChannel:            true,
+		Wallet:                "test",
+		Budget:                100_000,
+		MaxFeePercent:         2,
+	}, chain)
+
+	channel := func(id lightning.ChanId) *lightning.LightningChannel {
+		return &lightning.LightningChannel{OutboundSat: 800_000, InboundSat: 200_000, Capacity: 1_000_000, Id: id}
+	}
+	rpc.EXPECT().GetAutoSwapPairInfo(mock.Anything, mock.Anything).Return(newPairInfo(), nil)
+	rpc.EXPECT().GetLightningChannels("").Return([]*lightning.LightningChannel{channel(1), channel(2)}, nil).Once()
+	rpc.EXPECT().CreateAutoReverseSwap(mock.Anything, mock.Anything).Return("swapId", nil).Once()
+	rpc.EXPECT().CreateAutoReverseSwap(mock.Anything, mock.Anything).Return("", errors.New("failed")).Once()
+
+	require.Error(t, cfg.CheckAndExecute(nil, false))
+
+	rpc.EXPECT().GetLightningChannels("").Return(nil, nil).Once()
+	require.NoError(t, cfg.CheckAndExecute(nil, false))
+
+	events, err := cfg.database.QueryAutoSwapEvents(database.AutoSwapEventQuery{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Empty(t, events[0].Swaps)
+	require.Empty(t, events[0].Error)
+
+	event := events[1]
+	require.Equal(t, string(Lightning), event.Swapper)
+	require.Contains(t, event.Error, "failed")
+	require.Len(t, event.Swaps, 2)
+	require.Equal(t, "swapId", event.Swaps[0].SwapId)
+	require.Equal(t, boltz.ReverseSwap, event.Swaps[0].Type)
+	require.Empty(t, event.Swaps[1].SwapId)
+	require.NotNil(t, event.Swaps[1].ChannelId)
+
+	events, err = cfg.database.QueryAutoSwapEvents(database.AutoSwapEventQuery{ExcludeEmpty: true})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	t.Run("Accepted", func(t *testing.T) {
+		rpc.EXPECT().GetLightningChannels("").Return([]*lightning.LightningChannel{channel(1), channel(2)}, nil).Once()
+		rpc.EXPECT().CreateAutoReverseSwap(mock.Anything, mock.Anything).Return("accepted", nil).Once()
+
+		recommendations, err := cfg.GetSwapRecommendations(false)
+		require.NoError(t, err)
+		require.Len(t, recommendations, 2)
+
+		rpc.EXPECT().GetLightningChannels("").Return([]*lightning.LightningChannel{channel(1), channel(2)}, nil).Once()
+		require.NoError(t, cfg.CheckAndExecute(recommendations[:1], false))
+
+		events, err := cfg.database.QueryAutoSwapEvents(database.AutoSwapEventQuery{})
+		require.NoError(t, err)
+		require.Len(t, events[0].Swaps, 1)
+		require.Equal(t, "accepted", events[0].Swaps[0].SwapId)
+	})
+}
+
 func Test_checkAccepted(t *testing.T) {
 	chanId := &boltzrpc.ChannelId{Lnd: 123123, Cln: "123123"}
 
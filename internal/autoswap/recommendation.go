@@ -17,11 +17,13 @@ type checks struct {
 
 const (
 	ReasonMaxFeePercent     = "fee exceeds maximum percentage"
+	ReasonMaxSwapFee        = "fee exceeds maximum swap fee"
 	ReasonAmountBelowMin    = "amount below minimal"
 	ReasonBudgetExceeded    = "budget exceeded"
 	ReasonPendingSwap       = "pending swap"
 	ReasonFailedSwap        = "failed swap"
 	ReasonInsufficientFunds = "insufficient funds"
+	ReasonApprovalRequired  = "approval required"
 )
 
 func (recommendation *checks) Dismiss(reason string) {
@@ -33,11 +35,13 @@ func (recommendation *checks) Dismissed() bool {
 }
 
 type checkParams struct {
-	Amount           uint64
-	MaxFeePercent    boltz.Percentage
-	Budget           *uint64
-	Pair             *boltzrpc.PairInfo
-	DismissedReasons []string
+	Amount            uint64
+	MaxFeePercent     boltz.Percentage
+	MaxSwapFee        uint64
+	Budget            *uint64
+	Pair              *boltzrpc.PairInfo
+	DismissedReasons  []string
+	ApprovalThreshold uint64
 }
 
 func check(amount uint64, params checkParams) checks {
@@ -57,6 +61,10 @@ func check(amount uint64, params checkParams) checks {
 		checks.Dismiss(ReasonMaxFeePercent)
 	}
 
+	if params.MaxSwapFee != 0 && checks.FeeEstimate > params.MaxSwapFee {
+		checks.Dismiss(ReasonMaxSwapFee)
+	}
+
 	if params.Budget != nil {
 		if checks.FeeEstimate > *params.Budget {
 			checks.Dismiss(ReasonBudgetExceeded)
@@ -64,5 +72,9 @@ func check(amount uint64, params checkParams) checks {
 			*params.Budget -= checks.FeeEstimate
 		}
 	}
+
+	if params.ApprovalThreshold != 0 && checks.Amount > params.ApprovalThreshold {
+		checks.Dismiss(ReasonApprovalRequired)
+	}
 	return checks
 }
@@ -88,6 +88,22 @@ func TestRawRecommendation(t *testing.T) {
 				DismissedReasons: []string{ReasonBudgetExceeded},
 			},
 		},
+		{
+			name:   "ApprovalRequired",
+			amount: 500,
+			params: checkParams{
+				MaxFeePercent:     50,
+				ApprovalThreshold: 400,
+				Pair: &boltzrpc.PairInfo{
+					Limits: limits,
+					Fees:   fees,
+				},
+			},
+			result: checks{
+				FeeEstimate:      150,
+				DismissedReasons: []string{ReasonApprovalRequired},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2,6 +2,7 @@ package autoswap
 
 import (
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
@@ -29,10 +30,11 @@ func serializeLightningSwap(swap *LightningSwap) *autoswaprpc.LightningSwap {
 		Type:             serializers.SerializeSwapType(swap.Type),
 		FeeEstimate:      swap.FeeEstimate,
 		DismissedReasons: swap.DismissedReasons,
+		Currency:         serializers.SerializeCurrency(swap.Currency),
 	}
 }
 
-func serializeAutoChainSwap(swap *ChainSwap) *autoswaprpc.ChainSwap {
+func serializeAutoChainSwap(swap *ChainSwap, pair boltz.Pair) *autoswaprpc.ChainSwap {
 	if swap == nil {
 		return nil
 	}
@@ -40,5 +42,6 @@ func serializeAutoChainSwap(swap *ChainSwap) *autoswaprpc.ChainSwap {
 		Amount:           swap.Amount,
 		FeeEstimate:      swap.FeeEstimate,
 		DismissedReasons: swap.DismissedReasons,
+		Pair:             serializers.SerializePair(pair),
 	}
 }
@@ -0,0 +1,647 @@
+package autoswap
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+type Snapshot = autoswaprpc.Snapshot
+
+var simulationCount atomic.Uint64
+
+// SimulationResult describes what a single swapper would have done during a simulation
+type SimulationResult struct {
+	Swaps     []*autoswaprpc.SimulatedSwap
+	TotalFees uint64
+	// Budgets contains the state of every budget interval at the end of the simulation
+	Budgets []*Budget
+	Errors  []string
+
+	budgetEnd time.Time
+}
+
+type SimulationResults struct {
+	Lightning *SimulationResult
+	Chain     []*SimulationResult
+}
+
+func (result *SimulationResult) addSwap(swap *autoswaprpc.SimulatedSwap) {
+	result.Swaps = append(result.Swaps, swap)
+	if len(swap.DismissedReasons) == 0 {
+		result.TotalFees += swap.FeeEstimate
+	}
+}
+
+func (result *SimulationResult) addBudget(budget *Budget) {
+	if budget == nil {
+		return
+	}
+	// budgets roll continuously, so only the last state within every elapsed budget interval is kept
+	if last := len(result.Budgets) - 1; last >= 0 && budget.EndDate.Before(result.budgetEnd) {
+		result.Budgets[last] = budget
+	} else {
+		result.Budgets = append(result.Budgets, budget)
+		result.budgetEnd = budget.EndDate.Add(budget.EndDate.Sub(budget.StartDate))
+	}
+}
+
+func (result *SimulationResult) addError(at time.Time, err error) {
+	result.Errors = append(result.Errors, fmt.Sprintf("%s: %s", at.Format(time.RFC3339), err))
+}
+
+var errSimulatedWallet = errors.New("not available in simulated wallet")
+
+// simulatedWallet reports the balances recorded in snapshots, adjusted by the swaps executed during the simulation.
+// It is not backed by a real wallet, so every other call fails and no funds can ever be sent.
+type simulatedWallet struct {
+	info    onchain.WalletInfo
+	balance *onchain.Balance
+	change  int64
+}
+
+var _ onchain.Wallet = &simulatedWallet{}
+
+func (wallet *simulatedWallet) Ready() bool {
+	return true
+}
+
+func (wallet *simulatedWallet) NewAddress() (string, error) {
+	return "", errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) SendToAddress(onchain.WalletSendArgs) (string, error) {
+	return "", errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) Disconnect() error {
+	return nil
+}
+
+func (wallet *simulatedWallet) GetTransactions(uint64, uint64) ([]*onchain.WalletTransaction, error) {
+	return nil, errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) BumpTransactionFee(string, float64) (string, error) {
+	return "", errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) GetSendFee(onchain.WalletSendArgs) (uint64, uint64, error) {
+	return 0, 0, errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) GetOutputs(string) ([]*onchain.Output, error) {
+	return nil, errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) Sync() error {
+	return errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) FullScan() error {
+	return errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) ApplyTransaction(string) error {
+	return errSimulatedWallet
+}
+
+func (wallet *simulatedWallet) GetWalletInfo() onchain.WalletInfo {
+	return wallet.info
+}
+
+func (wallet *simulatedWallet) GetBalance() (*onchain.Balance, error) {
+	if wallet.balance == nil {
+		return nil, fmt.Errorf("no balance recorded for wallet %s", wallet.info.Name)
+	}
+	confirmed := applyChange(wallet.balance.Confirmed, wallet.change)
+	return &onchain.Balance{
+		Confirmed:   confirmed,
+		Unconfirmed: wallet.balance.Unconfirmed,
+		Total:       confirmed + wallet.balance.Unconfirmed,
+	}, nil
+}
+
+func applyChange(value uint64, change int64) uint64 {
+	return uint64(max(int64(value)+change, 0))
+}
+
+// simulation replaces the rpc, database and wallets used by the swappers, so that their decision logic
+// can be evaluated against recorded snapshots. Executed swaps are stored as successful in an in-memory database,
+// which makes them count towards the budget, and their effect on channel and wallet balances is applied to all
+// following snapshots.
+type simulation struct {
+	shared
+
+	snapshot *Snapshot
+	time     time.Time
+	wallets  map[database.Id]*simulatedWallet
+	// outbound balance moved by the executed swaps by channel
+	channelChanges map[lightning.ChanId]int64
+	swapCount      int
+}
+
+func (autoSwap *AutoSwap) newSimulation() (*simulation, error) {
+	if autoSwap.onchain == nil {
+		return nil, errors.New("onchain not available")
+	}
+	// a shared cache is required since the driver might open multiple connections, which would each get their own database otherwise
+	db := &database.Database{Path: fmt.Sprintf("file:simulation%d?mode=memory&cache=shared", simulationCount.Add(1))}
+	if err := db.Connect(); err != nil {
+		return nil, fmt.Errorf("could not create simulation database: %w", err)
+	}
+	sim := &simulation{
+		snapshot:       &Snapshot{},
+		wallets:        make(map[database.Id]*simulatedWallet),
+		channelChanges: make(map[lightning.ChanId]int64),
+	}
+	sim.shared = shared{
+		onchain:  &onchain.Onchain{Network: autoSwap.onchain.Network},
+		database: db,
+		rpc:      sim,
+		clock:    func() time.Time { return sim.time },
+	}
+
+	tenants, err := autoSwap.database.QueryTenants()
+	if err != nil {
+		return nil, err
+	}
+	// tenants are matched by name since the ids in the simulation database might differ
+	tenantIds := map[database.Id]database.Id{database.DefaultTenantId: database.DefaultTenantId}
+	for _, tenant := range tenants {
+		if tenant.Id == database.DefaultTenantId {
+			continue
+		}
+		simulated := &database.Tenant{Name: tenant.Name}
+		if err := db.CreateTenant(simulated); err != nil {
+			return nil, fmt.Errorf("could not create tenant: %w", err)
+		}
+		tenantIds[tenant.Id] = simulated.Id
+	}
+
+	for _, wallet := range autoSwap.onchain.Wallets {
+		info := wallet.GetWalletInfo()
+		info.TenantId = tenantIds[info.TenantId]
+		simulated := &simulatedWallet{info: info}
+		sim.wallets[info.Id] = simulated
+		sim.onchain.Wallets = append(sim.onchain.Wallets, simulated)
+	}
+	return sim, nil
+}
+
+// update applies a snapshot, fields which are not set are carried over from the previous one
+func (sim *simulation) update(snapshot *Snapshot) {
+	sim.time = time.Unix(snapshot.Timestamp, 0)
+	if len(snapshot.Channels) > 0 {
+		sim.snapshot.Channels = snapshot.Channels
+	}
+	if snapshot.Pairs != nil {
+		sim.snapshot.Pairs = snapshot.Pairs
+	}
+	if len(snapshot.FeeRates) > 0 {
+		sim.snapshot.FeeRates = snapshot.FeeRates
+	}
+	for _, recorded := range snapshot.Wallets {
+		if wallet, ok := sim.wallets[recorded.Id]; ok && recorded.Balance != nil {
+			wallet.balance = &onchain.Balance{
+				Total:       recorded.Balance.Total,
+				Confirmed:   recorded.Balance.Confirmed,
+				Unconfirmed: recorded.Balance.Unconfirmed,
+			}
+		}
+	}
+}
+
+func (sim *simulation) GetAutoSwapPairInfo(swapType boltzrpc.SwapType, pair *boltzrpc.Pair) (*boltzrpc.PairInfo, error) {
+	var pairs []*boltzrpc.PairInfo
+	switch swapType {
+	case boltzrpc.SwapType_SUBMARINE:
+		pairs = sim.snapshot.Pairs.GetSubmarine()
+	case boltzrpc.SwapType_REVERSE:
+		pairs = sim.snapshot.Pairs.GetReverse()
+	case boltzrpc.SwapType_CHAIN:
+		pairs = sim.snapshot.Pairs.GetChain()
+	}
+	for _, info := range pairs {
+		if proto.Equal(info.Pair, pair) {
+			return info, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s pair info recorded for %s", swapType, serializers.ParsePair(pair))
+}
+
+func (sim *simulation) GetAutoSwapPairs() (*boltzrpc.GetPairsResponse, error) {
+	if sim.snapshot.Pairs == nil {
+		return nil, errors.New("no pairs recorded")
+	}
+	return sim.snapshot.Pairs, nil
+}
+
+func (sim *simulation) GetLightningChannels(string) ([]*lightning.LightningChannel, error) {
+	if len(sim.snapshot.Channels) == 0 {
+		return nil, errors.New("no channels recorded")
+	}
+	var channels []*lightning.LightningChannel
+	for _, recorded := range sim.snapshot.Channels {
+		id := lightning.ChanId(recorded.GetId().GetLnd())
+		if cln := recorded.GetId().GetCln(); cln != "" {
+			var err error
+			id, err = lightning.NewChanIdFromString(cln)
+			if err != nil {
+				return nil, fmt.Errorf("invalid channel id: %w", err)
+			}
+		}
+		// swaps can only move funds from one side of the channel to the other
+		spendable := recorded.OutboundSat + recorded.InboundSat
+		outbound := min(applyChange(recorded.OutboundSat, sim.channelChanges[id]), spendable)
+		channels = append(channels, &lightning.LightningChannel{
+			OutboundSat: outbound,
+			InboundSat:  spendable - outbound,
+			Capacity:    recorded.Capacity,
+			Id:          id,
+			PeerId:      recorded.PeerId,
+		})
+	}
+	return channels, nil
+}
+
+func (sim *simulation) GetLightningNode(string) (lightning.LightningNode, error) {
+	return nil, errors.New("channel opening can not be simulated")
+}
+
+func (sim *simulation) GetBlockUpdates(boltz.Currency) (<-chan *onchain.BlockEpoch, func()) {
+	return nil, func() {}
+}
+
+func (sim *simulation) EstimateFee(currency boltz.Currency) (float64, error) {
+	feeRate, ok := sim.snapshot.FeeRates[string(currency)]
+	if !ok {
+		return 0, fmt.Errorf("no %s fee rate recorded", currency)
+	}
+	return feeRate, nil
+}
+
+func (sim *simulation) WalletSendFee(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendFee, error) {
+	wallet, ok := sim.wallets[request.Id]
+	if !ok {
+		return nil, fmt.Errorf("wallet %d not found", request.Id)
+	}
+	balance, err := wallet.GetBalance()
+	if err != nil {
+		return nil, err
+	}
+	feeRate, err := sim.EstimateFee(wallet.info.Currency)
+	if err != nil {
+		return nil, err
+	}
+	fee := uint64(math.Ceil(feeRate * estimatedTxVsize))
+	amount := request.Amount
+	if request.GetSendAll() {
+		if balance.Confirmed < fee {
+			return nil, status.Error(codes.InvalidArgument, "insufficient balance")
+		}
+		amount = balance.Confirmed - fee
+	} else if amount+fee > balance.Confirmed {
+		return nil, status.Error(codes.InvalidArgument, "insufficient balance")
+	}
+	return &boltzrpc.WalletSendFee{Amount: amount, Fee: fee, FeeRate: feeRate}, nil
+}
+
+func (sim *simulation) nextId() string {
+	sim.swapCount++
+	return fmt.Sprintf("simulated%d", sim.swapCount)
+}
+
+func (sim *simulation) fees(swapType boltzrpc.SwapType, pair *boltzrpc.Pair, amount uint64) (serviceFee int64, onchainFee uint64, err error) {
+	info, err := sim.GetAutoSwapPairInfo(swapType, pair)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(boltz.Percentage(info.Fees.Percentage).Calculate(amount)), info.Fees.MinerFees, nil
+}
+
+func (sim *simulation) changeWallet(walletId *database.Id, change int64) {
+	if walletId == nil {
+		return
+	}
+	if wallet, ok := sim.wallets[*walletId]; ok {
+		wallet.change += change
+	}
+}
+
+// moveChannelBalance adds the amount to the outbound balance of the channel. If no channel is specified,
+// the amount is spread over all channels which have enough balance on the respective side.
+func (sim *simulation) moveChannelBalance(chanId lightning.ChanId, amount int64) error {
+	if chanId != 0 {
+		sim.channelChanges[chanId] += amount
+		return nil
+	}
+	channels, err := sim.GetLightningChannels("")
+	if err != nil {
+		return err
+	}
+	remaining := uint64(math.Abs(float64(amount)))
+	for _, channel := range channels {
+		if remaining == 0 {
+			break
+		}
+		available := channel.OutboundSat
+		if amount > 0 {
+			available = channel.InboundSat
+		}
+		moved := min(available, remaining)
+		if amount > 0 {
+			sim.channelChanges[channel.Id] += int64(moved)
+		} else {
+			sim.channelChanges[channel.Id] -= int64(moved)
+		}
+		remaining -= moved
+	}
+	return nil
+}
+
+func (sim *simulation) CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) (string, error) {
+	serviceFee, onchainFee, err := sim.fees(boltzrpc.SwapType_SUBMARINE, request.Pair, request.Amount)
+	if err != nil {
+		return "", err
+	}
+	id := sim.nextId()
+	err = sim.database.CreateSwap(database.Swap{
+		Id:             id,
+		Pair:           serializers.ParsePair(request.Pair),
+		State:          boltzrpc.SwapState_SUCCESSFUL,
+		CreatedAt:      sim.time,
+		ExpectedAmount: request.Amount,
+		IsAuto:         true,
+		ServiceFee:     &serviceFee,
+		OnchainFee:     &onchainFee,
+		TenantId:       tenant.Id,
+	})
+	if err != nil {
+		return "", err
+	}
+	sim.changeWallet(request.WalletId, -int64(request.Amount+uint64(serviceFee)+onchainFee))
+	return id, sim.moveChannelBalance(0, int64(request.Amount))
+}
+
+func (sim *simulation) CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
+	serviceFee, onchainFee, err := sim.fees(boltzrpc.SwapType_REVERSE, request.Pair, request.Amount)
+	if err != nil {
+		return "", err
+	}
+	var chanIds []lightning.ChanId
+	for _, raw := range request.ChanIds {
+		chanId, err := lightning.NewChanIdFromString(raw)
+		if err != nil {
+			return "", err
+		}
+		chanIds = append(chanIds, chanId)
+	}
+	id := sim.nextId()
+	err = sim.database.CreateReverseSwap(database.ReverseSwap{
+		Id:            id,
+		Pair:          serializers.ParsePair(request.Pair),
+		ChanIds:       chanIds,
+		State:         boltzrpc.SwapState_SUCCESSFUL,
+		CreatedAt:     sim.time,
+		InvoiceAmount: request.Amount,
+		IsAuto:        true,
+		ServiceFee:    &serviceFee,
+		OnchainFee:    &onchainFee,
+		TenantId:      tenant.Id,
+	})
+	if err != nil {
+		return "", err
+	}
+	sim.changeWallet(request.WalletId, int64(request.Amount)-serviceFee-int64(onchainFee))
+	var chanId lightning.ChanId
+	if len(chanIds) == 1 {
+		chanId = chanIds[0]
+	}
+	return id, sim.moveChannelBalance(chanId, -int64(request.Amount))
+}
+
+func (sim *simulation) CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin ChainSwapOrigin) (string, error) {
+	amount := request.GetAmount()
+	serviceFee, onchainFee, err := sim.fees(boltzrpc.SwapType_CHAIN, request.Pair, amount)
+	if err != nil {
+		return "", err
+	}
+	id := sim.nextId()
+	pair := serializers.ParsePair(request.Pair)
+	err = sim.database.CreateChainSwap(database.ChainSwap{
+		Id:            id,
+		Pair:          pair,
+		State:         boltzrpc.SwapState_SUCCESSFUL,
+		CreatedAt:     sim.time,
+		IsAuto:        true,
+		ServiceFee:    &serviceFee,
+		OnchainFee:    &onchainFee,
+		TenantId:      tenant.Id,
+		AutoSwapRule:  origin.Rule,
+		LightningNode: origin.LightningNode,
+		FromData:      &database.ChainSwapData{Id: id, Currency: pair.From, Amount: amount},
+		ToData:        &database.ChainSwapData{Id: id, Currency: pair.To},
+	})
+	if err != nil {
+		return "", err
+	}
+	sim.changeWallet(request.FromWalletId, -int64(amount))
+	sim.changeWallet(request.ToWalletId, int64(amount)-serviceFee-int64(onchainFee))
+	return id, nil
+}
+
+func (sim *simulation) AutoWalletSend(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error) {
+	sendFee, err := sim.WalletSendFee(request)
+	if err != nil {
+		return "", err
+	}
+	sim.changeWallet(&request.Id, -int64(sendFee.Amount+sendFee.Fee))
+	sim.changeWallet(toWalletId, int64(sendFee.Amount))
+	return sim.nextId(), nil
+}
+
+func (sim *simulation) runLightning(cfg *LightningConfig, result *SimulationResult) error {
+	recommendations, err := cfg.getSwapRecommendations(false)
+	if err != nil {
+		return err
+	}
+	for _, recommendation := range recommendations {
+		swap := recommendation.Swap
+		simulated := &autoswaprpc.SimulatedSwap{
+			Timestamp:        sim.time.Unix(),
+			Type:             swap.Type,
+			Amount:           swap.Amount,
+			FeeEstimate:      swap.FeeEstimate,
+			DismissedReasons: swap.DismissedReasons,
+		}
+		if recommendation.Channel.GetId().GetLnd() != 0 {
+			simulated.ChannelId = recommendation.Channel.Id
+		}
+		result.addSwap(simulated)
+		if _, err := cfg.execute(recommendation, false); err != nil {
+			return err
+		}
+	}
+	budget, err := cfg.GetCurrentBudget()
+	result.addBudget(budget)
+	return err
+}
+
+func (sim *simulation) runChain(cfg *ChainConfig, result *SimulationResult) error {
+	recommendation, err := cfg.getRecommendation()
+	if err != nil {
+		return err
+	}
+	if swap := recommendation.Swap; swap != nil {
+		result.addSwap(&autoswaprpc.SimulatedSwap{
+			Timestamp:        sim.time.Unix(),
+			Type:             boltzrpc.SwapType_CHAIN,
+			Amount:           swap.Amount,
+			FeeEstimate:      swap.FeeEstimate,
+			DismissedReasons: swap.DismissedReasons,
+		})
+		if _, err := cfg.execute(swap, nil, false); err != nil {
+			return err
+		}
+	}
+	budget, err := cfg.GetCurrentBudget()
+	result.addBudget(budget)
+	return err
+}
+
+// Simulate replays the snapshots through the decision logic of the given configuration without creating any swaps.
+// Swaps recommended for a snapshot are assumed to complete instantly and at their estimated fee.
+func (autoSwap *AutoSwap) Simulate(config *Config, snapshots []*Snapshot) (*SimulationResults, error) {
+	if len(snapshots) == 0 {
+		return nil, errors.New("no snapshots to simulate")
+	}
+	sim, err := autoSwap.newSimulation()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := sim.database.Close(); err != nil {
+			logger.Warnf("Could not close simulation database: %s", err)
+		}
+	}()
+
+	results := &SimulationResults{}
+	var lnConfig *LightningConfig
+	for _, serialized := range config.GetLightning() {
+		// snapshots only contain the channels of the main node
+		if serialized.LightningNode != "" {
+			continue
+		}
+		lnConfig = NewLightningConfig(serialized, sim.shared)
+		if err := lnConfig.Init(); err != nil {
+			return nil, fmt.Errorf("invalid lightning config: %w", err)
+		}
+		// channel openings depend on the onchain wallet of the node, which is not part of the snapshots
+		lnConfig.channelOpening = nil
+		results.Lightning = &SimulationResult{}
+		break
+	}
+	var chainConfigs []*ChainConfig
+	for _, serialized := range config.GetChain() {
+		chainConfig := NewChainConfig(serialized, sim.shared)
+		if err := chainConfig.Init(); err != nil {
+			return nil, fmt.Errorf("invalid chain config: %w", err)
+		}
+		chainConfigs = append(chainConfigs, chainConfig)
+		results.Chain = append(results.Chain, &SimulationResult{})
+	}
+	if lnConfig == nil && len(chainConfigs) == 0 {
+		return nil, errors.New("no config to simulate")
+	}
+
+	snapshots = slices.Clone(snapshots)
+	slices.SortStableFunc(snapshots, func(a, b *Snapshot) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	for _, snapshot := range snapshots {
+		sim.update(snapshot)
+		if lnConfig != nil {
+			if err := sim.runLightning(lnConfig, results.Lightning); err != nil {
+				results.Lightning.addError(sim.time, err)
+			}
+		}
+		for i, chainConfig := range chainConfigs {
+			if err := sim.runChain(chainConfig, results.Chain[i]); err != nil {
+				results.Chain[i].addError(sim.time, err)
+			}
+		}
+	}
+	return results, nil
+}
+
+// GetSnapshot captures the current state the decision logic depends on, which can later be replayed with Simulate.
+// Channels are only included if tenantId is nil.
+func (autoSwap *AutoSwap) GetSnapshot(tenantId *database.Id) (*Snapshot, error) {
+	if autoSwap.onchain == nil {
+		return nil, errors.New("onchain not available")
+	}
+	snapshot := &Snapshot{
+		Timestamp: autoSwap.now().Unix(),
+		FeeRates:  make(map[string]float64),
+	}
+
+	if tenantId == nil {
+		channels, err := autoSwap.rpc.GetLightningChannels("")
+		if err != nil {
+			logger.Debugf("Not including channels in snapshot: %s", err)
+		}
+		for _, channel := range channels {
+			snapshot.Channels = append(snapshot.Channels, serializeLightningChannel(channel))
+		}
+	}
+
+	pairs, err := autoSwap.rpc.GetAutoSwapPairs()
+	if err != nil {
+		return nil, fmt.Errorf("could not get pairs: %w", err)
+	}
+	snapshot.Pairs = pairs
+
+	for _, wallet := range autoSwap.onchain.Wallets {
+		info := wallet.GetWalletInfo()
+		if !wallet.Ready() || (tenantId != nil && info.TenantId != *tenantId) {
+			continue
+		}
+		balance, err := wallet.GetBalance()
+		if err != nil {
+			return nil, fmt.Errorf("could not get balance of wallet %s: %w", info.Name, err)
+		}
+		snapshot.Wallets = append(snapshot.Wallets, &autoswaprpc.WalletSnapshot{
+			Id:      info.Id,
+			Name:    info.Name,
+			Balance: serializers.SerializeWalletBalance(balance),
+		})
+	}
+
+	for _, currency := range []boltz.Currency{boltz.CurrencyBtc, boltz.CurrencyLiquid} {
+		feeRate, err := autoSwap.onchain.EstimateFee(currency)
+		if err != nil {
+			logger.Debugf("Not including %s fee rate in snapshot: %s", currency, err)
+			continue
+		}
+		snapshot.FeeRates[string(currency)] = feeRate
+	}
+	return snapshot, nil
+}
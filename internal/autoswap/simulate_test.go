@@ -0,0 +1,191 @@
+package autoswap
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/stretchr/testify/require"
+)
+
+func simulationPairs() *boltzrpc.GetPairsResponse {
+	reverse := newPairInfo()
+	reverse.Pair = &boltzrpc.Pair{From: boltzrpc.Currency_BTC, To: boltzrpc.Currency_LBTC}
+	chain := newPairInfo()
+	chain.Pair = &boltzrpc.Pair{From: boltzrpc.Currency_LBTC, To: boltzrpc.Currency_BTC}
+	return &boltzrpc.GetPairsResponse{
+		Reverse: []*boltzrpc.PairInfo{reverse},
+		Chain:   []*boltzrpc.PairInfo{chain},
+	}
+}
+
+func simulationChannel(id lightning.ChanId) *boltzrpc.LightningChannel {
+	return serializeLightningChannel(&lightning.LightningChannel{
+		OutboundSat: 800_000,
+		InboundSat:  200_000,
+		Capacity:    1_000_000,
+		Id:          id,
+	})
+}
+
+func TestSimulate(t *testing.T) {
+	walletInfo := onchain.WalletInfo{Id: 1, Name: "test", Currency: boltz.CurrencyLiquid}
+	start := time.Now().Add(-24 * time.Hour).Unix()
+
+	setup := func(t *testing.T) *AutoSwap {
+		swapper, _ := getSwapper(t)
+		swapper.onchain.AddWallet(mockedWallet{info: walletInfo}.Create(t))
+		return swapper
+	}
+
+	lnConfig := func(budget uint64) *Config {
+		return &Config{
+			Lightning: []*SerializedLnConfig{{
+				InboundBalancePercent: 30,
+				SwapType:              "reverse",
+				PerChannel:            true,
+				Currency:              boltzrpc.Currency_LBTC,
+				Wallet:                walletInfo.Name,
+				Budget:                budget,
+				MaxFeePercent:         2,
+			}},
+		}
+	}
+
+	t.Run("Lightning", func(t *testing.T) {
+		swapper := setup(t)
+		snapshots := []*Snapshot{
+			{
+				Timestamp: start,
+				Channels:  []*boltzrpc.LightningChannel{simulationChannel(1)},
+				Pairs:     simulationPairs(),
+				Wallets:   []*autoswaprpc.WalletSnapshot{{Id: walletInfo.Id, Balance: &boltzrpc.Balance{}}},
+			},
+			// the recorded balance did not change, but the simulated swap has to be taken into account
+			{Timestamp: start + 60},
+		}
+
+		results, err := swapper.Simulate(lnConfig(100_000), snapshots)
+		require.NoError(t, err)
+		require.Empty(t, results.Chain)
+
+		result := results.Lightning
+		require.Empty(t, result.Errors)
+		require.Len(t, result.Swaps, 1)
+		swap := result.Swaps[0]
+		require.Equal(t, boltzrpc.SwapType_REVERSE, swap.Type)
+		require.Equal(t, uint64(750_000), swap.Amount)
+		require.Equal(t, uint64(1), swap.ChannelId.GetLnd())
+		require.Empty(t, swap.DismissedReasons)
+		require.Equal(t, swap.FeeEstimate, result.TotalFees)
+
+		require.Len(t, result.Budgets, 1)
+		require.Equal(t, 100_000-swap.FeeEstimate, result.Budgets[0].Amount)
+	})
+
+	t.Run("BudgetExhausted", func(t *testing.T) {
+		swapper := setup(t)
+		snapshots := []*Snapshot{
+			{
+				Timestamp: start,
+				Channels:  []*boltzrpc.LightningChannel{simulationChannel(1)},
+				Pairs:     simulationPairs(),
+				Wallets:   []*autoswaprpc.WalletSnapshot{{Id: walletInfo.Id, Balance: &boltzrpc.Balance{}}},
+			},
+			{
+				Timestamp: start + 60,
+				Channels:  []*boltzrpc.LightningChannel{simulationChannel(1), simulationChannel(2)},
+			},
+		}
+
+		results, err := swapper.Simulate(lnConfig(10_000), snapshots)
+		require.NoError(t, err)
+
+		result := results.Lightning
+		require.Len(t, result.Swaps, 2)
+		require.Empty(t, result.Swaps[0].DismissedReasons)
+		require.Equal(t, uint64(2), result.Swaps[1].ChannelId.GetLnd())
+		require.Contains(t, result.Swaps[1].DismissedReasons, ReasonBudgetExceeded)
+		require.Equal(t, result.Swaps[0].FeeEstimate, result.TotalFees)
+	})
+
+	t.Run("Chain", func(t *testing.T) {
+		swapper := setup(t)
+		config := &Config{
+			Chain: []*SerializedChainConfig{{
+				MaxBalance:    100_000,
+				FromWallet:    walletInfo.Name,
+				ToAddress:     "bcrt1q2q5f9te4va7xet4c93awrurux04h0pfwcuzzcu",
+				MaxFeePercent: 10,
+			}},
+		}
+		snapshots := []*Snapshot{
+			{
+				Timestamp: start,
+				Pairs:     simulationPairs(),
+				Wallets: []*autoswaprpc.WalletSnapshot{
+					{Id: walletInfo.Id, Balance: &boltzrpc.Balance{Confirmed: 200_000, Total: 200_000}},
+				},
+				FeeRates: map[string]float64{string(boltz.CurrencyLiquid): 0.1},
+			},
+			{Timestamp: start + 60},
+		}
+
+		results, err := swapper.Simulate(config, snapshots)
+		require.NoError(t, err)
+		require.Nil(t, results.Lightning)
+		require.Len(t, results.Chain, 1)
+
+		result := results.Chain[0]
+		require.Empty(t, result.Errors)
+		require.Len(t, result.Swaps, 1)
+		require.Equal(t, boltzrpc.SwapType_CHAIN, result.Swaps[0].Type)
+		require.Equal(t, uint64(200_000-14), result.Swaps[0].Amount)
+	})
+
+	t.Run("MissingData", func(t *testing.T) {
+		swapper := setup(t)
+		results, err := swapper.Simulate(lnConfig(100_000), []*Snapshot{{Timestamp: start}})
+		require.NoError(t, err)
+		require.Len(t, results.Lightning.Errors, 1)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		swapper := setup(t)
+		_, err := swapper.Simulate(lnConfig(100_000), nil)
+		require.Error(t, err)
+
+		_, err = swapper.Simulate(&Config{}, []*Snapshot{{Timestamp: start}})
+		require.Error(t, err)
+	})
+}
+
+func TestGetSnapshot(t *testing.T) {
+	swapper, mockProvider := getSwapper(t)
+	balance := &onchain.Balance{Confirmed: 1000, Total: 1000}
+	swapper.onchain.AddWallet(mockedWallet{
+		info:    onchain.WalletInfo{Id: 1, Name: "test", Currency: boltz.CurrencyLiquid},
+		balance: balance,
+	}.Create(t))
+
+	channels := []*lightning.LightningChannel{{OutboundSat: 100, InboundSat: 100, Capacity: 200, Id: 1}}
+	mockProvider.EXPECT().GetLightningChannels("").Return(channels, nil).Once()
+	mockProvider.EXPECT().GetAutoSwapPairs().Return(simulationPairs(), nil)
+
+	snapshot, err := swapper.GetSnapshot(nil)
+	require.NoError(t, err)
+	require.Len(t, snapshot.Channels, 1)
+	require.Len(t, snapshot.Wallets, 1)
+	require.Equal(t, balance.Confirmed, snapshot.Wallets[0].Balance.Confirmed)
+	require.NotNil(t, snapshot.Pairs)
+
+	otherTenant := uint64(2)
+	snapshot, err = swapper.GetSnapshot(&otherTenant)
+	require.NoError(t, err)
+	require.Empty(t, snapshot.Channels)
+	require.Empty(t, snapshot.Wallets)
+}
@@ -12,9 +12,9 @@ import (
 
 type Strategy = func(channels []*lightning.LightningChannel) []*LightningRecommendation
 
-func (cfg *LightningConfig) channelRecommendation(channel *lightning.LightningChannel) *LightningRecommendation {
-	outbound := cfg.outboundBalance.Get(channel.Capacity)
-	inbound := cfg.inboundBalance.Get(channel.Capacity)
+func (cfg *LightningConfig) channelRecommendation(channel *lightning.LightningChannel, rule *channelRule) *LightningRecommendation {
+	outbound := rule.outboundBalance.Get(channel.Capacity)
+	inbound := rule.inboundBalance.Get(channel.Capacity)
 
 	if channel.Capacity < outbound+inbound {
 		logger.Warnf("Capacity of channel %d is smaller than the sum of the outbound and inbound tresholds", channel.Id)
@@ -32,16 +32,16 @@ func (cfg *LightningConfig) channelRecommendation(channel *lightning.LightningCh
 	swap := &LightningSwap{}
 	if channel.OutboundSat < outbound {
 		swap.Type = boltz.NormalSwap
-		if cfg.swapType == boltz.NormalSwap {
+		if rule.swapType == boltz.NormalSwap {
 			swap.Amount = channel.InboundSat
 		}
 	} else if channel.InboundSat < inbound {
 		swap.Type = boltz.ReverseSwap
-		if cfg.swapType == boltz.ReverseSwap {
+		if rule.swapType == boltz.ReverseSwap {
 			swap.Amount = channel.OutboundSat
 		}
 	}
-	if swap.Type != "" && cfg.Allowed(swap.Type) {
+	if swap.Type != "" && rule.Allowed(swap.Type) {
 		if swap.Amount == 0 {
 			target := float64(outbound+(channel.Capacity-inbound)) / 2
 			swap.Amount = uint64(math.Abs(float64(channel.OutboundSat) - target))
@@ -57,6 +57,10 @@ func (cfg *LightningConfig) channelRecommendation(channel *lightning.LightningCh
 				swap.Amount -= reserve
 			}
 		}
+		if swap != nil && rule.maxSwapAmount != 0 && swap.Amount > rule.maxSwapAmount {
+			logger.Debugf("Limiting swap amount %d of channel %d to maximum of %d", swap.Amount, channel.Id, rule.maxSwapAmount)
+			swap.Amount = rule.maxSwapAmount
+		}
 		recommendation.Swap = swap
 	}
 	return recommendation
@@ -66,6 +70,9 @@ func (cfg *LightningConfig) totalBalanceStrategy(channels []*lightning.Lightning
 	var total lightning.LightningChannel
 
 	for _, channel := range channels {
+		if cfg.channelRule(channel).Exclude {
+			continue
+		}
 		total.OutboundSat += channel.OutboundSat
 		total.InboundSat += channel.InboundSat
 		total.Capacity += channel.Capacity
@@ -73,14 +80,20 @@ func (cfg *LightningConfig) totalBalanceStrategy(channels []*lightning.Lightning
 
 	logger.Debugf("Total channel balances %+v", total)
 
-	return []*LightningRecommendation{cfg.channelRecommendation(&total)}
+	return []*LightningRecommendation{cfg.channelRecommendation(&total, cfg.defaultRule)}
 }
 
 func (cfg *LightningConfig) perChannelStrategy(channels []*lightning.LightningChannel) []*LightningRecommendation {
 	var recommendations []*LightningRecommendation
 
 	for _, channel := range channels {
-		recommendations = append(recommendations, cfg.channelRecommendation(channel))
+		rule := cfg.channelRule(channel)
+		if rule.Exclude {
+			continue
+		}
+		if recommendation := cfg.channelRecommendation(channel, rule); recommendation != nil {
+			recommendations = append(recommendations, recommendation)
+		}
 	}
 
 	return recommendations
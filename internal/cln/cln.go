@@ -7,11 +7,16 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/cln/protos"
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
@@ -30,9 +35,12 @@ type Cln struct {
 	CertChain  string `long:"cln.certchain" description:"Path to the client cert of the CLN gRPC"`
 	ServerName string `long:"cln.servername" description:"Server name used in the certificate"`
 
+	RpcFile string `long:"cln.rpcfile" description:"Path to the JSON-RPC socket of CLN. Used instead of gRPC if set"`
+
 	Client protos.NodeClient
 
 	regtest    bool
+	network    *boltz.Network
 	walletInfo onchain.WalletInfo
 }
 
@@ -40,6 +48,18 @@ const (
 	serviceName = lightning.NodeTypeCln
 
 	paymentFailure = "payment failed"
+
+	// weight of the shared channel input and output of a splice transaction including its overhead
+	spliceWeight = 800
+	// number of rounds to exchange the splice transaction with the peer until the commitments are secured
+	maxSpliceUpdates = 10
+
+	// virtual size of the child transaction which spends a single output of the wallet to bump its parent
+	childVsize = 11 + 68 + 43
+	// weight of an output without its script
+	outputBaseWeight = (8 + 1) * 4
+
+	walletAccount = "wallet"
 )
 
 var (
@@ -61,6 +81,11 @@ func (c *Cln) Disconnect() error {
 }
 
 func (c *Cln) Connect() error {
+	if c.RpcFile != "" {
+		c.Client = protos.NewNodeClient(newJsonRpcConn(c.RpcFile))
+		return nil
+	}
+
 	caFile, err := os.ReadFile(c.RootCert)
 	if err != nil {
 		return fmt.Errorf("could not read %s root certificate %s: %s", serviceName, c.RootCert, err)
@@ -120,6 +145,9 @@ func (c *Cln) GetInfo() (*lightning.LightningInfo, error) {
 		return nil, err
 	}
 	c.regtest = info.Network == "regtest"
+	if network, err := boltz.ParseChain(info.Network); err == nil {
+		c.network = network
+	}
 	return &lightning.LightningInfo{
 		Pubkey:      hex.EncodeToString(info.Id),
 		BlockHeight: info.Blockheight,
@@ -169,12 +197,320 @@ func (c *Cln) ListChannels() ([]*lightning.LightningChannel, error) {
 	return results, nil
 }
 
+func (c *Cln) OpenChannel(request lightning.OpenChannelRequest) (*lightning.ChannelPoint, error) {
+	peerId, err := hex.DecodeString(request.PeerId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid peer id: %w", err)
+	}
+	if request.Host != "" {
+		_, err := c.Client.ConnectPeer(context.Background(), &protos.ConnectRequest{
+			Id: request.PeerId + "@" + request.Host,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not connect to peer: %w", err)
+		}
+	}
+	announce := !request.Private
+	fundRequest := &protos.FundchannelRequest{
+		Id: peerId,
+		Amount: &protos.AmountOrAll{
+			Value: &protos.AmountOrAll_Amount{Amount: &protos.Amount{Msat: request.Amount * 1000}},
+		},
+		Announce: &announce,
+	}
+	if request.SatPerVbyte != 0 {
+		fundRequest.Feerate = &protos.Feerate{
+			Style: &protos.Feerate_Perkb{Perkb: uint32(request.SatPerVbyte * 1000)},
+		}
+	}
+	response, err := c.Client.FundChannel(context.Background(), fundRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &lightning.ChannelPoint{
+		FundingTxId: hex.EncodeToString(response.Txid),
+		OutputIndex: response.Outnum,
+	}, nil
+}
+
+func (c *Cln) spliceChannelId(chanId lightning.ChanId) ([]byte, error) {
+	response, err := c.Client.ListPeerChannels(context.Background(), &protos.ListpeerchannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range response.Channels {
+		if channel.GetShortChannelId() == chanId.ToCln() {
+			if channel.State != protos.ChannelState_ChanneldNormal {
+				return nil, fmt.Errorf("channel %s is not active", chanId.ToCln())
+			}
+			return channel.ChannelId, nil
+		}
+	}
+	return nil, fmt.Errorf("channel %s not found", chanId.ToCln())
+}
+
+func (c *Cln) SpliceChannel(request lightning.SpliceRequest) (string, error) {
+	ctx := context.Background()
+	channelId, err := c.spliceChannelId(request.ChannelId)
+	if err != nil {
+		return "", err
+	}
+	feeratePerKw := uint32(request.SatPerVbyte * 250)
+	relativeAmount := request.Amount
+
+	var psbt string
+	if request.Amount > 0 {
+		if request.Address != "" {
+			return "", errors.New("address can only be set when splicing out")
+		}
+		excessAsChange := true
+		funded, err := c.Client.FundPsbt(ctx, &protos.FundpsbtRequest{
+			Satoshi: &protos.AmountOrAll{
+				Value: &protos.AmountOrAll_Amount{Amount: &protos.Amount{Msat: uint64(request.Amount) * 1000}},
+			},
+			Feerate:        &protos.Feerate{Style: &protos.Feerate_Perkw{Perkw: feeratePerKw}},
+			Startweight:    spliceWeight,
+			ExcessAsChange: &excessAsChange,
+		})
+		if err != nil {
+			return "", fmt.Errorf("could not fund splice: %w", err)
+		}
+		psbt = funded.Psbt
+	} else if request.Amount < 0 {
+		amount := uint64(-request.Amount)
+		outputRequest := &protos.AddpsbtoutputRequest{Satoshi: &protos.Amount{Msat: amount * 1000}}
+		if request.Address != "" {
+			outputRequest.Destination = &request.Address
+		}
+		output, err := c.Client.AddPsbtOutput(ctx, outputRequest)
+		if err != nil {
+			return "", fmt.Errorf("could not add splice output: %w", err)
+		}
+		psbt = output.Psbt
+		// the mining fee of a splice out is paid by the channel as well
+		fee := uint64(math.Ceil(request.SatPerVbyte * spliceWeight / 4))
+		relativeAmount = -int64(amount + fee)
+	} else {
+		return "", errors.New("splice amount must not be zero")
+	}
+
+	initResponse, err := c.Client.SpliceInit(ctx, &protos.SpliceInitRequest{
+		ChannelId:      channelId,
+		RelativeAmount: relativeAmount,
+		Initialpsbt:    &psbt,
+		FeeratePerKw:   &feeratePerKw,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not initiate splice: %w", err)
+	}
+	psbt = initResponse.Psbt
+
+	secured := false
+	for i := 0; i < maxSpliceUpdates && !secured; i++ {
+		update, err := c.Client.SpliceUpdate(ctx, &protos.SpliceUpdateRequest{ChannelId: channelId, Psbt: psbt})
+		if err != nil {
+			return "", fmt.Errorf("could not update splice: %w", err)
+		}
+		psbt = update.Psbt
+		secured = update.CommitmentsSecured
+	}
+	if !secured {
+		return "", fmt.Errorf("splice commitments not secured after %d updates", maxSpliceUpdates)
+	}
+
+	if request.Amount > 0 {
+		// the inputs from the wallet have to be signed by us
+		signed, err := c.Client.SignPsbt(ctx, &protos.SignpsbtRequest{Psbt: psbt})
+		if err != nil {
+			return "", fmt.Errorf("could not sign splice: %w", err)
+		}
+		psbt = signed.SignedPsbt
+	}
+
+	response, err := c.Client.SpliceSigned(ctx, &protos.SpliceSignedRequest{ChannelId: channelId, Psbt: psbt})
+	if err != nil {
+		return "", fmt.Errorf("could not finalize splice: %w", err)
+	}
+	return hex.EncodeToString(response.Txid), nil
+}
+
+func (c *Cln) chainParams() (*boltz.Network, error) {
+	if c.network == nil {
+		if _, err := c.GetInfo(); err != nil {
+			return nil, err
+		}
+		if c.network == nil {
+			return nil, errors.New("network of node is not supported")
+		}
+	}
+	return c.network, nil
+}
+
+// GetTransactions combines the transactions of the wallet with the chain events of the bookkeeper plugin,
+// which know the outputs of the wallet that were received and spent in each transaction.
+// Neither call can be limited to recent blocks, so the whole history is fetched; the periodic transaction
+// tracking only calls this for wallets with subscribers
 func (c *Cln) GetTransactions(limit, offset uint64) ([]*onchain.WalletTransaction, error) {
-	return nil, lightning.ErrUnsupported
+	network, err := c.chainParams()
+	if err != nil {
+		return nil, err
+	}
+	listed, err := c.Client.ListTransactions(context.Background(), &protos.ListtransactionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	account := walletAccount
+	events, err := c.Client.BkprListAccountEvents(context.Background(), &protos.BkprlistaccounteventsRequest{
+		Account: &account,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not list wallet events: %w", err)
+	}
+
+	transactions := make(map[string]*onchain.WalletTransaction)
+	ours := make(map[string]bool)
+	get := func(txId string) *onchain.WalletTransaction {
+		if _, ok := transactions[txId]; !ok {
+			transactions[txId] = &onchain.WalletTransaction{Id: txId}
+		}
+		return transactions[txId]
+	}
+	for _, event := range events.Events {
+		if event.ItemType != protos.BkprlistaccounteventsEvents_CHAIN || event.Outpoint == nil {
+			continue
+		}
+		var tx *onchain.WalletTransaction
+		switch event.Tag {
+		case "deposit":
+			txId, _, _ := strings.Cut(*event.Outpoint, ":")
+			tx = get(txId)
+			tx.BalanceChange += int64(event.CreditMsat.GetMsat() / 1000)
+			ours[*event.Outpoint] = true
+		case "withdrawal":
+			if event.Txid == nil {
+				continue
+			}
+			tx = get(hex.EncodeToString(event.Txid))
+			tx.BalanceChange -= int64(event.DebitMsat.GetMsat() / 1000)
+		default:
+			continue
+		}
+		if event.Timestamp != 0 {
+			tx.Timestamp = time.Unix(int64(event.Timestamp), 0)
+		}
+		if event.Blockheight != nil {
+			tx.BlockHeight = *event.Blockheight
+		}
+	}
+
+	for _, listedTx := range listed.Transactions {
+		tx, ok := transactions[hex.EncodeToString(listedTx.Hash)]
+		if !ok {
+			continue
+		}
+		tx.BlockHeight = listedTx.Blockheight
+		allOurs := len(listedTx.Outputs) > 0
+		for _, output := range listedTx.Outputs {
+			result := onchain.TransactionOutput{
+				Amount:       output.AmountMsat.GetMsat() / 1000,
+				IsOurAddress: ours[fmt.Sprintf("%s:%d", tx.Id, output.Index)],
+			}
+			_, addresses, _, err := txscript.ExtractPkScriptAddrs(output.ScriptPubKey, network.Btc)
+			if err == nil && len(addresses) == 1 {
+				result.Address = addresses[0].EncodeAddress()
+			}
+			tx.Outputs = append(tx.Outputs, result)
+			allOurs = allOurs && result.IsOurAddress
+		}
+		tx.IsConsolidation = allOurs && tx.BalanceChange < 0
+	}
+
+	result := make([]*onchain.WalletTransaction, 0, len(transactions))
+	for _, tx := range transactions {
+		result = append(result, tx)
+	}
+	return lightning.PaginateTransactions(result, limit, offset), nil
 }
 
+// BumpTransactionFee bumps the fee of an unconfirmed transaction with a child which sends one of its outputs back
+// to the wallet, since lightningd can not replace the transactions of its wallet.
+// The id of the bumped transaction stays the same and is returned
 func (c *Cln) BumpTransactionFee(txId string, feeRate float64) (string, error) {
-	return "", lightning.ErrUnsupported
+	listed, err := c.Client.ListTransactions(context.Background(), &protos.ListtransactionsRequest{})
+	if err != nil {
+		return "", err
+	}
+	outputs := make(map[string]uint64)
+	var parent *protos.ListtransactionsTransactions
+	for _, tx := range listed.Transactions {
+		id := hex.EncodeToString(tx.Hash)
+		for _, output := range tx.Outputs {
+			outputs[fmt.Sprintf("%s:%d", id, output.Index)] = output.AmountMsat.GetMsat() / 1000
+		}
+		if id == txId {
+			parent = tx
+		}
+	}
+	if parent == nil {
+		return "", fmt.Errorf("transaction %s not found", txId)
+	}
+	if parent.Blockheight != 0 {
+		return "", fmt.Errorf("transaction %s is already confirmed", txId)
+	}
+
+	funds, err := c.Client.ListFunds(context.Background(), &protos.ListfundsRequest{})
+	if err != nil {
+		return "", err
+	}
+	var utxo *protos.Outpoint
+	for _, output := range funds.Outputs {
+		if hex.EncodeToString(output.Txid) == txId && !output.GetReserved() {
+			utxo = &protos.Outpoint{Txid: output.Txid, Outnum: output.Output}
+			break
+		}
+	}
+	if utxo == nil {
+		return "", fmt.Errorf("transaction %s has no output of the wallet which could pay for it", txId)
+	}
+
+	parentTx, err := boltz.NewBtcTxFromHex(hex.EncodeToString(parent.Rawtx))
+	if err != nil {
+		return "", fmt.Errorf("could not parse transaction %s: %w", txId, err)
+	}
+	// inputs which are not from the wallet are unknown, which makes the child pay more than necessary
+	var parentFee int64
+	for _, input := range parent.Inputs {
+		parentFee += int64(outputs[fmt.Sprintf("%s:%d", hex.EncodeToString(input.Txid), input.Index)])
+	}
+	for _, output := range parent.Outputs {
+		parentFee -= int64(output.AmountMsat.GetMsat() / 1000)
+	}
+	parentFee = max(parentFee, 0)
+
+	packageFee := feeRate*float64(parentTx.VSize()+childVsize) - float64(parentFee)
+	childFeeRate := math.Ceil(packageFee / childVsize)
+	if childFeeRate < feeRate {
+		childFeeRate = math.Ceil(feeRate)
+	}
+
+	address, err := c.NewAddress()
+	if err != nil {
+		return "", err
+	}
+	// the output being spent is unconfirmed by definition
+	minConf := uint32(0)
+	child, err := c.Client.Withdraw(context.Background(), &protos.WithdrawRequest{
+		Destination: address,
+		Satoshi:     &protos.AmountOrAll{Value: &protos.AmountOrAll_All{All: true}},
+		Utxos:       []*protos.Outpoint{utxo},
+		Feerate:     &protos.Feerate{Style: &protos.Feerate_Perkb{Perkb: uint32(childFeeRate * 1000)}},
+		Minconf:     &minConf,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not send child transaction: %w", err)
+	}
+	logger.Infof("Bumped fee of transaction %s with child %s", txId, hex.EncodeToString(child.Txid))
+	return txId, nil
 }
 
 func (c *Cln) SanityCheck() (string, error) {
@@ -186,7 +522,7 @@ func (c *Cln) SanityCheck() (string, error) {
 	return info.Version, nil
 }
 
-func (c *Cln) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string) (*lightning.AddInvoiceResponse, error) {
+func (c *Cln) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string, routeHints bool) (*lightning.AddInvoiceResponse, error) {
 	request := &protos.InvoiceRequest{
 		// wtf is this
 		AmountMsat: &protos.AmountOrAny{
@@ -208,6 +544,13 @@ func (c *Cln) CreateInvoice(value uint64, preimage []byte, expiry int64, memo st
 		expiryDate := uint64(time.Now().Unix()) + uint64(expiry)
 		request.Expiry = &expiryDate
 	}
+	if routeHints {
+		channels, err := c.privateChannels()
+		if err != nil {
+			return nil, fmt.Errorf("could not get private channels: %w", err)
+		}
+		request.Exposeprivatechannels = channels
+	}
 	invoice, err := c.Client.Invoice(context.Background(), request)
 
 	if err != nil {
@@ -220,12 +563,31 @@ func (c *Cln) CreateInvoice(value uint64, preimage []byte, expiry int64, memo st
 	}, nil
 }
 
-func (c *Cln) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, chanIds []lightning.ChanId) (*lightning.PayInvoiceResponse, error) {
+// privateChannels returns the short channel ids of all usable private channels
+func (c *Cln) privateChannels() ([]string, error) {
+	response, err := c.Client.ListPeerChannels(context.Background(), &protos.ListpeerchannelsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var channels []string
+	for _, channel := range response.Channels {
+		if channel.GetPrivate() && channel.State == protos.ChannelState_ChanneldNormal && channel.ShortChannelId != nil {
+			channels = append(channels, *channel.ShortChannelId)
+		}
+	}
+	return channels, nil
+}
+
+func (c *Cln) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, chanIds []lightning.ChanId, maxParts uint32) (*lightning.PayInvoiceResponse, error) {
 	retry := uint32(timeoutSeconds)
 
 	if len(chanIds) > 0 {
 		return nil, fmt.Errorf("chanIds are not supported for cln")
 	}
+	if maxParts != 0 {
+		// xpay splits payments on its own and has no option to limit the number of parts
+		logger.Debugf("Ignoring max parts of %d for %s payment", maxParts, serviceName)
+	}
 
 	res, err := c.Client.Xpay(ctx, &protos.XpayRequest{
 		Invstring: invoice,
@@ -331,14 +693,116 @@ func (c *Cln) GetOutputs(address string) ([]*onchain.Output, error) {
 	return nil, lightning.ErrUnsupported
 }
 
+// GetSendFee funds a PSBT without reserving its inputs to learn the fee lightningd would pay for a withdrawal
 func (c *Cln) GetSendFee(args onchain.WalletSendArgs) (send uint64, fee uint64, err error) {
-	return 0, 0, lightning.ErrUnsupported
+	network, err := c.chainParams()
+	if err != nil {
+		return 0, 0, err
+	}
+	address, err := btcutil.DecodeAddress(args.Address, network.Btc)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid address: %w", err)
+	}
+	script, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		return 0, 0, err
+	}
+	reserve := uint32(0)
+	// the change output is added to the estimated weight, just like withdraw would add it
+	excessAsChange := !args.SendAll
+	request := &protos.FundpsbtRequest{
+		Satoshi:        &protos.AmountOrAll{},
+		Feerate:        &protos.Feerate{Style: &protos.Feerate_Perkb{Perkb: uint32(args.SatPerVbyte * 1000)}},
+		Startweight:    uint32(outputBaseWeight + len(script)*4),
+		Reserve:        &reserve,
+		ExcessAsChange: &excessAsChange,
+	}
+	if args.SendAll {
+		request.Satoshi.Value = &protos.AmountOrAll_All{All: true}
+	} else {
+		request.Satoshi.Value = &protos.AmountOrAll_Amount{Amount: &protos.Amount{Msat: args.Amount * 1000}}
+	}
+	response, err := c.Client.FundPsbt(context.Background(), request)
+	if err != nil {
+		return 0, 0, err
+	}
+	fee = uint64(math.Ceil(float64(response.FeeratePerKw) * float64(response.EstimatedFinalWeight) / 1000))
+	if args.SendAll {
+		return response.ExcessMsat.GetMsat() / 1000, fee, nil
+	}
+	return args.Amount, fee, nil
 }
 
 func (c *Cln) ApplyTransaction(txHex string) error {
 	return nil
 }
 
+// ProbeRoute uses getroute, which does not estimate the probability of the route succeeding
+func (c *Cln) ProbeRoute(destination []byte, amountSat uint64) (*lightning.RouteEstimate, error) {
+	amountMsat := amountSat * 1000
+	response, err := c.Client.GetRoute(context.Background(), &protos.GetrouteRequest{
+		Id:         destination,
+		AmountMsat: &protos.Amount{Msat: amountMsat},
+		Riskfactor: 10,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Route) == 0 {
+		return nil, errors.New("no route found")
+	}
+	// the first hop carries the amount plus the fees of all following hops
+	return &lightning.RouteEstimate{
+		FeeMsat: response.Route[0].AmountMsat.GetMsat() - amountMsat,
+	}, nil
+}
+
+func (c *Cln) CreateOffer(amountSat uint64, description string) (*lightning.CreateOfferResponse, error) {
+	amount := "any"
+	if amountSat != 0 {
+		amount = fmt.Sprintf("%dsat", amountSat)
+	}
+	response, err := c.Client.Offer(context.Background(), &protos.OfferRequest{
+		Amount:      amount,
+		Description: &description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &lightning.CreateOfferResponse{
+		Id:    hex.EncodeToString(response.OfferId),
+		Offer: response.Bolt12,
+	}, nil
+}
+
+func (c *Cln) DisableOffer(id string) error {
+	offerId, err := hex.DecodeString(id)
+	if err != nil {
+		return fmt.Errorf("invalid offer id: %w", err)
+	}
+	_, err = c.Client.DisableOffer(context.Background(), &protos.DisableofferRequest{OfferId: offerId})
+	return err
+}
+
+func (c *Cln) ListOfferPayments(id string) ([]*lightning.OfferPayment, error) {
+	response, err := c.Client.ListInvoices(context.Background(), &protos.ListinvoicesRequest{OfferId: &id})
+	if err != nil {
+		return nil, err
+	}
+	var payments []*lightning.OfferPayment
+	for _, invoice := range response.Invoices {
+		if invoice.Status != protos.ListinvoicesInvoices_PAID {
+			continue
+		}
+		payments = append(payments, &lightning.OfferPayment{
+			PaymentHash: invoice.PaymentHash,
+			AmountSat:   invoice.GetAmountReceivedMsat().GetMsat() / 1000,
+			PaidAt:      time.Unix(int64(invoice.GetPaidAt()), 0),
+		})
+	}
+	return payments, nil
+}
+
 func encodeOptionalBytes(data []byte) string {
 	if data == nil {
 		return ""
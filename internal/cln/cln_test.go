@@ -0,0 +1,289 @@
+package cln
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpliceChannel(t *testing.T) {
+	chanId, err := lightning.NewChanIdFromString("100x1x0")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		request lightning.SpliceRequest
+		calls   []string
+	}{
+		{
+			name:    "Out",
+			request: lightning.SpliceRequest{ChannelId: chanId, Amount: -100_000, Address: "bcrt1", SatPerVbyte: 2},
+			calls:   []string{"listpeerchannels", "addpsbtoutput", "splice_init", "splice_update", "splice_update", "splice_signed"},
+		},
+		{
+			name:    "In",
+			request: lightning.SpliceRequest{ChannelId: chanId, Amount: 100_000, SatPerVbyte: 2},
+			calls:   []string{"listpeerchannels", "fundpsbt", "splice_init", "splice_update", "splice_update", "signpsbt", "splice_signed"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls []string
+			node := setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+				calls = append(calls, method)
+				switch method {
+				case "listpeerchannels":
+					return map[string]any{"channels": []map[string]any{
+						{"short_channel_id": "100x1x0", "channel_id": "aa", "state": "CHANNELD_NORMAL"},
+					}}, nil
+				case "addpsbtoutput":
+					require.Equal(t, "bcrt1", params["destination"])
+					require.Equal(t, "100000000msat", params["satoshi"])
+					return map[string]any{"psbt": "output"}, nil
+				case "fundpsbt":
+					require.Equal(t, "100000000msat", params["satoshi"])
+					require.Equal(t, "500perkw", params["feerate"])
+					return map[string]any{"psbt": "funded"}, nil
+				case "splice_init":
+					require.Equal(t, "aa", params["channel_id"])
+					require.Equal(t, float64(500), params["feerate_per_kw"])
+					if tc.request.Amount < 0 {
+						// the fee is taken from the channel on top of the output
+						require.Equal(t, float64(-100_400), params["relative_amount"])
+						require.Equal(t, "output", params["initialpsbt"])
+					} else {
+						require.Equal(t, float64(100_000), params["relative_amount"])
+						require.Equal(t, "funded", params["initialpsbt"])
+					}
+					return map[string]any{"psbt": "init"}, nil
+				case "splice_update":
+					secured := params["psbt"] == "updated"
+					return map[string]any{"psbt": "updated", "commitments_secured": secured}, nil
+				case "signpsbt":
+					require.Equal(t, "updated", params["psbt"])
+					return map[string]any{"signed_psbt": "signed"}, nil
+				case "splice_signed":
+					if tc.request.Amount > 0 {
+						require.Equal(t, "signed", params["psbt"])
+					} else {
+						require.Equal(t, "updated", params["psbt"])
+					}
+					return map[string]any{"txid": "bb", "tx": "cc", "psbt": "final"}, nil
+				}
+				return nil, &jsonRpcError{Code: -32601, Message: "Unknown command"}
+			})
+
+			txId, err := node.SpliceChannel(tc.request)
+			require.NoError(t, err)
+			require.Equal(t, "bb", txId)
+			require.Equal(t, tc.calls, calls)
+		})
+	}
+
+	t.Run("UnknownChannel", func(t *testing.T) {
+		node := setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+			return map[string]any{"channels": []map[string]any{}}, nil
+		})
+		_, err := node.SpliceChannel(lightning.SpliceRequest{ChannelId: chanId, Amount: 1000})
+		require.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestGetTransactions(t *testing.T) {
+	ourScript, err := hex.DecodeString("0014" + strings.Repeat("11", 20))
+	require.NoError(t, err)
+	otherScript, err := hex.DecodeString("0014" + strings.Repeat("22", 20))
+	require.NoError(t, err)
+
+	node := setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+		switch method {
+		case "getinfo":
+			return map[string]any{"id": "02aa", "network": "regtest"}, nil
+		case "listtransactions":
+			return map[string]any{"transactions": []map[string]any{
+				{
+					"hash": "aa", "blockheight": 100,
+					"outputs": []map[string]any{{"index": 0, "amount_msat": "100000000msat", "scriptPubKey": hex.EncodeToString(ourScript)}},
+				},
+				{
+					"hash": "bb", "blockheight": 0,
+					"outputs": []map[string]any{
+						{"index": 0, "amount_msat": "40000000msat", "scriptPubKey": hex.EncodeToString(otherScript)},
+						{"index": 1, "amount_msat": "59000000msat", "scriptPubKey": hex.EncodeToString(ourScript)},
+					},
+				},
+			}}, nil
+		case "bkpr-listaccountevents":
+			require.Equal(t, "wallet", params["account"])
+			return map[string]any{"events": []map[string]any{
+				{"account": "wallet", "type": "chain", "tag": "deposit", "outpoint": "aa:0", "credit_msat": 100000000, "timestamp": 1000},
+				{"account": "wallet", "type": "chain", "tag": "withdrawal", "outpoint": "aa:0", "txid": "bb", "debit_msat": 100000000, "timestamp": 2000},
+				{"account": "wallet", "type": "chain", "tag": "deposit", "outpoint": "bb:1", "credit_msat": 59000000, "timestamp": 2000},
+				{"account": "wallet", "type": "onchain_fee", "tag": "onchain_fee", "txid": "bb", "debit_msat": 1000000},
+			}}, nil
+		}
+		return nil, &jsonRpcError{Code: -32601, Message: "Unknown command"}
+	})
+
+	transactions, err := node.GetTransactions(0, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+
+	spend := transactions[0]
+	require.Equal(t, "bb", spend.Id)
+	require.Equal(t, int64(-41_000), spend.BalanceChange)
+	require.Zero(t, spend.BlockHeight)
+	require.False(t, spend.IsConsolidation)
+	require.Len(t, spend.Outputs, 2)
+	require.False(t, spend.Outputs[0].IsOurAddress)
+	require.True(t, spend.Outputs[1].IsOurAddress)
+	require.Equal(t, uint64(59_000), spend.Outputs[1].Amount)
+	require.NotEmpty(t, spend.Outputs[1].Address)
+
+	deposit := transactions[1]
+	require.Equal(t, "aa", deposit.Id)
+	require.Equal(t, int64(100_000), deposit.BalanceChange)
+	require.Equal(t, uint32(100), deposit.BlockHeight)
+	require.Equal(t, int64(1000), deposit.Timestamp.Unix())
+
+	paginated, err := node.GetTransactions(1, 1)
+	require.NoError(t, err)
+	require.Len(t, paginated, 1)
+	require.Equal(t, "aa", paginated[0].Id)
+}
+
+func TestBumpTransactionFee(t *testing.T) {
+	parent := wire.NewMsgTx(2)
+	parent.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0}, nil, [][]byte{make([]byte, 72), make([]byte, 33)}))
+	parent.AddTxOut(wire.NewTxOut(99_000, make([]byte, 22)))
+	var buffer bytes.Buffer
+	require.NoError(t, parent.Serialize(&buffer))
+	parentId := parent.TxHash().String()
+	inputId := strings.Repeat("00", 32)
+
+	setup := func(t *testing.T, blockHeight uint32, withdraw func(params map[string]any)) *Cln {
+		return setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+			switch method {
+			case "listtransactions":
+				return map[string]any{"transactions": []map[string]any{
+					{"hash": inputId, "blockheight": 100, "outputs": []map[string]any{{"index": 0, "amount_msat": "100000000msat"}}},
+					{
+						"hash": parentId, "blockheight": blockHeight, "rawtx": hex.EncodeToString(buffer.Bytes()),
+						"inputs":  []map[string]any{{"txid": inputId, "index": 0}},
+						"outputs": []map[string]any{{"index": 0, "amount_msat": "99000000msat"}},
+					},
+				}}, nil
+			case "listfunds":
+				return map[string]any{"outputs": []map[string]any{
+					{"txid": parentId, "output": 0, "amount_msat": "99000000msat", "status": "unconfirmed"},
+				}}, nil
+			case "newaddr":
+				return map[string]any{"p2tr": "bcrt1p"}, nil
+			case "withdraw":
+				withdraw(params)
+				return map[string]any{"txid": "cc", "tx": "dd", "psbt": "ee"}, nil
+			}
+			return nil, &jsonRpcError{Code: -32601, Message: "Unknown command"}
+		})
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		var withdrawn map[string]any
+		node := setup(t, 0, func(params map[string]any) { withdrawn = params })
+		txId, err := node.BumpTransactionFee(parentId, 20)
+		require.NoError(t, err)
+		require.Equal(t, parentId, txId)
+		require.Equal(t, "bcrt1p", withdrawn["destination"])
+		require.Equal(t, "all", withdrawn["satoshi"])
+		require.Equal(t, []any{parentId + ":0"}, withdrawn["utxos"])
+		require.Equal(t, float64(0), withdrawn["minconf"])
+
+		// the child pays for the parent which paid 1000 sats already
+		vsize := float64((&boltz.BtcTransaction{Tx: *btcutil.NewTx(parent)}).VSize())
+		expected := math.Ceil((20*(vsize+childVsize) - 1000) / childVsize)
+		require.Equal(t, fmt.Sprintf("%dperkb", int(expected*1000)), withdrawn["feerate"])
+	})
+
+	t.Run("Confirmed", func(t *testing.T) {
+		node := setup(t, 101, func(map[string]any) {})
+		_, err := node.BumpTransactionFee(parentId, 20)
+		require.ErrorContains(t, err, "confirmed")
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		node := setup(t, 0, func(map[string]any) {})
+		_, err := node.BumpTransactionFee(strings.Repeat("ff", 32), 20)
+		require.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestGetSendFee(t *testing.T) {
+	address := "bcrt1q" + "w508d6qejxtdg4y5r3zarvary0c5xw7kygt080"
+	node := setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+		switch method {
+		case "getinfo":
+			return map[string]any{"id": "02aa", "network": "regtest"}, nil
+		case "fundpsbt":
+			require.Equal(t, "2000perkb", params["feerate"])
+			require.Equal(t, float64(0), params["reserve"])
+			require.Equal(t, float64(outputBaseWeight+22*4), params["startweight"])
+			if params["satoshi"] == "all" {
+				require.Equal(t, false, params["excess_as_change"])
+				return map[string]any{"psbt": "funded", "feerate_per_kw": 500, "estimated_final_weight": 561, "excess_msat": "90000000msat"}, nil
+			}
+			// the weight includes the change output
+			require.Equal(t, true, params["excess_as_change"])
+			return map[string]any{"psbt": "funded", "feerate_per_kw": 500, "estimated_final_weight": 733, "excess_msat": "0msat", "change_outnum": 1}, nil
+		}
+		return nil, &jsonRpcError{Code: -32601, Message: "Unknown command"}
+	})
+
+	send, fee, err := node.GetSendFee(onchain.WalletSendArgs{Address: address, Amount: 10_000, SatPerVbyte: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(10_000), send)
+	require.Equal(t, uint64(367), fee)
+
+	send, fee, err = node.GetSendFee(onchain.WalletSendArgs{Address: address, SendAll: true, SatPerVbyte: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(90_000), send)
+	require.Equal(t, uint64(281), fee)
+}
+
+func TestCreateInvoice(t *testing.T) {
+	var invoiceParams map[string]any
+	node := setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+		switch method {
+		case "listpeerchannels":
+			return map[string]any{"channels": []map[string]any{
+				{"short_channel_id": "100x1x0", "private": true, "state": "CHANNELD_NORMAL"},
+				{"short_channel_id": "101x1x0", "private": false, "state": "CHANNELD_NORMAL"},
+				{"short_channel_id": "102x1x0", "private": true, "state": "CHANNELD_AWAITING_LOCKIN"},
+			}}, nil
+		case "invoice":
+			invoiceParams = params
+			return map[string]any{"bolt11": "lnbcrt1", "payment_hash": "aa"}, nil
+		}
+		return nil, &jsonRpcError{Code: -32601, Message: "Unknown command"}
+	})
+
+	invoice, err := node.CreateInvoice(1000, nil, 0, "memo", true)
+	require.NoError(t, err)
+	require.Equal(t, "lnbcrt1", invoice.PaymentRequest)
+	// only usable private channels are exposed
+	require.Equal(t, []any{"100x1x0"}, invoiceParams["exposeprivatechannels"])
+
+	_, err = node.CreateInvoice(1000, nil, 0, "memo", false)
+	require.NoError(t, err)
+	require.NotContains(t, invoiceParams, "exposeprivatechannels")
+}
@@ -0,0 +1,336 @@
+package cln
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+	"unicode"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errStreamsUnsupported = errors.New("streams are not supported over the JSON-RPC socket")
+
+// jsonRpcConn lets the generated gRPC client talk to the JSON-RPC socket of lightningd instead of cln-grpc.
+// The method of a call is derived from the name of its request and the messages are translated with their descriptors:
+// amounts are msat values, bytes are hex encoded and enums are lowercase strings.
+type jsonRpcConn struct {
+	path string
+	id   atomic.Uint64
+}
+
+func newJsonRpcConn(path string) *jsonRpcConn {
+	return &jsonRpcConn{path: path}
+}
+
+type jsonRpcRequest struct {
+	JsonRpc string `json:"jsonrpc"`
+	Id      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+type jsonRpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonRpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *jsonRpcError   `json:"error"`
+}
+
+func (conn *jsonRpcConn) Invoke(ctx context.Context, _ string, args any, reply any, _ ...grpc.CallOption) error {
+	request, ok := args.(proto.Message)
+	if !ok {
+		return fmt.Errorf("invalid request type %T", args)
+	}
+	response, ok := reply.(proto.Message)
+	if !ok {
+		return fmt.Errorf("invalid response type %T", reply)
+	}
+	name := command(request.ProtoReflect().Descriptor())
+	result, err := conn.call(ctx, name, encodeMessage(request.ProtoReflect()))
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(result))
+	decoder.UseNumber()
+	var decoded any
+	if err := decoder.Decode(&decoded); err != nil {
+		return fmt.Errorf("could not decode %s response: %w", name, err)
+	}
+	return decodeMessage(response.ProtoReflect(), decoded)
+}
+
+// command returns the JSON-RPC command of a request. cln-grpc names requests after their command, but capitalizes
+// words which are separated by underscores and drops the dashes of commands of plugins like bookkeeper
+func command(request protoreflect.MessageDescriptor) string {
+	var name strings.Builder
+	for i, char := range strings.TrimSuffix(string(request.Name()), "Request") {
+		if i > 0 && unicode.IsUpper(char) {
+			name.WriteRune('_')
+		}
+		name.WriteRune(unicode.ToLower(char))
+	}
+	result := name.String()
+	for _, prefix := range []string{"bkpr", "askrene"} {
+		if strings.HasPrefix(result, prefix) {
+			return prefix + "-" + strings.TrimPrefix(result, prefix)
+		}
+	}
+	return result
+}
+
+func (conn *jsonRpcConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, errStreamsUnsupported
+}
+
+func (conn *jsonRpcConn) call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	var dialer net.Dialer
+	socket, err := dialer.DialContext(ctx, "unix", conn.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to %s rpc socket: %w", serviceName, err)
+	}
+	defer socket.Close()
+
+	// payments can take a long time, so the socket is closed as soon as the call is cancelled
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			socket.Close()
+		case <-done:
+		}
+	}()
+
+	request := jsonRpcRequest{JsonRpc: "2.0", Id: conn.id.Add(1), Method: method, Params: params}
+	if err := json.NewEncoder(socket).Encode(request); err != nil {
+		return nil, err
+	}
+	var response jsonRpcResponse
+	if err := json.NewDecoder(socket).Decode(&response); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("could not read %s response: %w", method, err)
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("%s failed: %s (code %d)", method, response.Error.Message, response.Error.Code)
+	}
+	return response.Result, nil
+}
+
+func amountField(msg protoreflect.Message, name protoreflect.Name) protoreflect.FieldDescriptor {
+	return msg.Descriptor().Fields().ByName(name)
+}
+
+func encodeMessage(msg protoreflect.Message) any {
+	switch msg.Descriptor().FullName() {
+	case "cln.Amount":
+		// plain numbers are interpreted as satoshis by parameters like "satoshi", so the unit is always explicit
+		return fmt.Sprintf("%dmsat", msg.Get(amountField(msg, "msat")).Uint())
+	case "cln.Feerate":
+		if field := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("style")); field != nil {
+			if field.Kind() == protoreflect.BoolKind {
+				return string(field.Name())
+			}
+			return fmt.Sprintf("%d%s", msg.Get(field).Uint(), field.Name())
+		}
+		return nil
+	case "cln.Outpoint":
+		return fmt.Sprintf("%x:%d", msg.Get(amountField(msg, "txid")).Bytes(), msg.Get(amountField(msg, "outnum")).Uint())
+	case "cln.AmountOrAny":
+		if msg.Get(amountField(msg, "any")).Bool() {
+			return "any"
+		}
+		return encodeMessage(msg.Get(amountField(msg, "amount")).Message())
+	case "cln.AmountOrAll":
+		if msg.Get(amountField(msg, "all")).Bool() {
+			return "all"
+		}
+		return encodeMessage(msg.Get(amountField(msg, "amount")).Message())
+	}
+	result := make(map[string]any)
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := strings.TrimPrefix(string(field.Name()), "item_")
+		if field.IsList() {
+			list := value.List()
+			values := make([]any, list.Len())
+			for i := range values {
+				values[i] = encodeValue(field, list.Get(i))
+			}
+			result[name] = values
+		} else {
+			result[name] = encodeValue(field, value)
+		}
+		return true
+	})
+	return result
+}
+
+func encodeValue(field protoreflect.FieldDescriptor, value protoreflect.Value) any {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return encodeMessage(value.Message())
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(value.Bytes())
+	case protoreflect.EnumKind:
+		if enum := field.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return strings.ToLower(string(enum.Name()))
+		}
+		return nil
+	default:
+		return value.Interface()
+	}
+}
+
+// decodeMessage sets the fields of msg from a decoded JSON value. Fields which are unknown to the gRPC
+// definitions are ignored, since lightningd returns more than cln-grpc does in some cases
+func decodeMessage(msg protoreflect.Message, value any) error {
+	switch msg.Descriptor().FullName() {
+	case "cln.Amount":
+		msat, err := decodeUint(value)
+		if err != nil {
+			return err
+		}
+		msg.Set(amountField(msg, "msat"), protoreflect.ValueOfUint64(msat))
+		return nil
+	case "cln.AmountOrAny", "cln.AmountOrAll":
+		if keyword, ok := value.(string); ok && (keyword == "any" || keyword == "all") {
+			msg.Set(amountField(msg, protoreflect.Name(keyword)), protoreflect.ValueOfBool(true))
+			return nil
+		}
+		return decodeMessage(msg.Mutable(amountField(msg, "amount")).Message(), value)
+	}
+	object, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("expected object for %s, got %T", msg.Descriptor().FullName(), value)
+	}
+	fields := msg.Descriptor().Fields()
+	for key, value := range object {
+		field := fields.ByName(protoreflect.Name(key))
+		if field == nil {
+			// cln-grpc prefixes fields whose names are reserved in some languages, like "type"
+			field = fields.ByName(protoreflect.Name("item_" + key))
+		}
+		if field == nil || value == nil {
+			continue
+		}
+		if field.IsList() {
+			values, ok := value.([]any)
+			if !ok {
+				continue
+			}
+			list := msg.Mutable(field).List()
+			for _, value := range values {
+				if field.Kind() == protoreflect.MessageKind {
+					element := list.NewElement()
+					if err := decodeMessage(element.Message(), value); err != nil {
+						return err
+					}
+					list.Append(element)
+				} else if decoded, ok := decodeScalar(field, value); ok {
+					list.Append(decoded)
+				}
+			}
+		} else if field.Kind() == protoreflect.MessageKind {
+			if err := decodeMessage(msg.Mutable(field).Message(), value); err != nil {
+				return err
+			}
+		} else if decoded, ok := decodeScalar(field, value); ok {
+			msg.Set(field, decoded)
+		}
+	}
+	return nil
+}
+
+func decodeUint(value any) (uint64, error) {
+	switch value := value.(type) {
+	case json.Number:
+		var result uint64
+		_, err := fmt.Sscan(value.String(), &result)
+		return result, err
+	case string:
+		// amounts used to be returned with an "msat" suffix
+		var result uint64
+		_, err := fmt.Sscan(strings.TrimSuffix(value, "msat"), &result)
+		return result, err
+	}
+	return 0, fmt.Errorf("invalid amount: %v", value)
+}
+
+func normalizeEnum(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// decodeScalar converts a JSON value to the type of the field and reports whether that was possible
+func decodeScalar(field protoreflect.FieldDescriptor, value any) (protoreflect.Value, bool) {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		if value, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(value), true
+		}
+	case protoreflect.StringKind:
+		if value, ok := value.(string); ok {
+			return protoreflect.ValueOfString(value), true
+		}
+	case protoreflect.BytesKind:
+		if value, ok := value.(string); ok {
+			if decoded, err := hex.DecodeString(value); err == nil {
+				return protoreflect.ValueOfBytes(decoded), true
+			}
+		}
+	case protoreflect.EnumKind:
+		if value, ok := value.(string); ok {
+			values := field.Enum().Values()
+			for i := 0; i < values.Len(); i++ {
+				if normalizeEnum(string(values.Get(i).Name())) == normalizeEnum(value) {
+					return protoreflect.ValueOfEnum(values.Get(i).Number()), true
+				}
+			}
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if value, ok := value.(json.Number); ok {
+			if parsed, err := value.Int64(); err == nil {
+				return protoreflect.ValueOfInt32(int32(parsed)), true
+			}
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if value, ok := value.(json.Number); ok {
+			if parsed, err := value.Int64(); err == nil {
+				return protoreflect.ValueOfInt64(parsed), true
+			}
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if parsed, err := decodeUint(value); err == nil {
+			return protoreflect.ValueOfUint32(uint32(parsed)), true
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if parsed, err := decodeUint(value); err == nil {
+			return protoreflect.ValueOfUint64(parsed), true
+		}
+	case protoreflect.FloatKind:
+		if value, ok := value.(json.Number); ok {
+			if parsed, err := value.Float64(); err == nil {
+				return protoreflect.ValueOfFloat32(float32(parsed)), true
+			}
+		}
+	case protoreflect.DoubleKind:
+		if value, ok := value.(json.Number); ok {
+			if parsed, err := value.Float64(); err == nil {
+				return protoreflect.ValueOfFloat64(parsed), true
+			}
+		}
+	}
+	return protoreflect.Value{}, false
+}
@@ -0,0 +1,103 @@
+package cln
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/cln/protos"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+type rpcHandler func(method string, params map[string]any) (any, *jsonRpcError)
+
+func setupRpc(t *testing.T, handler rpcHandler) *Cln {
+	path := filepath.Join(t.TempDir(), "lightning-rpc")
+	listener, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			var request struct {
+				Id     uint64         `json:"id"`
+				Method string         `json:"method"`
+				Params map[string]any `json:"params"`
+			}
+			if err := json.NewDecoder(conn).Decode(&request); err == nil {
+				result, rpcErr := handler(request.Method, request.Params)
+				_ = json.NewEncoder(conn).Encode(map[string]any{"jsonrpc": "2.0", "id": request.Id, "result": result, "error": rpcErr})
+			}
+			conn.Close()
+		}
+	}()
+
+	node := &Cln{RpcFile: path}
+	require.NoError(t, node.Connect())
+	return node
+}
+
+func TestJsonRpc(t *testing.T) {
+	node := setupRpc(t, func(method string, params map[string]any) (any, *jsonRpcError) {
+		switch method {
+		case "getinfo":
+			return map[string]any{
+				"id":          "02aa",
+				"alias":       "alias",
+				"blockheight": 100,
+				"network":     "regtest",
+				"address":     []map[string]any{{"type": "ipv4", "address": "127.0.0.1", "port": 9735}},
+			}, nil
+		case "xpay":
+			require.Equal(t, "lnbcrt1", params["invstring"])
+			require.Equal(t, "10000msat", params["maxfee"])
+			return map[string]any{"payment_preimage": "0102", "amount_sent_msat": 1_010_000}, nil
+		case "withdraw":
+			require.Equal(t, "1000000msat", params["satoshi"])
+			require.Equal(t, "2000perkb", params["feerate"])
+			return map[string]any{"txid": "04"}, nil
+		case "listpays":
+			return map[string]any{"pays": []map[string]any{{"payment_hash": "03", "status": "complete"}}}, nil
+		}
+		return nil, &jsonRpcError{Code: -32601, Message: "Unknown command"}
+	})
+
+	info, err := node.Client.Getinfo(context.Background(), &protos.GetinfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x02, 0xaa}, info.Id)
+	require.Equal(t, "alias", info.GetAlias())
+	require.Equal(t, uint32(100), info.Blockheight)
+	require.Len(t, info.Address, 1)
+	require.Equal(t, protos.GetinfoAddress_IPV4, info.Address[0].ItemType)
+
+	maxFee := &protos.Amount{Msat: 10_000}
+	payment, err := node.Client.Xpay(context.Background(), &protos.XpayRequest{Invstring: "lnbcrt1", Maxfee: maxFee})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, payment.PaymentPreimage)
+	require.Equal(t, uint64(1_010_000), payment.AmountSentMsat.Msat)
+
+	txId, err := node.SendToAddress(onchain.WalletSendArgs{Address: "bcrt1", Amount: 1000, SatPerVbyte: 2})
+	require.NoError(t, err)
+	require.Equal(t, "04", txId)
+
+	pays, err := node.Client.ListPays(context.Background(), &protos.ListpaysRequest{})
+	require.NoError(t, err)
+	require.Equal(t, protos.ListpaysPays_COMPLETE, pays.Pays[0].Status)
+
+	_, err = node.Client.Stop(context.Background(), &protos.StopRequest{})
+	require.ErrorContains(t, err, "Unknown command")
+}
+
+func TestCommand(t *testing.T) {
+	require.Equal(t, "listpeerchannels", command((&protos.ListpeerchannelsRequest{}).ProtoReflect().Descriptor()))
+	require.Equal(t, "splice_init", command((&protos.SpliceInitRequest{}).ProtoReflect().Descriptor()))
+	require.Equal(t, "fundchannel_start", command((&protos.FundchannelStartRequest{}).ProtoReflect().Descriptor()))
+	require.Equal(t, "bkpr-listincome", command((&protos.BkprlistincomeRequest{}).ProtoReflect().Descriptor()))
+}
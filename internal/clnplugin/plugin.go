@@ -0,0 +1,315 @@
+package clnplugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/config"
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/internal/rpcserver"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	DataDirOption = "boltz-datadir"
+
+	// codes of the JSON-RPC errors returned to lightningd
+	codeInvalidParams = -32602
+	codeInternal      = -32603
+	codeUnknownMethod = -32601
+	codeNotReady      = -1
+)
+
+var ErrStdinClosed = errors.New("lightningd closed stdin of the plugin")
+
+// IsPlugin reports whether the process was started by lightningd as a plugin
+func IsPlugin() bool {
+	return os.Getenv("LIGHTNINGD_PLUGIN") == "1"
+}
+
+type method struct {
+	fullMethod  string
+	description string
+	request     func() proto.Message
+	response    func() proto.Message
+}
+
+var methods = map[string]method{
+	"boltz-getinfo": {
+		fullMethod:  boltzrpc.Boltz_GetInfo_FullMethodName,
+		description: "Returns basic information about the boltz-client daemon",
+		request:     func() proto.Message { return &boltzrpc.GetInfoRequest{} },
+		response:    func() proto.Message { return &boltzrpc.GetInfoResponse{} },
+	},
+	"boltz-createswap": {
+		fullMethod:  boltzrpc.Boltz_CreateSwap_FullMethodName,
+		description: "Creates a submarine swap (chain to lightning)",
+		request:     func() proto.Message { return &boltzrpc.CreateSwapRequest{} },
+		response:    func() proto.Message { return &boltzrpc.CreateSwapResponse{} },
+	},
+	"boltz-createreverseswap": {
+		fullMethod:  boltzrpc.Boltz_CreateReverseSwap_FullMethodName,
+		description: "Creates a reverse swap (lightning to chain)",
+		request:     func() proto.Message { return &boltzrpc.CreateReverseSwapRequest{} },
+		response:    func() proto.Message { return &boltzrpc.CreateReverseSwapResponse{} },
+	},
+	"boltz-listswaps": {
+		fullMethod:  boltzrpc.Boltz_ListSwaps_FullMethodName,
+		description: "Lists all swaps",
+		request:     func() proto.Message { return &boltzrpc.ListSwapsRequest{} },
+		response:    func() proto.Message { return &boltzrpc.ListSwapsResponse{} },
+	},
+	"boltz-getswapinfo": {
+		fullMethod:  boltzrpc.Boltz_GetSwapInfo_FullMethodName,
+		description: "Returns the details of a swap",
+		request:     func() proto.Message { return &boltzrpc.GetSwapInfoRequest{} },
+		response:    func() proto.Message { return &boltzrpc.GetSwapInfoResponse{} },
+	},
+	"boltz-autoswap-status": {
+		fullMethod:  autoswaprpc.AutoSwap_GetStatus_FullMethodName,
+		description: "Returns the status of autoswap",
+		request:     func() proto.Message { return &autoswaprpc.GetStatusRequest{} },
+		response:    func() proto.Message { return &autoswaprpc.GetStatusResponse{} },
+	},
+}
+
+// Config is the configuration lightningd passes to the plugin in the init call
+type Config struct {
+	LightningDir string
+	RpcFile      string
+	Network      string
+	DataDir      string
+}
+
+// Apply makes the daemon use the node which started the plugin via its JSON-RPC socket
+func (pluginConfig Config) Apply(cfg *config.Config) {
+	cfg.Node = "cln"
+	cfg.Network = pluginConfig.Network
+	cfg.Cln.RpcFile = pluginConfig.RpcFile
+	if !filepath.IsAbs(cfg.Cln.RpcFile) {
+		cfg.Cln.RpcFile = filepath.Join(pluginConfig.LightningDir, cfg.Cln.RpcFile)
+	}
+}
+
+// Plugin speaks the plugin protocol of Core Lightning with lightningd over stdin and stdout.
+// Calls to the boltz-* methods are forwarded to the gRPC server of the daemon once it is ready.
+type Plugin struct {
+	in             *json.Decoder
+	out            io.Writer
+	writeLock      sync.Mutex
+	defaultDataDir string
+
+	init chan Config
+
+	connLock sync.RWMutex
+	conn     grpc.ClientConnInterface
+	ctx      context.Context
+}
+
+func New(in io.Reader, out io.Writer, defaultDataDir string) *Plugin {
+	return &Plugin{
+		in:             json.NewDecoder(in),
+		out:            out,
+		defaultDataDir: defaultDataDir,
+		init:           make(chan Config, 1),
+	}
+}
+
+// Init returns a channel which receives the configuration once lightningd initialized the plugin
+func (plugin *Plugin) Init() <-chan Config {
+	return plugin.init
+}
+
+// SetConnection sets the connection to the gRPC server of the daemon to which method calls are forwarded.
+// The context is used for all calls and should contain the authentication metadata the server requires
+func (plugin *Plugin) SetConnection(ctx context.Context, conn grpc.ClientConnInterface) {
+	plugin.connLock.Lock()
+	defer plugin.connLock.Unlock()
+	plugin.ctx = ctx
+	plugin.conn = conn
+}
+
+// Connect forwards method calls to the in-process gRPC server of the daemon, which needs neither TLS nor authentication
+func (plugin *Plugin) Connect(rpc *rpcserver.RpcServer) error {
+	conn, err := rpc.InProcessConnection()
+	if err != nil {
+		return fmt.Errorf("could not connect to gRPC server: %w", err)
+	}
+	plugin.SetConnection(context.Background(), conn)
+	return nil
+}
+
+type request struct {
+	Id     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type response struct {
+	JsonRpc string    `json:"jsonrpc"`
+	Id      any       `json:"id"`
+	Result  any       `json:"result,omitempty"`
+	Error   *rpcError `json:"error,omitempty"`
+}
+
+// Run reads requests from lightningd until stdin is closed, which is how lightningd tells plugins to shut down
+func (plugin *Plugin) Run() error {
+	for {
+		var req request
+		if err := plugin.in.Decode(&req); err != nil {
+			if errors.Is(err, io.EOF) {
+				return ErrStdinClosed
+			}
+			return fmt.Errorf("could not decode request: %w", err)
+		}
+		// notifications have no id and do not expect a response
+		if req.Id == nil {
+			continue
+		}
+		switch req.Method {
+		case "getmanifest":
+			plugin.respond(req.Id, plugin.manifest(), nil)
+		case "init":
+			result, err := plugin.handleInit(req.Params)
+			plugin.respond(req.Id, result, err)
+		default:
+			// method calls are handled concurrently since swaps can take a while to be created
+			go func() {
+				result, err := plugin.handleMethod(req.Method, req.Params)
+				plugin.respond(req.Id, result, err)
+			}()
+		}
+	}
+}
+
+func (plugin *Plugin) respond(id json.RawMessage, result any, err *rpcError) {
+	res := response{JsonRpc: "2.0", Id: id, Result: result, Error: err}
+	if result == nil && err == nil {
+		res.Result = map[string]any{}
+	}
+	plugin.writeLock.Lock()
+	defer plugin.writeLock.Unlock()
+	if err := json.NewEncoder(plugin.out).Encode(res); err != nil {
+		logger.Errorf("Could not write response to lightningd: %v", err)
+	}
+}
+
+func (plugin *Plugin) manifest() any {
+	rpcMethods := make([]map[string]string, 0, len(methods))
+	for _, name := range slices.Sorted(maps.Keys(methods)) {
+		method := methods[name]
+		rpcMethods = append(rpcMethods, map[string]string{
+			"name":        name,
+			"usage":       "[json]",
+			"description": method.description,
+		})
+	}
+	return map[string]any{
+		"options": []map[string]string{{
+			"name":        DataDirOption,
+			"type":        "string",
+			"default":     plugin.defaultDataDir,
+			"description": "Data directory of boltz-client",
+		}},
+		"rpcmethods": rpcMethods,
+		"dynamic":    false,
+	}
+}
+
+func (plugin *Plugin) handleInit(params json.RawMessage) (any, *rpcError) {
+	var init struct {
+		Options       map[string]any `json:"options"`
+		Configuration struct {
+			LightningDir string `json:"lightning-dir"`
+			RpcFile      string `json:"rpc-file"`
+			Network      string `json:"network"`
+		} `json:"configuration"`
+	}
+	if err := json.Unmarshal(params, &init); err != nil {
+		return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	config := Config{
+		LightningDir: init.Configuration.LightningDir,
+		RpcFile:      init.Configuration.RpcFile,
+		Network:      init.Configuration.Network,
+		DataDir:      plugin.defaultDataDir,
+	}
+	// lightningd calls mainnet "bitcoin"
+	if config.Network == "bitcoin" {
+		config.Network = "mainnet"
+	}
+	if dataDir, ok := init.Options[DataDirOption].(string); ok && dataDir != "" {
+		config.DataDir = dataDir
+	}
+	select {
+	case plugin.init <- config:
+	default:
+		return nil, &rpcError{Code: codeInternal, Message: "plugin was initialized already"}
+	}
+	return nil, nil
+}
+
+func (plugin *Plugin) handleMethod(name string, params json.RawMessage) (any, *rpcError) {
+	method, ok := methods[name]
+	if !ok {
+		return nil, &rpcError{Code: codeUnknownMethod, Message: "unknown method " + name}
+	}
+
+	request := method.request()
+	params = bytes.TrimSpace(params)
+	if len(params) != 0 && params[0] == '[' {
+		var positional []json.RawMessage
+		if err := json.Unmarshal(params, &positional); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: err.Error()}
+		}
+		switch len(positional) {
+		case 0:
+			params = nil
+		case 1:
+			// the whole request can be passed as a single json argument
+			params = positional[0]
+		default:
+			return nil, &rpcError{Code: codeInvalidParams, Message: "use named parameters (lightning-cli -k) or pass the request as json"}
+		}
+	}
+	if len(params) != 0 && string(params) != "null" {
+		if err := protojson.Unmarshal(params, request); err != nil {
+			return nil, &rpcError{Code: codeInvalidParams, Message: "invalid params: " + err.Error()}
+		}
+	}
+
+	plugin.connLock.RLock()
+	conn, ctx := plugin.conn, plugin.ctx
+	plugin.connLock.RUnlock()
+	if conn == nil {
+		return nil, &rpcError{Code: codeNotReady, Message: "boltz-client is still starting"}
+	}
+
+	response := method.response()
+	if err := conn.Invoke(ctx, method.fullMethod, request, response); err != nil {
+		return nil, &rpcError{Code: codeInternal, Message: err.Error()}
+	}
+	result, err := protojson.Marshal(response)
+	if err != nil {
+		return nil, &rpcError{Code: codeInternal, Message: err.Error()}
+	}
+	return json.RawMessage(result), nil
+}
@@ -0,0 +1,145 @@
+package clnplugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/cln"
+	"github.com/BoltzExchange/boltz-client/v2/internal/config"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeConn struct {
+	grpc.ClientConnInterface
+	invoke func(method string, args, reply any) error
+}
+
+func (conn *fakeConn) Invoke(_ context.Context, method string, args any, reply any, _ ...grpc.CallOption) error {
+	return conn.invoke(method, args, reply)
+}
+
+type testPlugin struct {
+	*Plugin
+	requests  *io.PipeWriter
+	responses *json.Decoder
+	done      chan error
+}
+
+func setupPlugin(t *testing.T) *testPlugin {
+	inReader, inWriter := io.Pipe()
+	outReader, outWriter := io.Pipe()
+	plugin := &testPlugin{
+		Plugin:    New(inReader, outWriter, "/default"),
+		requests:  inWriter,
+		responses: json.NewDecoder(outReader),
+		done:      make(chan error, 1),
+	}
+	go func() {
+		plugin.done <- plugin.Run()
+	}()
+	t.Cleanup(func() {
+		inWriter.Close()
+		outReader.Close()
+	})
+	return plugin
+}
+
+func (plugin *testPlugin) call(t *testing.T, method string, params any) (result map[string]any, rpcErr *rpcError) {
+	require.NoError(t, json.NewEncoder(plugin.requests).Encode(map[string]any{
+		"jsonrpc": "2.0", "id": 1, "method": method, "params": params,
+	}))
+	var response struct {
+		Result map[string]any `json:"result"`
+		Error  *rpcError      `json:"error"`
+	}
+	require.NoError(t, plugin.responses.Decode(&response))
+	return response.Result, response.Error
+}
+
+func TestPlugin(t *testing.T) {
+	plugin := setupPlugin(t)
+
+	manifest, rpcErr := plugin.call(t, "getmanifest", map[string]any{})
+	require.Nil(t, rpcErr)
+	require.Len(t, manifest["rpcmethods"], len(methods))
+	options := manifest["options"].([]any)
+	require.Equal(t, "/default", options[0].(map[string]any)["default"])
+
+	_, rpcErr = plugin.call(t, "boltz-getinfo", map[string]any{})
+	require.NotNil(t, rpcErr)
+	require.Equal(t, codeNotReady, rpcErr.Code)
+
+	_, rpcErr = plugin.call(t, "init", map[string]any{
+		"options": map[string]any{DataDirOption: "/boltz"},
+		"configuration": map[string]any{
+			"lightning-dir": "/lightning/bitcoin",
+			"rpc-file":      "lightning-rpc",
+			"network":       "bitcoin",
+		},
+	})
+	require.Nil(t, rpcErr)
+
+	pluginConfig := <-plugin.Init()
+	require.Equal(t, "/boltz", pluginConfig.DataDir)
+
+	cfg := &config.Config{}
+	cfg.Cln = &cln.Cln{}
+	pluginConfig.Apply(cfg)
+	require.Equal(t, "mainnet", cfg.Network)
+	require.Equal(t, "/lightning/bitcoin/lightning-rpc", cfg.Cln.RpcFile)
+
+	plugin.SetConnection(context.Background(), &fakeConn{
+		invoke: func(method string, args, reply any) error {
+			switch method {
+			case boltzrpc.Boltz_GetSwapInfo_FullMethodName:
+				require.Equal(t, "swapId", args.(*boltzrpc.GetSwapInfoRequest).GetSwapId())
+				proto.Merge(reply.(proto.Message), &boltzrpc.GetSwapInfoResponse{
+					Swap: &boltzrpc.SwapInfo{Id: "swapId"},
+				})
+				return nil
+			case boltzrpc.Boltz_GetInfo_FullMethodName:
+				return errors.New("not available")
+			}
+			return errors.New("unexpected method")
+		},
+	})
+
+	t.Run("Named", func(t *testing.T) {
+		result, rpcErr := plugin.call(t, "boltz-getswapinfo", map[string]any{"swapId": "swapId"})
+		require.Nil(t, rpcErr)
+		require.Equal(t, "swapId", result["swap"].(map[string]any)["id"])
+	})
+
+	t.Run("Json", func(t *testing.T) {
+		result, rpcErr := plugin.call(t, "boltz-getswapinfo", []any{map[string]any{"swapId": "swapId"}})
+		require.Nil(t, rpcErr)
+		require.Equal(t, "swapId", result["swap"].(map[string]any)["id"])
+	})
+
+	t.Run("InvalidParams", func(t *testing.T) {
+		_, rpcErr := plugin.call(t, "boltz-getswapinfo", map[string]any{"invalid": true})
+		require.NotNil(t, rpcErr)
+		require.Equal(t, codeInvalidParams, rpcErr.Code)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		_, rpcErr := plugin.call(t, "boltz-getinfo", nil)
+		require.NotNil(t, rpcErr)
+		require.Contains(t, rpcErr.Message, "not available")
+	})
+
+	t.Run("Unknown", func(t *testing.T) {
+		_, rpcErr := plugin.call(t, "boltz-unknown", nil)
+		require.NotNil(t, rpcErr)
+		require.Equal(t, codeUnknownMethod, rpcErr.Code)
+	})
+
+	require.NoError(t, plugin.requests.Close())
+	require.ErrorIs(t, <-plugin.done, ErrStdinClosed)
+}
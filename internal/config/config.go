@@ -18,6 +18,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/internal/cln"
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
 	"github.com/BoltzExchange/boltz-client/v2/internal/lnd"
+	"github.com/BoltzExchange/boltz-client/v2/internal/phoenixd"
 	"github.com/BoltzExchange/boltz-client/v2/internal/utils"
 )
 
@@ -52,7 +53,19 @@ type RpcOptions struct {
 }
 
 type LightningOptions struct {
-	RoutingFeeLimitPpm uint64 `long:"lightning.routing-fee-limit-ppm" description:"Default fee limit in ppm for lightning payments. Can be overridden on a per-swap basis."`
+	RoutingFeeLimitPpm        uint64 `long:"lightning.routing-fee-limit-ppm" description:"Default fee limit in ppm for lightning payments. Can be overridden on a per-swap basis."`
+	RoutingFeeLimitCeilingPpm uint64 `long:"lightning.routing-fee-limit-ceiling-ppm" description:"Fee limit in ppm which failed reverse swap payments are escalated to. Defaults to the routing fee limit. Can be overridden on a per-swap basis."`
+	PaymentAttempts           uint   `long:"lightning.payment-attempts" description:"Number of attempts to pay the invoice of a reverse swap. Defaults to 1, or 3 if a routing fee limit ceiling is set."`
+	MaxParts                  uint32 `long:"lightning.max-parts" description:"Maximal number of parts lightning payments are split into. Only supported by LND. Can be overridden on a per-swap basis."`
+}
+
+// NodeOptions configures an additional lightning node which swaps and autoswap can be bound to by its name.
+// Exactly one of LND, Cln and Phoenixd has to be set
+type NodeOptions struct {
+	Name     string
+	LND      *lnd.LND
+	Cln      *cln.Cln
+	Phoenixd *phoenixd.Phoenixd
 }
 
 type Config struct {
@@ -69,11 +82,15 @@ type Config struct {
 
 	Network string `long:"network" description:"Network to use (mainnet, testnet, regtest)"`
 
-	Boltz *boltzOptions `group:"Boltz Options"`
-	LND   *lnd.LND      `group:"LND Options"`
-	Cln   *cln.Cln      `group:"Cln Options"`
+	Boltz    *boltzOptions      `group:"Boltz Options"`
+	LND      *lnd.LND           `group:"LND Options"`
+	Cln      *cln.Cln           `group:"Cln Options"`
+	Phoenixd *phoenixd.Phoenixd `group:"Phoenixd Options"`
+
+	Node string `long:"node" description:"Lightning node to use (cln, lnd or phoenixd)"`
 
-	Node string `long:"node" description:"Lightning node to use (cln or lnd)"`
+	// Nodes can only be set in the config file
+	Nodes []*NodeOptions `no-flag:"true"`
 
 	Standalone bool `long:"standalone" description:"Run boltz-client without a lightning node"`
 
@@ -94,9 +111,11 @@ type Config struct {
 	Pro        bool   `long:"pro" description:"Use the Boltz Pro API"`
 	ReferralId string `long:"referral-id" description:"Custom referral ID to use when creating swaps"`
 
-	MaxZeroConfAmount        *uint64 `long:"max-zeroconf-amount" description:"Maximum amount of sats to accept 0-conf"`
-	AutoConsolidateThreshold *uint64 `long:"auto-consolidate-threshold" description:"Number of UTXOs that trigger auto consolidation. Set to 0 to disable"`
-	WalletMergeThreshold     *uint32 `long:"wallet-merge-threshold" description:"Threshold used to merge persisted Liquid wallet updates. Set to 0 to disable"`
+	MaxZeroConfAmount           *uint64  `long:"max-zeroconf-amount" description:"Maximum amount of sats to accept 0-conf"`
+	AutoConsolidateThreshold    *uint64  `long:"auto-consolidate-threshold" description:"Number of UTXOs that trigger auto consolidation. Set to 0 to disable"`
+	AutoConsolidateBtcThreshold *uint64  `long:"auto-consolidate-btc-threshold" description:"Number of confirmed UTXOs that trigger auto consolidation of BTC wallets, of which the smallest are consolidated. Has to be at least 2. Disabled if not set"`
+	AutoConsolidateMaxFeeRate   *float64 `long:"auto-consolidate-max-fee-rate" description:"Maximum fee rate in sat/vbyte at which BTC wallets are auto consolidated"`
+	WalletMergeThreshold        *uint32  `long:"wallet-merge-threshold" description:"Threshold used to merge persisted Liquid wallet updates. Set to 0 to disable"`
 
 	Help *helpOptions `group:"Help Options"`
 }
@@ -143,6 +162,11 @@ func LoadConfig(dataDir string) (*Config, error) {
 			ServerName: "cln",
 		},
 
+		Phoenixd: &phoenixd.Phoenixd{
+			Host: "127.0.0.1",
+			Port: 9740,
+		},
+
 		Lightning: &LightningOptions{
 			RoutingFeeLimitPpm: 2500,
 		},
@@ -212,39 +236,35 @@ func LoadConfig(dataDir string) (*Config, error) {
 		cfg.Cln.DataDir = "~/.lightning"
 	} else if strings.EqualFold(cfg.Node, "LND") && cfg.LND.DataDir == "" {
 		cfg.LND.DataDir = "~/.lnd"
+	} else if strings.EqualFold(cfg.Node, "phoenixd") && cfg.Phoenixd.DataDir == "" {
+		cfg.Phoenixd.DataDir = "~/.phoenix"
 	}
 
-	cfg.LND.Macaroon = utils.ExpandHomeDir(cfg.LND.Macaroon)
-	cfg.LND.Certificate = utils.ExpandHomeDir(cfg.LND.Certificate)
-
-	if cfg.LND.DataDir != "" {
-		cfg.LND.DataDir = utils.ExpandHomeDir(cfg.LND.DataDir)
-		if cfg.Network == "" {
-			return nil, fmt.Errorf("network must be set when lnd datadir is configured")
-		}
-
-		defaultMacaroon := fmt.Sprintf("./data/chain/bitcoin/%s/admin.macaroon", cfg.Network)
-		cfg.LND.Macaroon = utils.ExpandDefaultPath(cfg.LND.DataDir, cfg.LND.Macaroon, defaultMacaroon)
-		cfg.LND.Certificate = utils.ExpandDefaultPath(cfg.LND.DataDir, cfg.LND.Certificate, "tls.cert")
+	if err := expandLnd(cfg.LND, cfg.Network); err != nil {
+		return nil, err
 	}
-
-	cfg.Cln.RootCert = utils.ExpandHomeDir(cfg.Cln.RootCert)
-	cfg.Cln.PrivateKey = utils.ExpandHomeDir(cfg.Cln.PrivateKey)
-	cfg.Cln.CertChain = utils.ExpandHomeDir(cfg.Cln.CertChain)
-
-	if cfg.Cln.DataDir != "" {
-		cfg.Cln.DataDir = utils.ExpandHomeDir(cfg.Cln.DataDir)
-		if cfg.Network == "" {
-			return nil, fmt.Errorf("network must be set when cln datadir is configured")
+	if err := expandCln(cfg.Cln, cfg.Network); err != nil {
+		return nil, err
+	}
+	if err := expandPhoenixd(cfg.Phoenixd); err != nil {
+		return nil, err
+	}
+	for _, node := range cfg.Nodes {
+		if node.LND != nil {
+			if err := expandLnd(node.LND, cfg.Network); err != nil {
+				return nil, fmt.Errorf("node %s: %w", node.Name, err)
+			}
 		}
-		if cfg.Network == "mainnet" {
-			cfg.Cln.DataDir += "/bitcoin"
-		} else {
-			cfg.Cln.DataDir += "/" + cfg.Network
+		if node.Cln != nil {
+			if err := expandCln(node.Cln, cfg.Network); err != nil {
+				return nil, fmt.Errorf("node %s: %w", node.Name, err)
+			}
+		}
+		if node.Phoenixd != nil {
+			if err := expandPhoenixd(node.Phoenixd); err != nil {
+				return nil, fmt.Errorf("node %s: %w", node.Name, err)
+			}
 		}
-		cfg.Cln.RootCert = utils.ExpandDefaultPath(cfg.Cln.DataDir, cfg.Cln.RootCert, "ca.pem")
-		cfg.Cln.PrivateKey = utils.ExpandDefaultPath(cfg.Cln.DataDir, cfg.Cln.PrivateKey, "client-key.pem")
-		cfg.Cln.CertChain = utils.ExpandDefaultPath(cfg.Cln.DataDir, cfg.Cln.CertChain, "client.pem")
 	}
 
 	cfg.LogFile = utils.ExpandDefaultPath(cfg.DataDir, cfg.LogFile, "boltz.log")
@@ -276,6 +296,58 @@ func LoadConfig(dataDir string) (*Config, error) {
 	return &cfg, nil
 }
 
+func expandLnd(lnd *lnd.LND, network string) error {
+	lnd.Macaroon = utils.ExpandHomeDir(lnd.Macaroon)
+	lnd.Certificate = utils.ExpandHomeDir(lnd.Certificate)
+
+	if lnd.DataDir != "" {
+		lnd.DataDir = utils.ExpandHomeDir(lnd.DataDir)
+		if network == "" {
+			return fmt.Errorf("network must be set when lnd datadir is configured")
+		}
+
+		defaultMacaroon := fmt.Sprintf("./data/chain/bitcoin/%s/admin.macaroon", network)
+		lnd.Macaroon = utils.ExpandDefaultPath(lnd.DataDir, lnd.Macaroon, defaultMacaroon)
+		lnd.Certificate = utils.ExpandDefaultPath(lnd.DataDir, lnd.Certificate, "tls.cert")
+	}
+	return nil
+}
+
+func expandCln(cln *cln.Cln, network string) error {
+	cln.RootCert = utils.ExpandHomeDir(cln.RootCert)
+	cln.PrivateKey = utils.ExpandHomeDir(cln.PrivateKey)
+	cln.CertChain = utils.ExpandHomeDir(cln.CertChain)
+	cln.RpcFile = utils.ExpandHomeDir(cln.RpcFile)
+
+	if cln.DataDir != "" {
+		cln.DataDir = utils.ExpandHomeDir(cln.DataDir)
+		if network == "" {
+			return fmt.Errorf("network must be set when cln datadir is configured")
+		}
+		if network == "mainnet" {
+			cln.DataDir += "/bitcoin"
+		} else {
+			cln.DataDir += "/" + network
+		}
+		cln.RootCert = utils.ExpandDefaultPath(cln.DataDir, cln.RootCert, "ca.pem")
+		cln.PrivateKey = utils.ExpandDefaultPath(cln.DataDir, cln.PrivateKey, "client-key.pem")
+		cln.CertChain = utils.ExpandDefaultPath(cln.DataDir, cln.CertChain, "client.pem")
+	}
+	return nil
+}
+
+func expandPhoenixd(phoenixd *phoenixd.Phoenixd) error {
+	if phoenixd.DataDir != "" && phoenixd.Password == "" {
+		phoenixd.DataDir = utils.ExpandHomeDir(phoenixd.DataDir)
+		password, err := phoenixd.ReadPassword()
+		if err != nil {
+			return err
+		}
+		phoenixd.Password = password
+	}
+	return nil
+}
+
 func createDirIfNotExists(dir string) {
 	if !utils.FileExists(dir) {
 		err := os.Mkdir(dir, 0700)
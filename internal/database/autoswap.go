@@ -0,0 +1,358 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+// AutoSwapEvent records a single evaluation cycle of an autoswapper.
+type AutoSwapEvent struct {
+	Id        Id
+	Swapper   string
+	TenantId  Id
+	CreatedAt time.Time
+	// Error is set if the cycle could not be completed
+	Error string
+	// Swaps contains the swaps which were recommended during the cycle
+	Swaps []*AutoSwapEventSwap
+	// Rule is the name of the evaluated chain rule
+	Rule string
+}
+
+// AutoSwapEventSwap is a swap recommendation of an evaluation cycle and its outcome.
+type AutoSwapEventSwap struct {
+	Type        boltz.SwapType    `json:"type"`
+	Amount      uint64            `json:"amount"`
+	FeeEstimate uint64            `json:"feeEstimate"`
+	ChannelId   *lightning.ChanId `json:"channelId,omitempty"`
+	// Currency is the onchain currency of a lightning swap or the currency which is sent by a chain swap
+	Currency         boltz.Currency `json:"currency,omitempty"`
+	DismissedReasons []string       `json:"dismissedReasons,omitempty"`
+	// SwapId is only set if the recommendation was executed, it is the id of the transaction for direct sends
+	SwapId string `json:"swapId,omitempty"`
+}
+
+type AutoSwapEventQuery struct {
+	TenantId *Id
+	Swapper  *string
+	Since    time.Time
+	// ExcludeEmpty skips cycles which neither recommended a swap nor failed
+	ExcludeEmpty bool
+	Limit        *uint64
+	Offset       *uint64
+}
+
+func parseAutoSwapEvent(r row) (*AutoSwapEvent, error) {
+	event := &AutoSwapEvent{}
+	var createdAt int64
+	swaps := JsonScanner[[]*AutoSwapEventSwap]{Nullable: true}
+	if err := r.Scan(&event.Id, &event.Swapper, &event.TenantId, &createdAt, &event.Error, &swaps, &event.Rule); err != nil {
+		return nil, err
+	}
+	event.CreatedAt = parseTime(createdAt)
+	event.Swaps = swaps.Value
+	return event, nil
+}
+
+func (d *Database) CreateAutoSwapEvent(event *AutoSwapEvent) error {
+	var swaps any
+	if len(event.Swaps) > 0 {
+		swaps = formatJson(event.Swaps)
+	}
+	query := "INSERT INTO autoSwapEvents (swapper, tenantId, createdAt, error, swaps, rule) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := d.Exec(query, event.Swapper, event.TenantId, FormatTime(event.CreatedAt), event.Error, swaps, event.Rule)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	event.Id = Id(id)
+	return nil
+}
+
+// QueryAutoSwapEvents returns the matching events, most recent first.
+func (d *Database) QueryAutoSwapEvents(query AutoSwapEventQuery) ([]*AutoSwapEvent, error) {
+	var conditions []string
+	var values []any
+	if query.TenantId != nil {
+		conditions = append(conditions, "tenantId = ?")
+		values = append(values, *query.TenantId)
+	}
+	if query.Swapper != nil {
+		conditions = append(conditions, "swapper = ?")
+		values = append(values, *query.Swapper)
+	}
+	if !query.Since.IsZero() {
+		conditions = append(conditions, "createdAt >= ?")
+		values = append(values, FormatTime(query.Since))
+	}
+	if query.ExcludeEmpty {
+		conditions = append(conditions, "(swaps IS NOT NULL OR error != '')")
+	}
+	statement := "SELECT * FROM autoSwapEvents"
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY createdAt DESC, id DESC"
+	if query.Limit != nil || query.Offset != nil {
+		// sqlite only accepts an offset in combination with a limit
+		limit := int64(-1)
+		if query.Limit != nil {
+			limit = int64(*query.Limit)
+		}
+		statement += " LIMIT ?"
+		values = append(values, limit)
+	}
+	if query.Offset != nil {
+		statement += " OFFSET ?"
+		values = append(values, *query.Offset)
+	}
+
+	rows, err := d.Query(statement, values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query autoswap events: %w", err)
+	}
+	defer closeRows(rows)
+	var events []*AutoSwapEvent
+	for rows.Next() {
+		event, err := parseAutoSwapEvent(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse autoswap event: %w", err)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// DeleteAutoSwapEvents removes the events of a tenant and swapper which were recorded before the given time.
+func (d *Database) DeleteAutoSwapEvents(tenantId Id, swapper string, before time.Time) error {
+	_, err := d.Exec(
+		"DELETE FROM autoSwapEvents WHERE tenantId = ? AND swapper = ? AND createdAt < ?",
+		tenantId, swapper, FormatTime(before),
+	)
+	return err
+}
+
+type AutoSwapApprovalState string
+
+const (
+	ApprovalPending  AutoSwapApprovalState = "pending"
+	ApprovalApproved AutoSwapApprovalState = "approved"
+	ApprovalRejected AutoSwapApprovalState = "rejected"
+)
+
+// AutoSwapApproval is a recommendation which exceeded the approval threshold of an autoswapper.
+type AutoSwapApproval struct {
+	Id          Id
+	Swapper     string
+	TenantId    Id
+	Type        boltz.SwapType
+	Amount      uint64
+	FeeEstimate uint64
+	ChannelId   *lightning.ChanId
+	State       AutoSwapApprovalState
+	// SwapId is set once the approved recommendation was executed
+	SwapId    string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	// Currency is the onchain currency of a lightning swap or the currency which is sent by a chain swap
+	Currency boltz.Currency
+	// Rule is the name of the chain rule which made the recommendation
+	Rule string
+}
+
+type AutoSwapApprovalQuery struct {
+	TenantId *Id
+	Swapper  *string
+	Rule     *string
+	States   []AutoSwapApprovalState
+	// ExpiresAfter skips approvals which expired before the given time
+	ExpiresAfter time.Time
+}
+
+func parseAutoSwapApproval(r row) (*AutoSwapApproval, error) {
+	approval := &AutoSwapApproval{}
+	var createdAt, expiresAt int64
+	var channelId sql.NullInt64
+	err := r.Scan(
+		&approval.Id,
+		&approval.Swapper,
+		&approval.TenantId,
+		&approval.Type,
+		&approval.Amount,
+		&approval.FeeEstimate,
+		&channelId,
+		&approval.State,
+		&approval.SwapId,
+		&createdAt,
+		&expiresAt,
+		&approval.Currency,
+		&approval.Rule,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if channelId.Valid {
+		chanId := lightning.ChanId(channelId.Int64)
+		approval.ChannelId = &chanId
+	}
+	approval.CreatedAt = parseTime(createdAt)
+	approval.ExpiresAt = parseTime(expiresAt)
+	return approval, nil
+}
+
+func (d *Database) CreateAutoSwapApproval(approval *AutoSwapApproval) error {
+	query := `INSERT INTO autoSwapApprovals (swapper, tenantId, type, amount, feeEstimate, channelId, state, swapId, createdAt, expiresAt, currency, rule)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := d.Exec(
+		query,
+		approval.Swapper,
+		approval.TenantId,
+		approval.Type,
+		approval.Amount,
+		approval.FeeEstimate,
+		approval.ChannelId,
+		approval.State,
+		approval.SwapId,
+		FormatTime(approval.CreatedAt),
+		FormatTime(approval.ExpiresAt),
+		approval.Currency,
+		approval.Rule,
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	approval.Id = Id(id)
+	return nil
+}
+
+// GetAutoSwapApproval returns nil if there is no approval with the given id.
+func (d *Database) GetAutoSwapApproval(id Id) (*AutoSwapApproval, error) {
+	approval, err := parseAutoSwapApproval(d.QueryRow("SELECT * FROM autoSwapApprovals WHERE id = ?", id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse autoswap approval: %w", err)
+	}
+	return approval, nil
+}
+
+func (d *Database) QueryAutoSwapApprovals(query AutoSwapApprovalQuery) ([]*AutoSwapApproval, error) {
+	var conditions []string
+	var values []any
+	if query.TenantId != nil {
+		conditions = append(conditions, "tenantId = ?")
+		values = append(values, *query.TenantId)
+	}
+	if query.Swapper != nil {
+		conditions = append(conditions, "swapper = ?")
+		values = append(values, *query.Swapper)
+	}
+	if query.Rule != nil {
+		conditions = append(conditions, "rule = ?")
+		values = append(values, *query.Rule)
+	}
+	if len(query.States) > 0 {
+		placeholders := make([]string, len(query.States))
+		for i, state := range query.States {
+			placeholders[i] = "?"
+			values = append(values, state)
+		}
+		conditions = append(conditions, "state IN ("+strings.Join(placeholders, ",")+")")
+	}
+	if !query.ExpiresAfter.IsZero() {
+		conditions = append(conditions, "expiresAt > ?")
+		values = append(values, FormatTime(query.ExpiresAfter))
+	}
+	statement := "SELECT * FROM autoSwapApprovals"
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY createdAt DESC, id DESC"
+
+	rows, err := d.Query(statement, values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query autoswap approvals: %w", err)
+	}
+	defer closeRows(rows)
+	var approvals []*AutoSwapApproval
+	for rows.Next() {
+		approval, err := parseAutoSwapApproval(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse autoswap approval: %w", err)
+		}
+		approvals = append(approvals, approval)
+	}
+	return approvals, nil
+}
+
+func (d *Database) SetAutoSwapApprovalState(id Id, state AutoSwapApprovalState, swapId string) error {
+	_, err := d.Exec("UPDATE autoSwapApprovals SET state = ?, swapId = ? WHERE id = ?", state, swapId, id)
+	return err
+}
+
+// AutoSwapSend is a wallet transaction which an autoswapper sent instead of creating a swap.
+type AutoSwapSend struct {
+	TxId     string
+	Swapper  string
+	TenantId Id
+	// Rule is the name of the chain rule or lightning node the transaction was sent for
+	Rule      string
+	Currency  boltz.Currency
+	Amount    uint64
+	Fee       uint64
+	CreatedAt time.Time
+}
+
+type AutoSwapSendQuery struct {
+	TenantId Id
+	Swapper  string
+	Rule     string
+	Currency *boltz.Currency
+	Since    time.Time
+}
+
+func (d *Database) CreateAutoSwapSend(send *AutoSwapSend) error {
+	query := `INSERT INTO autoSwapSends (txId, swapper, tenantId, rule, currency, amount, fee, createdAt)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := d.Exec(
+		query,
+		send.TxId,
+		send.Swapper,
+		send.TenantId,
+		send.Rule,
+		send.Currency,
+		send.Amount,
+		send.Fee,
+		FormatTime(send.CreatedAt),
+	)
+	return err
+}
+
+// QueryAutoSwapSendFees returns the sum of the fees of the matching transactions.
+func (d *Database) QueryAutoSwapSendFees(query AutoSwapSendQuery) (uint64, error) {
+	statement := "SELECT COALESCE(SUM(fee), 0) FROM autoSwapSends WHERE tenantId = ? AND swapper = ? AND rule = ? AND createdAt >= ?"
+	values := []any{query.TenantId, query.Swapper, query.Rule, FormatTime(query.Since)}
+	if query.Currency != nil {
+		statement += " AND currency = ?"
+		values = append(values, *query.Currency)
+	}
+	var fees uint64
+	if err := d.QueryRow(statement, values...).Scan(&fees); err != nil {
+		return 0, fmt.Errorf("failed to query autoswap send fees: %w", err)
+	}
+	return fees, nil
+}
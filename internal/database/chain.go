@@ -25,8 +25,12 @@ type ChainSwap struct {
 	OnchainFee        *uint64
 	CreatedAt         time.Time
 	TenantId          Id
-	FromData          *ChainSwapData
-	ToData            *ChainSwapData
+	// AutoSwapRule is the name of the chain autoswap rule which created the swap
+	AutoSwapRule string
+	// LightningNode is set if the swap funds a channel opening of the lightning autoswapper of that node
+	LightningNode *string
+	FromData      *ChainSwapData
+	ToData        *ChainSwapData
 }
 
 type ChainSwapData struct {
@@ -61,6 +65,8 @@ type ChainSwapSerialized struct {
 	OnchainFee        *uint64
 	CreatedAt         int64
 	TenantId          Id
+	AutoSwapRule      string
+	LightningNode     *string
 }
 
 type ChainSwapDataSerialized struct {
@@ -95,6 +101,8 @@ func (swap *ChainSwap) Serialize() ChainSwapSerialized {
 		OnchainFee:        swap.OnchainFee,
 		CreatedAt:         FormatTime(swap.CreatedAt),
 		TenantId:          swap.TenantId,
+		AutoSwapRule:      swap.AutoSwapRule,
+		LightningNode:     swap.LightningNode,
 	}
 }
 
@@ -118,8 +126,8 @@ func (swapData *ChainSwapData) Serialize() ChainSwapDataSerialized {
 
 const insertChainSwap = `
 		INSERT INTO chainSwaps
-		(id, fromCurrency, toCurrency, state, error, status, acceptZeroConf, preimage, isAuto, serviceFee, serviceFeePercent, onchainFee, createdAt, tenantId, createdAt)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		(id, fromCurrency, toCurrency, state, error, status, acceptZeroConf, preimage, isAuto, serviceFee, serviceFeePercent, onchainFee, createdAt, tenantId, createdAt, autoSwapRule, lightningNode)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 func (database *Database) CreateChainSwap(swap ChainSwap) error {
@@ -145,6 +153,8 @@ func (database *Database) CreateChainSwap(swap ChainSwap) error {
 		serialized.CreatedAt,
 		serialized.TenantId,
 		FormatTime(swap.CreatedAt),
+		serialized.AutoSwapRule,
+		serialized.LightningNode,
 	)
 	if err != nil {
 		return tx.Rollback(err)
@@ -362,6 +372,8 @@ func (database *Database) parseChainSwap(rows *sql.Rows) (*ChainSwap, error) {
 			"onchainFee":        &onchainFee,
 			"createdAt":         &createdAt,
 			"tenantId":          &swap.TenantId,
+			"autoSwapRule":      &swap.AutoSwapRule,
+			"lightningNode":     &swap.LightningNode,
 		},
 	)
 
@@ -123,7 +123,9 @@ CREATE TABLE swaps
     onchainFee          INT,
     createdAt           INT,
     walletId            INT REFERENCES wallets (id) ON DELETE SET NULL,
-    tenantId            INT REFERENCES tenants (id)
+    tenantId            INT REFERENCES tenants (id),
+    lightningNode       VARCHAR DEFAULT '',
+    routeHints          BOOLEAN DEFAULT 0
 );
 
 CREATE TABLE chainSwaps
@@ -141,7 +143,9 @@ CREATE TABLE chainSwaps
     serviceFeePercent REAL,
     onchainFee        INT,
     createdAt         INT,
-    tenantId          INT REFERENCES tenants (id)
+    tenantId          INT REFERENCES tenants (id),
+    autoSwapRule      VARCHAR DEFAULT '',
+    lightningNode     VARCHAR
 );
 
 CREATE TABLE chainSwapsData
@@ -196,16 +200,10 @@ CREATE TABLE reverseSwaps
     externalPay         BOOLEAN,
     walletId            INT REFERENCES wallets (id) ON DELETE SET NULL,
     tenantId            INT REFERENCES tenants (id),
-    routingFeeLimitPpm  INT
-);
-CREATE TABLE autobudget
-(
-    startDate INTEGER NOT NULL,
-    endDate   INTEGER NOT NULL,
-    name      VARCHAR NOT NULL,
-    tenantId  INT REFERENCES tenants (id),
-
-    PRIMARY KEY (startDate, name, tenantId)
+    routingFeeLimitPpm  INT,
+    lightningNode       VARCHAR DEFAULT '',
+    routingFeeLimitCeilingPpm INT,
+    maxParts            INT
 );
 CREATE TABLE wallets
 (
@@ -236,6 +234,117 @@ CREATE TABLE swapMnemonic
     mnemonic     VARCHAR PRIMARY KEY,
     lastKeyIndex INT DEFAULT 0
 );
+CREATE TABLE spendingPolicies
+(
+    tenantId         INT NOT NULL REFERENCES tenants (id),
+    walletId         INT NOT NULL DEFAULT 0,
+    dailyLimit       INT,
+    weeklyLimit      INT,
+    maxAmount        INT,
+    requireAllowlist BOOLEAN DEFAULT FALSE,
+    addressCooldown  INT DEFAULT 0,
+
+    PRIMARY KEY (tenantId, walletId)
+);
+CREATE TABLE allowedAddresses
+(
+    tenantId  INT NOT NULL REFERENCES tenants (id),
+    address   VARCHAR NOT NULL,
+    label     VARCHAR DEFAULT '',
+    createdAt INT,
+
+    PRIMARY KEY (tenantId, address)
+);
+CREATE TABLE spendings
+(
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    tenantId  INT NOT NULL REFERENCES tenants (id),
+    walletId  INT,
+    currency  VARCHAR,
+    amount    INT,
+    address   VARCHAR,
+    reference VARCHAR,
+    createdAt INT
+);
+CREATE INDEX spendingsTenantCreatedAt ON spendings (tenantId, createdAt);
+CREATE TABLE autoSwapEvents
+(
+    id        INTEGER PRIMARY KEY AUTOINCREMENT,
+    swapper   VARCHAR NOT NULL,
+    tenantId  INT NOT NULL REFERENCES tenants (id),
+    createdAt INT,
+    error     VARCHAR DEFAULT '',
+    swaps     JSON,
+    rule      VARCHAR DEFAULT ''
+);
+CREATE INDEX autoSwapEventsTenantCreatedAt ON autoSwapEvents (tenantId, swapper, createdAt);
+CREATE TABLE autoSwapApprovals
+(
+    id           INTEGER PRIMARY KEY AUTOINCREMENT,
+    swapper      VARCHAR NOT NULL,
+    tenantId     INT NOT NULL REFERENCES tenants (id),
+    type         VARCHAR NOT NULL,
+    amount       INT,
+    feeEstimate  INT,
+    channelId    INT,
+    state        VARCHAR NOT NULL,
+    swapId       VARCHAR DEFAULT '',
+    createdAt    INT,
+    expiresAt    INT,
+    currency     VARCHAR DEFAULT '',
+    rule         VARCHAR DEFAULT ''
+);
+CREATE TABLE paymentAttempts
+(
+    id            INTEGER PRIMARY KEY AUTOINCREMENT,
+    swapId        VARCHAR NOT NULL,
+    attempt       INT NOT NULL,
+    feeLimit      INT,
+    maxParts      INT,
+    state         VARCHAR NOT NULL,
+    feeMsat       INT,
+    error         VARCHAR DEFAULT '',
+    createdAt     INT,
+    incoming      BOOLEAN DEFAULT 0,
+    amount        INT DEFAULT 0,
+    failureReason VARCHAR DEFAULT '',
+    hops          VARCHAR DEFAULT ''
+);
+CREATE INDEX paymentAttemptsSwapId ON paymentAttempts (swapId);
+CREATE TABLE autoSwapSends
+(
+    txId      VARCHAR PRIMARY KEY,
+    swapper   VARCHAR NOT NULL,
+    tenantId  INT NOT NULL REFERENCES tenants (id),
+    rule      VARCHAR DEFAULT '',
+    currency  VARCHAR NOT NULL,
+    amount    INT,
+    fee       INT,
+    createdAt INT
+);
+CREATE TABLE offers
+(
+    id            VARCHAR PRIMARY KEY,
+    offer         VARCHAR NOT NULL,
+    tenantId      INT NOT NULL REFERENCES tenants (id),
+    lightningNode VARCHAR DEFAULT '',
+    walletId      INT NOT NULL,
+    currency      VARCHAR NOT NULL,
+    description   VARCHAR DEFAULT '',
+    amount        INT DEFAULT 0,
+    active        BOOLEAN DEFAULT TRUE,
+    createdAt     INT
+);
+CREATE TABLE offerPayments
+(
+    paymentHash VARCHAR PRIMARY KEY,
+    offerId     VARCHAR NOT NULL REFERENCES offers (id),
+    amount      INT,
+    swapId      VARCHAR DEFAULT '',
+    error       VARCHAR DEFAULT '',
+    createdAt   INT,
+    attempts    INT DEFAULT 1
+);
 ` + createViews
 
 type Database struct {
@@ -346,6 +455,11 @@ type SwapQuery struct {
 	Limit    *uint64
 	Offset   *uint64
 	Ids      []string
+	// AutoSwapRule only matches chain swaps which were created by the chain autoswap rule with the given name
+	AutoSwapRule *string
+	// LightningNode only matches submarine and reverse swaps which are bound to the lightning node with the given name
+	// and chain swaps which fund channel openings of that node
+	LightningNode *string
 }
 
 var PendingSwapQuery = SwapQuery{
@@ -397,6 +511,14 @@ func (query *SwapQuery) ToWhereClauseWithExisting(conditions []string, values []
 		}
 		conditions = append(conditions, "id IN ("+strings.Join(placeholders, ",")+")")
 	}
+	if query.AutoSwapRule != nil {
+		conditions = append(conditions, "id IN (SELECT id FROM chainSwaps WHERE autoSwapRule = ? AND lightningNode IS NULL)")
+		values = append(values, *query.AutoSwapRule)
+	}
+	if query.LightningNode != nil {
+		conditions = append(conditions, "id IN (SELECT id FROM swaps WHERE lightningNode = ? UNION SELECT id FROM reverseSwaps WHERE lightningNode = ? UNION SELECT id FROM chainSwaps WHERE lightningNode = ?)")
+		values = append(values, *query.LightningNode, *query.LightningNode, *query.LightningNode)
+	}
 	var where string
 	if len(conditions) > 0 {
 		where = " WHERE " + strings.Join(conditions, " AND ")
@@ -471,6 +593,13 @@ func (database *Database) Connect() error {
 	return nil
 }
 
+func (database *Database) Close() error {
+	if database.db == nil {
+		return nil
+	}
+	return database.db.Close()
+}
+
 func (database *Database) Exec(query string, args ...any) (sql.Result, error) {
 	database.lock.Lock()
 	defer database.lock.Unlock()
@@ -21,7 +21,7 @@ type swapStatus struct {
 	status string
 }
 
-const latestSchemaVersion = 19
+const latestSchemaVersion = 34
 
 func (database *Database) migrate() error {
 	version, err := database.queryVersion()
@@ -658,6 +658,230 @@ func (database *Database) performMigration(tx *Transaction, oldVersion int) erro
 		if _, err := tx.Exec(migration); err != nil {
 			return err
 		}
+	case 19:
+		logMigration(oldVersion)
+
+		migration := `
+		CREATE TABLE spendingPolicies
+		(
+			tenantId         INT NOT NULL REFERENCES tenants (id),
+			walletId         INT NOT NULL DEFAULT 0,
+			dailyLimit       INT,
+			weeklyLimit      INT,
+			maxAmount        INT,
+			requireAllowlist BOOLEAN DEFAULT FALSE,
+			addressCooldown  INT DEFAULT 0,
+
+			PRIMARY KEY (tenantId, walletId)
+		);
+		CREATE TABLE allowedAddresses
+		(
+			tenantId  INT NOT NULL REFERENCES tenants (id),
+			address   VARCHAR NOT NULL,
+			label     VARCHAR DEFAULT '',
+			createdAt INT,
+
+			PRIMARY KEY (tenantId, address)
+		);
+		CREATE TABLE spendings
+		(
+			id        INTEGER PRIMARY KEY AUTOINCREMENT,
+			tenantId  INT NOT NULL REFERENCES tenants (id),
+			walletId  INT,
+			currency  VARCHAR,
+			amount    INT,
+			address   VARCHAR,
+			reference VARCHAR,
+			createdAt INT
+		);
+		CREATE INDEX spendingsTenantCreatedAt ON spendings (tenantId, createdAt);
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 20:
+		logMigration(oldVersion)
+
+		migration := `
+		CREATE TABLE autoSwapEvents
+		(
+			id        INTEGER PRIMARY KEY AUTOINCREMENT,
+			swapper   VARCHAR NOT NULL,
+			tenantId  INT NOT NULL REFERENCES tenants (id),
+			createdAt INT,
+			error     VARCHAR DEFAULT '',
+			swaps     JSON
+		);
+		CREATE INDEX autoSwapEventsTenantCreatedAt ON autoSwapEvents (tenantId, swapper, createdAt);
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 21:
+		logMigration(oldVersion)
+
+		migration := `
+		CREATE TABLE autoSwapApprovals
+		(
+			id          INTEGER PRIMARY KEY AUTOINCREMENT,
+			swapper     VARCHAR NOT NULL,
+			tenantId    INT NOT NULL REFERENCES tenants (id),
+			type        VARCHAR NOT NULL,
+			amount      INT,
+			feeEstimate INT,
+			channelId   INT,
+			state       VARCHAR NOT NULL,
+			swapId      VARCHAR DEFAULT '',
+			createdAt   INT,
+			expiresAt   INT
+		);
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 22:
+		logMigration(oldVersion)
+
+		if _, err := tx.Exec("ALTER TABLE autoSwapApprovals ADD COLUMN fromCurrency VARCHAR DEFAULT ''"); err != nil {
+			return err
+		}
+	case 23:
+		logMigration(oldVersion)
+
+		migration := `
+		ALTER TABLE chainSwaps ADD COLUMN autoSwapRule VARCHAR DEFAULT '';
+		ALTER TABLE autoSwapEvents ADD COLUMN rule VARCHAR DEFAULT '';
+		ALTER TABLE autoSwapApprovals ADD COLUMN rule VARCHAR DEFAULT '';
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 24:
+		logMigration(oldVersion)
+
+		// budgets are evaluated over rolling windows now, so the intervals don't have to be stored anymore
+		if _, err := tx.Exec("DROP TABLE autobudget"); err != nil {
+			return err
+		}
+	case 25:
+		logMigration(oldVersion)
+
+		migration := `
+		ALTER TABLE swaps ADD COLUMN lightningNode VARCHAR DEFAULT '';
+		ALTER TABLE reverseSwaps ADD COLUMN lightningNode VARCHAR DEFAULT '';
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 26:
+		logMigration(oldVersion)
+
+		migration := `
+		CREATE TABLE offers
+		(
+			id            VARCHAR PRIMARY KEY,
+			offer         VARCHAR NOT NULL,
+			tenantId      INT NOT NULL REFERENCES tenants (id),
+			lightningNode VARCHAR DEFAULT '',
+			walletId      INT NOT NULL,
+			currency      VARCHAR NOT NULL,
+			description   VARCHAR DEFAULT '',
+			amount        INT DEFAULT 0,
+			active        BOOLEAN DEFAULT TRUE,
+			createdAt     INT
+		);
+		CREATE TABLE offerPayments
+		(
+			paymentHash VARCHAR PRIMARY KEY,
+			offerId     VARCHAR NOT NULL REFERENCES offers (id),
+			amount      INT,
+			swapId      VARCHAR DEFAULT '',
+			error       VARCHAR DEFAULT '',
+			createdAt   INT
+		);
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 27:
+		logMigration(oldVersion)
+
+		migration := `
+		ALTER TABLE reverseSwaps ADD COLUMN routingFeeLimitCeilingPpm INT;
+		ALTER TABLE reverseSwaps ADD COLUMN maxParts INT;
+		CREATE TABLE paymentAttempts
+		(
+			id        INTEGER PRIMARY KEY AUTOINCREMENT,
+			swapId    VARCHAR NOT NULL,
+			attempt   INT NOT NULL,
+			feeLimit  INT,
+			maxParts  INT,
+			state     VARCHAR NOT NULL,
+			feeMsat   INT,
+			error     VARCHAR DEFAULT '',
+			createdAt INT
+		);
+		CREATE INDEX paymentAttemptsSwapId ON paymentAttempts (swapId);
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 28:
+		logMigration(oldVersion)
+
+		if _, err := tx.Exec("ALTER TABLE swaps ADD COLUMN routeHints BOOLEAN DEFAULT 0"); err != nil {
+			return err
+		}
+	case 29:
+		logMigration(oldVersion)
+
+		migration := `
+		ALTER TABLE paymentAttempts ADD COLUMN incoming BOOLEAN DEFAULT 0;
+		ALTER TABLE paymentAttempts ADD COLUMN amount INT DEFAULT 0;
+		ALTER TABLE paymentAttempts ADD COLUMN failureReason VARCHAR DEFAULT '';
+		ALTER TABLE paymentAttempts ADD COLUMN hops VARCHAR DEFAULT '';
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 30:
+		logMigration(oldVersion)
+
+		// approvals of lightning swaps store their onchain currency too now
+		if _, err := tx.Exec("ALTER TABLE autoSwapApprovals RENAME COLUMN fromCurrency TO currency"); err != nil {
+			return err
+		}
+	case 31:
+		logMigration(oldVersion)
+
+		migration := `
+		CREATE TABLE autoSwapSends
+		(
+			txId      VARCHAR PRIMARY KEY,
+			swapper   VARCHAR NOT NULL,
+			tenantId  INT NOT NULL REFERENCES tenants (id),
+			rule      VARCHAR DEFAULT '',
+			currency  VARCHAR NOT NULL,
+			amount    INT,
+			fee       INT,
+			createdAt INT
+		);
+		`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+	case 32:
+		logMigration(oldVersion)
+
+		if _, err := tx.Exec("ALTER TABLE chainSwaps ADD COLUMN lightningNode VARCHAR"); err != nil {
+			return err
+		}
+	case 33:
+		logMigration(oldVersion)
+
+		if _, err := tx.Exec("ALTER TABLE offerPayments ADD COLUMN attempts INT DEFAULT 1"); err != nil {
+			return err
+		}
 	case latestSchemaVersion:
 		logger.Info("database already at latest schema version: " + strconv.Itoa(latestSchemaVersion))
 		return nil
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+// Offer is a BOLT12 offer of a lightning node whose payments are swapped into a wallet by reverse swaps.
+type Offer struct {
+	// Id is the id of the offer assigned by the lightning node
+	Id            string
+	Offer         string
+	TenantId      Id
+	LightningNode string
+	WalletId      Id
+	// Currency is the currency of the wallet which is the destination of the reverse swaps
+	Currency    boltz.Currency
+	Description string
+	// AmountSat is 0 if the payer can choose the amount
+	AmountSat uint64
+	Active    bool
+	CreatedAt time.Time
+}
+
+// OfferPayment is a payment to an offer and the reverse swap it triggered.
+type OfferPayment struct {
+	PaymentHash []byte
+	OfferId     string
+	AmountSat   uint64
+	// SwapId is empty if no reverse swap could be created, in which case Error is set.
+	// Both are empty while the reverse swap is being created
+	SwapId    string
+	Error     string
+	CreatedAt time.Time
+	// Attempts is the number of times the creation of a reverse swap was attempted
+	Attempts uint64
+}
+
+type OfferQuery struct {
+	TenantId *Id
+	Active   *bool
+}
+
+func parseOffer(r row) (*Offer, error) {
+	offer := &Offer{}
+	var createdAt int64
+	err := r.Scan(
+		&offer.Id,
+		&offer.Offer,
+		&offer.TenantId,
+		&offer.LightningNode,
+		&offer.WalletId,
+		&offer.Currency,
+		&offer.Description,
+		&offer.AmountSat,
+		&offer.Active,
+		&createdAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse offer: %w", err)
+	}
+	offer.CreatedAt = parseTime(createdAt)
+	return offer, nil
+}
+
+func (d *Database) CreateOffer(offer *Offer) error {
+	query := `INSERT INTO offers (id, offer, tenantId, lightningNode, walletId, currency, description, amount, active, createdAt)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := d.Exec(
+		query,
+		offer.Id,
+		offer.Offer,
+		offer.TenantId,
+		offer.LightningNode,
+		offer.WalletId,
+		offer.Currency,
+		offer.Description,
+		offer.AmountSat,
+		offer.Active,
+		FormatTime(offer.CreatedAt),
+	)
+	return err
+}
+
+func (d *Database) GetOffer(id string) (*Offer, error) {
+	return parseOffer(d.QueryRow("SELECT * FROM offers WHERE id = ?", id))
+}
+
+func (d *Database) QueryOffers(query OfferQuery) ([]*Offer, error) {
+	var conditions []string
+	var values []any
+	if query.TenantId != nil {
+		conditions = append(conditions, "tenantId = ?")
+		values = append(values, *query.TenantId)
+	}
+	if query.Active != nil {
+		conditions = append(conditions, "active = ?")
+		values = append(values, *query.Active)
+	}
+	statement := "SELECT * FROM offers"
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY createdAt"
+
+	rows, err := d.Query(statement, values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query offers: %w", err)
+	}
+	defer closeRows(rows)
+	var offers []*Offer
+	for rows.Next() {
+		offer, err := parseOffer(rows)
+		if err != nil {
+			return nil, err
+		}
+		offers = append(offers, offer)
+	}
+	return offers, nil
+}
+
+func (d *Database) SetOfferActive(id string, active bool) error {
+	_, err := d.Exec("UPDATE offers SET active = ? WHERE id = ?", active, id)
+	return err
+}
+
+func parseOfferPayment(r row) (*OfferPayment, error) {
+	payment := &OfferPayment{}
+	var paymentHash string
+	var createdAt int64
+	err := r.Scan(&paymentHash, &payment.OfferId, &payment.AmountSat, &payment.SwapId, &payment.Error, &createdAt, &payment.Attempts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse offer payment: %w", err)
+	}
+	payment.PaymentHash, err = hex.DecodeString(paymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse offer payment hash: %w", err)
+	}
+	payment.CreatedAt = parseTime(createdAt)
+	return payment, nil
+}
+
+func (d *Database) CreateOfferPayment(payment *OfferPayment) error {
+	query := "INSERT INTO offerPayments (paymentHash, offerId, amount, swapId, error, createdAt, attempts) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	_, err := d.Exec(
+		query,
+		hex.EncodeToString(payment.PaymentHash),
+		payment.OfferId,
+		payment.AmountSat,
+		payment.SwapId,
+		payment.Error,
+		FormatTime(payment.CreatedAt),
+		payment.Attempts,
+	)
+	return err
+}
+
+// RetryOfferPayment marks a payment whose reverse swap could not be created as pending again
+// and counts the additional attempt.
+func (d *Database) RetryOfferPayment(paymentHash []byte) error {
+	query := "UPDATE offerPayments SET error = '', attempts = attempts + 1 WHERE paymentHash = ? AND swapId = '' AND error != ''"
+	_, err := d.Exec(query, hex.EncodeToString(paymentHash))
+	return err
+}
+
+// SetOfferPaymentSwap records the outcome of the reverse swap of a pending offer payment.
+func (d *Database) SetOfferPaymentSwap(paymentHash []byte, swapId string, swapError string) error {
+	query := "UPDATE offerPayments SET swapId = ?, error = ? WHERE paymentHash = ?"
+	_, err := d.Exec(query, swapId, swapError, hex.EncodeToString(paymentHash))
+	return err
+}
+
+func (d *Database) GetOfferPayment(paymentHash []byte) (*OfferPayment, error) {
+	return parseOfferPayment(d.QueryRow("SELECT * FROM offerPayments WHERE paymentHash = ?", hex.EncodeToString(paymentHash)))
+}
+
+// QueryOfferPayments returns the payments of an offer, most recent first.
+func (d *Database) QueryOfferPayments(offerId string) ([]*OfferPayment, error) {
+	rows, err := d.Query("SELECT * FROM offerPayments WHERE offerId = ? ORDER BY createdAt DESC", offerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query offer payments: %w", err)
+	}
+	defer closeRows(rows)
+	var payments []*OfferPayment
+	for rows.Next() {
+		payment, err := parseOfferPayment(rows)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, payment)
+	}
+	return payments, nil
+}
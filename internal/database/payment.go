@@ -0,0 +1,172 @@
+package database
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+)
+
+// PaymentAttempt is a single try of the lightning node to pay the invoice of a reverse swap,
+// or the settlement of the invoice of a submarine swap if it is incoming.
+// An attempt can consist of multiple htlcs, of which only the route of one is stored in Hops.
+type PaymentAttempt struct {
+	Id     Id
+	SwapId string
+	// Attempt is the number of the attempt, starting at 1
+	Attempt uint
+	// FeeLimit is the maximal routing fee of the attempt in satoshis
+	FeeLimit uint64
+	MaxParts *uint32
+	State    lightning.PaymentState
+	FeeMsat  *uint64
+	Error    string
+	// CreatedAt is the time at which the attempt was started
+	CreatedAt time.Time
+	// Incoming is set for invoices of the client which were paid by Boltz
+	Incoming  bool
+	AmountSat uint64
+	// FailureReason is the reason the lightning node reported for a failed payment
+	FailureReason string
+	Hops          []lightning.PaymentHop
+}
+
+type PaymentAttemptQuery struct {
+	SwapId   *string
+	TenantId *Id
+	Limit    *uint64
+	Offset   *uint64
+}
+
+func parsePaymentAttempt(r row) (*PaymentAttempt, error) {
+	attempt := &PaymentAttempt{}
+	var maxParts, feeMsat sql.NullInt64
+	var createdAt int64
+	hops := JsonScanner[[]lightning.PaymentHop]{Nullable: true}
+	err := r.Scan(
+		&attempt.Id,
+		&attempt.SwapId,
+		&attempt.Attempt,
+		&attempt.FeeLimit,
+		&maxParts,
+		&attempt.State,
+		&feeMsat,
+		&attempt.Error,
+		&createdAt,
+		&attempt.Incoming,
+		&attempt.AmountSat,
+		&attempt.FailureReason,
+		&hops,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payment attempt: %w", err)
+	}
+	if maxParts.Valid {
+		parts := uint32(maxParts.Int64)
+		attempt.MaxParts = &parts
+	}
+	attempt.FeeMsat = parseNullUint(feeMsat)
+	attempt.CreatedAt = parseTime(createdAt)
+	attempt.Hops = hops.Value
+	return attempt, nil
+}
+
+func formatHops(hops []lightning.PaymentHop) string {
+	if len(hops) == 0 {
+		return ""
+	}
+	return formatJson(hops)
+}
+
+func (d *Database) CreatePaymentAttempt(attempt *PaymentAttempt) error {
+	query := `INSERT INTO paymentAttempts (swapId, attempt, feeLimit, maxParts, state, feeMsat, error, createdAt, incoming, amount, failureReason, hops)
+			  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	result, err := d.Exec(
+		query,
+		attempt.SwapId,
+		attempt.Attempt,
+		attempt.FeeLimit,
+		attempt.MaxParts,
+		attempt.State,
+		attempt.FeeMsat,
+		attempt.Error,
+		FormatTime(attempt.CreatedAt),
+		attempt.Incoming,
+		attempt.AmountSat,
+		attempt.FailureReason,
+		formatHops(attempt.Hops),
+	)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	attempt.Id = Id(id)
+	return nil
+}
+
+// UpdatePaymentAttempt sets the outcome of an attempt
+func (d *Database) UpdatePaymentAttempt(attempt *PaymentAttempt) error {
+	_, err := d.Exec(
+		"UPDATE paymentAttempts SET state = ?, feeMsat = ?, error = ?, failureReason = ?, hops = ? WHERE id = ?",
+		attempt.State, attempt.FeeMsat, attempt.Error, attempt.FailureReason, formatHops(attempt.Hops), attempt.Id,
+	)
+	return err
+}
+
+func (d *Database) queryPaymentAttempts(statement string, values ...any) ([]*PaymentAttempt, error) {
+	rows, err := d.Query(statement, values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query payment attempts: %w", err)
+	}
+	defer closeRows(rows)
+	var attempts []*PaymentAttempt
+	for rows.Next() {
+		attempt, err := parsePaymentAttempt(rows)
+		if err != nil {
+			return nil, err
+		}
+		attempts = append(attempts, attempt)
+	}
+	return attempts, nil
+}
+
+// QueryPaymentAttempts returns the payment attempts of a swap in the order they were made.
+func (d *Database) QueryPaymentAttempts(swapId string) ([]*PaymentAttempt, error) {
+	return d.queryPaymentAttempts("SELECT * FROM paymentAttempts WHERE swapId = ? ORDER BY attempt", swapId)
+}
+
+// QuerySwapPayments returns the payment attempts and invoice settlements of swaps, the latest first.
+func (d *Database) QuerySwapPayments(query PaymentAttemptQuery) ([]*PaymentAttempt, error) {
+	var conditions []string
+	var values []any
+	if query.SwapId != nil {
+		conditions = append(conditions, "swapId = ?")
+		values = append(values, *query.SwapId)
+	}
+	if query.TenantId != nil {
+		conditions = append(conditions, "swapId IN (SELECT id FROM allSwaps WHERE tenantId = ?)")
+		values = append(values, *query.TenantId)
+	}
+	statement := "SELECT * FROM paymentAttempts"
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY createdAt DESC, id DESC"
+	if query.Limit != nil {
+		statement += " LIMIT ?"
+		values = append(values, *query.Limit)
+	}
+	if query.Offset != nil {
+		if query.Limit == nil {
+			statement += " LIMIT -1"
+		}
+		statement += " OFFSET ?"
+		values = append(values, *query.Offset)
+	}
+	return d.queryPaymentAttempts(statement, values...)
+}
@@ -0,0 +1,101 @@
+package database_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapPayments(t *testing.T) {
+	db := database.Database{Path: ":memory:"}
+	require.NoError(t, db.Connect())
+
+	tenant := &database.Tenant{Name: "payments"}
+	require.NoError(t, db.CreateTenant(tenant))
+	require.NoError(t, db.CreateReverseSwap(database.ReverseSwap{Id: "reverse", TenantId: database.DefaultTenantId}))
+	require.NoError(t, db.CreateSwap(database.Swap{Id: "submarine", TenantId: tenant.Id}))
+
+	now := time.Now().Truncate(time.Second)
+	failed := &database.PaymentAttempt{
+		SwapId:    "reverse",
+		Attempt:   1,
+		FeeLimit:  100,
+		State:     lightning.PaymentPending,
+		AmountSat: 10_000,
+		CreatedAt: now.Add(-2 * time.Second),
+	}
+	require.NoError(t, db.CreatePaymentAttempt(failed))
+	failed.State = lightning.PaymentFailed
+	failed.Error = "no route"
+	failed.FailureReason = "FAILURE_REASON_NO_ROUTE"
+	failed.Hops = []lightning.PaymentHop{
+		{ChanId: 1, Pubkey: "02aa", AmountMsat: 10_001_000, FeeMsat: 1000},
+		{ChanId: 2, Pubkey: "03bb", AmountMsat: 10_000_000, Failure: "TEMPORARY_CHANNEL_FAILURE"},
+	}
+	require.NoError(t, db.UpdatePaymentAttempt(failed))
+
+	succeeded := &database.PaymentAttempt{
+		SwapId:    "reverse",
+		Attempt:   2,
+		FeeLimit:  200,
+		State:     lightning.PaymentSucceeded,
+		AmountSat: 10_000,
+		CreatedAt: now.Add(-time.Second),
+	}
+	require.NoError(t, db.CreatePaymentAttempt(succeeded))
+
+	settled := &database.PaymentAttempt{
+		SwapId:    "submarine",
+		Attempt:   1,
+		State:     lightning.PaymentSucceeded,
+		Incoming:  true,
+		AmountSat: 20_000,
+		CreatedAt: now,
+	}
+	require.NoError(t, db.CreatePaymentAttempt(settled))
+
+	attempts, err := db.QueryPaymentAttempts("reverse")
+	require.NoError(t, err)
+	require.Len(t, attempts, 2)
+	require.Equal(t, failed.Hops, attempts[0].Hops)
+	require.Equal(t, "FAILURE_REASON_NO_ROUTE", attempts[0].FailureReason)
+	require.Equal(t, uint64(10_000), attempts[0].AmountSat)
+	require.Empty(t, attempts[1].Hops)
+
+	t.Run("All", func(t *testing.T) {
+		payments, err := db.QuerySwapPayments(database.PaymentAttemptQuery{})
+		require.NoError(t, err)
+		require.Len(t, payments, 3)
+		require.Equal(t, settled.Id, payments[0].Id)
+		require.True(t, payments[0].Incoming)
+	})
+
+	t.Run("Swap", func(t *testing.T) {
+		swapId := "reverse"
+		payments, err := db.QuerySwapPayments(database.PaymentAttemptQuery{SwapId: &swapId})
+		require.NoError(t, err)
+		require.Len(t, payments, 2)
+	})
+
+	t.Run("Tenant", func(t *testing.T) {
+		payments, err := db.QuerySwapPayments(database.PaymentAttemptQuery{TenantId: &tenant.Id})
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		require.Equal(t, "submarine", payments[0].SwapId)
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		limit, offset := uint64(1), uint64(1)
+		payments, err := db.QuerySwapPayments(database.PaymentAttemptQuery{Limit: &limit, Offset: &offset})
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		require.Equal(t, succeeded.Id, payments[0].Id)
+
+		payments, err = db.QuerySwapPayments(database.PaymentAttemptQuery{Offset: &offset})
+		require.NoError(t, err)
+		require.Len(t, payments, 2)
+	})
+}
@@ -0,0 +1,219 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+// SpendingPolicy restricts how funds of a tenant can be spent.
+// If WalletId is set, the policy only applies to spendings from that wallet.
+type SpendingPolicy struct {
+	TenantId         Id
+	WalletId         *Id
+	DailyLimit       *uint64
+	WeeklyLimit      *uint64
+	MaxAmount        *uint64
+	RequireAllowlist bool
+	AddressCooldown  time.Duration
+}
+
+// AllowedAddress is a destination address a tenant registered for outgoing payments.
+type AllowedAddress struct {
+	TenantId  Id
+	Address   string
+	Label     string
+	CreatedAt time.Time
+}
+
+// Spending records funds which left a tenant through a wallet send, a swap or a lightning payment.
+type Spending struct {
+	TenantId  Id
+	WalletId  *Id
+	Currency  boltz.Currency
+	Amount    uint64
+	Address   string
+	Reference string
+	CreatedAt time.Time
+}
+
+// policies without a specific wallet are stored with wallet id 0 so that they are covered by the primary key
+func formatPolicyWalletId(walletId *Id) Id {
+	if walletId == nil {
+		return 0
+	}
+	return *walletId
+}
+
+func parseSpendingPolicy(r row) (*SpendingPolicy, error) {
+	policy := &SpendingPolicy{}
+	var walletId Id
+	var dailyLimit, weeklyLimit, maxAmount sql.NullInt64
+	var cooldown int64
+	err := r.Scan(&policy.TenantId, &walletId, &dailyLimit, &weeklyLimit, &maxAmount, &policy.RequireAllowlist, &cooldown)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse spending policy: %w", err)
+	}
+	if walletId != 0 {
+		policy.WalletId = &walletId
+	}
+	policy.DailyLimit = parseNullUint(dailyLimit)
+	policy.WeeklyLimit = parseNullUint(weeklyLimit)
+	policy.MaxAmount = parseNullUint(maxAmount)
+	policy.AddressCooldown = time.Duration(cooldown) * time.Second
+	return policy, nil
+}
+
+// SetSpendingPolicy creates or replaces the policy for the tenant and wallet of the given policy.
+func (d *Database) SetSpendingPolicy(policy *SpendingPolicy) error {
+	query := `INSERT OR REPLACE INTO spendingPolicies (tenantId, walletId, dailyLimit, weeklyLimit, maxAmount, requireAllowlist, addressCooldown)
+			  VALUES (?, ?, ?, ?, ?, ?, ?)`
+	_, err := d.Exec(
+		query,
+		policy.TenantId,
+		formatPolicyWalletId(policy.WalletId),
+		policy.DailyLimit,
+		policy.WeeklyLimit,
+		policy.MaxAmount,
+		policy.RequireAllowlist,
+		int64(policy.AddressCooldown.Seconds()),
+	)
+	return err
+}
+
+// QuerySpendingPolicies returns the policies of a tenant, or of all tenants if tenantId is nil.
+func (d *Database) QuerySpendingPolicies(tenantId *Id) ([]*SpendingPolicy, error) {
+	query := "SELECT * FROM spendingPolicies"
+	var values []any
+	if tenantId != nil {
+		query += " WHERE tenantId = ?"
+		values = append(values, *tenantId)
+	}
+	rows, err := d.Query(query, values...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query spending policies: %w", err)
+	}
+	defer closeRows(rows)
+	var result []*SpendingPolicy
+	for rows.Next() {
+		policy, err := parseSpendingPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, policy)
+	}
+	return result, nil
+}
+
+// DeleteSpendingPolicy removes the policy of a tenant which applies to the given wallet, or the tenant wide one if walletId is nil.
+func (d *Database) DeleteSpendingPolicy(tenantId Id, walletId *Id) error {
+	result, err := d.Exec("DELETE FROM spendingPolicies WHERE tenantId = ? AND walletId = ?", tenantId, formatPolicyWalletId(walletId))
+	if err != nil {
+		return fmt.Errorf("failed to delete spending policy: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func parseAllowedAddress(r row) (*AllowedAddress, error) {
+	address := &AllowedAddress{}
+	var createdAt int64
+	if err := r.Scan(&address.TenantId, &address.Address, &address.Label, &createdAt); err != nil {
+		return nil, err
+	}
+	address.CreatedAt = parseTime(createdAt)
+	return address, nil
+}
+
+// CreateAllowedAddress adds an address to the allowlist of a tenant.
+func (d *Database) CreateAllowedAddress(address *AllowedAddress) error {
+	query := "INSERT INTO allowedAddresses (tenantId, address, label, createdAt) VALUES (?, ?, ?, ?)"
+	_, err := d.Exec(query, address.TenantId, address.Address, address.Label, FormatTime(address.CreatedAt))
+	return err
+}
+
+// GetAllowedAddress returns nil if the address is not part of the allowlist of the tenant.
+func (d *Database) GetAllowedAddress(tenantId Id, address string) (*AllowedAddress, error) {
+	row := d.QueryRow("SELECT * FROM allowedAddresses WHERE tenantId = ? AND address = ?", tenantId, address)
+	allowed, err := parseAllowedAddress(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to parse allowed address: %w", err)
+	}
+	return allowed, nil
+}
+
+func (d *Database) QueryAllowedAddresses(tenantId Id) ([]*AllowedAddress, error) {
+	rows, err := d.Query("SELECT * FROM allowedAddresses WHERE tenantId = ? ORDER BY createdAt", tenantId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query allowed addresses: %w", err)
+	}
+	defer closeRows(rows)
+	var result []*AllowedAddress
+	for rows.Next() {
+		address, err := parseAllowedAddress(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse allowed address: %w", err)
+		}
+		result = append(result, address)
+	}
+	return result, nil
+}
+
+func (d *Database) DeleteAllowedAddress(tenantId Id, address string) error {
+	result, err := d.Exec("DELETE FROM allowedAddresses WHERE tenantId = ? AND address = ?", tenantId, address)
+	if err != nil {
+		return fmt.Errorf("failed to delete allowed address: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (d *Database) CreateSpending(spending *Spending) error {
+	query := "INSERT INTO spendings (tenantId, walletId, currency, amount, address, reference, createdAt) VALUES (?, ?, ?, ?, ?, ?, ?)"
+	_, err := d.Exec(
+		query,
+		spending.TenantId,
+		spending.WalletId,
+		spending.Currency,
+		spending.Amount,
+		spending.Address,
+		spending.Reference,
+		FormatTime(spending.CreatedAt),
+	)
+	return err
+}
+
+// QuerySpent returns the total amount a tenant spent since the given time, optionally restricted to a single wallet.
+func (d *Database) QuerySpent(tenantId Id, walletId *Id, since time.Time) (uint64, error) {
+	query := "SELECT COALESCE(SUM(amount), 0) FROM spendings WHERE tenantId = ? AND createdAt >= ?"
+	values := []any{tenantId, since.Unix()}
+	if walletId != nil {
+		query += " AND walletId = ?"
+		values = append(values, *walletId)
+	}
+	var spent uint64
+	if err := d.QueryRow(query, values...).Scan(&spent); err != nil {
+		return 0, fmt.Errorf("failed to query spent amount: %w", err)
+	}
+	return spent, nil
+}
+
+// DeleteTenantPolicies removes all policy related data of a tenant.
+func (d *Database) DeleteTenantPolicies(tenantId Id) error {
+	for _, table := range []string{"spendingPolicies", "allowedAddresses", "spendings"} {
+		if _, err := d.Exec("DELETE FROM "+table+" WHERE tenantId = ?", tenantId); err != nil {
+			return fmt.Errorf("failed to delete %s of tenant: %w", table, err)
+		}
+	}
+	return nil
+}
@@ -45,6 +45,12 @@ type ReverseSwap struct {
 	WalletId            *Id
 	TenantId            Id
 	RoutingFeeLimitPpm  *uint64
+	// LightningNode is the name of the node which pays the invoice, empty for the default node
+	LightningNode string
+	// RoutingFeeLimitCeilingPpm is the fee limit of the last payment attempt
+	RoutingFeeLimitCeilingPpm *uint64
+	// MaxParts is the maximum number of parts the payment of the invoice can be split into
+	MaxParts *uint32
 }
 
 type ReverseSwapSerialized struct {
@@ -77,6 +83,10 @@ type ReverseSwapSerialized struct {
 	WalletId            *Id
 	TenantId            Id
 	RoutingFeeLimitPpm  *uint64
+	LightningNode       string
+
+	RoutingFeeLimitCeilingPpm *uint64
+	MaxParts                  *uint32
 }
 
 func (reverseSwap *ReverseSwap) Serialize() ReverseSwapSerialized {
@@ -109,6 +119,10 @@ func (reverseSwap *ReverseSwap) Serialize() ReverseSwapSerialized {
 		WalletId:            reverseSwap.WalletId,
 		TenantId:            reverseSwap.TenantId,
 		RoutingFeeLimitPpm:  reverseSwap.RoutingFeeLimitPpm,
+		LightningNode:       reverseSwap.LightningNode,
+
+		RoutingFeeLimitCeilingPpm: reverseSwap.RoutingFeeLimitCeilingPpm,
+		MaxParts:                  reverseSwap.MaxParts,
 	}
 }
 
@@ -134,7 +148,7 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 	var preimage string
 	var redeemScript string
 	blindingKey := PrivateKeyScanner{Nullable: true}
-	var createdAt, paidAt, serviceFee, onchainFee, routingFeeMsat, routingFeeLimitPpm sql.NullInt64
+	var createdAt, paidAt, serviceFee, onchainFee, routingFeeMsat, routingFeeLimitPpm, routingFeeLimitCeilingPpm, maxParts sql.NullInt64
 	var externalPay sql.NullBool
 	swapTree := JsonScanner[*boltz.SerializedTree]{Nullable: true}
 	refundPubKey := PublicKeyScanner{Nullable: true}
@@ -175,6 +189,10 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 			"tenantId":            &reverseSwap.TenantId,
 			"walletId":            &reverseSwap.WalletId,
 			"routingFeeLimitPpm":  &routingFeeLimitPpm,
+			"lightningNode":       &reverseSwap.LightningNode,
+
+			"routingFeeLimitCeilingPpm": &routingFeeLimitCeilingPpm,
+			"maxParts":                  &maxParts,
 		},
 	)
 
@@ -186,6 +204,11 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 	reverseSwap.OnchainFee = parseNullUint(onchainFee)
 	reverseSwap.RoutingFeeMsat = parseNullUint(routingFeeMsat)
 	reverseSwap.RoutingFeeLimitPpm = parseNullUint(routingFeeLimitPpm)
+	reverseSwap.RoutingFeeLimitCeilingPpm = parseNullUint(routingFeeLimitCeilingPpm)
+	if maxParts.Valid {
+		parts := uint32(maxParts.Int64)
+		reverseSwap.MaxParts = &parts
+	}
 	reverseSwap.Status = boltz.ParseEvent(status)
 	reverseSwap.ChanIds = chanIds.Value
 
@@ -321,8 +344,9 @@ const insertReverseSwapStatement = `
 INSERT INTO reverseSwaps (id, fromCurrency, toCurrency, chanIds, state, error, status, acceptZeroConf, privateKey, preimage, redeemScript,
                           invoice, claimAddress, onchainAmount, invoiceAmount, timeoutBlockheight, lockupTransactionId,
                           claimTransactionId, blindingKey, isAuto, createdAt, routingFeeMsat, serviceFee,
-                          serviceFeePercent, onchainFee, refundPubKey, swapTree, externalPay, tenantId, walletId, routingFeeLimitPpm)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+                          serviceFeePercent, onchainFee, refundPubKey, swapTree, externalPay, tenantId, walletId, routingFeeLimitPpm,
+                          lightningNode, routingFeeLimitCeilingPpm, maxParts)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
@@ -359,6 +383,9 @@ func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
 		reverseSwap.TenantId,
 		reverseSwap.WalletId,
 		reverseSwap.RoutingFeeLimitPpm,
+		reverseSwap.LightningNode,
+		reverseSwap.RoutingFeeLimitCeilingPpm,
+		reverseSwap.MaxParts,
 	)
 	return err
 }
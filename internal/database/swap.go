@@ -40,6 +40,10 @@ type Swap struct {
 	OnchainFee          *uint64
 	WalletId            *Id
 	TenantId            Id
+	// LightningNode is the name of the node the swap is bound to, empty for the default node
+	LightningNode string
+	// RouteHints includes hints for the private channels of the node in the invoice of the swap
+	RouteHints bool
 }
 
 type SwapSerialized struct {
@@ -69,6 +73,8 @@ type SwapSerialized struct {
 	OnchainFee          *uint64
 	WalletId            *Id
 	TenantId            Id
+	LightningNode       string
+	RouteHints          bool
 }
 
 func (swap *Swap) BlindingPubKey() *btcec.PublicKey {
@@ -111,6 +117,8 @@ func (swap *Swap) Serialize() SwapSerialized {
 		OnchainFee:          swap.OnchainFee,
 		WalletId:            swap.WalletId,
 		TenantId:            swap.TenantId,
+		LightningNode:       swap.LightningNode,
+		RouteHints:          swap.RouteHints,
 	}
 }
 
@@ -168,6 +176,8 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 			"createdAt":           &createdAt,
 			"walletId":            &swap.WalletId,
 			"tenantId":            &swap.TenantId,
+			"lightningNode":       &swap.LightningNode,
+			"routeHints":          &swap.RouteHints,
 		},
 	)
 
@@ -338,8 +348,9 @@ func (database *Database) QueryRefundableSwaps(tenantId *Id, currency boltz.Curr
 const insertSwapStatement = `
 INSERT INTO swaps (id, fromCurrency, toCurrency, chanIds, state, error, status, privateKey, preimage, redeemScript, invoice, paymentHash, address,
                    expectedAmount, timeoutBlockheight, lockupTransactionId, refundTransactionId, refundAddress,
-                   blindingKey, isAuto, createdAt, serviceFee, serviceFeePercent, onchainFee, walletId, claimPubKey, swapTree, tenantId)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+                   blindingKey, isAuto, createdAt, serviceFee, serviceFeePercent, onchainFee, walletId, claimPubKey, swapTree, tenantId,
+                   lightningNode, routeHints)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 func (database *Database) CreateSwap(swap Swap) error {
@@ -379,6 +390,8 @@ func (database *Database) CreateSwap(swap Swap) error {
 		formatPublicKey(swap.ClaimPubKey),
 		formatJson(swap.SwapTree.Serialize()),
 		swap.TenantId,
+		swap.LightningNode,
+		swap.RouteHints,
 	)
 	return err
 }
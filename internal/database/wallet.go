@@ -169,5 +169,8 @@ func (d *Database) DeleteWallet(id Id) error {
 	if rows, _ := result.RowsAffected(); rows == 0 {
 		return fmt.Errorf("failed to delete wallet with id %d", id)
 	}
+	if _, err := d.Exec("DELETE FROM spendingPolicies WHERE walletId = ?", id); err != nil {
+		return fmt.Errorf("failed to delete spending policies of wallet: %w", err)
+	}
 	return nil
 }
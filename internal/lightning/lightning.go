@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
@@ -19,6 +20,8 @@ const (
 
 	NodeTypeLnd LightningNodeType = "LND"
 	NodeTypeCln LightningNodeType = "CLN"
+	// phoenixd is an HTTP API in front of a Phoenix wallet whose channels are managed automatically
+	NodeTypePhoenixd LightningNodeType = "phoenixd"
 
 	// The cltv expiry has to be lowered in regtest to allow for lower swap timeouts
 	RegtestCltv = 24
@@ -32,6 +35,19 @@ type PaymentStatus struct {
 	FailureReason string
 	Preimage      string
 	FeeMsat       uint64
+	// Hops of the route of the htlc which settled the payment or, if none did, of the latest htlc.
+	// Only LND reports them
+	Hops []PaymentHop
+}
+
+// PaymentHop is a channel a payment was forwarded through
+type PaymentHop struct {
+	ChanId     ChanId `json:"chanId"`
+	Pubkey     string `json:"pubkey"`
+	AmountMsat uint64 `json:"amountMsat"`
+	FeeMsat    uint64 `json:"feeMsat"`
+	// Failure is the error the node of the hop returned when the payment failed there
+	Failure string `json:"failure,omitempty"`
 }
 
 type PaymentUpdate struct {
@@ -68,6 +84,26 @@ func (channel *LightningChannel) GetId() ChanId {
 	return channel.Id
 }
 
+type OpenChannelRequest struct {
+	// PeerId is the hex encoded public key of the peer
+	PeerId string
+	// Host is used to connect to the peer first, if set
+	Host        string
+	Amount      uint64
+	SatPerVbyte float64
+	Private     bool
+}
+
+type SpliceRequest struct {
+	ChannelId ChanId
+	// Amount is spliced into the channel from the onchain wallet of the node
+	// or, if negative, out of the channel on top of the mining fee
+	Amount int64
+	// Address receives the funds which are spliced out instead of the onchain wallet of the node
+	Address     string
+	SatPerVbyte float64
+}
+
 type AddInvoiceResponse struct {
 	PaymentRequest string
 	PaymentHash    []byte
@@ -77,17 +113,48 @@ type PayInvoiceResponse struct {
 	FeeMsat uint
 }
 
+type CreateOfferResponse struct {
+	// Id is the hex encoded id of the offer assigned by the node
+	Id    string
+	Offer string
+}
+
+// OfferPayment is a settled invoice which was requested for an offer of the node
+type OfferPayment struct {
+	PaymentHash []byte
+	AmountSat   uint64
+	PaidAt      time.Time
+}
+
+// RouteEstimate is the outcome of probing a route without paying it
+type RouteEstimate struct {
+	FeeMsat uint64
+	// SuccessProbability is between 0 and 1 and nil if the node can not estimate it
+	SuccessProbability *float64
+}
+
 type LightningNode interface {
 	onchain.Wallet
 
 	Connect() error
 	Name() string
 	PaymentStatus(paymentHash []byte) (*PaymentStatus, error)
-	PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []ChanId) (*PayInvoiceResponse, error)
-	CreateInvoice(value uint64, preimage []byte, expiry int64, memo string) (*AddInvoiceResponse, error)
+	// PayInvoice pays the invoice with a fee limit in satoshis. A maxParts of 0 lets the node decide how often the payment is split
+	PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []ChanId, maxParts uint32) (*PayInvoiceResponse, error)
+	// CreateInvoice creates an invoice. If routeHints is set, hints for the private channels of the node are included
+	CreateInvoice(value uint64, preimage []byte, expiry int64, memo string, routeHints bool) (*AddInvoiceResponse, error)
 	NewAddress() (string, error)
 	GetInfo() (*LightningInfo, error)
 	ListChannels() ([]*LightningChannel, error)
+	OpenChannel(request OpenChannelRequest) (*ChannelPoint, error)
+	// SpliceChannel changes the capacity of an existing channel and returns the id of the splice transaction
+	SpliceChannel(request SpliceRequest) (string, error)
+	// ProbeRoute finds a route for paying amountSat to the destination node and estimates its fee
+	ProbeRoute(destination []byte, amountSat uint64) (*RouteEstimate, error)
+	// CreateOffer creates a reusable BOLT12 offer. An amount of 0 allows the payer to pick the amount
+	CreateOffer(amountSat uint64, description string) (*CreateOfferResponse, error)
+	DisableOffer(id string) error
+	ListOfferPayments(id string) ([]*OfferPayment, error)
 	SetupWallet(info onchain.WalletInfo)
 }
 
@@ -1,6 +1,9 @@
 package lightning
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -27,3 +30,26 @@ func CalculateFeeLimit(invoice string, chainParams *chaincfg.Params, feeLimitPpm
 	// feeLimit is in sats already, no need for ToUnit
 	return uint(feeLimit), nil
 }
+
+// EstimateRoutingFee probes routes to all destinations and returns the estimate of the cheapest one
+func EstimateRoutingFee(node LightningNode, destinations [][]byte, amountSat uint64) (*RouteEstimate, error) {
+	var best *RouteEstimate
+	err := errors.New("no destinations")
+	for _, destination := range destinations {
+		estimate, probeErr := node.ProbeRoute(destination, amountSat)
+		if probeErr != nil {
+			if errors.Is(probeErr, ErrUnsupported) {
+				return nil, probeErr
+			}
+			err = probeErr
+			continue
+		}
+		if best == nil || estimate.FeeMsat < best.FeeMsat {
+			best = estimate
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("could not find route: %w", err)
+	}
+	return best, nil
+}
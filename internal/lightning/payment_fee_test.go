@@ -1,10 +1,12 @@
 package lightning
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetFeeLimit(t *testing.T) {
@@ -35,3 +37,32 @@ func TestGetFeeLimit(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, uint(0), zeroFeeLimit)
 }
+
+type probeNode struct {
+	LightningNode
+	routes map[string]*RouteEstimate
+}
+
+func (node *probeNode) ProbeRoute(destination []byte, amountSat uint64) (*RouteEstimate, error) {
+	if route, ok := node.routes[string(destination)]; ok {
+		return route, nil
+	}
+	return nil, errors.New("no route found")
+}
+
+func TestEstimateRoutingFee(t *testing.T) {
+	node := &probeNode{routes: map[string]*RouteEstimate{
+		"expensive": {FeeMsat: 2000},
+		"cheap":     {FeeMsat: 1000},
+	}}
+
+	estimate, err := EstimateRoutingFee(node, [][]byte{[]byte("expensive"), []byte("unreachable"), []byte("cheap")}, 100_000)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), estimate.FeeMsat)
+
+	_, err = EstimateRoutingFee(node, [][]byte{[]byte("unreachable")}, 100_000)
+	require.ErrorContains(t, err, "no route found")
+
+	_, err = EstimateRoutingFee(node, nil, 100_000)
+	require.Error(t, err)
+}
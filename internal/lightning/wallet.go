@@ -0,0 +1,32 @@
+package lightning
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+)
+
+// PaginateTransactions sorts the transactions of a node wallet like the other wallets do, unconfirmed ones first
+// and then by block height descending, and returns the requested page
+func PaginateTransactions(transactions []*onchain.WalletTransaction, limit, offset uint64) []*onchain.WalletTransaction {
+	if limit == 0 {
+		limit = onchain.DefaultTransactionsLimit
+	}
+	slices.SortStableFunc(transactions, func(a, b *onchain.WalletTransaction) int {
+		if (a.BlockHeight == 0) != (b.BlockHeight == 0) {
+			if a.BlockHeight == 0 {
+				return -1
+			}
+			return 1
+		}
+		if byHeight := cmp.Compare(b.BlockHeight, a.BlockHeight); byHeight != 0 {
+			return byHeight
+		}
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+	if offset >= uint64(len(transactions)) {
+		return nil
+	}
+	return transactions[offset:min(offset+limit, uint64(len(transactions)))]
+}
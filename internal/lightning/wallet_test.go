@@ -0,0 +1,32 @@
+package lightning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginateTransactions(t *testing.T) {
+	now := time.Now()
+	transactions := func() []*onchain.WalletTransaction {
+		return []*onchain.WalletTransaction{
+			{Id: "old", BlockHeight: 10},
+			{Id: "unconfirmed", Timestamp: now},
+			{Id: "new", BlockHeight: 20, Timestamp: now.Add(-time.Minute)},
+			{Id: "newest", BlockHeight: 20, Timestamp: now},
+		}
+	}
+	ids := func(transactions []*onchain.WalletTransaction) (result []string) {
+		for _, tx := range transactions {
+			result = append(result, tx.Id)
+		}
+		return result
+	}
+
+	require.Equal(t, []string{"unconfirmed", "newest", "new", "old"}, ids(PaginateTransactions(transactions(), 0, 0)))
+	require.Equal(t, []string{"newest", "new"}, ids(PaginateTransactions(transactions(), 2, 1)))
+	require.Equal(t, []string{"old"}, ids(PaginateTransactions(transactions(), 2, 3)))
+	require.Empty(t, PaginateTransactions(transactions(), 2, 4))
+}
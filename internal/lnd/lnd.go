@@ -1,18 +1,24 @@
 package lnd
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
 	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
 	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
@@ -213,20 +219,184 @@ func (lnd *LND) ListChannels() ([]*lightning.LightningChannel, error) {
 	return results, nil
 }
 
+func (lnd *LND) SpliceChannel(request lightning.SpliceRequest) (string, error) {
+	return "", lightning.ErrUnsupported
+}
+
+func (lnd *LND) OpenChannel(request lightning.OpenChannelRequest) (*lightning.ChannelPoint, error) {
+	pubkey, err := hex.DecodeString(request.PeerId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid peer id: %w", err)
+	}
+	if request.Host != "" {
+		_, err := lnd.client.ConnectPeer(lnd.ctx, &lnrpc.ConnectPeerRequest{
+			Addr: &lnrpc.LightningAddress{Pubkey: request.PeerId, Host: request.Host},
+		})
+		if err != nil && !strings.Contains(err.Error(), "already connected") {
+			return nil, fmt.Errorf("could not connect to peer: %w", err)
+		}
+	}
+	point, err := lnd.client.OpenChannelSync(lnd.ctx, &lnrpc.OpenChannelRequest{
+		NodePubkey:         pubkey,
+		LocalFundingAmount: int64(request.Amount),
+		SatPerVbyte:        roundFeeRate(request.SatPerVbyte),
+		Private:            request.Private,
+	})
+	if err != nil {
+		return nil, err
+	}
+	fundingTxId := point.GetFundingTxidStr()
+	if fundingTxId == "" {
+		hash, err := chainhash.NewHash(point.GetFundingTxidBytes())
+		if err != nil {
+			return nil, fmt.Errorf("invalid funding transaction id: %w", err)
+		}
+		fundingTxId = hash.String()
+	}
+	return &lightning.ChannelPoint{
+		FundingTxId: fundingTxId,
+		OutputIndex: point.OutputIndex,
+	}, nil
+}
+
+// number of blocks below the tip whose transactions are fetched first, the range doubles until the page is filled
+const transactionsWindow = 1008
+
+// GetTransactions fetches the transactions in growing block ranges going back from the tip,
+// so that the whole history of the wallet is only loaded when paginating that far
 func (lnd *LND) GetTransactions(limit, offset uint64) ([]*onchain.WalletTransaction, error) {
-	return nil, lightning.ErrUnsupported
+	if limit == 0 {
+		limit = onchain.DefaultTransactionsLimit
+	}
+	tip, err := lnd.GetBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	var transactions []*onchain.WalletTransaction
+	window := int32(transactionsWindow)
+	// the first range includes the unconfirmed transactions
+	end := int32(-1)
+	top := int32(tip)
+	for {
+		start := max(top-window+1, 0)
+		response, err := lnd.client.GetTransactions(lnd.ctx, &lnrpc.GetTransactionsRequest{StartHeight: start, EndHeight: end})
+		if err != nil {
+			return nil, err
+		}
+		for _, tx := range response.Transactions {
+			transactions = append(transactions, parseTransaction(tx))
+		}
+		// an end height of 0 would include everything up to the tip again, and the genesis block has no wallet transactions anyway
+		if uint64(len(transactions)) >= limit+offset || start <= 1 {
+			break
+		}
+		top = start - 1
+		end = top
+		window *= 2
+	}
+	return lightning.PaginateTransactions(transactions, limit, offset), nil
 }
 
+func parseTransaction(tx *lnrpc.Transaction) *onchain.WalletTransaction {
+	result := &onchain.WalletTransaction{
+		Id:            tx.TxHash,
+		BalanceChange: tx.Amount,
+	}
+	if tx.TimeStamp != 0 {
+		result.Timestamp = time.Unix(tx.TimeStamp, 0)
+	}
+	if tx.NumConfirmations > 0 {
+		result.BlockHeight = uint32(tx.BlockHeight)
+	}
+	allOurs := len(tx.OutputDetails) > 0
+	for _, output := range tx.OutputDetails {
+		result.Outputs = append(result.Outputs, onchain.TransactionOutput{
+			Address:      output.Address,
+			Amount:       uint64(output.Amount),
+			IsOurAddress: output.IsOurAddress,
+		})
+		allOurs = allOurs && output.IsOurAddress
+	}
+	// only the fee leaves the wallet when consolidating
+	result.IsConsolidation = allOurs && tx.Amount < 0 && -tx.Amount == tx.TotalFees
+	return result
+}
+
+// BumpTransactionFee bumps the fee of an unconfirmed transaction with a child spending one of its outputs, since lnd
+// can not replace the transactions of its wallet. The id of the bumped transaction stays the same and is returned
 func (lnd *LND) BumpTransactionFee(txId string, feeRate float64) (string, error) {
-	return "", lightning.ErrUnsupported
+	response, err := lnd.client.GetTransactions(lnd.ctx, &lnrpc.GetTransactionsRequest{EndHeight: -1})
+	if err != nil {
+		return "", err
+	}
+	index := slices.IndexFunc(response.Transactions, func(tx *lnrpc.Transaction) bool {
+		return tx.TxHash == txId
+	})
+	if index == -1 {
+		return "", fmt.Errorf("transaction %s not found", txId)
+	}
+	tx := response.Transactions[index]
+	if tx.NumConfirmations > 0 {
+		return "", fmt.Errorf("transaction %s is already confirmed", txId)
+	}
+	outputIndex := slices.IndexFunc(tx.OutputDetails, func(output *lnrpc.OutputDetail) bool {
+		return output.IsOurAddress
+	})
+	if outputIndex == -1 {
+		return "", fmt.Errorf("transaction %s has no output of the wallet which could pay for it", txId)
+	}
+	_, err = lnd.walletKit.BumpFee(lnd.ctx, &walletrpc.BumpFeeRequest{
+		Outpoint: &lnrpc.OutPoint{
+			TxidStr:     txId,
+			OutputIndex: uint32(tx.OutputDetails[outputIndex].OutputIndex),
+		},
+		SatPerVbyte: roundFeeRate(feeRate),
+		Immediate:   true,
+	})
+	if err != nil {
+		return "", err
+	}
+	return txId, nil
 }
 
-func (lnd *LND) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string) (*lightning.AddInvoiceResponse, error) {
+func (lnd *LND) CreateOffer(amountSat uint64, description string) (*lightning.CreateOfferResponse, error) {
+	// LND does not support BOLT12 yet
+	return nil, lightning.ErrUnsupported
+}
+
+func (lnd *LND) DisableOffer(id string) error {
+	return lightning.ErrUnsupported
+}
+
+func (lnd *LND) ListOfferPayments(id string) ([]*lightning.OfferPayment, error) {
+	return nil, lightning.ErrUnsupported
+}
+
+func (lnd *LND) ProbeRoute(destination []byte, amountSat uint64) (*lightning.RouteEstimate, error) {
+	response, err := lnd.client.QueryRoutes(lnd.ctx, &lnrpc.QueryRoutesRequest{
+		PubKey:            hex.EncodeToString(destination),
+		Amt:               int64(amountSat),
+		UseMissionControl: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Routes) == 0 {
+		return nil, errors.New("no route found")
+	}
+	return &lightning.RouteEstimate{
+		FeeMsat:            uint64(response.Routes[0].TotalFeesMsat),
+		SuccessProbability: &response.SuccessProb,
+	}, nil
+}
+
+func (lnd *LND) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string, routeHints bool) (*lightning.AddInvoiceResponse, error) {
 	request := &lnrpc.Invoice{
 		Memo:      memo,
 		Value:     int64(value),
 		Expiry:    expiry,
 		RPreimage: preimage,
+		Private:   routeHints,
 	}
 	if lnd.regtest {
 		request.CltvExpiry = lightning.RegtestCltv
@@ -294,7 +464,7 @@ func (lnd *LND) GetChannelInfo(chanId uint64) (*lnrpc.ChannelEdge, error) {
 	})
 }
 
-func (lnd *LND) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, chanIds []lightning.ChanId) (*lightning.PayInvoiceResponse, error) {
+func (lnd *LND) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, chanIds []lightning.ChanId, maxParts uint32) (*lightning.PayInvoiceResponse, error) {
 	var outgoungIds []uint64
 	for _, chanId := range chanIds {
 		if chanId != 0 {
@@ -308,6 +478,7 @@ func (lnd *LND) PayInvoice(ctx context.Context, invoice string, feeLimit uint, t
 		FeeLimitSat:       int64(feeLimit),
 		NoInflightUpdates: true,
 		OutgoingChanIds:   outgoungIds,
+		MaxParts:          maxParts,
 	})
 	if err != nil {
 		return nil, err
@@ -349,9 +520,37 @@ func (lnd *LND) PaymentStatus(paymentHash []byte) (*lightning.PaymentStatus, err
 		FailureReason: event.FailureReason.String(),
 		Preimage:      event.PaymentPreimage,
 		State:         paymentStatusFromGrpc[event.Status],
+		Hops:          parseHops(event.Htlcs),
 	}, nil
 }
 
+// parseHops returns the route of the htlc which settled the payment or of the latest one if none did
+func parseHops(htlcs []*lnrpc.HTLCAttempt) []lightning.PaymentHop {
+	if len(htlcs) == 0 {
+		return nil
+	}
+	htlc := htlcs[len(htlcs)-1]
+	if index := slices.IndexFunc(htlcs, func(htlc *lnrpc.HTLCAttempt) bool {
+		return htlc.Status == lnrpc.HTLCAttempt_SUCCEEDED
+	}); index != -1 {
+		htlc = htlcs[index]
+	}
+	var hops []lightning.PaymentHop
+	for _, hop := range htlc.GetRoute().GetHops() {
+		hops = append(hops, lightning.PaymentHop{
+			ChanId:     lightning.ChanId(hop.ChanId),
+			Pubkey:     hop.PubKey,
+			AmountMsat: uint64(hop.AmtToForwardMsat),
+			FeeMsat:    uint64(hop.FeeMsat),
+		})
+	}
+	// the source index counts our own node as 0, so the failing hop is the one leading to that node
+	if failure := htlc.GetFailure(); failure != nil && failure.FailureSourceIndex > 0 && int(failure.FailureSourceIndex) <= len(hops) {
+		hops[failure.FailureSourceIndex-1].Failure = failure.Code.String()
+	}
+	return hops
+}
+
 func (lnd *LND) NewAddress() (string, error) {
 	response, err := lnd.client.NewAddress(lnd.ctx, &lnrpc.NewAddressRequest{
 		Type: lnrpc.AddressType_WITNESS_PUBKEY_HASH,
@@ -381,7 +580,7 @@ func (lnd *LND) SendToAddress(args onchain.WalletSendArgs) (string, error) {
 	response, err := lnd.client.SendCoins(lnd.ctx, &lnrpc.SendCoinsRequest{
 		Addr:        args.Address,
 		Amount:      int64(args.Amount),
-		SatPerVbyte: uint64(args.SatPerVbyte),
+		SatPerVbyte: roundFeeRate(args.SatPerVbyte),
 		SendAll:     args.SendAll,
 	})
 
@@ -396,8 +595,99 @@ func (lnd *LND) GetOutputs(address string) ([]*onchain.Output, error) {
 	return nil, lightning.ErrUnsupported
 }
 
+// virtual sizes of the parts of a transaction sending the whole wallet balance to a single output
+const (
+	sendAllBaseVsize  = 11 + 43
+	witnessInputVsize = 68
+	nestedInputVsize  = 91
+	taprootInputVsize = 58
+	// the anchor reserve is kept in a taproot change output
+	changeOutputVsize = 43
+)
+
+// roundFeeRate rounds the fee rate up to whole sat/vbyte since lnd does not accept fractions,
+// which makes sure that previews use the same fee rate as the actual send
+func roundFeeRate(satPerVbyte float64) uint64 {
+	return uint64(math.Ceil(satPerVbyte))
+}
+
+const (
+	defaultMinConfs   = 1
+	unlimitedMaxConfs = math.MaxInt32
+)
+
+// GetSendFee funds a PSBT with the wallet of lnd to learn the fee of a send, releasing the selected inputs afterwards.
+// The fee of sending all funds is estimated from the confirmed outputs of the wallet instead
 func (lnd *LND) GetSendFee(args onchain.WalletSendArgs) (send uint64, fee uint64, err error) {
-	return 0, 0, lightning.ErrUnsupported
+	satPerVbyte := roundFeeRate(args.SatPerVbyte)
+	if args.SendAll {
+		return lnd.getSendAllFee(satPerVbyte)
+	}
+	response, err := lnd.walletKit.FundPsbt(lnd.ctx, &walletrpc.FundPsbtRequest{
+		Template: &walletrpc.FundPsbtRequest_Raw{
+			Raw: &walletrpc.TxTemplate{Outputs: map[string]uint64{args.Address: args.Amount}},
+		},
+		Fees:     &walletrpc.FundPsbtRequest_SatPerVbyte{SatPerVbyte: satPerVbyte},
+		MinConfs: defaultMinConfs,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	// the inputs are only locked to build the preview
+	for _, lease := range response.LockedUtxos {
+		if _, err := lnd.walletKit.ReleaseOutput(lnd.ctx, &walletrpc.ReleaseOutputRequest{
+			Id:       lease.Id,
+			Outpoint: lease.Outpoint,
+		}); err != nil {
+			logger.Warnf("Could not release output %s:%d of fee preview: %v", lease.Outpoint.GetTxidStr(), lease.Outpoint.GetOutputIndex(), err)
+		}
+	}
+	packet, err := psbt.NewFromRawBytes(bytes.NewReader(response.FundedPsbt), false)
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not parse funded psbt: %w", err)
+	}
+	txFee, err := packet.GetTxFee()
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not get fee of funded psbt: %w", err)
+	}
+	return args.Amount, uint64(txFee), nil
+}
+
+// getSendAllFee estimates a send of all funds, which keeps the reserve for fee bumping anchor channels
+func (lnd *LND) getSendAllFee(satPerVbyte uint64) (send uint64, fee uint64, err error) {
+	balance, err := lnd.client.WalletBalance(lnd.ctx, &lnrpc.WalletBalanceRequest{})
+	if err != nil {
+		return 0, 0, err
+	}
+	reserve := uint64(balance.ReservedBalanceAnchorChan)
+	response, err := lnd.walletKit.ListUnspent(lnd.ctx, &walletrpc.ListUnspentRequest{
+		MinConfs: defaultMinConfs,
+		MaxConfs: unlimitedMaxConfs,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	var total int64
+	vsize := float64(sendAllBaseVsize)
+	for _, utxo := range response.Utxos {
+		total += utxo.AmountSat
+		switch utxo.AddressType {
+		case lnrpc.AddressType_NESTED_PUBKEY_HASH, lnrpc.AddressType_UNUSED_NESTED_PUBKEY_HASH:
+			vsize += nestedInputVsize
+		case lnrpc.AddressType_TAPROOT_PUBKEY, lnrpc.AddressType_UNUSED_TAPROOT_PUBKEY:
+			vsize += taprootInputVsize
+		default:
+			vsize += witnessInputVsize
+		}
+	}
+	if reserve > 0 {
+		vsize += changeOutputVsize
+	}
+	fee = uint64(math.Ceil(vsize)) * satPerVbyte
+	if uint64(total) <= fee+reserve {
+		return 0, 0, errors.New("insufficient funds")
+	}
+	return uint64(total) - fee - reserve, fee, nil
 }
 
 func (lnd *LND) ApplyTransaction(txHex string) error {
@@ -0,0 +1,197 @@
+package lnd
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testClient records the requests of the calls it implements, all other calls panic
+type testClient struct {
+	lnrpc.LightningClient
+	invoice   *lnrpc.Invoice
+	sendCoins *lnrpc.SendCoinsRequest
+	reserve   int64
+
+	height              uint32
+	transactions        []*lnrpc.Transaction
+	transactionRequests []*lnrpc.GetTransactionsRequest
+}
+
+func (c *testClient) GetInfo(context.Context, *lnrpc.GetInfoRequest, ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+	return &lnrpc.GetInfoResponse{BlockHeight: c.height}, nil
+}
+
+func (c *testClient) GetTransactions(_ context.Context, request *lnrpc.GetTransactionsRequest, _ ...grpc.CallOption) (*lnrpc.TransactionDetails, error) {
+	c.transactionRequests = append(c.transactionRequests, request)
+	var result []*lnrpc.Transaction
+	for _, tx := range c.transactions {
+		if request.EndHeight == -1 {
+			if tx.BlockHeight == 0 || tx.BlockHeight >= request.StartHeight {
+				result = append(result, tx)
+			}
+		} else if tx.BlockHeight != 0 && tx.BlockHeight >= request.StartHeight && tx.BlockHeight <= request.EndHeight {
+			result = append(result, tx)
+		}
+	}
+	return &lnrpc.TransactionDetails{Transactions: result}, nil
+}
+
+func (c *testClient) SendCoins(_ context.Context, request *lnrpc.SendCoinsRequest, _ ...grpc.CallOption) (*lnrpc.SendCoinsResponse, error) {
+	c.sendCoins = request
+	return &lnrpc.SendCoinsResponse{Txid: "txid"}, nil
+}
+
+func (c *testClient) WalletBalance(context.Context, *lnrpc.WalletBalanceRequest, ...grpc.CallOption) (*lnrpc.WalletBalanceResponse, error) {
+	return &lnrpc.WalletBalanceResponse{ReservedBalanceAnchorChan: c.reserve}, nil
+}
+
+type testWalletKit struct {
+	walletrpc.WalletKitClient
+	utxos    []*lnrpc.Utxo
+	fundPsbt *walletrpc.FundPsbtRequest
+}
+
+func (w *testWalletKit) ListUnspent(context.Context, *walletrpc.ListUnspentRequest, ...grpc.CallOption) (*walletrpc.ListUnspentResponse, error) {
+	return &walletrpc.ListUnspentResponse{Utxos: w.utxos}, nil
+}
+
+func (w *testWalletKit) FundPsbt(_ context.Context, request *walletrpc.FundPsbtRequest, _ ...grpc.CallOption) (*walletrpc.FundPsbtResponse, error) {
+	w.fundPsbt = request
+	return nil, errors.New("insufficient funds")
+}
+
+func (c *testClient) AddInvoice(_ context.Context, request *lnrpc.Invoice, _ ...grpc.CallOption) (*lnrpc.AddInvoiceResponse, error) {
+	c.invoice = request
+	return &lnrpc.AddInvoiceResponse{PaymentRequest: "lnbcrt1", RHash: []byte{1}}, nil
+}
+
+func setup(t *testing.T) (*LND, *testClient, *testWalletKit) {
+	client := &testClient{}
+	walletKit := &testWalletKit{}
+	return &LND{ctx: context.Background(), client: client, walletKit: walletKit}, client, walletKit
+}
+
+func TestCreateInvoice(t *testing.T) {
+	node, client, _ := setup(t)
+
+	invoice, err := node.CreateInvoice(1000, nil, 0, "memo", true)
+	require.NoError(t, err)
+	require.Equal(t, "lnbcrt1", invoice.PaymentRequest)
+	require.True(t, client.invoice.Private)
+	require.Equal(t, int64(1000), client.invoice.Value)
+
+	_, err = node.CreateInvoice(1000, nil, 0, "memo", false)
+	require.NoError(t, err)
+	require.False(t, client.invoice.Private)
+}
+
+func TestSendFeeRate(t *testing.T) {
+	node, client, walletKit := setup(t)
+
+	// fractional fee rates are rounded up for both the preview and the send
+	_, _, err := node.GetSendFee(onchain.WalletSendArgs{Address: "bcrt1", Amount: 1000, SatPerVbyte: 1.1})
+	require.Error(t, err)
+	require.Equal(t, uint64(2), walletKit.fundPsbt.GetSatPerVbyte())
+
+	_, err = node.SendToAddress(onchain.WalletSendArgs{Address: "bcrt1", Amount: 1000, SatPerVbyte: 1.1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), client.sendCoins.SatPerVbyte)
+}
+
+func TestGetSendAllFee(t *testing.T) {
+	node, client, walletKit := setup(t)
+	walletKit.utxos = []*lnrpc.Utxo{
+		{AmountSat: 50_000, AddressType: lnrpc.AddressType_WITNESS_PUBKEY_HASH},
+		{AmountSat: 50_000, AddressType: lnrpc.AddressType_TAPROOT_PUBKEY},
+	}
+	args := onchain.WalletSendArgs{SendAll: true, SatPerVbyte: 1.5}
+	vsize := uint64(sendAllBaseVsize + witnessInputVsize + taprootInputVsize)
+
+	send, fee, err := node.GetSendFee(args)
+	require.NoError(t, err)
+	require.Equal(t, vsize*2, fee)
+	require.Equal(t, 100_000-fee, send)
+
+	t.Run("AnchorReserve", func(t *testing.T) {
+		client.reserve = 10_000
+		send, fee, err := node.GetSendFee(args)
+		require.NoError(t, err)
+		require.Equal(t, (vsize+changeOutputVsize)*2, fee)
+		require.Equal(t, 100_000-fee-10_000, send)
+
+		client.reserve = math.MaxInt32
+		_, _, err = node.GetSendFee(args)
+		require.Error(t, err)
+	})
+}
+
+func TestParseHops(t *testing.T) {
+	route := func(chanIds ...uint64) *lnrpc.Route {
+		var hops []*lnrpc.Hop
+		for _, chanId := range chanIds {
+			hops = append(hops, &lnrpc.Hop{ChanId: chanId, AmtToForwardMsat: 1000})
+		}
+		return &lnrpc.Route{Hops: hops}
+	}
+	failed := &lnrpc.HTLCAttempt{
+		Status:  lnrpc.HTLCAttempt_FAILED,
+		Route:   route(1, 2),
+		Failure: &lnrpc.Failure{Code: lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE, FailureSourceIndex: 1},
+	}
+	succeeded := &lnrpc.HTLCAttempt{Status: lnrpc.HTLCAttempt_SUCCEEDED, Route: route(3)}
+
+	require.Nil(t, parseHops(nil))
+
+	// the failing hop is the one leading to the node which reported the failure
+	hops := parseHops([]*lnrpc.HTLCAttempt{failed})
+	require.Len(t, hops, 2)
+	require.Equal(t, "TEMPORARY_CHANNEL_FAILURE", hops[0].Failure)
+	require.Empty(t, hops[1].Failure)
+
+	// the route of the settling htlc is preferred over later ones
+	hops = parseHops([]*lnrpc.HTLCAttempt{succeeded, failed})
+	require.Len(t, hops, 1)
+	require.EqualValues(t, 3, hops[0].ChanId)
+}
+
+func TestGetTransactions(t *testing.T) {
+	node, client, _ := setup(t)
+	client.height = 10_000
+	client.transactions = []*lnrpc.Transaction{
+		{TxHash: "unconfirmed"},
+		{TxHash: "recent", BlockHeight: 9_900, NumConfirmations: 101},
+		{TxHash: "older", BlockHeight: 8_000, NumConfirmations: 2001},
+		{TxHash: "oldest", BlockHeight: 100, NumConfirmations: 9901},
+	}
+
+	// only the blocks close to the tip are fetched if they fill the page
+	transactions, err := node.GetTransactions(2, 0)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	require.Equal(t, "unconfirmed", transactions[0].Id)
+	require.Equal(t, "recent", transactions[1].Id)
+	require.Len(t, client.transactionRequests, 1)
+	require.Equal(t, int32(10_000-transactionsWindow+1), client.transactionRequests[0].StartHeight)
+	require.Equal(t, int32(-1), client.transactionRequests[0].EndHeight)
+
+	// older ranges are only fetched when paginating further
+	client.transactionRequests = nil
+	transactions, err = node.GetTransactions(2, 2)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	require.Equal(t, "older", transactions[0].Id)
+	require.Equal(t, "oldest", transactions[1].Id)
+	for i, request := range client.transactionRequests[1:] {
+		// the ranges do not overlap
+		require.Equal(t, client.transactionRequests[i].StartHeight-1, request.EndHeight)
+	}
+	require.Zero(t, client.transactionRequests[len(client.transactionRequests)-1].StartHeight)
+}
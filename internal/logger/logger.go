@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/fatih/color"
 	"gopkg.in/natefinch/lumberjack.v2"
+	"io"
 	"log"
 	"os"
 	"strings"
@@ -45,6 +46,11 @@ type Options struct {
 	Level string
 }
 
+// SetConsoleOutput changes where console logs are written to, which is stdout by default
+func SetConsoleOutput(w io.Writer) {
+	consoleLogger.SetOutput(w)
+}
+
 // Init set logfile to "" to disable the file logger
 func Init(options Options) {
 	isDisabled = false
@@ -89,6 +89,10 @@ var (
 			Entity: "swap",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/ListSwapPayments": {{
+			Entity: "swap",
+			Action: "read",
+		}},
 		"/boltzrpc.Boltz/GetSwapInfoStream": {{
 			Entity: "swap",
 			Action: "read",
@@ -121,6 +125,18 @@ var (
 			Entity: "swap",
 			Action: "write",
 		}},
+		"/boltzrpc.Boltz/CreateOffer": {{
+			Entity: "swap",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ListOffers": {{
+			Entity: "swap",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/DisableOffer": {{
+			Entity: "swap",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/CreateWallet": {{
 			Entity: "wallet",
 			Action: "write",
@@ -145,6 +161,18 @@ var (
 			Entity: "wallet",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/CreateWalletPsbt": {{
+			Entity: "wallet",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/SignWalletPsbt": {{
+			Entity: "wallet",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/FinalizeWalletPsbt": {{
+			Entity: "wallet",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/GetWalletCredentials": {{
 			Entity: "wallet",
 			Action: "write",
@@ -157,6 +185,14 @@ var (
 			Entity: "wallet",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/SubscribeWalletTransactions": {{
+			Entity: "wallet",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/ConsolidateWallet": {{
+			Entity: "wallet",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/BumpTransaction": {{
 			Entity: "wallet",
 			Action: "write",
@@ -197,6 +233,30 @@ var (
 			Entity: "admin",
 			Action: "write",
 		}},
+		"/boltzrpc.Boltz/SetSpendingPolicy": {{
+			Entity: "admin",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/RemoveSpendingPolicy": {{
+			Entity: "admin",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ListSpendingPolicies": {{
+			Entity: "wallet",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/AddAllowedAddress": {{
+			Entity: "admin",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/ListAllowedAddresses": {{
+			Entity: "wallet",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/RemoveAllowedAddress": {{
+			Entity: "admin",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/BakeMacaroon": {{
 			Entity: "admin",
 			Action: "write",
@@ -237,6 +297,30 @@ var (
 			Entity: "autoswap",
 			Action: "write",
 		}},
+		"/autoswaprpc.AutoSwap/GetSnapshot": {{
+			Entity: "autoswap",
+			Action: "read",
+		}},
+		"/autoswaprpc.AutoSwap/Simulate": {{
+			Entity: "autoswap",
+			Action: "read",
+		}},
+		"/autoswaprpc.AutoSwap/ListAutoSwapEvents": {{
+			Entity: "autoswap",
+			Action: "read",
+		}},
+		"/autoswaprpc.AutoSwap/ListPendingApprovals": {{
+			Entity: "autoswap",
+			Action: "read",
+		}},
+		"/autoswaprpc.AutoSwap/ApproveRecommendation": {{
+			Entity: "autoswap",
+			Action: "write",
+		}},
+		"/autoswaprpc.AutoSwap/RejectRecommendation": {{
+			Entity: "autoswap",
+			Action: "write",
+		}},
 	}
 )
 
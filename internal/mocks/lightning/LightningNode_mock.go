@@ -261,8 +261,8 @@ func (_c *MockLightningNode_Connect_Call) RunAndReturn(run func() error) *MockLi
 }
 
 // CreateInvoice provides a mock function for the type MockLightningNode
-func (_mock *MockLightningNode) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string) (*lightning.AddInvoiceResponse, error) {
-	ret := _mock.Called(value, preimage, expiry, memo)
+func (_mock *MockLightningNode) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string, routeHints bool) (*lightning.AddInvoiceResponse, error) {
+	ret := _mock.Called(value, preimage, expiry, memo, routeHints)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateInvoice")
@@ -270,18 +270,18 @@ func (_mock *MockLightningNode) CreateInvoice(value uint64, preimage []byte, exp
 
 	var r0 *lightning.AddInvoiceResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(uint64, []byte, int64, string) (*lightning.AddInvoiceResponse, error)); ok {
-		return returnFunc(value, preimage, expiry, memo)
+	if returnFunc, ok := ret.Get(0).(func(uint64, []byte, int64, string, bool) (*lightning.AddInvoiceResponse, error)); ok {
+		return returnFunc(value, preimage, expiry, memo, routeHints)
 	}
-	if returnFunc, ok := ret.Get(0).(func(uint64, []byte, int64, string) *lightning.AddInvoiceResponse); ok {
-		r0 = returnFunc(value, preimage, expiry, memo)
+	if returnFunc, ok := ret.Get(0).(func(uint64, []byte, int64, string, bool) *lightning.AddInvoiceResponse); ok {
+		r0 = returnFunc(value, preimage, expiry, memo, routeHints)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*lightning.AddInvoiceResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(uint64, []byte, int64, string) error); ok {
-		r1 = returnFunc(value, preimage, expiry, memo)
+	if returnFunc, ok := ret.Get(1).(func(uint64, []byte, int64, string, bool) error); ok {
+		r1 = returnFunc(value, preimage, expiry, memo, routeHints)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -298,11 +298,12 @@ type MockLightningNode_CreateInvoice_Call struct {
 //   - preimage []byte
 //   - expiry int64
 //   - memo string
-func (_e *MockLightningNode_Expecter) CreateInvoice(value interface{}, preimage interface{}, expiry interface{}, memo interface{}) *MockLightningNode_CreateInvoice_Call {
-	return &MockLightningNode_CreateInvoice_Call{Call: _e.mock.On("CreateInvoice", value, preimage, expiry, memo)}
+//   - routeHints bool
+func (_e *MockLightningNode_Expecter) CreateInvoice(value interface{}, preimage interface{}, expiry interface{}, memo interface{}, routeHints interface{}) *MockLightningNode_CreateInvoice_Call {
+	return &MockLightningNode_CreateInvoice_Call{Call: _e.mock.On("CreateInvoice", value, preimage, expiry, memo, routeHints)}
 }
 
-func (_c *MockLightningNode_CreateInvoice_Call) Run(run func(value uint64, preimage []byte, expiry int64, memo string)) *MockLightningNode_CreateInvoice_Call {
+func (_c *MockLightningNode_CreateInvoice_Call) Run(run func(value uint64, preimage []byte, expiry int64, memo string, routeHints bool)) *MockLightningNode_CreateInvoice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 uint64
 		if args[0] != nil {
@@ -320,11 +321,16 @@ func (_c *MockLightningNode_CreateInvoice_Call) Run(run func(value uint64, preim
 		if args[3] != nil {
 			arg3 = args[3].(string)
 		}
+		var arg4 bool
+		if args[4] != nil {
+			arg4 = args[4].(bool)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
+			arg4,
 		)
 	})
 	return _c
@@ -335,7 +341,126 @@ func (_c *MockLightningNode_CreateInvoice_Call) Return(addInvoiceResponse *light
 	return _c
 }
 
-func (_c *MockLightningNode_CreateInvoice_Call) RunAndReturn(run func(value uint64, preimage []byte, expiry int64, memo string) (*lightning.AddInvoiceResponse, error)) *MockLightningNode_CreateInvoice_Call {
+func (_c *MockLightningNode_CreateInvoice_Call) RunAndReturn(run func(value uint64, preimage []byte, expiry int64, memo string, routeHints bool) (*lightning.AddInvoiceResponse, error)) *MockLightningNode_CreateInvoice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOffer provides a mock function for the type MockLightningNode
+func (_mock *MockLightningNode) CreateOffer(amountSat uint64, description string) (*lightning.CreateOfferResponse, error) {
+	ret := _mock.Called(amountSat, description)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOffer")
+	}
+
+	var r0 *lightning.CreateOfferResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(uint64, string) (*lightning.CreateOfferResponse, error)); ok {
+		return returnFunc(amountSat, description)
+	}
+	if returnFunc, ok := ret.Get(0).(func(uint64, string) *lightning.CreateOfferResponse); ok {
+		r0 = returnFunc(amountSat, description)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lightning.CreateOfferResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(uint64, string) error); ok {
+		r1 = returnFunc(amountSat, description)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLightningNode_CreateOffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOffer'
+type MockLightningNode_CreateOffer_Call struct {
+	*mock.Call
+}
+
+// CreateOffer is a helper method to define mock.On call
+//   - amountSat uint64
+//   - description string
+func (_e *MockLightningNode_Expecter) CreateOffer(amountSat interface{}, description interface{}) *MockLightningNode_CreateOffer_Call {
+	return &MockLightningNode_CreateOffer_Call{Call: _e.mock.On("CreateOffer", amountSat, description)}
+}
+
+func (_c *MockLightningNode_CreateOffer_Call) Run(run func(amountSat uint64, description string)) *MockLightningNode_CreateOffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 uint64
+		if args[0] != nil {
+			arg0 = args[0].(uint64)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLightningNode_CreateOffer_Call) Return(createOfferResponse *lightning.CreateOfferResponse, err error) *MockLightningNode_CreateOffer_Call {
+	_c.Call.Return(createOfferResponse, err)
+	return _c
+}
+
+func (_c *MockLightningNode_CreateOffer_Call) RunAndReturn(run func(amountSat uint64, description string) (*lightning.CreateOfferResponse, error)) *MockLightningNode_CreateOffer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableOffer provides a mock function for the type MockLightningNode
+func (_mock *MockLightningNode) DisableOffer(id string) error {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableOffer")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockLightningNode_DisableOffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableOffer'
+type MockLightningNode_DisableOffer_Call struct {
+	*mock.Call
+}
+
+// DisableOffer is a helper method to define mock.On call
+//   - id string
+func (_e *MockLightningNode_Expecter) DisableOffer(id interface{}) *MockLightningNode_DisableOffer_Call {
+	return &MockLightningNode_DisableOffer_Call{Call: _e.mock.On("DisableOffer", id)}
+}
+
+func (_c *MockLightningNode_DisableOffer_Call) Run(run func(id string)) *MockLightningNode_DisableOffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLightningNode_DisableOffer_Call) Return(err error) *MockLightningNode_DisableOffer_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockLightningNode_DisableOffer_Call) RunAndReturn(run func(id string) error) *MockLightningNode_DisableOffer_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -833,6 +958,68 @@ func (_c *MockLightningNode_ListChannels_Call) RunAndReturn(run func() ([]*light
 	return _c
 }
 
+// ListOfferPayments provides a mock function for the type MockLightningNode
+func (_mock *MockLightningNode) ListOfferPayments(id string) ([]*lightning.OfferPayment, error) {
+	ret := _mock.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOfferPayments")
+	}
+
+	var r0 []*lightning.OfferPayment
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) ([]*lightning.OfferPayment, error)); ok {
+		return returnFunc(id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) []*lightning.OfferPayment); ok {
+		r0 = returnFunc(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*lightning.OfferPayment)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLightningNode_ListOfferPayments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOfferPayments'
+type MockLightningNode_ListOfferPayments_Call struct {
+	*mock.Call
+}
+
+// ListOfferPayments is a helper method to define mock.On call
+//   - id string
+func (_e *MockLightningNode_Expecter) ListOfferPayments(id interface{}) *MockLightningNode_ListOfferPayments_Call {
+	return &MockLightningNode_ListOfferPayments_Call{Call: _e.mock.On("ListOfferPayments", id)}
+}
+
+func (_c *MockLightningNode_ListOfferPayments_Call) Run(run func(id string)) *MockLightningNode_ListOfferPayments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLightningNode_ListOfferPayments_Call) Return(offerPayments []*lightning.OfferPayment, err error) *MockLightningNode_ListOfferPayments_Call {
+	_c.Call.Return(offerPayments, err)
+	return _c
+}
+
+func (_c *MockLightningNode_ListOfferPayments_Call) RunAndReturn(run func(id string) ([]*lightning.OfferPayment, error)) *MockLightningNode_ListOfferPayments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function for the type MockLightningNode
 func (_mock *MockLightningNode) Name() string {
 	ret := _mock.Called()
@@ -930,9 +1117,71 @@ func (_c *MockLightningNode_NewAddress_Call) RunAndReturn(run func() (string, er
 	return _c
 }
 
+// OpenChannel provides a mock function for the type MockLightningNode
+func (_mock *MockLightningNode) OpenChannel(request lightning.OpenChannelRequest) (*lightning.ChannelPoint, error) {
+	ret := _mock.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenChannel")
+	}
+
+	var r0 *lightning.ChannelPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(lightning.OpenChannelRequest) (*lightning.ChannelPoint, error)); ok {
+		return returnFunc(request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(lightning.OpenChannelRequest) *lightning.ChannelPoint); ok {
+		r0 = returnFunc(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lightning.ChannelPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(lightning.OpenChannelRequest) error); ok {
+		r1 = returnFunc(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLightningNode_OpenChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenChannel'
+type MockLightningNode_OpenChannel_Call struct {
+	*mock.Call
+}
+
+// OpenChannel is a helper method to define mock.On call
+//   - request lightning.OpenChannelRequest
+func (_e *MockLightningNode_Expecter) OpenChannel(request interface{}) *MockLightningNode_OpenChannel_Call {
+	return &MockLightningNode_OpenChannel_Call{Call: _e.mock.On("OpenChannel", request)}
+}
+
+func (_c *MockLightningNode_OpenChannel_Call) Run(run func(request lightning.OpenChannelRequest)) *MockLightningNode_OpenChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 lightning.OpenChannelRequest
+		if args[0] != nil {
+			arg0 = args[0].(lightning.OpenChannelRequest)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLightningNode_OpenChannel_Call) Return(channelPoint *lightning.ChannelPoint, err error) *MockLightningNode_OpenChannel_Call {
+	_c.Call.Return(channelPoint, err)
+	return _c
+}
+
+func (_c *MockLightningNode_OpenChannel_Call) RunAndReturn(run func(request lightning.OpenChannelRequest) (*lightning.ChannelPoint, error)) *MockLightningNode_OpenChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayInvoice provides a mock function for the type MockLightningNode
-func (_mock *MockLightningNode) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []lightning.ChanId) (*lightning.PayInvoiceResponse, error) {
-	ret := _mock.Called(ctx, invoice, feeLimit, timeoutSeconds, channelIds)
+func (_mock *MockLightningNode) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []lightning.ChanId, maxParts uint32) (*lightning.PayInvoiceResponse, error) {
+	ret := _mock.Called(ctx, invoice, feeLimit, timeoutSeconds, channelIds, maxParts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PayInvoice")
@@ -940,18 +1189,18 @@ func (_mock *MockLightningNode) PayInvoice(ctx context.Context, invoice string,
 
 	var r0 *lightning.PayInvoiceResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint, uint, []lightning.ChanId) (*lightning.PayInvoiceResponse, error)); ok {
-		return returnFunc(ctx, invoice, feeLimit, timeoutSeconds, channelIds)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint, uint, []lightning.ChanId, uint32) (*lightning.PayInvoiceResponse, error)); ok {
+		return returnFunc(ctx, invoice, feeLimit, timeoutSeconds, channelIds, maxParts)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint, uint, []lightning.ChanId) *lightning.PayInvoiceResponse); ok {
-		r0 = returnFunc(ctx, invoice, feeLimit, timeoutSeconds, channelIds)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, uint, uint, []lightning.ChanId, uint32) *lightning.PayInvoiceResponse); ok {
+		r0 = returnFunc(ctx, invoice, feeLimit, timeoutSeconds, channelIds, maxParts)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*lightning.PayInvoiceResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint, uint, []lightning.ChanId) error); ok {
-		r1 = returnFunc(ctx, invoice, feeLimit, timeoutSeconds, channelIds)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, uint, uint, []lightning.ChanId, uint32) error); ok {
+		r1 = returnFunc(ctx, invoice, feeLimit, timeoutSeconds, channelIds, maxParts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -969,11 +1218,12 @@ type MockLightningNode_PayInvoice_Call struct {
 //   - feeLimit uint
 //   - timeoutSeconds uint
 //   - channelIds []lightning.ChanId
-func (_e *MockLightningNode_Expecter) PayInvoice(ctx interface{}, invoice interface{}, feeLimit interface{}, timeoutSeconds interface{}, channelIds interface{}) *MockLightningNode_PayInvoice_Call {
-	return &MockLightningNode_PayInvoice_Call{Call: _e.mock.On("PayInvoice", ctx, invoice, feeLimit, timeoutSeconds, channelIds)}
+//   - maxParts uint32
+func (_e *MockLightningNode_Expecter) PayInvoice(ctx interface{}, invoice interface{}, feeLimit interface{}, timeoutSeconds interface{}, channelIds interface{}, maxParts interface{}) *MockLightningNode_PayInvoice_Call {
+	return &MockLightningNode_PayInvoice_Call{Call: _e.mock.On("PayInvoice", ctx, invoice, feeLimit, timeoutSeconds, channelIds, maxParts)}
 }
 
-func (_c *MockLightningNode_PayInvoice_Call) Run(run func(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []lightning.ChanId)) *MockLightningNode_PayInvoice_Call {
+func (_c *MockLightningNode_PayInvoice_Call) Run(run func(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []lightning.ChanId, maxParts uint32)) *MockLightningNode_PayInvoice_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -995,12 +1245,17 @@ func (_c *MockLightningNode_PayInvoice_Call) Run(run func(ctx context.Context, i
 		if args[4] != nil {
 			arg4 = args[4].([]lightning.ChanId)
 		}
+		var arg5 uint32
+		if args[5] != nil {
+			arg5 = args[5].(uint32)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
 			arg4,
+			arg5,
 		)
 	})
 	return _c
@@ -1011,7 +1266,7 @@ func (_c *MockLightningNode_PayInvoice_Call) Return(payInvoiceResponse *lightnin
 	return _c
 }
 
-func (_c *MockLightningNode_PayInvoice_Call) RunAndReturn(run func(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []lightning.ChanId) (*lightning.PayInvoiceResponse, error)) *MockLightningNode_PayInvoice_Call {
+func (_c *MockLightningNode_PayInvoice_Call) RunAndReturn(run func(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, channelIds []lightning.ChanId, maxParts uint32) (*lightning.PayInvoiceResponse, error)) *MockLightningNode_PayInvoice_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1078,6 +1333,74 @@ func (_c *MockLightningNode_PaymentStatus_Call) RunAndReturn(run func(paymentHas
 	return _c
 }
 
+// ProbeRoute provides a mock function for the type MockLightningNode
+func (_mock *MockLightningNode) ProbeRoute(destination []byte, amountSat uint64) (*lightning.RouteEstimate, error) {
+	ret := _mock.Called(destination, amountSat)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProbeRoute")
+	}
+
+	var r0 *lightning.RouteEstimate
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]byte, uint64) (*lightning.RouteEstimate, error)); ok {
+		return returnFunc(destination, amountSat)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]byte, uint64) *lightning.RouteEstimate); ok {
+		r0 = returnFunc(destination, amountSat)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lightning.RouteEstimate)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]byte, uint64) error); ok {
+		r1 = returnFunc(destination, amountSat)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLightningNode_ProbeRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProbeRoute'
+type MockLightningNode_ProbeRoute_Call struct {
+	*mock.Call
+}
+
+// ProbeRoute is a helper method to define mock.On call
+//   - destination []byte
+//   - amountSat uint64
+func (_e *MockLightningNode_Expecter) ProbeRoute(destination interface{}, amountSat interface{}) *MockLightningNode_ProbeRoute_Call {
+	return &MockLightningNode_ProbeRoute_Call{Call: _e.mock.On("ProbeRoute", destination, amountSat)}
+}
+
+func (_c *MockLightningNode_ProbeRoute_Call) Run(run func(destination []byte, amountSat uint64)) *MockLightningNode_ProbeRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []byte
+		if args[0] != nil {
+			arg0 = args[0].([]byte)
+		}
+		var arg1 uint64
+		if args[1] != nil {
+			arg1 = args[1].(uint64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLightningNode_ProbeRoute_Call) Return(routeEstimate *lightning.RouteEstimate, err error) *MockLightningNode_ProbeRoute_Call {
+	_c.Call.Return(routeEstimate, err)
+	return _c
+}
+
+func (_c *MockLightningNode_ProbeRoute_Call) RunAndReturn(run func(destination []byte, amountSat uint64) (*lightning.RouteEstimate, error)) *MockLightningNode_ProbeRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Ready provides a mock function for the type MockLightningNode
 func (_mock *MockLightningNode) Ready() bool {
 	ret := _mock.Called()
@@ -1222,6 +1545,66 @@ func (_c *MockLightningNode_SetupWallet_Call) RunAndReturn(run func(info onchain
 	return _c
 }
 
+// SpliceChannel provides a mock function for the type MockLightningNode
+func (_mock *MockLightningNode) SpliceChannel(request lightning.SpliceRequest) (string, error) {
+	ret := _mock.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SpliceChannel")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(lightning.SpliceRequest) (string, error)); ok {
+		return returnFunc(request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(lightning.SpliceRequest) string); ok {
+		r0 = returnFunc(request)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(lightning.SpliceRequest) error); ok {
+		r1 = returnFunc(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockLightningNode_SpliceChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpliceChannel'
+type MockLightningNode_SpliceChannel_Call struct {
+	*mock.Call
+}
+
+// SpliceChannel is a helper method to define mock.On call
+//   - request lightning.SpliceRequest
+func (_e *MockLightningNode_Expecter) SpliceChannel(request interface{}) *MockLightningNode_SpliceChannel_Call {
+	return &MockLightningNode_SpliceChannel_Call{Call: _e.mock.On("SpliceChannel", request)}
+}
+
+func (_c *MockLightningNode_SpliceChannel_Call) Run(run func(request lightning.SpliceRequest)) *MockLightningNode_SpliceChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 lightning.SpliceRequest
+		if args[0] != nil {
+			arg0 = args[0].(lightning.SpliceRequest)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockLightningNode_SpliceChannel_Call) Return(s string, err error) *MockLightningNode_SpliceChannel_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockLightningNode_SpliceChannel_Call) RunAndReturn(run func(request lightning.SpliceRequest) (string, error)) *MockLightningNode_SpliceChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Sync provides a mock function for the type MockLightningNode
 func (_mock *MockLightningNode) Sync() error {
 	ret := _mock.Called()
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/utils"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
@@ -25,6 +26,8 @@ type Nursery struct {
 	network *boltz.Network
 
 	lightning lightning.LightningNode
+	// lightningNodes are the additional nodes swaps can be bound to, keyed by their name
+	lightningNodes map[string]lightning.LightningNode
 
 	onchain  *onchain.Onchain
 	boltz    *boltz.Api
@@ -37,6 +40,9 @@ type Nursery struct {
 	waitGroup          sync.WaitGroup
 	maxZeroConfAmount  uint64
 	maxRoutingFeePpm   uint64
+	paymentRetries     PaymentRetries
+	// paymentRetryDelay is the time waited before a failed payment is retried
+	paymentRetryDelay time.Duration
 
 	// updateLock is locked when a swap update is being processed.
 	// it is used to prevent a race between a `ClaimSwaps` call where an update can be triggered
@@ -67,6 +73,7 @@ func New(
 		boltzWs:          boltzClient.NewWebsocket(),
 		maxRoutingFeePpm: maxRoutingFeePpm,
 	}
+	nursery.paymentRetryDelay = defaultPaymentRetryDelay
 	if maxZeroConfAmount != nil {
 		nursery.maxZeroConfAmount = *maxZeroConfAmount
 	}
@@ -74,6 +81,23 @@ func New(
 	return nursery
 }
 
+// AddLightningNode makes an additional lightning node available to the swaps which are bound to it
+func (nursery *Nursery) AddLightningNode(name string, node lightning.LightningNode) {
+	if nursery.lightningNodes == nil {
+		nursery.lightningNodes = make(map[string]lightning.LightningNode)
+	}
+	nursery.lightningNodes[name] = node
+}
+
+// lightningNode returns the node with the given name, the main node if the name is empty
+// and nil if there is no such node
+func (nursery *Nursery) lightningNode(name string) lightning.LightningNode {
+	if name == "" {
+		return nursery.lightning
+	}
+	return nursery.lightningNodes[name]
+}
+
 type SwapUpdate struct {
 	Swap        *database.Swap
 	ReverseSwap *database.ReverseSwap
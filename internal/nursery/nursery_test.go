@@ -12,6 +12,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
 	"github.com/BoltzExchange/boltz-client/v2/internal/test"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -43,8 +44,8 @@ func setup(t *testing.T) *Nursery {
 }
 
 func TestPayReverseSwap(t *testing.T) {
+	testInvoice := "lnbcrt10m1p5y4z9epp5hh09qu0605hcjvc5r6dv3ma0z45h7pxjcp4xv383avzxk4yf0tlsdqqcqzzsxqyz5vqsp5nzsy8g59gvlp694x7rc7gxfllk0wswl95vvk5eguc30jrvcqeuws9qxpqysgqmfdaryxsaze7s26ew6y4zu3hk8p9sj8ezcpcvt6rchjuxva5zvwyq7897ffw4mjmsg6efugt5k7qhfy04j6wxnlzpfu48r5mjsruzugqjp04ec"
 	t.Run("MaxRoutingFee", func(t *testing.T) {
-		testInvoice := "lnbcrt10m1p5y4z9epp5hh09qu0605hcjvc5r6dv3ma0z45h7pxjcp4xv383avzxk4yf0tlsdqqcqzzsxqyz5vqsp5nzsy8g59gvlp694x7rc7gxfllk0wswl95vvk5eguc30jrvcqeuws9qxpqysgqmfdaryxsaze7s26ew6y4zu3hk8p9sj8ezcpcvt6rchjuxva5zvwyq7897ffw4mjmsg6efugt5k7qhfy04j6wxnlzpfu48r5mjsruzugqjp04ec"
 		testInvoiceAmount := uint64(1_000_000)
 		maxRoutingFeePpm := uint64(100)
 		expectedLimit := uint(maxRoutingFeePpm) // 1 million sat invoice
@@ -53,7 +54,7 @@ func TestPayReverseSwap(t *testing.T) {
 			nursery := setup(t)
 			mockLightning := lnmock.NewMockLightningNode(t)
 			mockLightning.EXPECT().
-				PayInvoice(mock.Anything, testInvoice, expectedLimit, mock.Anything, mock.Anything).
+				PayInvoice(mock.Anything, testInvoice, expectedLimit, mock.Anything, mock.Anything, mock.Anything).
 				Return(&lightning.PayInvoiceResponse{
 					FeeMsat: 1,
 				}, nil)
@@ -88,6 +89,106 @@ func TestPayReverseSwap(t *testing.T) {
 		})
 
 	})
+	t.Run("Retries", func(t *testing.T) {
+		hops := []lightning.PaymentHop{{ChanId: 1, Pubkey: "02aa", AmountMsat: 1000, Failure: "TEMPORARY_CHANNEL_FAILURE"}}
+		setup := func(t *testing.T) (*Nursery, *lnmock.MockLightningNode, *database.ReverseSwap) {
+			nursery := setup(t)
+			nursery.paymentRetryDelay = 0
+			nursery.maxRoutingFeePpm = 100
+			nursery.SetPaymentRetries(PaymentRetries{Attempts: 3, FeeLimitCeilingPpm: 300, MaxParts: 4})
+			mockLightning := lnmock.NewMockLightningNode(t)
+			mockLightning.EXPECT().
+				PaymentStatus(mock.Anything).
+				Return(&lightning.PaymentStatus{State: lightning.PaymentFailed, FailureReason: "FAILURE_REASON_NO_ROUTE", Hops: hops}, nil)
+			nursery.lightning = mockLightning
+			swap := &database.ReverseSwap{Id: "test-swap", Invoice: testInvoice, TenantId: database.DefaultTenantId}
+			require.NoError(t, nursery.database.CreateReverseSwap(*swap))
+			return nursery, mockLightning, swap
+		}
+
+		t.Run("Escalate", func(t *testing.T) {
+			nursery, mockLightning, swap := setup(t)
+			mockLightning.EXPECT().
+				PayInvoice(mock.Anything, testInvoice, uint(100), mock.Anything, mock.Anything, uint32(4)).
+				Return(nil, errors.New("no route")).Once()
+			mockLightning.EXPECT().
+				PayInvoice(mock.Anything, testInvoice, uint(200), mock.Anything, mock.Anything, uint32(4)).
+				Return(&lightning.PayInvoiceResponse{FeeMsat: 150_000}, nil).Once()
+
+			require.NoError(t, nursery.payReverseSwap(swap))
+			nursery.waitGroup.Wait()
+
+			attempts, err := nursery.database.QueryPaymentAttempts(swap.Id)
+			require.NoError(t, err)
+			require.Len(t, attempts, 2)
+			require.Equal(t, lightning.PaymentFailed, attempts[0].State)
+			require.Equal(t, "no route", attempts[0].Error)
+			require.Equal(t, "FAILURE_REASON_NO_ROUTE", attempts[0].FailureReason)
+			require.Equal(t, hops, attempts[0].Hops)
+			require.Equal(t, uint64(100), attempts[0].FeeLimit)
+			require.Equal(t, uint32(4), *attempts[0].MaxParts)
+			require.Equal(t, lightning.PaymentSucceeded, attempts[1].State)
+			require.Equal(t, uint64(150_000), *attempts[1].FeeMsat)
+			require.Empty(t, attempts[1].FailureReason)
+		})
+
+		t.Run("Exhausted", func(t *testing.T) {
+			nursery, mockLightning, swap := setup(t)
+			for _, feeLimit := range []uint{100, 200, 300} {
+				mockLightning.EXPECT().
+					PayInvoice(mock.Anything, testInvoice, feeLimit, mock.Anything, mock.Anything, uint32(4)).
+					Return(nil, errors.New("no route")).Once()
+			}
+
+			require.NoError(t, nursery.payReverseSwap(swap))
+			nursery.waitGroup.Wait()
+
+			attempts, err := nursery.database.QueryPaymentAttempts(swap.Id)
+			require.NoError(t, err)
+			require.Len(t, attempts, 3)
+
+			stored, err := nursery.database.QueryReverseSwap(swap.Id)
+			require.NoError(t, err)
+			require.Equal(t, boltzrpc.SwapState_ERROR, stored.State)
+			require.Equal(t, "no route", stored.Error)
+		})
+
+		t.Run("SwapOverrides", func(t *testing.T) {
+			nursery, mockLightning, swap := setup(t)
+			ceiling := uint64(100)
+			maxParts := uint32(1)
+			swap.RoutingFeeLimitCeilingPpm = &ceiling
+			swap.MaxParts = &maxParts
+			mockLightning.EXPECT().
+				PayInvoice(mock.Anything, testInvoice, uint(100), mock.Anything, mock.Anything, maxParts).
+				Return(nil, errors.New("no route")).Times(3)
+
+			require.NoError(t, nursery.payReverseSwap(swap))
+			nursery.waitGroup.Wait()
+		})
+
+		t.Run("DefaultAttempts", func(t *testing.T) {
+			nursery, mockLightning, swap := setup(t)
+			nursery.SetPaymentRetries(PaymentRetries{})
+			// without a ceiling the payment is not retried
+			mockLightning.EXPECT().
+				PayInvoice(mock.Anything, testInvoice, uint(100), mock.Anything, mock.Anything, uint32(0)).
+				Return(nil, errors.New("no route")).Once()
+			require.NoError(t, nursery.payReverseSwap(swap))
+			nursery.waitGroup.Wait()
+
+			nursery, mockLightning, swap = setup(t)
+			nursery.SetPaymentRetries(PaymentRetries{FeeLimitCeilingPpm: 300})
+			for _, feeLimit := range []uint{100, 200, 300} {
+				mockLightning.EXPECT().
+					PayInvoice(mock.Anything, testInvoice, feeLimit, mock.Anything, mock.Anything, uint32(0)).
+					Return(nil, errors.New("no route")).Once()
+			}
+			require.NoError(t, nursery.payReverseSwap(swap))
+			nursery.waitGroup.Wait()
+		})
+	})
+
 	t.Run("ExternalPay", func(t *testing.T) {
 		nursery := setup(t)
 		swap := &database.ReverseSwap{
@@ -107,6 +208,38 @@ func TestPayReverseSwap(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, "no lightning node available to pay invoice", err.Error())
 	})
+
+	t.Run("LightningNode", func(t *testing.T) {
+		nursery := setup(t)
+		// the main node must not be used for swaps bound to another node
+		nursery.lightning = lnmock.NewMockLightningNode(t)
+		node := lnmock.NewMockLightningNode(t)
+		node.EXPECT().PaymentStatus(mock.Anything).Return(&lightning.PaymentStatus{State: lightning.PaymentPending}, nil)
+		nursery.AddLightningNode("other", node)
+
+		swap := &database.ReverseSwap{Invoice: testInvoice, LightningNode: "other"}
+		require.NoError(t, nursery.payReverseSwap(swap))
+
+		swap.LightningNode = "unknown"
+		require.Error(t, nursery.payReverseSwap(swap))
+	})
+}
+
+func TestRecordInvoiceSettlement(t *testing.T) {
+	nursery := setup(t)
+	swap := &database.Swap{Id: "test-swap", TenantId: database.DefaultTenantId}
+	require.NoError(t, nursery.database.CreateSwap(*swap))
+
+	// the swap can be completed more than once, for example when it was claimed in a batch
+	nursery.recordInvoiceSettlement(swap, 10_000)
+	nursery.recordInvoiceSettlement(swap, 10_000)
+
+	payments, err := nursery.database.QueryPaymentAttempts(swap.Id)
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	require.True(t, payments[0].Incoming)
+	require.Equal(t, lightning.PaymentSucceeded, payments[0].State)
+	require.Equal(t, uint64(10_000), payments[0].AmountSat)
 }
 
 func TestChooseDirectOutput(t *testing.T) {
@@ -281,3 +414,10 @@ func TestGetFeeEstimations(t *testing.T) {
 		})
 	}
 }
+
+func TestFeeLimitSchedule(t *testing.T) {
+	require.Equal(t, []uint64{100}, feeLimitSchedule(100, 500, 0))
+	require.Equal(t, []uint64{100, 300, 500}, feeLimitSchedule(100, 500, 3))
+	// a ceiling below the limit disables escalation
+	require.Equal(t, []uint64{100, 100}, feeLimitSchedule(100, 0, 2))
+}
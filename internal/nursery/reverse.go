@@ -40,23 +40,78 @@ func (nursery *Nursery) RegisterReverseSwap(reverseSwap database.ReverseSwap) er
 	return nil
 }
 
+const (
+	defaultPaymentRetryDelay = 5 * time.Second
+	// defaultEscalationAttempts is used when a fee limit ceiling is set but no number of attempts
+	defaultEscalationAttempts = 3
+)
+
+// PaymentRetries configures how the invoices of reverse swaps are paid.
+// Failed payments are retried with a fee limit which escalates linearly from the routing fee limit
+// to FeeLimitCeilingPpm over the configured number of attempts.
+type PaymentRetries struct {
+	// Attempts defaults to 1, or defaultEscalationAttempts if the fee limit can be escalated
+	Attempts           uint
+	FeeLimitCeilingPpm uint64
+	// MaxParts limits the number of parts a payment is split into. 0 lets the node decide
+	MaxParts uint32
+}
+
+func (nursery *Nursery) SetPaymentRetries(retries PaymentRetries) {
+	nursery.paymentRetries = retries
+}
+
+// feeLimitSchedule returns the fee limit in ppm to use for each payment attempt
+func feeLimitSchedule(basePpm uint64, ceilingPpm uint64, attempts uint) []uint64 {
+	attempts = max(attempts, 1)
+	ceilingPpm = max(ceilingPpm, basePpm)
+	schedule := make([]uint64, attempts)
+	for i := range schedule {
+		schedule[i] = basePpm
+		if attempts > 1 {
+			schedule[i] += (ceilingPpm - basePpm) * uint64(i) / uint64(attempts-1)
+		}
+	}
+	return schedule
+}
+
 func (nursery *Nursery) payReverseSwap(reverseSwap *database.ReverseSwap) error {
 	if reverseSwap.ExternalPay {
 		return nil
 	}
-	if nursery.lightning == nil {
+	node := nursery.lightningNode(reverseSwap.LightningNode)
+	if node == nil {
 		return fmt.Errorf("no lightning node available to pay invoice")
 	}
 	feeLimitPpm := nursery.maxRoutingFeePpm
 	if reverseSwap.RoutingFeeLimitPpm != nil {
 		feeLimitPpm = *reverseSwap.RoutingFeeLimitPpm
 	}
-	feeLimit, err := lightning.CalculateFeeLimit(reverseSwap.Invoice, nursery.network.Btc, feeLimitPpm)
-	if err != nil {
-		return err
+	ceilingPpm := nursery.paymentRetries.FeeLimitCeilingPpm
+	if reverseSwap.RoutingFeeLimitCeilingPpm != nil {
+		ceilingPpm = *reverseSwap.RoutingFeeLimitCeilingPpm
+	}
+	maxParts := nursery.paymentRetries.MaxParts
+	if reverseSwap.MaxParts != nil {
+		maxParts = *reverseSwap.MaxParts
+	}
+	var feeLimits []uint
+	attempts := nursery.paymentRetries.Attempts
+	if attempts == 0 {
+		attempts = 1
+		if ceilingPpm > feeLimitPpm {
+			attempts = defaultEscalationAttempts
+		}
+	}
+	for _, ppm := range feeLimitSchedule(feeLimitPpm, ceilingPpm, attempts) {
+		feeLimit, err := lightning.CalculateFeeLimit(reverseSwap.Invoice, nursery.network.Btc, ppm)
+		if err != nil {
+			return err
+		}
+		feeLimits = append(feeLimits, feeLimit)
 	}
 
-	status, err := nursery.lightning.PaymentStatus(reverseSwap.PreimageHash())
+	status, err := node.PaymentStatus(reverseSwap.PreimageHash())
 	if err == nil && status.State != lightning.PaymentFailed {
 		logger.Debugf("Reverse Swap %s is already being paid", reverseSwap.Id)
 		return nil
@@ -65,33 +120,106 @@ func (nursery *Nursery) payReverseSwap(reverseSwap *database.ReverseSwap) error
 	nursery.waitGroup.Add(1)
 	go func() {
 		defer nursery.waitGroup.Done()
-		logger.Debugf("Paying invoice of Reverse Swap %s", reverseSwap.Id)
-		payment, err := nursery.lightning.PayInvoice(nursery.ctx, reverseSwap.Invoice, feeLimit, 30, reverseSwap.ChanIds)
-		if err != nil {
-			if nursery.ctx.Err() == nil {
-				logger.Errorf("Could not pay invoice %s: %v", reverseSwap.Invoice, err)
-
-				if dbErr := nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_ERROR, err.Error()); dbErr != nil {
-					logger.Error("Could not update Reverse Swap state: " + dbErr.Error())
+		var err error
+		for i, feeLimit := range feeLimits {
+			if i > 0 {
+				select {
+				case <-nursery.ctx.Done():
 					return
+				case <-time.After(nursery.paymentRetryDelay):
 				}
-				reverseSwap, err := nursery.database.QueryReverseSwap(reverseSwap.Id)
-				if err != nil {
-					logger.Error("Could not query Reverse Swap: " + err.Error())
+				// the previous attempt might still be in flight or have succeeded after all
+				status, statusErr := node.PaymentStatus(reverseSwap.PreimageHash())
+				if statusErr == nil && status.State != lightning.PaymentFailed {
+					logger.Debugf("Not retrying payment of Reverse Swap %s since it is %s", reverseSwap.Id, status.State)
 					return
 				}
-				nursery.sendReverseSwapUpdate(*reverseSwap)
 			}
-		} else {
-			logger.Info("Paid invoice of Reverse Swap " + reverseSwap.Id + " with fee of " + utils.FormatMilliSat(int64(payment.FeeMsat)) + " satoshis")
+			var payment *lightning.PayInvoiceResponse
+			payment, err = nursery.attemptPayment(node, reverseSwap, uint(i+1), feeLimit, maxParts)
+			if nursery.ctx.Err() != nil {
+				return
+			}
+			if err == nil {
+				logger.Info("Paid invoice of Reverse Swap " + reverseSwap.Id + " with fee of " + utils.FormatMilliSat(int64(payment.FeeMsat)) + " satoshis")
+				return
+			}
+			logger.Warnf("Attempt %d/%d to pay invoice of Reverse Swap %s with fee limit of %d sats failed: %v", i+1, len(feeLimits), reverseSwap.Id, feeLimit, err)
+		}
+
+		logger.Errorf("Could not pay invoice %s: %v", reverseSwap.Invoice, err)
+
+		if dbErr := nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_ERROR, err.Error()); dbErr != nil {
+			logger.Error("Could not update Reverse Swap state: " + dbErr.Error())
+			return
 		}
+		reverseSwap, err := nursery.database.QueryReverseSwap(reverseSwap.Id)
+		if err != nil {
+			logger.Error("Could not query Reverse Swap: " + err.Error())
+			return
+		}
+		nursery.sendReverseSwapUpdate(*reverseSwap)
 	}()
 	return nil
 }
 
+// attemptPayment pays the invoice of the swap once and records the outcome in the database
+func (nursery *Nursery) attemptPayment(
+	node lightning.LightningNode,
+	reverseSwap *database.ReverseSwap,
+	attempt uint,
+	feeLimit uint,
+	maxParts uint32,
+) (*lightning.PayInvoiceResponse, error) {
+	record := &database.PaymentAttempt{
+		SwapId:    reverseSwap.Id,
+		Attempt:   attempt,
+		FeeLimit:  uint64(feeLimit),
+		State:     lightning.PaymentPending,
+		CreatedAt: time.Now(),
+		AmountSat: reverseSwap.InvoiceAmount,
+	}
+	if maxParts != 0 {
+		record.MaxParts = &maxParts
+	}
+	if err := nursery.database.CreatePaymentAttempt(record); err != nil {
+		logger.Errorf("Could not save payment attempt of Reverse Swap %s: %v", reverseSwap.Id, err)
+	}
+
+	logger.Debugf("Paying invoice of Reverse Swap %s (attempt %d)", reverseSwap.Id, attempt)
+	payment, err := node.PayInvoice(nursery.ctx, reverseSwap.Invoice, feeLimit, 30, reverseSwap.ChanIds, maxParts)
+	if nursery.ctx.Err() != nil {
+		// the outcome is unknown, so the attempt stays pending
+		return nil, err
+	}
+	if err != nil {
+		record.State = lightning.PaymentFailed
+		record.Error = err.Error()
+	} else {
+		feeMsat := uint64(payment.FeeMsat)
+		record.State = lightning.PaymentSucceeded
+		record.FeeMsat = &feeMsat
+	}
+	// the node knows the route and why it failed, which the error of the payment call does not always tell
+	if status, statusErr := node.PaymentStatus(reverseSwap.PreimageHash()); statusErr == nil {
+		record.Hops = status.Hops
+		if record.State == lightning.PaymentFailed {
+			record.FailureReason = status.FailureReason
+		}
+	} else {
+		logger.Debugf("Could not get status of payment of Reverse Swap %s: %v", reverseSwap.Id, statusErr)
+	}
+	if record.Id != 0 {
+		if dbErr := nursery.database.UpdatePaymentAttempt(record); dbErr != nil {
+			logger.Errorf("Could not update payment attempt of Reverse Swap %s: %v", reverseSwap.Id, dbErr)
+		}
+	}
+	return payment, err
+}
+
 func (nursery *Nursery) finalizeReverseSwap(reverseSwap *database.ReverseSwap) error {
-	if nursery.lightning != nil && !reverseSwap.ExternalPay {
-		status, err := nursery.lightning.PaymentStatus(reverseSwap.PreimageHash())
+	if node := nursery.lightningNode(reverseSwap.LightningNode); node != nil && !reverseSwap.ExternalPay {
+		status, err := node.PaymentStatus(reverseSwap.PreimageHash())
 		if err != nil {
 			return fmt.Errorf("could not get payment status: %w", err)
 		} else if status.State == lightning.PaymentSucceeded {
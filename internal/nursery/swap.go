@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
@@ -239,16 +241,18 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, parsedStatus boltz
 			return
 		}
 
-		if nursery.lightning == nil {
+		node := nursery.lightningNode(swap.LightningNode)
+		if node == nil {
 			handleError("No lightning node available, can not create invoice for Swap " + swap.Id)
 			return
 		}
 
-		invoice, err := nursery.lightning.CreateInvoice(
+		invoice, err := node.CreateInvoice(
 			swapRates.InvoiceAmount,
 			swap.Preimage,
 			boltz.CalculateInvoiceExpiry(swap.TimoutBlockHeight-blockHeight, swap.Pair.From),
 			utils.GetSwapMemo(string(swap.Pair.From)),
+			swap.RouteHints,
 		)
 
 		if err != nil {
@@ -334,6 +338,8 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, parsedStatus boltz
 			}
 		}
 
+		nursery.recordInvoiceSettlement(swap, decodedInvoice.AmountSat)
+
 		invoiceAmount := int64(decodedInvoice.AmountSat)
 		serviceFee := boltz.CalculatePercentage(swap.ServiceFeePercent, invoiceAmount)
 		boltzOnchainFee := int64(swap.ExpectedAmount) - invoiceAmount - serviceFee
@@ -372,3 +378,26 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, parsedStatus boltz
 	}
 	nursery.sendSwapUpdate(*swap)
 }
+
+// recordInvoiceSettlement stores that Boltz paid the invoice of the swap, once
+func (nursery *Nursery) recordInvoiceSettlement(swap *database.Swap, amount uint64) {
+	payments, err := nursery.database.QueryPaymentAttempts(swap.Id)
+	if err != nil {
+		logger.Errorf("Could not query payments of Swap %s: %v", swap.Id, err)
+		return
+	}
+	if slices.ContainsFunc(payments, func(payment *database.PaymentAttempt) bool { return payment.Incoming }) {
+		return
+	}
+	err = nursery.database.CreatePaymentAttempt(&database.PaymentAttempt{
+		SwapId:    swap.Id,
+		Attempt:   1,
+		State:     lightning.PaymentSucceeded,
+		CreatedAt: time.Now(),
+		Incoming:  true,
+		AmountSat: amount,
+	})
+	if err != nil {
+		logger.Errorf("Could not save invoice settlement of Swap %s: %v", swap.Id, err)
+	}
+}
@@ -0,0 +1,236 @@
+package offers
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
+)
+
+const DefaultPollInterval = 30 * time.Second
+
+// MaxSwapAttempts is how often the creation of the reverse swap for a payment is attempted before giving up
+const MaxSwapAttempts = 5
+
+type RpcProvider interface {
+	GetLightningNode(name string) (lightning.LightningNode, error)
+	CreateOfferSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error)
+}
+
+// Offers watches the BOLT12 offers created by the daemon and swaps every payment
+// to one of them into the wallet of the offer with a reverse swap paid by the receiving node.
+type Offers struct {
+	database     *database.Database
+	rpc          RpcProvider
+	pollInterval time.Duration
+
+	// lock prevents a payment from being claimed twice when it is checked concurrently
+	lock sync.Mutex
+	stop chan struct{}
+}
+
+func New(db *database.Database, rpc RpcProvider) *Offers {
+	return &Offers{
+		database:     db,
+		rpc:          rpc,
+		pollInterval: DefaultPollInterval,
+	}
+}
+
+type CreateRequest struct {
+	TenantId      database.Id
+	LightningNode string
+	WalletId      database.Id
+	Currency      boltz.Currency
+	Description   string
+	AmountSat     uint64
+}
+
+func (offers *Offers) Create(request CreateRequest) (*database.Offer, error) {
+	node, err := offers.rpc.GetLightningNode(request.LightningNode)
+	if err != nil {
+		return nil, err
+	}
+	created, err := node.CreateOffer(request.AmountSat, request.Description)
+	if err != nil {
+		if errors.Is(err, lightning.ErrUnsupported) {
+			return nil, fmt.Errorf("%s does not support offers: %w", node.Name(), err)
+		}
+		return nil, fmt.Errorf("could not create offer: %w", err)
+	}
+	offer := &database.Offer{
+		Id:            created.Id,
+		Offer:         created.Offer,
+		TenantId:      request.TenantId,
+		LightningNode: request.LightningNode,
+		WalletId:      request.WalletId,
+		Currency:      request.Currency,
+		Description:   request.Description,
+		AmountSat:     request.AmountSat,
+		Active:        true,
+	}
+	if err := offers.database.CreateOffer(offer); err != nil {
+		return nil, err
+	}
+	logger.Infof("Created offer %s on lightning node %s", offer.Id, node.Name())
+	return offer, nil
+}
+
+// Disable disables the offer on the node. Payments which were received before are still swapped
+func (offers *Offers) Disable(offer *database.Offer) error {
+	if !offer.Active {
+		return nil
+	}
+	node, err := offers.rpc.GetLightningNode(offer.LightningNode)
+	if err != nil {
+		return err
+	}
+	if err := offers.checkOffer(node, offer); err != nil {
+		logger.Warnf("Could not check payments of offer %s before disabling it: %v", offer.Id, err)
+	}
+	if err := node.DisableOffer(offer.Id); err != nil {
+		return fmt.Errorf("could not disable offer: %w", err)
+	}
+	if err := offers.database.SetOfferActive(offer.Id, false); err != nil {
+		return err
+	}
+	offer.Active = false
+	logger.Infof("Disabled offer %s", offer.Id)
+	return nil
+}
+
+func (offers *Offers) Start() {
+	stop := make(chan struct{})
+	offers.stop = stop
+	go func() {
+		ticker := time.NewTicker(offers.pollInterval)
+		defer ticker.Stop()
+		for {
+			if err := offers.Check(); err != nil {
+				logger.Errorf("Could not check offers: %v", err)
+			}
+			select {
+			case <-ticker.C:
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+func (offers *Offers) Stop() {
+	if offers.stop != nil {
+		close(offers.stop)
+		offers.stop = nil
+	}
+}
+
+// Check creates reverse swaps for all payments to active offers which were not swapped yet
+func (offers *Offers) Check() error {
+	active := true
+	all, err := offers.database.QueryOffers(database.OfferQuery{Active: &active})
+	if err != nil {
+		return err
+	}
+	for _, offer := range all {
+		node, err := offers.rpc.GetLightningNode(offer.LightningNode)
+		if err != nil {
+			logger.Warnf("Lightning node of offer %s is not available: %v", offer.Id, err)
+			continue
+		}
+		if err := offers.checkOffer(node, offer); err != nil {
+			logger.Errorf("Could not check payments of offer %s: %v", offer.Id, err)
+		}
+	}
+	return nil
+}
+
+func (offers *Offers) checkOffer(node lightning.LightningNode, offer *database.Offer) error {
+	payments, err := node.ListOfferPayments(offer.Id)
+	if err != nil {
+		return err
+	}
+	for _, payment := range payments {
+		claimed, err := offers.claimPayment(offer, payment)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		swapId, swapErr := offers.swapPayment(offer, payment)
+		if err := offers.database.SetOfferPaymentSwap(payment.PaymentHash, swapId, swapErr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// claimPayment stores the payment as pending before its reverse swap is created, so that it is never swapped twice.
+// Payments whose swap could not be created are claimed again until MaxSwapAttempts is reached.
+// A payment whose swap creation was interrupted stays pending and has to be looked into manually
+func (offers *Offers) claimPayment(offer *database.Offer, payment *lightning.OfferPayment) (bool, error) {
+	offers.lock.Lock()
+	defer offers.lock.Unlock()
+
+	existing, err := offers.database.GetOfferPayment(payment.PaymentHash)
+	if err == nil {
+		if existing.SwapId != "" || existing.Error == "" || existing.Attempts >= MaxSwapAttempts {
+			return false, nil
+		}
+		if err := offers.database.RetryOfferPayment(payment.PaymentHash); err != nil {
+			return false, err
+		}
+		logger.Infof("Retrying reverse swap for payment to offer %s (attempt %d)", offer.Id, existing.Attempts+1)
+		return true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return false, err
+	}
+	err = offers.database.CreateOfferPayment(&database.OfferPayment{
+		PaymentHash: payment.PaymentHash,
+		OfferId:     offer.Id,
+		AmountSat:   payment.AmountSat,
+		Attempts:    1,
+	})
+	if err != nil {
+		return false, err
+	}
+	logger.Infof("Received payment of %d sats to offer %s", payment.AmountSat, offer.Id)
+	return true, nil
+}
+
+// swapPayment creates the reverse swap for a payment and returns its id or the reason it could not be created
+func (offers *Offers) swapPayment(offer *database.Offer, payment *lightning.OfferPayment) (string, string) {
+	tenant, err := offers.database.GetTenant(offer.TenantId)
+	if err != nil {
+		return "", fmt.Sprintf("could not get tenant: %v", err)
+	}
+	request := &boltzrpc.CreateReverseSwapRequest{
+		Amount: payment.AmountSat,
+		Pair: &boltzrpc.Pair{
+			From: boltzrpc.Currency_BTC,
+			To:   serializers.SerializeCurrency(offer.Currency),
+		},
+		WalletId:    &offer.WalletId,
+		Description: &offer.Description,
+	}
+	if offer.LightningNode != "" {
+		request.LightningNode = &offer.LightningNode
+	}
+	swapId, err := offers.rpc.CreateOfferSwap(tenant, request)
+	if err != nil {
+		logger.Warnf("Could not create reverse swap for payment to offer %s: %v", offer.Id, err)
+		return "", err.Error()
+	}
+	logger.Infof("Created reverse swap %s for payment to offer %s", swapId, offer.Id)
+	return swapId, ""
+}
@@ -0,0 +1,189 @@
+package offers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	lnmock "github.com/BoltzExchange/boltz-client/v2/internal/mocks/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testProvider struct {
+	node     *lnmock.MockLightningNode
+	requests []*boltzrpc.CreateReverseSwapRequest
+	swapErr  error
+	onSwap   func()
+}
+
+func (p *testProvider) GetLightningNode(name string) (lightning.LightningNode, error) {
+	if name != "" {
+		return nil, errors.New("lightning node not found")
+	}
+	return p.node, nil
+}
+
+func (p *testProvider) CreateOfferSwap(_ *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
+	if p.onSwap != nil {
+		p.onSwap()
+	}
+	if p.swapErr != nil {
+		return "", p.swapErr
+	}
+	p.requests = append(p.requests, request)
+	return "swapId", nil
+}
+
+func setup(t *testing.T) (*Offers, *testProvider) {
+	db := &database.Database{Path: "file:" + t.TempDir() + "?mode=memory&cache=shared"}
+	require.NoError(t, db.Connect())
+	node := lnmock.NewMockLightningNode(t)
+	node.EXPECT().Name().Return("CLN").Maybe()
+	provider := &testProvider{node: node}
+	return New(db, provider), provider
+}
+
+func createOffer(t *testing.T, offers *Offers, provider *testProvider) *database.Offer {
+	provider.node.EXPECT().CreateOffer(uint64(0), "donations").Return(&lightning.CreateOfferResponse{
+		Id:    "offerId",
+		Offer: "lno1",
+	}, nil)
+	offer, err := offers.Create(CreateRequest{
+		TenantId:    database.DefaultTenantId,
+		WalletId:    1,
+		Currency:    boltz.CurrencyLiquid,
+		Description: "donations",
+	})
+	require.NoError(t, err)
+	return offer
+}
+
+func TestCreate(t *testing.T) {
+	offers, provider := setup(t)
+	offer := createOffer(t, offers, provider)
+	require.True(t, offer.Active)
+
+	stored, err := offers.database.GetOffer(offer.Id)
+	require.NoError(t, err)
+	require.Equal(t, "lno1", stored.Offer)
+	require.Equal(t, boltz.CurrencyLiquid, stored.Currency)
+
+	provider.node.EXPECT().CreateOffer(uint64(0), "").Return(nil, lightning.ErrUnsupported)
+	_, err = offers.Create(CreateRequest{TenantId: database.DefaultTenantId})
+	require.ErrorIs(t, err, lightning.ErrUnsupported)
+
+	_, err = offers.Create(CreateRequest{TenantId: database.DefaultTenantId, LightningNode: "unknown"})
+	require.Error(t, err)
+}
+
+func TestCheck(t *testing.T) {
+	payment := &lightning.OfferPayment{PaymentHash: []byte{1}, AmountSat: 100_000, PaidAt: time.Now()}
+
+	t.Run("Swap", func(t *testing.T) {
+		offers, provider := setup(t)
+		offer := createOffer(t, offers, provider)
+
+		provider.node.EXPECT().ListOfferPayments(offer.Id).Return([]*lightning.OfferPayment{payment}, nil)
+		provider.onSwap = func() {
+			// the payment is claimed before the swap is created
+			pending, err := offers.database.GetOfferPayment(payment.PaymentHash)
+			require.NoError(t, err)
+			require.Empty(t, pending.SwapId)
+			require.Empty(t, pending.Error)
+		}
+		require.NoError(t, offers.Check())
+		// payments are only swapped once
+		require.NoError(t, offers.Check())
+
+		require.Len(t, provider.requests, 1)
+		request := provider.requests[0]
+		require.Equal(t, payment.AmountSat, request.Amount)
+		require.Equal(t, boltzrpc.Currency_LBTC, request.Pair.To)
+		require.Equal(t, offer.WalletId, request.GetWalletId())
+		require.Nil(t, request.LightningNode)
+
+		payments, err := offers.database.QueryOfferPayments(offer.Id)
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		require.Equal(t, "swapId", payments[0].SwapId)
+		require.Empty(t, payments[0].Error)
+	})
+
+	t.Run("SwapFailed", func(t *testing.T) {
+		offers, provider := setup(t)
+		offer := createOffer(t, offers, provider)
+		provider.swapErr = errors.New("amount too low")
+
+		provider.node.EXPECT().ListOfferPayments(offer.Id).Return([]*lightning.OfferPayment{payment}, nil)
+		require.NoError(t, offers.Check())
+
+		payments, err := offers.database.QueryOfferPayments(offer.Id)
+		require.NoError(t, err)
+		require.Len(t, payments, 1)
+		require.Empty(t, payments[0].SwapId)
+		require.Equal(t, "amount too low", payments[0].Error)
+		require.Equal(t, uint64(1), payments[0].Attempts)
+
+		// failed payments are retried until the attempts run out
+		for range MaxSwapAttempts + 2 {
+			require.NoError(t, offers.Check())
+		}
+		stored, err := offers.database.GetOfferPayment(payment.PaymentHash)
+		require.NoError(t, err)
+		require.Equal(t, uint64(MaxSwapAttempts), stored.Attempts)
+		require.Equal(t, "amount too low", stored.Error)
+
+		provider.swapErr = nil
+		require.NoError(t, offers.Check())
+		require.Empty(t, provider.requests)
+	})
+
+	t.Run("SwapRetried", func(t *testing.T) {
+		offers, provider := setup(t)
+		offer := createOffer(t, offers, provider)
+		provider.swapErr = errors.New("boltz unavailable")
+
+		provider.node.EXPECT().ListOfferPayments(offer.Id).Return([]*lightning.OfferPayment{payment}, nil)
+		require.NoError(t, offers.Check())
+
+		provider.swapErr = nil
+		provider.onSwap = func() {
+			// the error of the previous attempt is cleared while the swap is created again
+			pending, err := offers.database.GetOfferPayment(payment.PaymentHash)
+			require.NoError(t, err)
+			require.Empty(t, pending.Error)
+			require.Equal(t, uint64(2), pending.Attempts)
+		}
+		require.NoError(t, offers.Check())
+		require.NoError(t, offers.Check())
+		require.Len(t, provider.requests, 1)
+
+		stored, err := offers.database.GetOfferPayment(payment.PaymentHash)
+		require.NoError(t, err)
+		require.Equal(t, "swapId", stored.SwapId)
+		require.Empty(t, stored.Error)
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		offers, provider := setup(t)
+		offer := createOffer(t, offers, provider)
+
+		// payments received before the offer is disabled are still swapped
+		provider.node.EXPECT().ListOfferPayments(offer.Id).Return([]*lightning.OfferPayment{payment}, nil).Once()
+		provider.node.EXPECT().DisableOffer(offer.Id).Return(nil)
+		require.NoError(t, offers.Disable(offer))
+		require.False(t, offer.Active)
+		require.Len(t, provider.requests, 1)
+
+		// disabled offers are not checked anymore
+		require.NoError(t, offers.Check())
+
+		stored, err := offers.database.GetOffer(offer.Id)
+		require.NoError(t, err)
+		require.False(t, stored.Active)
+	})
+}
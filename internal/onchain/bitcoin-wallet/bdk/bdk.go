@@ -382,6 +382,42 @@ func uniffiCheckChecksums() {
 			panic("bdk: uniffi_bdk_checksum_method_wallet_bump_transaction_fee: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_bdk_checksum_method_wallet_confirmed_utxo_count()
+		})
+		if checksum != 41621 {
+			// If this happens try cleaning and rebuilding your project
+			panic("bdk: uniffi_bdk_checksum_method_wallet_confirmed_utxo_count: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_bdk_checksum_method_wallet_consolidate()
+		})
+		if checksum != 62997 {
+			// If this happens try cleaning and rebuilding your project
+			panic("bdk: uniffi_bdk_checksum_method_wallet_consolidate: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_bdk_checksum_method_wallet_create_psbt()
+		})
+		if checksum != 39421 {
+			// If this happens try cleaning and rebuilding your project
+			panic("bdk: uniffi_bdk_checksum_method_wallet_create_psbt: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_bdk_checksum_method_wallet_finalize_psbt()
+		})
+		if checksum != 21147 {
+			// If this happens try cleaning and rebuilding your project
+			panic("bdk: uniffi_bdk_checksum_method_wallet_finalize_psbt: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_bdk_checksum_method_wallet_full_scan()
@@ -418,6 +454,15 @@ func uniffiCheckChecksums() {
 			panic("bdk: uniffi_bdk_checksum_method_wallet_send_to_address: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_bdk_checksum_method_wallet_sign_psbt()
+		})
+		if checksum != 38312 {
+			// If this happens try cleaning and rebuilding your project
+			panic("bdk: uniffi_bdk_checksum_method_wallet_sign_psbt: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_bdk_checksum_method_wallet_sync()
@@ -758,10 +803,15 @@ type WalletInterface interface {
 	ApplyTransaction(txHex string) error
 	Balance() (Balance, error)
 	BumpTransactionFee(txId string, satPerVbyte float64) (string, error)
+	ConfirmedUtxoCount() (uint64, error)
+	Consolidate(maxUtxos uint64, satPerVbyte float64) (WalletSendResult, error)
+	CreatePsbt(address string, amount uint64, satPerVbyte float64, sendAll bool) (WalletPsbt, error)
+	FinalizePsbt(psbts []string) (string, error)
 	FullScan(chainClient *ChainClient) error
 	GetTransactions(limit uint64, offset uint64) ([]WalletTransaction, error)
 	NewAddress() (string, error)
 	SendToAddress(address string, amount uint64, satPerVbyte float64, sendAll bool) (WalletSendResult, error)
+	SignPsbt(psbt string) (WalletPsbt, error)
 	Sync(chainClient *ChainClient) error
 }
 type Wallet struct {
@@ -825,6 +875,72 @@ func (_self *Wallet) BumpTransactionFee(txId string, satPerVbyte float64) (strin
 	}
 }
 
+func (_self *Wallet) ConfirmedUtxoCount() (uint64, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) C.uint64_t {
+		return C.uniffi_bdk_fn_method_wallet_confirmed_utxo_count(
+			_pointer, _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue uint64
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterUint64INSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *Wallet) Consolidate(maxUtxos uint64, satPerVbyte float64) (WalletSendResult, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_bdk_fn_method_wallet_consolidate(
+				_pointer, FfiConverterUint64INSTANCE.Lower(maxUtxos), FfiConverterFloat64INSTANCE.Lower(satPerVbyte), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue WalletSendResult
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterWalletSendResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *Wallet) CreatePsbt(address string, amount uint64, satPerVbyte float64, sendAll bool) (WalletPsbt, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_bdk_fn_method_wallet_create_psbt(
+				_pointer, FfiConverterStringINSTANCE.Lower(address), FfiConverterUint64INSTANCE.Lower(amount), FfiConverterFloat64INSTANCE.Lower(satPerVbyte), FfiConverterBoolINSTANCE.Lower(sendAll), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue WalletPsbt
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterWalletPsbtINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+func (_self *Wallet) FinalizePsbt(psbts []string) (string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_bdk_fn_method_wallet_finalize_psbt(
+				_pointer, FfiConverterSequenceStringINSTANCE.Lower(psbts), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
 func (_self *Wallet) FullScan(chainClient *ChainClient) error {
 	_pointer := _self.ffiObject.incrementPointer("*Wallet")
 	defer _self.ffiObject.decrementPointer()
@@ -887,6 +1003,23 @@ func (_self *Wallet) SendToAddress(address string, amount uint64, satPerVbyte fl
 	}
 }
 
+func (_self *Wallet) SignPsbt(psbt string) (WalletPsbt, error) {
+	_pointer := _self.ffiObject.incrementPointer("*Wallet")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[Error](FfiConverterError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_bdk_fn_method_wallet_sign_psbt(
+				_pointer, FfiConverterStringINSTANCE.Lower(psbt), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue WalletPsbt
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterWalletPsbtINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
 func (_self *Wallet) Sync(chainClient *ChainClient) error {
 	_pointer := _self.ffiObject.incrementPointer("*Wallet")
 	defer _self.ffiObject.decrementPointer()
@@ -1026,6 +1159,58 @@ func (_ FfiDestroyerWalletCredentials) Destroy(value WalletCredentials) {
 	value.Destroy()
 }
 
+type WalletPsbt struct {
+	Psbt       string
+	Fee        uint64
+	SendAmount uint64
+	IsComplete bool
+	Recipients []WalletTransactionOutput
+}
+
+func (r *WalletPsbt) Destroy() {
+	FfiDestroyerString{}.Destroy(r.Psbt)
+	FfiDestroyerUint64{}.Destroy(r.Fee)
+	FfiDestroyerUint64{}.Destroy(r.SendAmount)
+	FfiDestroyerBool{}.Destroy(r.IsComplete)
+	FfiDestroyerSequenceWalletTransactionOutput{}.Destroy(r.Recipients)
+}
+
+type FfiConverterWalletPsbt struct{}
+
+var FfiConverterWalletPsbtINSTANCE = FfiConverterWalletPsbt{}
+
+func (c FfiConverterWalletPsbt) Lift(rb RustBufferI) WalletPsbt {
+	return LiftFromRustBuffer[WalletPsbt](c, rb)
+}
+
+func (c FfiConverterWalletPsbt) Read(reader io.Reader) WalletPsbt {
+	return WalletPsbt{
+		FfiConverterStringINSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterUint64INSTANCE.Read(reader),
+		FfiConverterBoolINSTANCE.Read(reader),
+		FfiConverterSequenceWalletTransactionOutputINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterWalletPsbt) Lower(value WalletPsbt) C.RustBuffer {
+	return LowerIntoRustBuffer[WalletPsbt](c, value)
+}
+
+func (c FfiConverterWalletPsbt) Write(writer io.Writer, value WalletPsbt) {
+	FfiConverterStringINSTANCE.Write(writer, value.Psbt)
+	FfiConverterUint64INSTANCE.Write(writer, value.Fee)
+	FfiConverterUint64INSTANCE.Write(writer, value.SendAmount)
+	FfiConverterBoolINSTANCE.Write(writer, value.IsComplete)
+	FfiConverterSequenceWalletTransactionOutputINSTANCE.Write(writer, value.Recipients)
+}
+
+type FfiDestroyerWalletPsbt struct{}
+
+func (_ FfiDestroyerWalletPsbt) Destroy(value WalletPsbt) {
+	value.Destroy()
+}
+
 type WalletSendResult struct {
 	TxHex      string
 	Fee        uint64
@@ -1343,6 +1528,49 @@ func (_ FfiDestroyerOptionalString) Destroy(value *string) {
 	}
 }
 
+type FfiConverterSequenceString struct{}
+
+var FfiConverterSequenceStringINSTANCE = FfiConverterSequenceString{}
+
+func (c FfiConverterSequenceString) Lift(rb RustBufferI) []string {
+	return LiftFromRustBuffer[[]string](c, rb)
+}
+
+func (c FfiConverterSequenceString) Read(reader io.Reader) []string {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]string, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterStringINSTANCE.Read(reader))
+	}
+	return result
+}
+
+func (c FfiConverterSequenceString) Lower(value []string) C.RustBuffer {
+	return LowerIntoRustBuffer[[]string](c, value)
+}
+
+func (c FfiConverterSequenceString) Write(writer io.Writer, value []string) {
+	if len(value) > math.MaxInt32 {
+		panic("[]string is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterStringINSTANCE.Write(writer, item)
+	}
+}
+
+type FfiDestroyerSequenceString struct{}
+
+func (FfiDestroyerSequenceString) Destroy(sequence []string) {
+	for _, value := range sequence {
+		FfiDestroyerString{}.Destroy(value)
+	}
+}
+
 type FfiConverterSequenceWalletTransaction struct{}
 
 var FfiConverterSequenceWalletTransactionINSTANCE = FfiConverterSequenceWalletTransaction{}
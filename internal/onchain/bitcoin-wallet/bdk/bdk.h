@@ -423,6 +423,26 @@ RustBuffer uniffi_bdk_fn_method_wallet_balance(void* ptr, RustCallStatus *out_st
 RustBuffer uniffi_bdk_fn_method_wallet_bump_transaction_fee(void* ptr, RustBuffer tx_id, double sat_per_vbyte, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_CONFIRMED_UTXO_COUNT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_CONFIRMED_UTXO_COUNT
+uint64_t uniffi_bdk_fn_method_wallet_confirmed_utxo_count(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_CONSOLIDATE
+#define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_CONSOLIDATE
+RustBuffer uniffi_bdk_fn_method_wallet_consolidate(void* ptr, uint64_t max_utxos, double sat_per_vbyte, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_CREATE_PSBT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_CREATE_PSBT
+RustBuffer uniffi_bdk_fn_method_wallet_create_psbt(void* ptr, RustBuffer address, uint64_t amount, double sat_per_vbyte, int8_t send_all, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_FINALIZE_PSBT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_FINALIZE_PSBT
+RustBuffer uniffi_bdk_fn_method_wallet_finalize_psbt(void* ptr, RustBuffer psbts, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_FULL_SCAN
 #define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_FULL_SCAN
 void uniffi_bdk_fn_method_wallet_full_scan(void* ptr, void* chain_client, RustCallStatus *out_status
@@ -443,6 +463,11 @@ RustBuffer uniffi_bdk_fn_method_wallet_new_address(void* ptr, RustCallStatus *ou
 RustBuffer uniffi_bdk_fn_method_wallet_send_to_address(void* ptr, RustBuffer address, uint64_t amount, double sat_per_vbyte, int8_t send_all, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_SIGN_PSBT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_SIGN_PSBT
+RustBuffer uniffi_bdk_fn_method_wallet_sign_psbt(void* ptr, RustBuffer psbt, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_SYNC
 #define UNIFFI_FFIDEF_UNIFFI_BDK_FN_METHOD_WALLET_SYNC
 void uniffi_bdk_fn_method_wallet_sync(void* ptr, void* chain_client, RustCallStatus *out_status
@@ -755,6 +780,30 @@ uint16_t uniffi_bdk_checksum_method_wallet_balance(void
 #define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_BUMP_TRANSACTION_FEE
 uint16_t uniffi_bdk_checksum_method_wallet_bump_transaction_fee(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_CONFIRMED_UTXO_COUNT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_CONFIRMED_UTXO_COUNT
+uint16_t uniffi_bdk_checksum_method_wallet_confirmed_utxo_count(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_CONSOLIDATE
+#define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_CONSOLIDATE
+uint16_t uniffi_bdk_checksum_method_wallet_consolidate(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_CREATE_PSBT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_CREATE_PSBT
+uint16_t uniffi_bdk_checksum_method_wallet_create_psbt(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_FINALIZE_PSBT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_FINALIZE_PSBT
+uint16_t uniffi_bdk_checksum_method_wallet_finalize_psbt(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_FULL_SCAN
@@ -779,6 +828,12 @@ uint16_t uniffi_bdk_checksum_method_wallet_new_address(void
 #define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_SEND_TO_ADDRESS
 uint16_t uniffi_bdk_checksum_method_wallet_send_to_address(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_SIGN_PSBT
+#define UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_SIGN_PSBT
+uint16_t uniffi_bdk_checksum_method_wallet_sign_psbt(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_BDK_CHECKSUM_METHOD_WALLET_SYNC
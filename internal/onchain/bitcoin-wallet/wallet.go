@@ -29,13 +29,18 @@ type Wallet struct {
 }
 
 type Config struct {
-	Network       *boltz.Network
-	DataDir       string
-	Electrum      *onchain.ElectrumOptions
-	SyncInterval  time.Duration
-	ChainProvider onchain.ChainProvider
+	Network                *boltz.Network
+	DataDir                string
+	Electrum               *onchain.ElectrumOptions
+	SyncInterval           time.Duration
+	ChainProvider          onchain.ChainProvider
+	ConsolidationThreshold *uint64
+	// ConsolidationMaxFeeRate is the highest fee rate in sat/vbyte at which wallets are auto consolidated
+	ConsolidationMaxFeeRate *float64
 }
 
+const DefaultConsolidationMaxFeeRate = float64(5)
+
 func newChainClient(electrum *onchain.ElectrumOptions) (*bdk.ChainClient, error) {
 	url := electrum.Url
 	if electrum.SSL {
@@ -48,6 +53,18 @@ func newChainClient(electrum *onchain.ElectrumOptions) (*bdk.ChainClient, error)
 }
 
 func NewBackend(cfg Config) (*Backend, error) {
+	// auto consolidation is opt-in for bitcoin wallets
+	if cfg.ConsolidationThreshold == nil {
+		threshold := uint64(0)
+		cfg.ConsolidationThreshold = &threshold
+	}
+	if *cfg.ConsolidationThreshold == 1 {
+		return nil, errors.New("consolidation threshold has to be at least 2 utxos")
+	}
+	if cfg.ConsolidationMaxFeeRate == nil {
+		maxFeeRate := DefaultConsolidationMaxFeeRate
+		cfg.ConsolidationMaxFeeRate = &maxFeeRate
+	}
 	electrum := cfg.Electrum
 	if electrum == nil {
 		switch cfg.Network {
@@ -116,6 +133,11 @@ func (backend *Backend) NewWallet(credentials *onchain.WalletCredentials) (oncha
 	if credentials.Mnemonic != "" {
 		creds.Mnemonic = &credentials.Mnemonic
 		info.Readonly = false
+		requiresCosigners, err := onchain.RequiresCosigners(backend, credentials)
+		if err != nil {
+			return nil, err
+		}
+		info.RequiresCosigners = requiresCosigners
 	}
 	descriptorHash := sha256.Sum256([]byte(creds.CoreDescriptor))
 	// each wallet requires its own db
@@ -202,7 +224,106 @@ func (w *Wallet) SendToAddress(args onchain.WalletSendArgs) (string, error) {
 	return w.broadcastTransaction(result.TxHex)
 }
 
+func convertPsbt(psbt bdk.WalletPsbt) *onchain.PartiallySignedTransaction {
+	result := &onchain.PartiallySignedTransaction{
+		Psbt:       psbt.Psbt,
+		Fee:        psbt.Fee,
+		SendAmount: psbt.SendAmount,
+		IsComplete: psbt.IsComplete,
+	}
+	for _, recipient := range psbt.Recipients {
+		result.Recipients = append(result.Recipients, onchain.TransactionOutput{
+			Address: recipient.Address,
+			Amount:  recipient.Amount,
+		})
+	}
+	return result
+}
+
+func (w *Wallet) CreatePsbt(args onchain.WalletSendArgs) (*onchain.PartiallySignedTransaction, error) {
+	psbt, err := w.Wallet.CreatePsbt(args.Address, args.Amount, args.SatPerVbyte, args.SendAll)
+	if err != nil {
+		if strings.Contains(err.Error(), "Insufficient funds") {
+			return nil, w.info.InsufficientBalanceError(args.Amount)
+		}
+		return nil, err
+	}
+	return convertPsbt(psbt), nil
+}
+
+func (w *Wallet) SignPsbt(psbt string) (*onchain.PartiallySignedTransaction, error) {
+	signed, err := w.Wallet.SignPsbt(psbt)
+	if err != nil {
+		return nil, err
+	}
+	return convertPsbt(signed), nil
+}
+
+func (w *Wallet) FinalizePsbt(psbts []string) (string, error) {
+	w.sendLock.Lock()
+	defer w.sendLock.Unlock()
+
+	txHex, err := w.Wallet.FinalizePsbt(psbts)
+	if err != nil {
+		return "", err
+	}
+	return w.broadcastTransaction(txHex)
+}
+
+func (w *Wallet) Consolidate(maxUtxos uint64, satPerVbyte float64) (string, error) {
+	w.sendLock.Lock()
+	defer w.sendLock.Unlock()
+
+	result, err := w.Wallet.Consolidate(maxUtxos, satPerVbyte)
+	if err != nil {
+		return "", err
+	}
+	return w.broadcastTransaction(result.TxHex)
+}
+
+func (w *Wallet) autoConsolidate() error {
+	threshold := *w.backend.cfg.ConsolidationThreshold
+	// multisig wallets can not be consolidated without the other cosigners
+	if threshold == 0 || w.info.Readonly || w.info.RequiresCosigners {
+		return nil
+	}
+	count, err := w.ConfirmedUtxoCount()
+	if err != nil {
+		return fmt.Errorf("confirmed utxo count: %w", err)
+	}
+	if count < threshold {
+		return nil
+	}
+	feeRate, err := w.backend.cfg.ChainProvider.EstimateFee()
+	if err != nil {
+		return fmt.Errorf("estimate fee: %w", err)
+	}
+	maxFeeRate := *w.backend.cfg.ConsolidationMaxFeeRate
+	if feeRate > maxFeeRate {
+		logger.Debugf("Not auto consolidating wallet %s since fee rate of %f sat/vbyte is above %f", w.info, feeRate, maxFeeRate)
+		return nil
+	}
+	feeRate = max(onchain.FeeFloor[boltz.CurrencyBtc], feeRate)
+	logger.Debugf("Auto consolidating wallet %s with %d of %d BTC utxos at %f sat/vbyte", w.info, threshold, count, feeRate)
+	txId, err := w.Consolidate(threshold, feeRate)
+	if err != nil {
+		return fmt.Errorf("consolidate: %w", err)
+	}
+	logger.Infof("Auto consolidated wallet %s: %s", w.info, txId)
+	return nil
+}
+
 func (w *Wallet) FullScan() error {
+	if err := w.fullScan(); err != nil {
+		return err
+	}
+	if err := w.autoConsolidate(); err != nil {
+		return fmt.Errorf("auto consolidation: %w", err)
+	}
+	return nil
+}
+
+func (w *Wallet) fullScan() error {
 	var err error
 	for _, electrum := range w.backend.electrumServers {
 		logger.Debugf("Full scanning wallet %d with electrum server: %s", w.info.Id, electrum)
@@ -222,6 +343,16 @@ func (w *Wallet) FullScan() error {
 }
 
 func (w *Wallet) Sync() error {
+	if err := w.sync(); err != nil {
+		return err
+	}
+	if err := w.autoConsolidate(); err != nil {
+		return fmt.Errorf("auto consolidation: %w", err)
+	}
+	return nil
+}
+
+func (w *Wallet) sync() error {
 	var err error
 	for _, electrum := range w.backend.electrumServers {
 		logger.Debugf("Syncing wallet %d with electrum server: %s", w.info.Id, electrum)
@@ -0,0 +1,143 @@
+//go:build !unit
+
+package bitcoin_wallet_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	bitcoin_wallet "github.com/BoltzExchange/boltz-client/v2/internal/onchain/bitcoin-wallet"
+	"github.com/BoltzExchange/boltz-client/v2/internal/test"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/stretchr/testify/require"
+)
+
+const syncInterval = 1 * time.Second
+
+func TestMain(m *testing.M) {
+	test.InitLogger()
+	os.Exit(m.Run())
+}
+
+func backendConfig(t *testing.T) bitcoin_wallet.Config {
+	return bitcoin_wallet.Config{
+		Network:       boltz.Regtest,
+		Electrum:      onchain.RegtestElectrumConfig.Btc,
+		DataDir:       t.TempDir(),
+		ChainProvider: onchain.NewBoltzChainProvider(&boltz.Api{URL: boltz.Regtest.DefaultBoltzUrl}, boltz.CurrencyBtc),
+	}
+}
+
+func newWallet(t *testing.T, cfg bitcoin_wallet.Config) *bitcoin_wallet.Wallet {
+	backend, err := bitcoin_wallet.NewBackend(cfg)
+	require.NoError(t, err)
+	wallet, err := backend.NewWallet(test.WalletCredentials(boltz.CurrencyBtc))
+	require.NoError(t, err)
+	return wallet.(*bitcoin_wallet.Wallet)
+}
+
+func fundUtxos(t *testing.T, wallet *bitcoin_wallet.Wallet, count int, amount uint64) {
+	for i := 0; i < count; i++ {
+		address, err := wallet.NewAddress()
+		require.NoError(t, err)
+		test.SendToAddress(test.BtcCli, address, amount)
+	}
+	test.MineBlock()
+}
+
+func findConsolidation(t *testing.T, wallet *bitcoin_wallet.Wallet) *onchain.WalletTransaction {
+	transactions, err := wallet.GetTransactions(0, 0)
+	require.NoError(t, err)
+	for _, tx := range transactions {
+		if tx.IsConsolidation {
+			return tx
+		}
+	}
+	return nil
+}
+
+func TestWallet_Consolidate(t *testing.T) {
+	wallet := newWallet(t, backendConfig(t))
+	fundUtxos(t, wallet, 1, 50000)
+	fundUtxos(t, wallet, 2, 10000)
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, wallet.Sync())
+		count, err := wallet.ConfirmedUtxoCount()
+		require.NoError(t, err)
+		return count == 3
+	}, 30*syncInterval, syncInterval)
+
+	_, err := wallet.Consolidate(1, 1)
+	require.Error(t, err)
+
+	txId, err := wallet.Consolidate(2, 1)
+	require.NoError(t, err)
+	require.NotEmpty(t, txId)
+
+	rawTx := test.GetRawTransaction(test.BtcCli, txId)
+	tx, err := boltz.NewBtcTxFromHex(rawTx)
+	require.NoError(t, err)
+	require.Len(t, tx.MsgTx().TxIn, 2)
+	// the two smallest utxos are consolidated
+	require.Less(t, tx.MsgTx().TxOut[0].Value, int64(20000))
+
+	test.MineBlock()
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, wallet.Sync())
+		count, err := wallet.ConfirmedUtxoCount()
+		require.NoError(t, err)
+		return count == 2
+	}, 30*syncInterval, syncInterval)
+
+	consolidation := findConsolidation(t, wallet)
+	require.NotNil(t, consolidation)
+	require.Equal(t, txId, consolidation.Id)
+}
+
+func TestWallet_AutoConsolidate(t *testing.T) {
+	t.Run("InvalidThreshold", func(t *testing.T) {
+		cfg := backendConfig(t)
+		threshold := uint64(1)
+		cfg.ConsolidationThreshold = &threshold
+		_, err := bitcoin_wallet.NewBackend(cfg)
+		require.Error(t, err)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		wallet := newWallet(t, backendConfig(t))
+		fundUtxos(t, wallet, 3, 10000)
+
+		require.Eventually(t, func() bool {
+			require.NoError(t, wallet.Sync())
+			count, err := wallet.ConfirmedUtxoCount()
+			require.NoError(t, err)
+			return count == 3
+		}, 30*syncInterval, syncInterval)
+
+		require.NoError(t, wallet.Sync())
+		require.Nil(t, findConsolidation(t, wallet))
+	})
+
+	t.Run("Threshold", func(t *testing.T) {
+		cfg := backendConfig(t)
+		threshold := uint64(3)
+		cfg.ConsolidationThreshold = &threshold
+		wallet := newWallet(t, cfg)
+		fundUtxos(t, wallet, 4, 10000)
+
+		require.Eventually(t, func() bool {
+			require.NoError(t, wallet.Sync())
+			return findConsolidation(t, wallet) != nil
+		}, 30*syncInterval, syncInterval)
+
+		consolidation := findConsolidation(t, wallet)
+		rawTx := test.GetRawTransaction(test.BtcCli, consolidation.Id)
+		tx, err := boltz.NewBtcTxFromHex(rawTx)
+		require.NoError(t, err)
+		require.Len(t, tx.MsgTx().TxIn, int(threshold))
+	})
+}
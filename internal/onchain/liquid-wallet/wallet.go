@@ -233,6 +233,10 @@ func (backend *BlockchainBackend) NewWallet(credentials *onchain.WalletCredentia
 		if err != nil {
 			return nil, err
 		}
+		result.info.RequiresCosigners, err = onchain.RequiresCosigners(backend, credentials)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		result.info.Readonly = true
 	}
@@ -390,23 +394,15 @@ func (w *Wallet) autoConsolidate() error {
 	consolidationUtxos, total := w.consolidationUtxos(utxos, consolidationThreshold)
 	if len(consolidationUtxos) > 0 {
 		logger.Debugf("Auto consolidating wallet %s with %d of %d L-BTC utxos", w.info, len(consolidationUtxos), total)
-		address, err := w.NewAddress()
-		if err != nil {
-			return fmt.Errorf("new address: %w", err)
-		}
 		feeRate, err := w.backend.cfg.ChainProvider.EstimateFee()
 		if err != nil {
 			return fmt.Errorf("estimate fee: %w", err)
 		}
 		feeRate = max(onchain.FeeFloor[boltz.CurrencyLiquid], feeRate)
 		logger.Debugf("Using fee rate of %f sat/vbyte for consolidation", feeRate)
-		txId, err := w.sendToAddress(onchain.WalletSendArgs{
-			SendAll:     true,
-			SatPerVbyte: feeRate,
-			Address:     address,
-		}, consolidationUtxos)
+		txId, err := w.consolidate(consolidationUtxos, feeRate)
 		if err != nil {
-			return fmt.Errorf("send: %w", err)
+			return err
 		}
 		logger.Infof("Auto consolidated wallet %s: %s", w.info, txId)
 		return nil
@@ -414,6 +410,41 @@ func (w *Wallet) autoConsolidate() error {
 	return nil
 }
 
+func (w *Wallet) Consolidate(maxUtxos uint64, satPerVbyte float64) (string, error) {
+	utxos, err := w.Utxos()
+	if err != nil {
+		return "", fmt.Errorf("get utxos: %w", err)
+	}
+	candidates := w.consolidationCandidates(utxos)
+	if len(candidates) < 2 {
+		return "", errors.New("not enough confirmed utxos to consolidate")
+	}
+	if maxUtxos > 0 && maxUtxos < uint64(len(candidates)) {
+		candidates = candidates[:maxUtxos]
+	}
+	outpoints := make([]*lwk.OutPoint, 0, len(candidates))
+	for _, utxo := range candidates {
+		outpoints = append(outpoints, utxo.outpoint)
+	}
+	return w.consolidate(outpoints, satPerVbyte)
+}
+
+func (w *Wallet) consolidate(outpoints []*lwk.OutPoint, satPerVbyte float64) (string, error) {
+	address, err := w.NewAddress()
+	if err != nil {
+		return "", fmt.Errorf("new address: %w", err)
+	}
+	txId, err := w.sendToAddress(onchain.WalletSendArgs{
+		SendAll:     true,
+		SatPerVbyte: satPerVbyte,
+		Address:     address,
+	}, outpoints)
+	if err != nil {
+		return "", fmt.Errorf("send: %w", err)
+	}
+	return txId, nil
+}
+
 func (w *Wallet) lbtcUtxoValue(utxo *lwk.WalletTxOut) (uint64, bool) {
 	unblinded := utxo.Unblinded()
 	if unblinded == nil || unblinded.Asset() != w.assetId() {
@@ -427,11 +458,8 @@ type consolidationUtxo struct {
 	value    uint64
 }
 
-func (w *Wallet) consolidationUtxos(utxos []*lwk.WalletTxOut, limit uint64) ([]*lwk.OutPoint, int) {
-	if limit == 0 {
-		return nil, 0
-	}
-
+// consolidationCandidates returns the confirmed L-BTC utxos of the wallet sorted by value in descending order
+func (w *Wallet) consolidationCandidates(utxos []*lwk.WalletTxOut) []consolidationUtxo {
 	var candidates []consolidationUtxo
 	for _, utxo := range utxos {
 		if utxo.Height() == nil {
@@ -446,14 +474,23 @@ func (w *Wallet) consolidationUtxos(utxos []*lwk.WalletTxOut, limit uint64) ([]*
 			value:    value,
 		})
 	}
-	if uint64(len(candidates)) < limit {
-		return nil, len(candidates)
-	}
-	total := len(candidates)
 
 	sort.Slice(candidates, func(i, j int) bool {
 		return candidates[i].value > candidates[j].value
 	})
+	return candidates
+}
+
+func (w *Wallet) consolidationUtxos(utxos []*lwk.WalletTxOut, limit uint64) ([]*lwk.OutPoint, int) {
+	if limit == 0 {
+		return nil, 0
+	}
+
+	candidates := w.consolidationCandidates(utxos)
+	if uint64(len(candidates)) < limit {
+		return nil, len(candidates)
+	}
+	total := len(candidates)
 
 	batchSize := int(limit)
 	candidates = candidates[:batchSize]
@@ -586,11 +623,7 @@ func (w *Wallet) DeriveBlindingKey(address string) (*btcec.PrivateKey, error) {
 	return privKey, nil
 }
 
-func (w *Wallet) tryCreateTransaction(args onchain.WalletSendArgs, walletUtxos []*lwk.OutPoint) (*lwk.Transaction, error) {
-	if w.signer == nil {
-		return nil, errors.New("wallet is readonly")
-	}
-
+func (w *Wallet) buildPset(args onchain.WalletSendArgs, walletUtxos []*lwk.OutPoint) (*lwk.Pset, error) {
 	builder := lwk.NewTxBuilder(convertNetwork(w.backend.cfg.Network))
 	addr, err := lwk.NewAddress(args.Address)
 	if err != nil {
@@ -623,13 +656,29 @@ func (w *Wallet) tryCreateTransaction(args onchain.WalletSendArgs, walletUtxos [
 		}
 		return nil, fmt.Errorf("finish: %w", err)
 	}
+	return pset, nil
+}
+
+func (w *Wallet) tryCreateTransaction(args onchain.WalletSendArgs, walletUtxos []*lwk.OutPoint) (*lwk.Transaction, error) {
+	if w.signer == nil {
+		return nil, errors.New("wallet is readonly")
+	}
+
+	pset, err := w.buildPset(args, walletUtxos)
+	if err != nil {
+		return nil, err
+	}
 
 	pset, err = w.signer.Sign(pset)
 	if err != nil {
 		return nil, fmt.Errorf("sign: %w", err)
 	}
 
-	pset, err = w.Finalize(pset)
+	return w.finalizePset(pset)
+}
+
+func (w *Wallet) finalizePset(pset *lwk.Pset) (*lwk.Transaction, error) {
+	pset, err := w.Finalize(pset)
 	if err != nil {
 		return nil, fmt.Errorf("finalize: %w", err)
 	}
@@ -642,6 +691,113 @@ func (w *Wallet) tryCreateTransaction(args onchain.WalletSendArgs, walletUtxos [
 	return tx, nil
 }
 
+// signPset adds our signatures to the pset, if we hold any keys, and returns its details
+func (w *Wallet) signPset(pset *lwk.Pset) (*onchain.PartiallySignedTransaction, error) {
+	var err error
+	if w.signer != nil {
+		pset, err = w.signer.Sign(pset)
+		if err != nil {
+			return nil, fmt.Errorf("sign: %w", err)
+		}
+	}
+	details, err := w.PsetDetails(pset)
+	if err != nil {
+		return nil, fmt.Errorf("pset details: %w", err)
+	}
+	balance := details.Balance()
+	fee := balance.Fee()
+	// the balance of our own wallet includes the fee
+	var sendAmount uint64
+	if change := balance.Balances()[w.assetId()]; change < 0 && uint64(-change) > fee {
+		sendAmount = uint64(-change) - fee
+	}
+	var recipients []onchain.TransactionOutput
+	for _, recipient := range balance.Recipients() {
+		asset := recipient.Asset()
+		if asset == nil || *asset != w.assetId() {
+			continue
+		}
+		output := onchain.TransactionOutput{}
+		if value := recipient.Value(); value != nil {
+			output.Amount = *value
+		}
+		if address := recipient.Address(); address != nil {
+			output.Address = (*address).String()
+		}
+		recipients = append(recipients, output)
+	}
+	return &onchain.PartiallySignedTransaction{
+		Psbt:       pset.String(),
+		Fee:        fee,
+		SendAmount: sendAmount,
+		IsComplete: len(details.FingerprintsMissing()) == 0,
+		Recipients: recipients,
+	}, nil
+}
+
+func (w *Wallet) CreatePsbt(args onchain.WalletSendArgs) (*onchain.PartiallySignedTransaction, error) {
+	pset, err := w.buildPset(args, nil)
+	if err != nil {
+		return nil, err
+	}
+	return w.signPset(pset)
+}
+
+func (w *Wallet) SignPsbt(psbt string) (*onchain.PartiallySignedTransaction, error) {
+	pset, err := lwk.NewPset(psbt)
+	if err != nil {
+		return nil, fmt.Errorf("parse pset: %w", err)
+	}
+	return w.signPset(pset)
+}
+
+func (w *Wallet) FinalizePsbt(psbts []string) (string, error) {
+	if len(psbts) == 0 {
+		return "", errors.New("no pset provided")
+	}
+	w.sendLock.Lock()
+	defer w.sendLock.Unlock()
+
+	var combined *lwk.Pset
+	for _, psbt := range psbts {
+		pset, err := lwk.NewPset(psbt)
+		if err != nil {
+			return "", fmt.Errorf("parse pset: %w", err)
+		}
+		if combined == nil {
+			combined = pset
+		} else {
+			combined, err = combined.Combine(pset)
+			if err != nil {
+				return "", fmt.Errorf("combine: %w", err)
+			}
+		}
+	}
+	if w.signer != nil {
+		var err error
+		combined, err = w.signer.Sign(combined)
+		if err != nil {
+			return "", fmt.Errorf("sign: %w", err)
+		}
+	}
+
+	tx, err := w.finalizePset(combined)
+	if err != nil {
+		return "", err
+	}
+
+	txId, err := w.backend.BroadcastTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := w.applyTransaction(tx); err != nil {
+		return "", err
+	}
+
+	return txId, nil
+}
+
 func (w *Wallet) createTransaction(args onchain.WalletSendArgs, walletUtxos []*lwk.OutPoint) (*lwk.Transaction, error) {
 	tx, err := w.tryCreateTransaction(args, walletUtxos)
 	if err != nil && strings.Contains(err.Error(), "insufficient") {
@@ -74,6 +74,48 @@ var DefaultWalletSyncIntervals = map[boltz.Currency]time.Duration{
 	boltz.CurrencyLiquid: time.Minute,
 }
 
+type WalletTransactionEventType int
+
+const (
+	TransactionNew WalletTransactionEventType = iota
+	TransactionConfirmed
+	TransactionReplaced
+)
+
+type WalletTransactionEvent struct {
+	Type        WalletTransactionEventType
+	Wallet      WalletInfo
+	Transaction *WalletTransaction
+}
+
+// maximum number of recent transactions which are compared between syncs to detect changes
+const transactionTrackingLimit = 100
+
+// number of events buffered per subscriber before its subscription is ended
+const transactionSubscriberBuffer = 100
+
+// ErrTransactionSubscriberOverflow is returned for subscriptions which were ended because the subscriber
+// did not keep up with the events. The subscriber has to re-sync with the transactions of the wallets.
+var ErrTransactionSubscriberOverflow = errors.New("transaction subscriber fell behind and missed events")
+
+// TransactionSubscription filters the wallet transaction events a subscriber receives
+type TransactionSubscription struct {
+	WalletId *Id
+	TenantId *Id
+}
+
+type transactionSubscriber struct {
+	TransactionSubscription
+	updates chan WalletTransactionEvent
+	err     error
+}
+
+func (subscriber *transactionSubscriber) matches(info WalletInfo) bool {
+	id := subscriber.WalletId == nil || info.Id == *subscriber.WalletId
+	tenantId := subscriber.TenantId == nil || info.TenantId == *subscriber.TenantId
+	return id && tenantId
+}
+
 type Currency struct {
 	Chain       ChainProvider
 	blockHeight atomic.Uint32
@@ -90,6 +132,14 @@ type Onchain struct {
 	syncWait   sync.WaitGroup
 	syncCtx    context.Context
 	syncCancel func()
+
+	subscriberLock         sync.Mutex
+	transactionSubscribers []*transactionSubscriber
+	subscribersClosed      bool
+
+	// transactions seen during the last check of wallets which have at least one subscriber
+	transactionLock   sync.Mutex
+	knownTransactions map[Wallet]map[string]*WalletTransaction
 }
 
 func (onchain *Onchain) Init() {
@@ -105,6 +155,7 @@ func (onchain *Onchain) Init() {
 			onchain.WalletSyncIntervals = DefaultWalletSyncIntervals
 		}
 	}
+	onchain.knownTransactions = make(map[Wallet]map[string]*WalletTransaction)
 }
 
 func (onchain *Onchain) AddWallet(wallet Wallet) {
@@ -118,6 +169,14 @@ func (onchain *Onchain) RemoveWallet(id Id) {
 		return current.GetWalletInfo().Id == id
 	})
 	onchain.OnWalletChange.Send(onchain.Wallets)
+
+	onchain.transactionLock.Lock()
+	defer onchain.transactionLock.Unlock()
+	for wallet := range onchain.knownTransactions {
+		if wallet.GetWalletInfo().Id == id {
+			delete(onchain.knownTransactions, wallet)
+		}
+	}
 }
 
 func (onchain *Onchain) startSyncLoop(wallet Wallet) {
@@ -146,6 +205,8 @@ func (onchain *Onchain) startSyncLoop(wallet Wallet) {
 					start := time.Now()
 					if err := wallet.Sync(); err != nil {
 						logger.Errorf("Sync for wallet %d failed: %v", info.Id, err)
+					} else {
+						onchain.checkWalletTransactions(wallet)
 					}
 					duration := time.Since(start)
 					logger.Debugf("Syncing wallet %s took %s", info.String(), duration)
@@ -157,6 +218,145 @@ func (onchain *Onchain) startSyncLoop(wallet Wallet) {
 	}()
 }
 
+// WalletTransactionUpdates subscribes to transaction changes of the wallets matching the subscription
+// which are detected while syncing wallets. Only changes that happen after subscribing will be reported.
+// The returned function ends the subscription. Subscribers which don't keep up have their channel closed,
+// so that a slow subscriber can not stall wallet syncs, in which case the function returns ErrTransactionSubscriberOverflow.
+func (onchain *Onchain) WalletTransactionUpdates(subscription TransactionSubscription) (<-chan WalletTransactionEvent, func() error) {
+	onchain.subscriberLock.Lock()
+	if onchain.subscribersClosed {
+		onchain.subscriberLock.Unlock()
+		return nil, func() error { return nil }
+	}
+	subscriber := &transactionSubscriber{
+		TransactionSubscription: subscription,
+		updates:                 make(chan WalletTransactionEvent, transactionSubscriberBuffer),
+	}
+	onchain.transactionSubscribers = append(onchain.transactionSubscribers, subscriber)
+	onchain.subscriberLock.Unlock()
+
+	for _, wallet := range onchain.Wallets {
+		if subscriber.matches(wallet.GetWalletInfo()) {
+			onchain.seedKnownTransactions(wallet)
+		}
+	}
+
+	return subscriber.updates, func() error {
+		onchain.subscriberLock.Lock()
+		defer onchain.subscriberLock.Unlock()
+		if subscriber.err != nil || onchain.subscribersClosed {
+			return subscriber.err
+		}
+		onchain.transactionSubscribers = slices.DeleteFunc(onchain.transactionSubscribers, func(current *transactionSubscriber) bool {
+			return current == subscriber
+		})
+		close(subscriber.updates)
+		return nil
+	}
+}
+
+func (onchain *Onchain) hasTransactionSubscriber(info WalletInfo) bool {
+	onchain.subscriberLock.Lock()
+	defer onchain.subscriberLock.Unlock()
+	return slices.ContainsFunc(onchain.transactionSubscribers, func(subscriber *transactionSubscriber) bool {
+		return subscriber.matches(info)
+	})
+}
+
+func (onchain *Onchain) sendTransactionEvent(event WalletTransactionEvent) {
+	onchain.subscriberLock.Lock()
+	defer onchain.subscriberLock.Unlock()
+	onchain.transactionSubscribers = slices.DeleteFunc(onchain.transactionSubscribers, func(subscriber *transactionSubscriber) bool {
+		if !subscriber.matches(event.Wallet) {
+			return false
+		}
+		select {
+		case subscriber.updates <- event:
+			return false
+		default:
+			logger.Warnf("Ending transaction subscription because subscriber fell behind on wallet %s", event.Wallet.String())
+			subscriber.err = ErrTransactionSubscriberOverflow
+			close(subscriber.updates)
+			return true
+		}
+	})
+}
+
+func (onchain *Onchain) closeTransactionSubscribers() {
+	onchain.subscriberLock.Lock()
+	defer onchain.subscriberLock.Unlock()
+	for _, subscriber := range onchain.transactionSubscribers {
+		close(subscriber.updates)
+	}
+	onchain.transactionSubscribers = nil
+	onchain.subscribersClosed = true
+}
+
+// seedKnownTransactions takes the baseline for a wallet which just got its first subscriber
+func (onchain *Onchain) seedKnownTransactions(wallet Wallet) {
+	onchain.transactionLock.Lock()
+	defer onchain.transactionLock.Unlock()
+	if _, ok := onchain.knownTransactions[wallet]; ok {
+		return
+	}
+	info := wallet.GetWalletInfo()
+	transactions, err := wallet.GetTransactions(transactionTrackingLimit, 0)
+	if err != nil {
+		// the next sync will take the baseline instead
+		logger.Warnf("Could not get transactions of wallet %s: %v", info.String(), err)
+		return
+	}
+	known := make(map[string]*WalletTransaction, len(transactions))
+	for _, tx := range transactions {
+		known[tx.Id] = tx
+	}
+	onchain.knownTransactions[wallet] = known
+}
+
+// checkWalletTransactions compares the recent transactions of a wallet with the ones seen during the previous check
+// and sends the corresponding events. Only wallets with at least one subscriber are checked, the baseline of
+// the others is dropped and taken again once a subscriber shows up.
+func (onchain *Onchain) checkWalletTransactions(wallet Wallet) {
+	info := wallet.GetWalletInfo()
+	onchain.transactionLock.Lock()
+	defer onchain.transactionLock.Unlock()
+	if !onchain.hasTransactionSubscriber(info) {
+		delete(onchain.knownTransactions, wallet)
+		return
+	}
+	known := onchain.knownTransactions[wallet]
+	transactions, err := wallet.GetTransactions(transactionTrackingLimit, 0)
+	if err != nil {
+		logger.Warnf("Could not get transactions of wallet %s: %v", info.String(), err)
+		return
+	}
+	send := func(eventType WalletTransactionEventType, tx *WalletTransaction) {
+		onchain.sendTransactionEvent(WalletTransactionEvent{Type: eventType, Wallet: info, Transaction: tx})
+	}
+	current := make(map[string]*WalletTransaction, len(transactions))
+	for _, tx := range transactions {
+		current[tx.Id] = tx
+		if known == nil {
+			continue
+		}
+		previous, ok := known[tx.Id]
+		if !ok {
+			send(TransactionNew, tx)
+		} else if previous.BlockHeight == 0 && tx.BlockHeight != 0 {
+			send(TransactionConfirmed, tx)
+		}
+	}
+	// if the limit was reached, a missing transaction might have simply been pushed out by newer ones
+	if len(transactions) < transactionTrackingLimit {
+		for id, tx := range known {
+			if _, ok := current[id]; !ok && tx.BlockHeight == 0 {
+				send(TransactionReplaced, tx)
+			}
+		}
+	}
+	onchain.knownTransactions[wallet] = current
+}
+
 func (onchain *Onchain) GetCurrency(currency boltz.Currency) (*Currency, error) {
 	if currency == boltz.CurrencyBtc && onchain.Btc != nil {
 		return onchain.Btc, nil
@@ -171,7 +371,7 @@ func (walletChecker *WalletChecker) Allowed(wallet Wallet) bool {
 	id := walletChecker.Id == nil || info.Id == *walletChecker.Id
 	currency := info.Currency == walletChecker.Currency || walletChecker.Currency == ""
 	name := walletChecker.Name == nil || info.Name == *walletChecker.Name
-	readonly := !info.Readonly || walletChecker.AllowReadonly
+	readonly := (!info.Readonly && !info.RequiresCosigners) || walletChecker.AllowReadonly
 	tenantId := walletChecker.TenantId == nil || info.TenantId == *walletChecker.TenantId
 	return wallet.Ready() && id && currency && name && readonly && tenantId
 }
@@ -457,5 +657,7 @@ func (onchain *Onchain) Disconnect() {
 	case <-time.After(10 * time.Second):
 		logger.Warnf("Wallet disconnect timed out")
 	case <-done:
+		// only safe to close once no sync loop can send anymore
+		onchain.closeTransactionSubscribers()
 	}
 }
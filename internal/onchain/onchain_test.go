@@ -1,12 +1,15 @@
 package onchain_test
 
 import (
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	onchainmock "github.com/BoltzExchange/boltz-client/v2/internal/mocks/onchain"
 	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -205,6 +208,7 @@ func TestWalletSync(t *testing.T) {
 			return nil
 		}).Once()
 		wallet.EXPECT().GetWalletInfo().Return(onchain.WalletInfo{Id: 1, Currency: boltz.CurrencyBtc}).Maybe()
+		wallet.EXPECT().GetTransactions(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 		onchainInstance.AddWallet(wallet)
 
 		select {
@@ -234,6 +238,7 @@ func TestWalletSync(t *testing.T) {
 		}).Once()
 		wallet.EXPECT().Disconnect().Return(nil).NotBefore(sync).Once()
 		wallet.EXPECT().GetWalletInfo().Return(onchain.WalletInfo{Id: 1, Currency: boltz.CurrencyBtc}).Maybe()
+		wallet.EXPECT().GetTransactions(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
 		onchainInstance.AddWallet(wallet)
 
 		select {
@@ -243,4 +248,181 @@ func TestWalletSync(t *testing.T) {
 		}
 	})
 
+	t.Run("Transactions", func(t *testing.T) {
+		onchainInstance := setup(t)
+		updates, stop := onchainInstance.WalletTransactionUpdates(onchain.TransactionSubscription{})
+		defer stop()
+
+		replaced := &onchain.WalletTransaction{Id: "replaced"}
+		confirmed := &onchain.WalletTransaction{Id: "confirmed"}
+		syncs := [][]*onchain.WalletTransaction{
+			{replaced, confirmed},
+			{{Id: "new"}, {Id: "confirmed", BlockHeight: 10}},
+		}
+
+		wallet := onchainmock.NewMockWallet(t)
+		wallet.EXPECT().Sync().Return(nil)
+		wallet.EXPECT().GetWalletInfo().Return(onchain.WalletInfo{Id: 1, Currency: boltz.CurrencyBtc}).Maybe()
+		wallet.EXPECT().Disconnect().Return(nil).Maybe()
+		calls := 0
+		wallet.EXPECT().GetTransactions(mock.Anything, mock.Anything).RunAndReturn(func(uint64, uint64) ([]*onchain.WalletTransaction, error) {
+			result := syncs[min(calls, len(syncs)-1)]
+			calls++
+			return result, nil
+		})
+		onchainInstance.AddWallet(wallet)
+
+		expected := map[string]onchain.WalletTransactionEventType{
+			"new":       onchain.TransactionNew,
+			"confirmed": onchain.TransactionConfirmed,
+			"replaced":  onchain.TransactionReplaced,
+		}
+		for range len(expected) {
+			select {
+			case event := <-updates:
+				require.Equal(t, expected[event.Transaction.Id], event.Type)
+				require.Equal(t, onchain.Id(1), event.Wallet.Id)
+				delete(expected, event.Transaction.Id)
+			case <-time.After(5 * syncInterval):
+				require.Fail(t, "timed out while waiting for transaction event")
+			}
+		}
+		require.Empty(t, expected)
+		onchainInstance.RemoveWallet(1)
+	})
+
+	t.Run("Subscribers", func(t *testing.T) {
+		onchainInstance := setup(t)
+
+		tenantId := onchain.Id(2)
+		otherTenant := onchain.Id(3)
+		walletId := onchain.Id(1)
+		updates, stop := onchainInstance.WalletTransactionUpdates(onchain.TransactionSubscription{TenantId: &tenantId})
+		defer stop()
+		otherUpdates, stopOther := onchainInstance.WalletTransactionUpdates(onchain.TransactionSubscription{TenantId: &otherTenant})
+		defer stopOther()
+		// never read from, so its buffer fills up
+		slowUpdates, stopSlow := onchainInstance.WalletTransactionUpdates(onchain.TransactionSubscription{WalletId: &walletId})
+
+		var many []*onchain.WalletTransaction
+		for i := range 150 {
+			many = append(many, &onchain.WalletTransaction{Id: fmt.Sprint(i)})
+		}
+		syncs := [][]*onchain.WalletTransaction{
+			{},
+			many[:75],
+			many,
+		}
+
+		wallet := onchainmock.NewMockWallet(t)
+		wallet.EXPECT().Sync().Return(nil)
+		wallet.EXPECT().GetWalletInfo().Return(onchain.WalletInfo{Id: walletId, TenantId: tenantId, Currency: boltz.CurrencyBtc}).Maybe()
+		wallet.EXPECT().Disconnect().Return(nil).Maybe()
+		var calls atomic.Int32
+		wallet.EXPECT().GetTransactions(mock.Anything, mock.Anything).RunAndReturn(func(uint64, uint64) ([]*onchain.WalletTransaction, error) {
+			call := int(calls.Add(1)) - 1
+			return syncs[min(call, len(syncs)-1)], nil
+		})
+		onchainInstance.AddWallet(wallet)
+
+		received := 0
+		for received < len(many) {
+			select {
+			case event := <-updates:
+				require.Equal(t, tenantId, event.Wallet.TenantId)
+				received++
+			case <-time.After(5 * syncInterval):
+				require.Fail(t, "timed out while waiting for transaction event")
+			}
+		}
+		// syncs continue even though one subscriber is not reading
+		require.Eventually(t, func() bool {
+			return calls.Load() > int32(len(syncs))
+		}, 10*syncInterval, syncInterval/2)
+		require.Empty(t, otherUpdates)
+
+		// the slow subscriber got ended instead of silently missing events
+		for range slowUpdates {
+		}
+		require.ErrorIs(t, stopSlow(), onchain.ErrTransactionSubscriberOverflow)
+		onchainInstance.RemoveWallet(walletId)
+	})
+
+	t.Run("Baseline", func(t *testing.T) {
+		onchainInstance := setup(t)
+
+		wallet := onchainmock.NewMockWallet(t)
+		synced := make(chan struct{}, 10)
+		wallet.EXPECT().Sync().RunAndReturn(func() error {
+			synced <- struct{}{}
+			return nil
+		})
+		wallet.EXPECT().GetWalletInfo().Return(onchain.WalletInfo{Id: 1, Currency: boltz.CurrencyBtc}).Maybe()
+		wallet.EXPECT().Disconnect().Return(nil).Maybe()
+		onchainInstance.AddWallet(wallet)
+
+		// transactions are not fetched while nobody is subscribed
+		<-synced
+		<-synced
+
+		existing := &onchain.WalletTransaction{Id: "existing"}
+		var calls atomic.Int32
+		wallet.EXPECT().GetTransactions(mock.Anything, mock.Anything).RunAndReturn(func(uint64, uint64) ([]*onchain.WalletTransaction, error) {
+			if calls.Add(1) == 1 {
+				return []*onchain.WalletTransaction{existing}, nil
+			}
+			return []*onchain.WalletTransaction{existing, {Id: "new"}}, nil
+		})
+		updates, stop := onchainInstance.WalletTransactionUpdates(onchain.TransactionSubscription{})
+		defer stop()
+		// the baseline is taken when subscribing
+		require.Equal(t, int32(1), calls.Load())
+
+		select {
+		case event := <-updates:
+			require.Equal(t, "new", event.Transaction.Id)
+			require.Equal(t, onchain.TransactionNew, event.Type)
+		case <-time.After(5 * syncInterval):
+			require.Fail(t, "timed out while waiting for transaction event")
+		}
+		onchainInstance.RemoveWallet(1)
+	})
+}
+
+type descriptorBackend struct {
+	onchain.WalletBackend
+	descriptor string
+}
+
+func (b descriptorBackend) DeriveDefaultDescriptor(string) (string, error) {
+	return b.descriptor, nil
+}
+
+func TestRequiresCosigners(t *testing.T) {
+	ours := "[72411c95/84'/1'/0']tpubDC2Q4xK4XH72JQHXbEJa4shGP8ScAPNVNuAWszA2wo6Qjzf4zo2ke69SshBpmJv8CKDX76QN64QPiiSJjC69hGgUtV2AgiVSzSQ6zgpZFGU/<0;1>/*"
+	cosigner := "[8c3c63c2/48'/1'/0'/2']tpubDCG5AotNoktxBFUJe6j1pRXGsVrRrgP89yvVsUgpjKFHZrRjv5jfX9MUGuS3fwmJ1w5b3xipUAN7quZdsFLkfJ4HSBKYKhfJKUYRbhLGzfL/<0;1>/*"
+	backend := descriptorBackend{descriptor: "wpkh(" + ours + ")#checksum"}
+
+	tests := []struct {
+		name       string
+		mnemonic   string
+		descriptor string
+		expected   bool
+	}{
+		{"Default", "mnemonic", "wpkh(" + ours + ")", false},
+		{"Multisig", "mnemonic", "wsh(sortedmulti(2," + ours + "," + cosigner + "))", true},
+		{"NoOrigin", "mnemonic", "wsh(sortedmulti(2," + ours + ",tpubDCG5AotNoktxBFUJe6j1pRXGsVrRrgP89yvVsUgpjKFHZrRjv5jfX9MUGuS3fwmJ1w5b3xipUAN7quZdsFLkfJ4HSBKYKhfJKUYRbhLGzfL/<0;1>/*))", true},
+		{"Readonly", "", "wsh(sortedmulti(2," + ours + "," + cosigner + "))", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requiresCosigners, err := onchain.RequiresCosigners(backend, &onchain.WalletCredentials{
+				Mnemonic:       tc.mnemonic,
+				CoreDescriptor: tc.descriptor,
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, requiresCosigners)
+		})
+	}
 }
@@ -3,6 +3,8 @@ package onchain
 import (
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
@@ -38,6 +40,8 @@ type WalletInfo struct {
 	Currency boltz.Currency
 	Readonly bool
 	TenantId Id
+	// RequiresCosigners is set for wallets which hold some, but not all keys of their descriptor
+	RequiresCosigners bool
 }
 
 type WalletSendArgs struct {
@@ -63,6 +67,35 @@ type Wallet interface {
 	ApplyTransaction(txHex string) error
 }
 
+// PartiallySignedTransaction is a wallet transaction which might still require signatures of other cosigners
+type PartiallySignedTransaction struct {
+	// Psbt is the base64 encoded PSBT (PSET for liquid)
+	Psbt       string
+	Fee        uint64
+	SendAmount uint64
+	// IsComplete is true once all signatures required to finalize the transaction are present
+	IsComplete bool
+	// Recipients are the outputs which do not belong to the wallet
+	Recipients []TransactionOutput
+}
+
+// PsbtWallet is implemented by wallets which support a partial signing workflow,
+// such as multisig wallets where only some of the keys are held by the daemon
+type PsbtWallet interface {
+	Wallet
+	CreatePsbt(args WalletSendArgs) (*PartiallySignedTransaction, error)
+	SignPsbt(psbt string) (*PartiallySignedTransaction, error)
+	// FinalizePsbt combines the given psbts and broadcasts the finalized transaction
+	FinalizePsbt(psbts []string) (string, error)
+}
+
+// ConsolidationWallet is implemented by wallets which can consolidate their utxos into a single output
+type ConsolidationWallet interface {
+	Wallet
+	// Consolidate spends the maxUtxos (all if 0) largest confirmed utxos into a new address of the wallet
+	Consolidate(maxUtxos uint64, satPerVbyte float64) (string, error)
+}
+
 func (info WalletInfo) InsufficientBalanceError(amount uint64) error {
 	return fmt.Errorf("wallet %s has insufficient balance for sending %d sats", info.Name, amount)
 }
@@ -178,3 +211,27 @@ func ValidateWalletCredentials(backend WalletBackend, credentials *WalletCredent
 	}
 	return nil
 }
+
+var descriptorKeyRegex = regexp.MustCompile(`(?:\[([[:xdigit:]]{8})[^\]]*\])?[tx]pub[[:alnum:]]+`)
+
+// RequiresCosigners checks whether the descriptor of the credentials contains keys
+// which are not derived from its mnemonic, in which case the wallet can not sign on its own
+func RequiresCosigners(backend WalletBackend, credentials *WalletCredentials) (bool, error) {
+	if credentials.Mnemonic == "" || credentials.CoreDescriptor == "" {
+		return false, nil
+	}
+	defaultDescriptor, err := backend.DeriveDefaultDescriptor(credentials.Mnemonic)
+	if err != nil {
+		return false, fmt.Errorf("derive default descriptor: %w", err)
+	}
+	defaultKey := descriptorKeyRegex.FindStringSubmatch(defaultDescriptor)
+	if defaultKey == nil || defaultKey[1] == "" {
+		return false, errors.New("default descriptor has no key origin")
+	}
+	for _, key := range descriptorKeyRegex.FindAllStringSubmatch(credentials.CoreDescriptor, -1) {
+		if !strings.EqualFold(key[1], defaultKey[1]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
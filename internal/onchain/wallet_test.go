@@ -311,6 +311,51 @@ func TestWallet_BumpTransactionFee(t *testing.T) {
 	})
 }
 
+func TestWallet_Psbt(t *testing.T) {
+	walletTest(t, true, func(t *testing.T, wallet onchain.Wallet) {
+		psbtWallet, ok := wallet.(onchain.PsbtWallet)
+		require.True(t, ok)
+
+		cli := test.GetCli(wallet.GetWalletInfo().Currency)
+		amount := uint64(10000)
+
+		psbt, err := psbtWallet.CreatePsbt(onchain.WalletSendArgs{
+			Address:     test.GetNewAddress(cli),
+			Amount:      amount,
+			SatPerVbyte: 1,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, psbt.Psbt)
+		require.NotZero(t, psbt.Fee)
+		require.Equal(t, amount, psbt.SendAmount)
+		// single sig wallets hold all keys
+		require.True(t, psbt.IsComplete)
+
+		signed, err := psbtWallet.SignPsbt(psbt.Psbt)
+		require.NoError(t, err)
+		require.True(t, signed.IsComplete)
+		require.Equal(t, psbt.Fee, signed.Fee)
+
+		_, err = psbtWallet.FinalizePsbt(nil)
+		require.Error(t, err)
+
+		txId, err := psbtWallet.FinalizePsbt([]string{psbt.Psbt, signed.Psbt})
+		require.NoError(t, err)
+		require.NotEmpty(t, txId)
+
+		test.MineBlock()
+
+		require.Eventually(t, func() bool {
+			require.NoError(t, wallet.Sync())
+			transactions, err := wallet.GetTransactions(0, 0)
+			require.NoError(t, err)
+			return slices.ContainsFunc(transactions, func(tx *onchain.WalletTransaction) bool {
+				return tx.Id == txId
+			})
+		}, 5*checkInterval, checkInterval/2)
+	})
+}
+
 func TestWallet_GetOutputs(t *testing.T) {
 	walletTest(t, false, func(t *testing.T, wallet onchain.Wallet) {
 		t.Skip("TODO")
@@ -0,0 +1,446 @@
+package phoenixd
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+// Phoenixd talks to the HTTP API of phoenixd. Channels are managed by phoenixd itself,
+// and onchain funds are sent by splicing out of the channel.
+type Phoenixd struct {
+	Host     string `long:"phoenixd.host" description:"HTTP host of phoenixd"`
+	Port     int    `long:"phoenixd.port" description:"HTTP port of phoenixd"`
+	Password string `long:"phoenixd.password" description:"Password of the phoenixd HTTP API"`
+	DataDir  string `long:"phoenixd.datadir" description:"Path to the data directory of phoenixd, used to read the password if it is not set"`
+
+	httpClient *http.Client
+	api        string
+	walletInfo onchain.WalletInfo
+}
+
+const (
+	serviceName = lightning.NodeTypePhoenixd
+
+	configFile       = "phoenix.conf"
+	passwordKey      = "http-password"
+	requestTimeout   = 30 * time.Second
+	channelStateOpen = "Normal"
+
+	paymentFailure = "payment failed"
+
+	// fees charged by the trampoline node of phoenixd, which are not configurable through the API
+	trampolineFeeBaseSat = 4
+	trampolineFeePpm     = 4000
+)
+
+var (
+	ErrPaymentNotInitiated = errors.New("payment not initialized")
+
+	errNotFound = errors.New("not found")
+)
+
+type getInfoResponse struct {
+	NodeId      string `json:"nodeId"`
+	Chain       string `json:"chain"`
+	BlockHeight uint32 `json:"blockHeight"`
+	Version     string `json:"version"`
+	Channels    []struct {
+		State               string `json:"state"`
+		ChannelId           string `json:"channelId"`
+		BalanceSat          uint64 `json:"balanceSat"`
+		InboundLiquiditySat uint64 `json:"inboundLiquiditySat"`
+		CapacitySat         uint64 `json:"capacitySat"`
+		FundingTxId         string `json:"fundingTxId"`
+	} `json:"channels"`
+}
+
+type getBalanceResponse struct {
+	BalanceSat   uint64 `json:"balanceSat"`
+	FeeCreditSat uint64 `json:"feeCreditSat"`
+}
+
+type createInvoiceResponse struct {
+	AmountSat   uint64 `json:"amountSat"`
+	PaymentHash string `json:"paymentHash"`
+	Serialized  string `json:"serialized"`
+}
+
+type payInvoiceResponse struct {
+	RecipientAmountSat uint64 `json:"recipientAmountSat"`
+	RoutingFeeSat      uint64 `json:"routingFeeSat"`
+	PaymentId          string `json:"paymentId"`
+	PaymentHash        string `json:"paymentHash"`
+	PaymentPreimage    string `json:"paymentPreimage"`
+	// Reason is only set if the payment failed
+	Reason string `json:"reason"`
+}
+
+type outgoingPayment struct {
+	PaymentHash string `json:"paymentHash"`
+	Preimage    string `json:"preimage"`
+	IsPaid      bool   `json:"isPaid"`
+	// Fees are in msat
+	Fees        uint64 `json:"fees"`
+	CompletedAt int64  `json:"completedAt"`
+}
+
+// ReadPassword reads the password of the HTTP API from the config file in the data directory of phoenixd
+func (p *Phoenixd) ReadPassword() (string, error) {
+	file, err := os.Open(filepath.Join(p.DataDir, configFile))
+	if err != nil {
+		return "", fmt.Errorf("could not open %s config: %w", serviceName, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.Errorf("Error closing %s config: %v", serviceName, err)
+		}
+	}()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if found && strings.TrimSpace(key) == passwordKey {
+			return strings.TrimSpace(value), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s not found in %s config", passwordKey, serviceName)
+}
+
+func (p *Phoenixd) Ready() bool {
+	return p.httpClient != nil
+}
+
+func (p *Phoenixd) Disconnect() error {
+	return nil
+}
+
+func (p *Phoenixd) Connect() error {
+	if p.Password == "" {
+		return fmt.Errorf("no %s password configured", serviceName)
+	}
+	p.api = "http://" + p.Host + ":" + strconv.Itoa(p.Port)
+	p.httpClient = &http.Client{}
+	return nil
+}
+
+func (p *Phoenixd) request(ctx context.Context, method string, path string, params url.Values, dest any) error {
+	var body io.Reader
+	if params != nil {
+		body = strings.NewReader(params.Encode())
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.api+path, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth("", p.Password)
+	if params != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	res, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := res.Body.Close(); err != nil {
+			logger.Errorf("Error closing response body: %v", err)
+		}
+	}()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s %s: %w", method, path, errNotFound)
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s status %d: %s", method, path, res.StatusCode, strings.TrimSpace(string(raw)))
+	}
+	if text, ok := dest.(*string); ok {
+		*text = strings.TrimSpace(string(raw))
+		return nil
+	}
+	return json.Unmarshal(raw, dest)
+}
+
+func (p *Phoenixd) get(path string, dest any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return p.request(ctx, http.MethodGet, path, nil, dest)
+}
+
+func (p *Phoenixd) post(path string, params url.Values, dest any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return p.request(ctx, http.MethodPost, path, params, dest)
+}
+
+func (p *Phoenixd) Name() string {
+	return string(serviceName)
+}
+
+func (p *Phoenixd) GetWalletInfo() onchain.WalletInfo {
+	return p.walletInfo
+}
+
+func (p *Phoenixd) Sync() error {
+	return nil
+}
+
+func (p *Phoenixd) FullScan() error {
+	return nil
+}
+
+func (p *Phoenixd) SetupWallet(info onchain.WalletInfo) {
+	p.walletInfo = info
+}
+
+func (p *Phoenixd) NodeType() lightning.LightningNodeType {
+	return serviceName
+}
+
+func (p *Phoenixd) GetInfo() (*lightning.LightningInfo, error) {
+	var info getInfoResponse
+	if err := p.get("/getinfo", &info); err != nil {
+		return nil, err
+	}
+	return &lightning.LightningInfo{
+		Pubkey:      info.NodeId,
+		BlockHeight: info.BlockHeight,
+		Version:     info.Version,
+		Network:     info.Chain,
+		// phoenixd only starts serving its API once it is synced
+		Synced: true,
+	}, nil
+}
+
+func (p *Phoenixd) GetBlockHeight() (uint32, error) {
+	info, err := p.GetInfo()
+	if err != nil {
+		return 0, err
+	}
+	return info.BlockHeight, nil
+}
+
+// ListChannels returns the open channels. phoenixd does not expose short channel ids,
+// so the id is derived from the channel id instead
+func (p *Phoenixd) ListChannels() ([]*lightning.LightningChannel, error) {
+	var info getInfoResponse
+	if err := p.get("/getinfo", &info); err != nil {
+		return nil, err
+	}
+	var results []*lightning.LightningChannel
+	for _, channel := range info.Channels {
+		if channel.State != channelStateOpen {
+			continue
+		}
+		channelId, err := hex.DecodeString(channel.ChannelId)
+		if err != nil || len(channelId) < 8 {
+			return nil, fmt.Errorf("invalid channel id: %s", channel.ChannelId)
+		}
+		results = append(results, &lightning.LightningChannel{
+			Id:          lightning.ChanId(binary.BigEndian.Uint64(channelId)),
+			OutboundSat: channel.BalanceSat,
+			InboundSat:  channel.InboundLiquiditySat,
+			Capacity:    channel.CapacitySat,
+			Point:       &lightning.ChannelPoint{FundingTxId: channel.FundingTxId},
+		})
+	}
+	return results, nil
+}
+
+func (p *Phoenixd) OpenChannel(request lightning.OpenChannelRequest) (*lightning.ChannelPoint, error) {
+	// liquidity is managed by phoenixd automatically
+	return nil, lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) SpliceChannel(request lightning.SpliceRequest) (string, error) {
+	// phoenixd splices its channel on its own when receiving or sending onchain
+	return "", lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) CreateOffer(amountSat uint64, description string) (*lightning.CreateOfferResponse, error) {
+	// phoenixd only exposes a single static offer without a way to tell its payments apart
+	return nil, lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) DisableOffer(id string) error {
+	return lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) ListOfferPayments(id string) ([]*lightning.OfferPayment, error) {
+	return nil, lightning.ErrUnsupported
+}
+
+// ProbeRoute is not supported since phoenixd routes all payments through its trampoline node
+func (p *Phoenixd) ProbeRoute(destination []byte, amountSat uint64) (*lightning.RouteEstimate, error) {
+	return nil, lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) GetTransactions(limit, offset uint64) ([]*onchain.WalletTransaction, error) {
+	return nil, lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) BumpTransactionFee(txId string, feeRate float64) (string, error) {
+	return "", lightning.ErrUnsupported
+}
+
+// CreateInvoice creates an invoice for which phoenixd picks the preimage. The API has no way to pass
+// a custom preimage, so swaps which create their invoice later on are rejected before reaching this point.
+// Route hints are always included by phoenixd since all of its channels are private
+func (p *Phoenixd) CreateInvoice(value uint64, preimage []byte, expiry int64, memo string, routeHints bool) (*lightning.AddInvoiceResponse, error) {
+	if preimage != nil {
+		return nil, fmt.Errorf("%s does not support invoices with a custom preimage: %w", serviceName, lightning.ErrUnsupported)
+	}
+	params := url.Values{}
+	params.Set("amountSat", strconv.FormatUint(value, 10))
+	params.Set("description", memo)
+	if expiry != 0 {
+		params.Set("expirySeconds", strconv.FormatInt(expiry, 10))
+	}
+	var invoice createInvoiceResponse
+	if err := p.post("/createinvoice", params, &invoice); err != nil {
+		return nil, err
+	}
+	paymentHash, err := hex.DecodeString(invoice.PaymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment hash: %w", err)
+	}
+	return &lightning.AddInvoiceResponse{
+		PaymentRequest: invoice.Serialized,
+		PaymentHash:    paymentHash,
+	}, nil
+}
+
+// PayInvoice pays the invoice through the trampoline node of phoenixd. The fee limit can not be passed to phoenixd,
+// so the payment is rejected upfront if the worst case trampoline fee exceeds it
+func (p *Phoenixd) PayInvoice(ctx context.Context, invoice string, feeLimit uint, timeoutSeconds uint, chanIds []lightning.ChanId, maxParts uint32) (*lightning.PayInvoiceResponse, error) {
+	if len(chanIds) > 0 {
+		return nil, fmt.Errorf("chanIds are not supported for %s", serviceName)
+	}
+	fee, err := p.maxRoutingFee(invoice)
+	if err != nil {
+		return nil, err
+	}
+	if fee > uint64(feeLimit) {
+		return nil, fmt.Errorf("routing fee of %s of up to %d sats exceeds limit of %d sats", serviceName, fee, feeLimit)
+	}
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+	defer cancel()
+
+	params := url.Values{}
+	params.Set("invoice", invoice)
+	var response payInvoiceResponse
+	if err := p.request(ctx, http.MethodPost, "/payinvoice", params, &response); err != nil {
+		return nil, err
+	}
+	if response.Reason != "" {
+		return nil, fmt.Errorf("payment failed: %s", response.Reason)
+	}
+	return &lightning.PayInvoiceResponse{
+		FeeMsat: uint(response.RoutingFeeSat * 1000),
+	}, nil
+}
+
+func (p *Phoenixd) maxRoutingFee(invoice string) (uint64, error) {
+	info, err := p.GetInfo()
+	if err != nil {
+		return 0, err
+	}
+	network, err := boltz.ParseChain(strings.ToLower(info.Network))
+	if err != nil {
+		return 0, err
+	}
+	decoded, err := lightning.DecodeInvoice(invoice, network.Btc)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode invoice: %w", err)
+	}
+	return trampolineFeeBaseSat + uint64(math.Ceil(float64(decoded.AmountSat)*trampolineFeePpm/1_000_000)), nil
+}
+
+// NewAddress is not supported since phoenixd has no onchain wallet to receive to
+func (p *Phoenixd) NewAddress() (string, error) {
+	return "", lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) PaymentStatus(paymentHash []byte) (*lightning.PaymentStatus, error) {
+	var payment outgoingPayment
+	if err := p.get("/payments/outgoingbyhash/"+hex.EncodeToString(paymentHash), &payment); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, ErrPaymentNotInitiated
+		}
+		return nil, err
+	}
+	status := &lightning.PaymentStatus{
+		State:   lightning.PaymentPending,
+		FeeMsat: payment.Fees,
+	}
+	if payment.IsPaid {
+		status.State = lightning.PaymentSucceeded
+		status.Preimage = payment.Preimage
+	} else if payment.CompletedAt != 0 {
+		status.State = lightning.PaymentFailed
+		status.FailureReason = paymentFailure
+	}
+	return status, nil
+}
+
+// GetBalance returns the balance of the channel, since that is what onchain sends are funded from
+func (p *Phoenixd) GetBalance() (*onchain.Balance, error) {
+	var response getBalanceResponse
+	if err := p.get("/getbalance", &response); err != nil {
+		return nil, err
+	}
+	return &onchain.Balance{
+		Confirmed: response.BalanceSat,
+		Total:     response.BalanceSat,
+	}, nil
+}
+
+func (p *Phoenixd) SendToAddress(args onchain.WalletSendArgs) (string, error) {
+	if args.SendAll {
+		return "", fmt.Errorf("sending all funds is not supported for %s", serviceName)
+	}
+	params := url.Values{}
+	params.Set("address", args.Address)
+	params.Set("amountSat", strconv.FormatUint(args.Amount, 10))
+	params.Set("feerateSatByte", strconv.FormatUint(uint64(math.Ceil(args.SatPerVbyte)), 10))
+	var txId string
+	if err := p.post("/sendtoaddress", params, &txId); err != nil {
+		return "", err
+	}
+	return txId, nil
+}
+
+func (p *Phoenixd) GetOutputs(address string) ([]*onchain.Output, error) {
+	return nil, lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) GetSendFee(args onchain.WalletSendArgs) (send uint64, fee uint64, err error) {
+	return 0, 0, lightning.ErrUnsupported
+}
+
+func (p *Phoenixd) ApplyTransaction(txHex string) error {
+	return nil
+}
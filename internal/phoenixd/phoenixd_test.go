@@ -0,0 +1,228 @@
+package phoenixd
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPassword    = "password"
+	testPaymentHash = "8a6ad6c5b8dc1d9e4bd0e6e6b2b0b1c6ae3a2d0f1bd4c8d6f9c4d1b2e6a7f801"
+	testChannelId   = "00000000000000018a6ad6c5b8dc1d9e4bd0e6e6b2b0b1c6ae3a2d0f1bd4c8d6"
+	// regtest invoice for 1,000,000 sats
+	testInvoice = "lnbcrt10m1p5y4z9epp5hh09qu0605hcjvc5r6dv3ma0z45h7pxjcp4xv383avzxk4yf0tlsdqqcqzzsxqyz5vqsp5nzsy8g59gvlp694x7rc7gxfllk0wswl95vvk5eguc30jrvcqeuws9qxpqysgqmfdaryxsaze7s26ew6y4zu3hk8p9sj8ezcpcvt6rchjuxva5zvwyq7897ffw4mjmsg6efugt5k7qhfy04j6wxnlzpfu48r5mjsruzugqjp04ec"
+)
+
+func setup(t *testing.T, handler http.HandlerFunc) *Phoenixd {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, password, ok := r.BasicAuth()
+		if !ok || password != testPassword {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	require.NoError(t, err)
+	parsedPort, err := strconv.Atoi(port)
+	require.NoError(t, err)
+
+	node := &Phoenixd{Host: host, Port: parsedPort, Password: testPassword}
+	require.NoError(t, node.Connect())
+	return node
+}
+
+func writeJson(t *testing.T, w http.ResponseWriter, response any) {
+	require.NoError(t, json.NewEncoder(w).Encode(response))
+}
+
+func TestConnect(t *testing.T) {
+	node := &Phoenixd{Host: "127.0.0.1", Port: 9740}
+	require.Error(t, node.Connect())
+	require.False(t, node.Ready())
+
+	node = setup(t, func(w http.ResponseWriter, r *http.Request) {})
+	node.Password = "wrong"
+	_, err := node.GetInfo()
+	require.ErrorContains(t, err, "401")
+}
+
+func TestReadPassword(t *testing.T) {
+	dataDir := t.TempDir()
+	node := &Phoenixd{DataDir: dataDir}
+	_, err := node.ReadPassword()
+	require.Error(t, err)
+
+	config := "chain=mainnet\nhttp-password = " + testPassword + "\nhttp-password-limited-access=limited\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, configFile), []byte(config), 0600))
+	password, err := node.ReadPassword()
+	require.NoError(t, err)
+	require.Equal(t, testPassword, password)
+}
+
+func TestGetInfo(t *testing.T) {
+	node := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/getinfo", r.URL.Path)
+		writeJson(t, w, map[string]any{
+			"nodeId":      "02abc",
+			"chain":       "regtest",
+			"blockHeight": 100,
+			"version":     "0.5.0",
+			"channels": []map[string]any{
+				{"state": "Normal", "channelId": testChannelId, "balanceSat": 100, "inboundLiquiditySat": 200, "capacitySat": 300, "fundingTxId": "txid"},
+				{"state": "Closing", "balanceSat": 50, "inboundLiquiditySat": 0, "capacitySat": 50, "fundingTxId": "closed"},
+			},
+		})
+	})
+
+	info, err := node.GetInfo()
+	require.NoError(t, err)
+	require.Equal(t, "02abc", info.Pubkey)
+	require.Equal(t, "regtest", info.Network)
+	require.Equal(t, uint32(100), info.BlockHeight)
+	require.True(t, info.Synced)
+
+	channels, err := node.ListChannels()
+	require.NoError(t, err)
+	require.Len(t, channels, 1)
+	require.Equal(t, uint64(100), channels[0].OutboundSat)
+	require.Equal(t, uint64(200), channels[0].InboundSat)
+	require.Equal(t, "txid", channels[0].Point.FundingTxId)
+	require.Equal(t, lightning.ChanId(1), channels[0].Id)
+}
+
+func TestGetBalance(t *testing.T) {
+	node := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/getbalance", r.URL.Path)
+		writeJson(t, w, map[string]any{"balanceSat": 1000, "feeCreditSat": 10})
+	})
+	balance, err := node.GetBalance()
+	require.NoError(t, err)
+	require.Equal(t, &onchain.Balance{Confirmed: 1000, Total: 1000}, balance)
+}
+
+func TestCreateInvoice(t *testing.T) {
+	node := setup(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/createinvoice", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "1000", r.PostForm.Get("amountSat"))
+		require.Equal(t, "memo", r.PostForm.Get("description"))
+		require.Equal(t, "3600", r.PostForm.Get("expirySeconds"))
+		writeJson(t, w, map[string]any{"amountSat": 1000, "paymentHash": testPaymentHash, "serialized": "lnbcrt1"})
+	})
+
+	invoice, err := node.CreateInvoice(1000, nil, 3600, "memo", false)
+	require.NoError(t, err)
+	require.Equal(t, "lnbcrt1", invoice.PaymentRequest)
+	require.Equal(t, testPaymentHash, hex.EncodeToString(invoice.PaymentHash))
+
+	_, err = node.CreateInvoice(1000, []byte{1}, 3600, "memo", false)
+	require.ErrorIs(t, err, lightning.ErrUnsupported)
+}
+
+func TestPayInvoice(t *testing.T) {
+	// worst case trampoline fee for the test invoice
+	const maxFee = 4 + 4000
+
+	payHandler := func(t *testing.T, response map[string]any) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/getinfo" {
+				writeJson(t, w, map[string]any{"chain": "regtest"})
+				return
+			}
+			require.Equal(t, "/payinvoice", r.URL.Path)
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, testInvoice, r.PostForm.Get("invoice"))
+			writeJson(t, w, response)
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		node := setup(t, payHandler(t, map[string]any{"recipientAmountSat": 1000000, "routingFeeSat": 4, "paymentHash": testPaymentHash}))
+		response, err := node.PayInvoice(context.Background(), testInvoice, maxFee, 30, nil, 0)
+		require.NoError(t, err)
+		require.Equal(t, uint(4000), response.FeeMsat)
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		node := setup(t, payHandler(t, map[string]any{"paymentHash": testPaymentHash, "reason": "no route"}))
+		_, err := node.PayInvoice(context.Background(), testInvoice, maxFee, 30, nil, 0)
+		require.ErrorContains(t, err, "no route")
+	})
+
+	t.Run("FeeLimit", func(t *testing.T) {
+		node := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/getinfo", r.URL.Path)
+			writeJson(t, w, map[string]any{"chain": "regtest"})
+		})
+		_, err := node.PayInvoice(context.Background(), testInvoice, maxFee-1, 30, nil, 0)
+		require.ErrorContains(t, err, "exceeds limit")
+	})
+
+	t.Run("ChanIds", func(t *testing.T) {
+		node := setup(t, func(w http.ResponseWriter, r *http.Request) {})
+		_, err := node.PayInvoice(context.Background(), testInvoice, maxFee, 30, []lightning.ChanId{1}, 0)
+		require.Error(t, err)
+	})
+}
+
+func TestPaymentStatus(t *testing.T) {
+	paymentHash, err := hex.DecodeString(testPaymentHash)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		payment  map[string]any
+		expected *lightning.PaymentStatus
+	}{
+		{
+			name:     "Pending",
+			payment:  map[string]any{"isPaid": false, "fees": 0},
+			expected: &lightning.PaymentStatus{State: lightning.PaymentPending},
+		},
+		{
+			name:     "Succeeded",
+			payment:  map[string]any{"isPaid": true, "fees": 4000, "preimage": "preimage", "completedAt": 1},
+			expected: &lightning.PaymentStatus{State: lightning.PaymentSucceeded, FeeMsat: 4000, Preimage: "preimage"},
+		},
+		{
+			name:     "Failed",
+			payment:  map[string]any{"isPaid": false, "completedAt": 1},
+			expected: &lightning.PaymentStatus{State: lightning.PaymentFailed, FailureReason: "payment failed"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node := setup(t, func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "/payments/outgoingbyhash/"+testPaymentHash, r.URL.Path)
+				writeJson(t, w, tc.payment)
+			})
+			status, err := node.PaymentStatus(paymentHash)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, status)
+		})
+	}
+
+	t.Run("NotFound", func(t *testing.T) {
+		node := setup(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		_, err := node.PaymentStatus(paymentHash)
+		require.ErrorIs(t, err, ErrPaymentNotInitiated)
+	})
+}
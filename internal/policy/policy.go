@@ -0,0 +1,198 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// Spend describes funds which are about to leave a tenant.
+type Spend struct {
+	TenantId database.Id
+	// WalletId is the wallet the funds are sent from, nil if they are paid from the lightning node
+	WalletId *database.Id
+	Currency boltz.Currency
+	Amount   uint64
+	// Addresses are the onchain destinations, empty if the funds go to a swap or an internal wallet
+	Addresses []string
+}
+
+// Violation is returned when a spend is not allowed by the policies of a tenant.
+type Violation struct {
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return "spending policy violated: " + v.Reason
+}
+
+func violation(format string, args ...any) *Violation {
+	return &Violation{Reason: fmt.Sprintf(format, args...)}
+}
+
+type Engine struct {
+	database *database.Database
+	lock     sync.Mutex
+	tenants  map[database.Id]*sync.Mutex
+
+	now func() time.Time
+}
+
+func NewEngine(database *database.Database) *Engine {
+	return &Engine{database: database, now: time.Now}
+}
+
+func (engine *Engine) tenantLock(tenantId database.Id) *sync.Mutex {
+	engine.lock.Lock()
+	defer engine.lock.Unlock()
+	if engine.tenants == nil {
+		engine.tenants = make(map[database.Id]*sync.Mutex)
+	}
+	lock, ok := engine.tenants[tenantId]
+	if !ok {
+		lock = &sync.Mutex{}
+		engine.tenants[tenantId] = lock
+	}
+	return lock
+}
+
+func (engine *Engine) applicablePolicies(spend Spend) ([]*database.SpendingPolicy, error) {
+	policies, err := engine.database.QuerySpendingPolicies(&spend.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	var result []*database.SpendingPolicy
+	for _, policy := range policies {
+		if policy.WalletId == nil || (spend.WalletId != nil && *policy.WalletId == *spend.WalletId) {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// AddressCooldown returns the longest address cooldown of all policies of a tenant
+func (engine *Engine) AddressCooldown(tenantId database.Id) (time.Duration, error) {
+	policies, err := engine.database.QuerySpendingPolicies(&tenantId)
+	if err != nil {
+		return 0, err
+	}
+	var cooldown time.Duration
+	for _, policy := range policies {
+		cooldown = max(cooldown, policy.AddressCooldown)
+	}
+	return cooldown, nil
+}
+
+func (engine *Engine) checkLimit(spend Spend, walletId *database.Id, window time.Duration, limit *uint64, name string) error {
+	if limit == nil {
+		return nil
+	}
+	spent, err := engine.database.QuerySpent(spend.TenantId, walletId, engine.now().Add(-window))
+	if err != nil {
+		return err
+	}
+	if spent+spend.Amount > *limit {
+		return violation("%s limit of %d sats would be exceeded (already spent %d sats)", name, *limit, spent)
+	}
+	return nil
+}
+
+// checkAddress only allows addresses which were added to the allowlist by an admin.
+// With a cooldown, they can only be used once it passed since they were added.
+func (engine *Engine) checkAddress(tenantId database.Id, address string, requireAllowlist bool, cooldown time.Duration) error {
+	if !requireAllowlist && cooldown == 0 {
+		return nil
+	}
+	allowed, err := engine.database.GetAllowedAddress(tenantId, address)
+	if err != nil {
+		return err
+	}
+	if allowed == nil {
+		return violation("address %s is not part of the allowlist", address)
+	}
+	if usableAt := allowed.CreatedAt.Add(cooldown); engine.now().Before(usableAt) {
+		return violation("address %s can not be used before %s", address, usableAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (engine *Engine) check(spend Spend) error {
+	policies, err := engine.applicablePolicies(spend)
+	if err != nil {
+		return fmt.Errorf("could not query spending policies: %w", err)
+	}
+
+	var requireAllowlist bool
+	var cooldown time.Duration
+	for _, policy := range policies {
+		if policy.MaxAmount != nil && spend.Amount > *policy.MaxAmount {
+			return violation("amount %d exceeds maximum of %d sats", spend.Amount, *policy.MaxAmount)
+		}
+		if err := engine.checkLimit(spend, policy.WalletId, day, policy.DailyLimit, "daily"); err != nil {
+			return err
+		}
+		if err := engine.checkLimit(spend, policy.WalletId, week, policy.WeeklyLimit, "weekly"); err != nil {
+			return err
+		}
+		requireAllowlist = requireAllowlist || policy.RequireAllowlist
+		cooldown = max(cooldown, policy.AddressCooldown)
+	}
+	for _, address := range spend.Addresses {
+		if err := engine.checkAddress(spend.TenantId, address, requireAllowlist, cooldown); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pending is a spend which passed all policy checks. The tenant stays locked until the spend is either
+// committed or released, so that concurrent spends can not exceed the limits together.
+type Pending struct {
+	Spend Spend
+
+	engine *Engine
+	unlock func()
+}
+
+// Begin verifies that the spend is allowed by all policies which apply to it.
+// A *Violation is returned if it is not.
+func (engine *Engine) Begin(spend Spend) (*Pending, error) {
+	lock := engine.tenantLock(spend.TenantId)
+	lock.Lock()
+	if err := engine.check(spend); err != nil {
+		lock.Unlock()
+		return nil, err
+	}
+	return &Pending{Spend: spend, engine: engine, unlock: sync.OnceFunc(lock.Unlock)}, nil
+}
+
+// Commit stores the executed spend so that it counts towards the limits of the tenant and releases the tenant.
+func (pending *Pending) Commit(reference string) error {
+	defer pending.Release()
+	spend := pending.Spend
+	return pending.engine.database.CreateSpending(&database.Spending{
+		TenantId:  spend.TenantId,
+		WalletId:  spend.WalletId,
+		Currency:  spend.Currency,
+		Amount:    spend.Amount,
+		Address:   strings.Join(spend.Addresses, ","),
+		Reference: reference,
+		CreatedAt: pending.engine.now(),
+	})
+}
+
+// Release unlocks the tenant without recording the spend, it is a noop if the spend was committed already.
+func (pending *Pending) Release() {
+	if pending != nil {
+		pending.unlock()
+	}
+}
@@ -0,0 +1,186 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
+	"github.com/stretchr/testify/require"
+)
+
+const testAddress = "bcrt1q7vq47xpsg2t8sxamm9ccyrqx9w0l9kl5e8lpdt"
+
+func setup(t *testing.T) (*Engine, database.Id) {
+	db := &database.Database{Path: ":memory:"}
+	require.NoError(t, db.Connect())
+	tenant := &database.Tenant{Name: "test"}
+	require.NoError(t, db.CreateTenant(tenant))
+	return NewEngine(db), tenant.Id
+}
+
+func requireViolation(t *testing.T, err error) {
+	var violation *Violation
+	require.ErrorAs(t, err, &violation)
+}
+
+func check(engine *Engine, spend Spend) error {
+	pending, err := engine.Begin(spend)
+	pending.Release()
+	return err
+}
+
+func record(engine *Engine, spend Spend, reference string) error {
+	pending, err := engine.Begin(spend)
+	if err != nil {
+		return err
+	}
+	return pending.Commit(reference)
+}
+
+func TestCheck(t *testing.T) {
+	walletId := database.Id(1)
+	otherWalletId := database.Id(2)
+
+	t.Run("NoPolicy", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		require.NoError(t, check(engine, Spend{TenantId: tenantId, Amount: 1_000_000, Addresses: []string{testAddress}}))
+	})
+
+	t.Run("MaxAmount", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		maxAmount := uint64(1000)
+		require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{TenantId: tenantId, MaxAmount: &maxAmount}))
+
+		require.NoError(t, check(engine, Spend{TenantId: tenantId, Amount: maxAmount}))
+		requireViolation(t, check(engine, Spend{TenantId: tenantId, Amount: maxAmount + 1}))
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		dailyLimit := uint64(1000)
+		weeklyLimit := uint64(1500)
+		require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{
+			TenantId:    tenantId,
+			DailyLimit:  &dailyLimit,
+			WeeklyLimit: &weeklyLimit,
+		}))
+
+		now := time.Now()
+		engine.now = func() time.Time { return now }
+
+		spend := Spend{TenantId: tenantId, WalletId: &walletId, Currency: boltz.CurrencyBtc, Amount: 600}
+		require.NoError(t, check(engine, spend))
+		require.NoError(t, record(engine, spend, "first"))
+		requireViolation(t, check(engine, spend))
+
+		// the daily limit resets after 24 hours, but the weekly one still applies
+		now = now.Add(25 * time.Hour)
+		require.NoError(t, check(engine, spend))
+		require.NoError(t, record(engine, spend, "second"))
+
+		now = now.Add(25 * time.Hour)
+		requireViolation(t, check(engine, spend))
+		require.NoError(t, check(engine, Spend{TenantId: tenantId, Amount: 300}))
+	})
+
+	t.Run("WalletPolicy", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		maxAmount := uint64(1000)
+		require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{
+			TenantId:  tenantId,
+			WalletId:  &walletId,
+			MaxAmount: &maxAmount,
+		}))
+
+		requireViolation(t, check(engine, Spend{TenantId: tenantId, WalletId: &walletId, Amount: 2000}))
+		require.NoError(t, check(engine, Spend{TenantId: tenantId, WalletId: &otherWalletId, Amount: 2000}))
+		require.NoError(t, check(engine, Spend{TenantId: tenantId, Amount: 2000}))
+	})
+
+	t.Run("Allowlist", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{TenantId: tenantId, RequireAllowlist: true}))
+
+		spend := Spend{TenantId: tenantId, Amount: 1000, Addresses: []string{testAddress}}
+		requireViolation(t, check(engine, spend))
+		// swaps without an external destination are not affected
+		require.NoError(t, check(engine, Spend{TenantId: tenantId, Amount: 1000}))
+
+		require.NoError(t, engine.database.CreateAllowedAddress(&database.AllowedAddress{TenantId: tenantId, Address: testAddress}))
+		require.NoError(t, check(engine, spend))
+	})
+
+	t.Run("Cooldown", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		cooldown := time.Hour
+		require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{TenantId: tenantId, AddressCooldown: cooldown}))
+
+		now := time.Now()
+		engine.now = func() time.Time { return now }
+
+		spend := Spend{TenantId: tenantId, Amount: 1000, Addresses: []string{testAddress}}
+		requireViolation(t, check(engine, spend))
+
+		// rejected spends must not register the address
+		allowed, err := engine.database.GetAllowedAddress(tenantId, testAddress)
+		require.NoError(t, err)
+		require.Nil(t, allowed)
+		now = now.Add(2 * cooldown)
+		requireViolation(t, check(engine, spend))
+
+		require.NoError(t, engine.database.CreateAllowedAddress(&database.AllowedAddress{TenantId: tenantId, Address: testAddress, CreatedAt: now}))
+		now = now.Add(cooldown / 2)
+		requireViolation(t, check(engine, spend))
+
+		now = now.Add(cooldown)
+		require.NoError(t, check(engine, spend))
+
+		result, err := engine.AddressCooldown(tenantId)
+		require.NoError(t, err)
+		require.Equal(t, cooldown, result)
+	})
+
+	t.Run("MultipleAddresses", func(t *testing.T) {
+		engine, tenantId := setup(t)
+		require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{TenantId: tenantId, RequireAllowlist: true}))
+		require.NoError(t, engine.database.CreateAllowedAddress(&database.AllowedAddress{TenantId: tenantId, Address: testAddress}))
+
+		requireViolation(t, check(engine, Spend{TenantId: tenantId, Amount: 1000, Addresses: []string{testAddress, "other"}}))
+	})
+}
+
+func TestConcurrentSpends(t *testing.T) {
+	engine, tenantId := setup(t)
+	dailyLimit := uint64(1000)
+	require.NoError(t, engine.database.SetSpendingPolicy(&database.SpendingPolicy{TenantId: tenantId, DailyLimit: &dailyLimit}))
+
+	spend := Spend{TenantId: tenantId, Currency: boltz.CurrencyBtc, Amount: 600}
+	pending, err := engine.Begin(spend)
+	require.NoError(t, err)
+
+	result := make(chan error)
+	go func() {
+		_, err := engine.Begin(spend)
+		result <- err
+	}()
+
+	select {
+	case <-result:
+		require.Fail(t, "second spend was checked before the first one was committed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	require.NoError(t, pending.Commit("first"))
+	requireViolation(t, <-result)
+
+	// released spends don't count towards the limits
+	otherTenant := &database.Tenant{Name: "other"}
+	require.NoError(t, engine.database.CreateTenant(otherTenant))
+	spend.TenantId = otherTenant.Id
+	pending, err = engine.Begin(spend)
+	require.NoError(t, err)
+	pending.Release()
+	pending.Release()
+	require.NoError(t, check(engine, spend))
+}
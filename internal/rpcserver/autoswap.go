@@ -6,11 +6,17 @@ import (
 	"fmt"
 	"github.com/BoltzExchange/boltz-client/v2/internal/autoswap"
 	"github.com/BoltzExchange/boltz-client/v2/internal/database"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
 	"github.com/BoltzExchange/boltz-client/v2/internal/macaroons"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/serializers"
 	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"maps"
+	"slices"
+	"time"
 )
 
 type routedAutoSwapServer struct {
@@ -20,22 +26,33 @@ type routedAutoSwapServer struct {
 	swapper  *autoswap.AutoSwap
 }
 
-func (server *routedAutoSwapServer) lnSwapper(ctx context.Context) *autoswap.LightningSwapper {
+func (server *routedAutoSwapServer) lnSwapper(ctx context.Context, node string) *autoswap.LightningSwapper {
 	if isAdmin(ctx) {
-		return server.swapper.GetLnSwapper()
+		return server.swapper.GetLnSwapperFor(node)
 	}
 	return nil
 }
 
-func (server *routedAutoSwapServer) chainSwapper(ctx context.Context) *autoswap.ChainSwapper {
-	return server.swapper.GetChainSwapper(requireTenantId(ctx))
+func (server *routedAutoSwapServer) lnSwappers(ctx context.Context) []*autoswap.LightningSwapper {
+	if isAdmin(ctx) {
+		return server.swapper.GetLnSwappers()
+	}
+	return nil
+}
+
+func (server *routedAutoSwapServer) chainSwapper(ctx context.Context, name string) *autoswap.ChainSwapper {
+	return server.swapper.GetChainSwapper(requireTenantId(ctx), name)
+}
+
+func (server *routedAutoSwapServer) chainSwappers(ctx context.Context) []*autoswap.ChainSwapper {
+	return server.swapper.GetChainSwappers(requireTenantId(ctx))
 }
 
 func (server *routedAutoSwapServer) requireSwapper(ctx context.Context) error {
 	if err := server.swapper.Error(); err != "" {
 		return fmt.Errorf("autoswap: %s", err)
 	}
-	if server.lnSwapper(ctx) == nil && server.chainSwapper(ctx) == nil {
+	if len(server.lnSwappers(ctx)) == 0 && len(server.chainSwappers(ctx)) == 0 {
 		return errors.New("autoswap not configured")
 	}
 	return nil
@@ -46,14 +63,14 @@ func (server *routedAutoSwapServer) GetRecommendations(ctx context.Context, _ *a
 		return nil, err
 	}
 	response := &autoswaprpc.GetRecommendationsResponse{}
-	if lnSwapper := server.lnSwapper(ctx); lnSwapper != nil {
+	for _, lnSwapper := range server.lnSwappers(ctx) {
 		recommendations, err := lnSwapper.GetConfig().GetSwapRecommendations(true)
 		if err != nil {
 			return nil, err
 		}
-		response.Lightning = recommendations
+		response.Lightning = append(response.Lightning, recommendations...)
 	}
-	if chainSwapper := server.chainSwapper(ctx); chainSwapper != nil {
+	for _, chainSwapper := range server.chainSwappers(ctx) {
 		recommendation, err := chainSwapper.GetConfig().GetRecommendation()
 		if err != nil {
 			return nil, err
@@ -68,28 +85,36 @@ func (server *routedAutoSwapServer) ExecuteRecommendations(ctx context.Context,
 		return nil, err
 	}
 
-	if len(request.Lightning) > 0 {
-		if lnSwapper := server.lnSwapper(ctx); lnSwapper != nil {
-			err := lnSwapper.GetConfig().CheckAndExecute(request.Lightning, request.GetForce())
-			if err != nil {
-				return nil, err
+	byNode := make(map[string][]*autoswaprpc.LightningRecommendation)
+	for _, recommendation := range request.Lightning {
+		byNode[recommendation.LightningNode] = append(byNode[recommendation.LightningNode], recommendation)
+	}
+	for _, node := range slices.Sorted(maps.Keys(byNode)) {
+		lnSwapper := server.lnSwapper(ctx, node)
+		if lnSwapper == nil {
+			if node != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "lightning swaps not configured for node %s", node)
 			}
-		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "lightning swaps not configured")
 		}
+		err := lnSwapper.GetConfig().CheckAndExecute(byNode[node], request.GetForce())
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	if len(request.Chain) > 0 {
-		if chainSwapper := server.chainSwapper(ctx); chainSwapper != nil {
-			for _, recommendation := range request.Chain {
-				err := chainSwapper.GetConfig().CheckAndExecute(recommendation.Swap, request.GetForce())
-				if err != nil {
-					return nil, err
-				}
+	for _, recommendation := range request.Chain {
+		chainSwapper := server.chainSwapper(ctx, recommendation.Name)
+		if chainSwapper == nil {
+			if recommendation.Name != "" {
+				return nil, status.Errorf(codes.InvalidArgument, "chain rule %s not configured", recommendation.Name)
 			}
-		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "chain swaps not configured")
 		}
+		err := chainSwapper.GetConfig().CheckAndExecute(recommendation.Swap, request.GetForce())
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &autoswaprpc.ExecuteRecommendationsResponse{}, nil
@@ -99,50 +124,86 @@ func serializeBudget(budget *autoswap.Budget) *autoswaprpc.Budget {
 	if budget == nil {
 		return nil
 	}
-	return &autoswaprpc.Budget{
+	serialized := &autoswaprpc.Budget{
 		Total:     budget.Total,
 		StartDate: serializeTime(budget.StartDate),
 		EndDate:   serializeTime(budget.EndDate),
 		Remaining: budget.Amount,
 		Stats:     budget.Stats,
 	}
+	if budget.Currency != nil {
+		currency := serializers.SerializeCurrency(*budget.Currency)
+		serialized.Currency = &currency
+	}
+	if budget.SwapType != nil {
+		swapType := serializers.SerializeSwapType(*budget.SwapType)
+		serialized.Type = &swapType
+	}
+	return serialized
+}
+
+func serializeBudgetLimits(budget *autoswap.Budget) (result []*autoswaprpc.Budget) {
+	if budget == nil {
+		return nil
+	}
+	for _, limit := range budget.Limits {
+		result = append(result, serializeBudget(limit))
+	}
+	return result
 }
 
 func (server *routedAutoSwapServer) GetStatus(ctx context.Context, _ *autoswaprpc.GetStatusRequest) (*autoswaprpc.GetStatusResponse, error) {
 	if err := server.requireSwapper(ctx); err != nil {
 		return nil, err
 	}
-	ln := &autoswaprpc.Status{Running: false}
-	chain := &autoswaprpc.Status{Running: false}
+	response := &autoswaprpc.GetStatusResponse{
+		Lightning: &autoswaprpc.Status{Running: false},
+		Chain:     &autoswaprpc.Status{Running: false},
+	}
 
-	if lnSwapper := server.lnSwapper(ctx); lnSwapper != nil {
+	for _, lnSwapper := range server.lnSwappers(ctx) {
 		cfg := lnSwapper.GetConfig()
-		budget, err := lnSwapper.GetConfig().GetCurrentBudget(false)
+		budget, err := cfg.GetCurrentBudget()
 		if err != nil {
 			return nil, err
 		}
-		ln.Running = lnSwapper.Running()
-		ln.Error = serializeOptionalString(lnSwapper.Error())
-		ln.Description = cfg.Description()
-		ln.Budget = serializeBudget(budget)
+		node := &autoswaprpc.Status{
+			Running:     lnSwapper.Running(),
+			Error:       serializeOptionalString(lnSwapper.Error()),
+			Description: cfg.Description(),
+			Budget:      serializeBudget(budget),
+			Budgets:     serializeBudgetLimits(budget),
+			Name:        cfg.LightningNode,
+		}
+		if cfg.LightningNode == "" {
+			response.Lightning = node
+		} else {
+			response.LightningNodes = append(response.LightningNodes, node)
+		}
 	}
 
-	if chainSwapper := server.chainSwapper(ctx); chainSwapper != nil {
+	for _, chainSwapper := range server.chainSwappers(ctx) {
 		cfg := chainSwapper.GetConfig()
-		budget, err := chainSwapper.GetConfig().GetCurrentBudget(false)
+		budget, err := cfg.GetCurrentBudget()
 		if err != nil {
 			return nil, err
 		}
-		chain.Running = chainSwapper.Running()
-		chain.Error = serializeOptionalString(chainSwapper.Error())
-		chain.Description = cfg.Description()
-		chain.Budget = serializeBudget(budget)
+		chain := &autoswaprpc.Status{
+			Running:     chainSwapper.Running(),
+			Error:       serializeOptionalString(chainSwapper.Error()),
+			Description: cfg.Description(),
+			Budget:      serializeBudget(budget),
+			Budgets:     serializeBudgetLimits(budget),
+			Name:        cfg.Name,
+		}
+		if cfg.Name == "" {
+			response.Chain = chain
+		} else {
+			response.ChainRules = append(response.ChainRules, chain)
+		}
 	}
 
-	return &autoswaprpc.GetStatusResponse{
-		Lightning: ln,
-		Chain:     chain,
-	}, nil
+	return response, nil
 }
 
 func (server *routedAutoSwapServer) GetConfig(ctx context.Context, _ *autoswaprpc.GetConfigRequest) (*autoswaprpc.Config, error) {
@@ -172,3 +233,175 @@ func (server *routedAutoSwapServer) UpdateChainConfig(ctx context.Context, reque
 
 	return server.GetConfig(ctx, &autoswaprpc.GetConfigRequest{})
 }
+
+func (server *routedAutoSwapServer) GetSnapshot(ctx context.Context, _ *autoswaprpc.GetSnapshotRequest) (*autoswaprpc.Snapshot, error) {
+	var tenantId *database.Id
+	if !isAdmin(ctx) {
+		tenantId = macaroons.TenantIdFromContext(ctx)
+	}
+	return server.swapper.GetSnapshot(tenantId)
+}
+
+func serializeSimulationResult(result *autoswap.SimulationResult) *autoswaprpc.SimulationResult {
+	if result == nil {
+		return nil
+	}
+	serialized := &autoswaprpc.SimulationResult{
+		Swaps:     result.Swaps,
+		TotalFees: result.TotalFees,
+		Errors:    result.Errors,
+	}
+	for _, budget := range result.Budgets {
+		serialized.Budgets = append(serialized.Budgets, serializeBudget(budget))
+	}
+	return serialized
+}
+
+func (server *routedAutoSwapServer) Simulate(ctx context.Context, request *autoswaprpc.SimulateRequest) (*autoswaprpc.SimulateResponse, error) {
+	config := request.Config
+	if config == nil {
+		config = server.swapper.GetConfig(macaroons.TenantIdFromContext(ctx))
+	} else if !isAdmin(ctx) {
+		if len(config.Lightning) > 0 {
+			return nil, status.Error(codes.PermissionDenied, "only admins can simulate lightning autoswap")
+		}
+		// tenants can only simulate chain swaps using their own wallets
+		tenant := requireTenant(ctx)
+		config = proto.Clone(config).(*autoswaprpc.Config)
+		for _, chainConfig := range config.Chain {
+			chainConfig.Tenant = &tenant.Name
+		}
+	}
+
+	results, err := server.swapper.Simulate(config, request.Snapshots)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	response := &autoswaprpc.SimulateResponse{Lightning: serializeSimulationResult(results.Lightning)}
+	for _, result := range results.Chain {
+		response.Chain = append(response.Chain, serializeSimulationResult(result))
+	}
+	return response, nil
+}
+
+func serializeAutoSwapEvent(event *database.AutoSwapEvent) *autoswaprpc.AutoSwapEvent {
+	serialized := &autoswaprpc.AutoSwapEvent{
+		Id:        event.Id,
+		Swapper:   serializeSwapperType(event.Swapper),
+		TenantId:  event.TenantId,
+		CreatedAt: event.CreatedAt.Unix(),
+		Error:     serializeOptionalString(event.Error),
+		Rule:      event.Rule,
+	}
+	for _, swap := range event.Swaps {
+		serializedSwap := &autoswaprpc.AutoSwapEventSwap{
+			Type:             serializers.SerializeSwapType(swap.Type),
+			Amount:           swap.Amount,
+			FeeEstimate:      swap.FeeEstimate,
+			DismissedReasons: swap.DismissedReasons,
+			SwapId:           serializeOptionalString(swap.SwapId),
+		}
+		if swap.ChannelId != nil {
+			serializedSwap.ChannelId = lightning.SerializeChanId(*swap.ChannelId)
+		}
+		serialized.Swaps = append(serialized.Swaps, serializedSwap)
+	}
+	return serialized
+}
+
+func (server *routedAutoSwapServer) ListAutoSwapEvents(ctx context.Context, request *autoswaprpc.ListAutoSwapEventsRequest) (*autoswaprpc.ListAutoSwapEventsResponse, error) {
+	query := database.AutoSwapEventQuery{
+		ExcludeEmpty: request.ExcludeEmpty,
+		Limit:        request.Limit,
+		Offset:       request.Offset,
+	}
+	if !isAdmin(ctx) {
+		query.TenantId = macaroons.TenantIdFromContext(ctx)
+	}
+	if request.Swapper != nil {
+		swapper := string(autoswap.Lightning)
+		if request.GetSwapper() == autoswaprpc.SwapperType_CHAIN {
+			swapper = string(autoswap.Chain)
+		}
+		query.Swapper = &swapper
+	}
+	if request.Since != nil {
+		query.Since = time.Unix(request.GetSince(), 0)
+	}
+	events, err := server.database.QueryAutoSwapEvents(query)
+	if err != nil {
+		return nil, err
+	}
+	response := &autoswaprpc.ListAutoSwapEventsResponse{}
+	for _, event := range events {
+		response.Events = append(response.Events, serializeAutoSwapEvent(event))
+	}
+	return response, nil
+}
+
+func serializeSwapperType(swapper string) autoswaprpc.SwapperType {
+	if swapper == string(autoswap.Chain) {
+		return autoswaprpc.SwapperType_CHAIN
+	}
+	return autoswaprpc.SwapperType_LIGHTNING
+}
+
+func serializePendingApproval(approval *database.AutoSwapApproval) *autoswaprpc.PendingApproval {
+	serialized := &autoswaprpc.PendingApproval{
+		Id:          approval.Id,
+		Swapper:     serializeSwapperType(approval.Swapper),
+		TenantId:    approval.TenantId,
+		Type:        serializers.SerializeSwapType(approval.Type),
+		Currency:    serializers.SerializeCurrency(approval.Currency),
+		Amount:      approval.Amount,
+		FeeEstimate: approval.FeeEstimate,
+		CreatedAt:   approval.CreatedAt.Unix(),
+		ExpiresAt:   approval.ExpiresAt.Unix(),
+		Rule:        approval.Rule,
+	}
+	if approval.ChannelId != nil {
+		serialized.ChannelId = lightning.SerializeChanId(*approval.ChannelId)
+	}
+	return serialized
+}
+
+func (server *routedAutoSwapServer) approvalTenant(ctx context.Context) *database.Id {
+	if isAdmin(ctx) {
+		return nil
+	}
+	return macaroons.TenantIdFromContext(ctx)
+}
+
+func handleApprovalError(err error) error {
+	if errors.Is(err, autoswap.ErrApprovalNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func (server *routedAutoSwapServer) ListPendingApprovals(ctx context.Context, _ *autoswaprpc.ListPendingApprovalsRequest) (*autoswaprpc.ListPendingApprovalsResponse, error) {
+	approvals, err := server.swapper.ListPendingApprovals(server.approvalTenant(ctx))
+	if err != nil {
+		return nil, err
+	}
+	response := &autoswaprpc.ListPendingApprovalsResponse{}
+	for _, approval := range approvals {
+		response.Approvals = append(response.Approvals, serializePendingApproval(approval))
+	}
+	return response, nil
+}
+
+func (server *routedAutoSwapServer) ApproveRecommendation(ctx context.Context, request *autoswaprpc.ApproveRecommendationRequest) (*autoswaprpc.ApproveRecommendationResponse, error) {
+	swapId, err := server.swapper.ApproveRecommendation(request.Id, server.approvalTenant(ctx))
+	if err != nil {
+		return nil, handleApprovalError(err)
+	}
+	return &autoswaprpc.ApproveRecommendationResponse{SwapId: swapId}, nil
+}
+
+func (server *routedAutoSwapServer) RejectRecommendation(ctx context.Context, request *autoswaprpc.RejectRecommendationRequest) (*autoswaprpc.RejectRecommendationResponse, error) {
+	if err := server.swapper.RejectRecommendation(request.Id, server.approvalTenant(ctx)); err != nil {
+		return nil, handleApprovalError(err)
+	}
+	return &autoswaprpc.RejectRecommendationResponse{}, nil
+}
@@ -316,7 +316,7 @@ func TestReverseSwap(t *testing.T) {
 
 		stream, _ := swapStream(t, client, swap.Id)
 
-		_, err = lnd.PayInvoice(context.Background(), *swap.Invoice, 10000, 30, nil)
+		_, err = lnd.PayInvoice(context.Background(), *swap.Invoice, 10000, 30, nil, 0)
 		require.NoError(t, err)
 
 		stream(boltzrpc.SwapState_PENDING)
@@ -389,7 +389,7 @@ func TestReverseSwap(t *testing.T) {
 
 			stream, _ := swapStream(t, client, swap.Id)
 
-			_, err = cfg.Cln.PayInvoice(context.Background(), *swap.Invoice, 10000, 30, nil)
+			_, err = cfg.Cln.PayInvoice(context.Background(), *swap.Invoice, 10000, 30, nil, 0)
 			require.NoError(t, err)
 
 			stream(boltzrpc.SwapState_PENDING)
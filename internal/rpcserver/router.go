@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"net/url"
 	"regexp"
@@ -25,7 +26,9 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
 	"github.com/BoltzExchange/boltz-client/v2/internal/macaroons"
 	"github.com/BoltzExchange/boltz-client/v2/internal/nursery"
+	"github.com/BoltzExchange/boltz-client/v2/internal/offers"
 	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/internal/policy"
 	"github.com/BoltzExchange/boltz-client/v2/internal/utils"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
@@ -59,14 +62,18 @@ type routedBoltzServer struct {
 
 	network *boltz.Network
 
-	onchain    *onchain.Onchain
-	lightning  lightning.LightningNode
-	boltz      *boltz.Api
-	nursery    *nursery.Nursery
-	database   *database.Database
-	swapper    *autoswap.AutoSwap
-	macaroon   *macaroons.Service
-	referralId string
+	onchain   *onchain.Onchain
+	lightning lightning.LightningNode
+	// lightningNodes are the additional nodes configured next to the main one, keyed by their name
+	lightningNodes map[string]lightning.LightningNode
+	boltz          *boltz.Api
+	nursery        *nursery.Nursery
+	database       *database.Database
+	swapper        *autoswap.AutoSwap
+	offers         *offers.Offers
+	macaroon       *macaroons.Service
+	policy         *policy.Engine
+	referralId     string
 
 	walletBackends map[boltz.Currency]onchain.WalletBackend
 
@@ -91,25 +98,83 @@ func (server *routedBoltzServer) GetBlockUpdates(currency boltz.Currency) (<-cha
 	}
 }
 
+func (server *routedBoltzServer) EstimateFee(currency boltz.Currency) (float64, error) {
+	return server.onchain.EstimateFee(currency)
+}
+
 func tenantContext(tenant *database.Tenant) context.Context {
 	return macaroons.AddTenantToContext(context.Background(), tenant)
 }
 
-func (server *routedBoltzServer) CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) error {
-	_, err := server.createSwap(tenantContext(tenant), true, request)
-	return err
+func (server *routedBoltzServer) CreateAutoSwap(tenant *database.Tenant, request *boltzrpc.CreateSwapRequest) (string, error) {
+	response, err := server.createSwap(tenantContext(tenant), true, request)
+	if err != nil {
+		return "", err
+	}
+	return response.GetId(), nil
 }
 
-func (server *routedBoltzServer) CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) error {
-	_, err := server.createReverseSwap(tenantContext(tenant), true, request)
-	return err
+func (server *routedBoltzServer) CreateAutoReverseSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
+	response, err := server.createReverseSwap(tenantContext(tenant), true, request)
+	if err != nil {
+		return "", err
+	}
+	return response.GetId(), nil
 }
 
-func (server *routedBoltzServer) GetLightningChannels() ([]*lightning.LightningChannel, error) {
-	if server.lightning != nil {
-		return server.lightning.ListChannels()
+// CreateOfferSwap creates the reverse swap for a payment to an offer, which is not an autoswap
+func (server *routedBoltzServer) CreateOfferSwap(tenant *database.Tenant, request *boltzrpc.CreateReverseSwapRequest) (string, error) {
+	response, err := server.createReverseSwap(tenantContext(tenant), false, request)
+	if err != nil {
+		return "", err
+	}
+	return response.GetId(), nil
+}
+
+func (server *routedBoltzServer) GetLightningChannels(name string) ([]*lightning.LightningChannel, error) {
+	node, err := server.GetLightningNode(name)
+	if err != nil {
+		return nil, err
+	}
+	return node.ListChannels()
+}
+
+// GetLightningNode returns the lightning node with the given name, the main node if the name is empty
+func (server *routedBoltzServer) GetLightningNode(name string) (lightning.LightningNode, error) {
+	if name == "" {
+		if server.lightning != nil {
+			return server.lightning, nil
+		}
+		return nil, errors.New("lightning node not available")
+	}
+	if node, ok := server.lightningNodes[name]; ok {
+		return node, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "lightning node %s not found", name)
+}
+
+// nodeWalletId returns the id of the wallet the lightning node with the given name is registered as
+func (server *routedBoltzServer) nodeWalletId(name string) (*database.Id, error) {
+	node, err := server.GetLightningNode(name)
+	if err != nil {
+		return nil, err
+	}
+	if !hasNodeWallet(node) {
+		return nil, nil
+	}
+	info, err := node.GetInfo()
+	if err != nil {
+		return nil, fmt.Errorf("could not get info from lightning: %w", err)
+	}
+	wallet, err := server.database.GetNodeWallet(info.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("could not get node wallet from db: %w", err)
 	}
-	return nil, errors.New("lightning channels not available")
+	return &wallet.Id, nil
+}
+
+func (server *routedBoltzServer) lightningNodeNames() []string {
+	return slices.Sorted(maps.Keys(server.lightningNodes))
 }
 
 func (server *routedBoltzServer) GetAutoSwapPairInfo(swapType boltzrpc.SwapType, pair *boltzrpc.Pair) (*boltzrpc.PairInfo, error) {
@@ -119,9 +184,36 @@ func (server *routedBoltzServer) GetAutoSwapPairInfo(swapType boltzrpc.SwapType,
 	})
 }
 
-func (server *routedBoltzServer) CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest) error {
-	_, err := server.createChainSwap(tenantContext(tenant), true, request)
-	return err
+func (server *routedBoltzServer) GetAutoSwapPairs() (*boltzrpc.GetPairsResponse, error) {
+	return server.GetPairs(context.Background(), &empty.Empty{})
+}
+
+func (server *routedBoltzServer) CreateAutoChainSwap(tenant *database.Tenant, request *boltzrpc.CreateChainSwapRequest, origin autoswap.ChainSwapOrigin) (string, error) {
+	response, err := server.createChainSwap(tenantContext(tenant), true, origin, request)
+	if err != nil {
+		return "", err
+	}
+	return response.GetId(), nil
+}
+
+// AutoWalletSend sends funds on behalf of autoswap. If toWalletId is set, the funds are sent to a new address of that wallet.
+func (server *routedBoltzServer) AutoWalletSend(tenant *database.Tenant, request *boltzrpc.WalletSendRequest, toWalletId *database.Id) (string, error) {
+	ctx := tenantContext(tenant)
+	if toWalletId != nil {
+		toWallet, err := server.getWallet(ctx, onchain.WalletChecker{Id: toWalletId, AllowReadonly: true})
+		if err != nil {
+			return "", err
+		}
+		request.Address, err = toWallet.NewAddress()
+		if err != nil {
+			return "", fmt.Errorf("could not get address of wallet %d: %w", *toWalletId, err)
+		}
+	}
+	response, err := server.walletSend(ctx, request, toWalletId != nil)
+	if err != nil {
+		return "", err
+	}
+	return response.GetTxId(), nil
 }
 
 func (server *routedBoltzServer) WalletSendFee(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendFee, error) {
@@ -276,6 +368,7 @@ func (server *routedBoltzServer) GetInfo(ctx context.Context, _ *boltzrpc.GetInf
 
 		response.Node = server.lightning.Name()
 		response.NodePubkey = lightningInfo.Pubkey
+		response.LightningNodes = server.lightningNodeNames()
 		//nolint:staticcheck
 		response.LndPubkey = lightningInfo.Pubkey
 	} else {
@@ -298,12 +391,22 @@ func (server *routedBoltzServer) GetInfo(ctx context.Context, _ *boltzrpc.GetInf
 
 }
 
-func (server *routedBoltzServer) GetPairInfo(_ context.Context, request *boltzrpc.GetPairInfoRequest) (*boltzrpc.PairInfo, error) {
+func (server *routedBoltzServer) GetPairInfo(ctx context.Context, request *boltzrpc.GetPairInfoRequest) (*boltzrpc.PairInfo, error) {
 	switch request.Type {
 	case boltzrpc.SwapType_SUBMARINE:
 		return server.getSubmarinePair(request.Pair)
 	case boltzrpc.SwapType_REVERSE:
-		return server.getReversePair(request.Pair)
+		pairInfo, err := server.getReversePair(request.Pair)
+		if err != nil {
+			return nil, err
+		}
+		if request.Amount != nil {
+			pairInfo.RoutingFee, err = server.estimateRoutingFee(ctx, request.GetLightningNode(), request.GetAmount())
+			if err != nil {
+				return nil, err
+			}
+		}
+		return pairInfo, nil
 	case boltzrpc.SwapType_CHAIN:
 		return server.getChainPair(request.Pair)
 	default:
@@ -373,12 +476,55 @@ func (server *routedBoltzServer) GetSwapQuote(ctx context.Context, request *bolt
 		return nil, status.Errorf(codes.InvalidArgument, "amount %d exceeds maximum %d", amountToCheck, pairInfo.Limits.Maximal)
 	}
 
-	return &boltzrpc.GetSwapQuoteResponse{
+	response := &boltzrpc.GetSwapQuoteResponse{
 		SendAmount:    quote.SendAmount,
 		ReceiveAmount: quote.ReceiveAmount,
 		BoltzFee:      quote.BoltzFee,
 		NetworkFee:    quote.NetworkFee,
 		PairInfo:      pairInfo,
+	}
+	if request.Type == boltzrpc.SwapType_REVERSE {
+		// the invoice of a reverse swap is for the send amount
+		response.RoutingFee, err = server.estimateRoutingFee(ctx, request.GetLightningNode(), quote.SendAmount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// estimateRoutingFee probes routes from the lightning node to the nodes of Boltz.
+// Failing to find a route is not an error, since the quote is still valid without the estimate
+func (server *routedBoltzServer) estimateRoutingFee(ctx context.Context, nodeName string, amount uint64) (*boltzrpc.RoutingFeeEstimate, error) {
+	if err := server.checkLightningNode(ctx, &nodeName); err != nil {
+		return nil, err
+	}
+	if !server.lightningAvailable(ctx) {
+		return nil, nil
+	}
+	node, err := server.GetLightningNode(nodeName)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := server.boltz.GetNodes()
+	if err != nil {
+		logger.Warnf("Could not get nodes of Boltz to probe routes: %v", err)
+		return nil, nil
+	}
+	var destinations [][]byte
+	for _, info := range nodes[string(boltz.CurrencyBtc)] {
+		if destination, err := hex.DecodeString(info.PublicKey); err == nil {
+			destinations = append(destinations, destination)
+		}
+	}
+	estimate, err := lightning.EstimateRoutingFee(node, destinations, amount)
+	if err != nil {
+		logger.Debugf("Could not estimate routing fee of %d sats with %s: %v", amount, node.Name(), err)
+		return nil, nil
+	}
+	return &boltzrpc.RoutingFeeEstimate{
+		FeeMsat:            estimate.FeeMsat,
+		SuccessProbability: estimate.SuccessProbability,
 	}, nil
 }
 
@@ -665,6 +811,19 @@ func (server *routedBoltzServer) GetSwapInfo(ctx context.Context, request *boltz
 	return nil, status.Errorf(codes.InvalidArgument, "no ID or payment hash provided")
 }
 
+func (server *routedBoltzServer) ListSwapPayments(ctx context.Context, request *boltzrpc.ListSwapPaymentsRequest) (*boltzrpc.ListSwapPaymentsResponse, error) {
+	payments, err := server.database.QuerySwapPayments(database.PaymentAttemptQuery{
+		SwapId:   request.SwapId,
+		TenantId: macaroons.TenantIdFromContext(ctx),
+		Limit:    request.Limit,
+		Offset:   request.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &boltzrpc.ListSwapPaymentsResponse{Payments: serializePaymentAttempts(payments)}, nil
+}
+
 func (server *routedBoltzServer) GetSwapInfoStream(request *boltzrpc.GetSwapInfoRequest, stream boltzrpc.Boltz_GetSwapInfoStreamServer) error {
 	var updates <-chan nursery.SwapUpdate
 	var stop func()
@@ -774,6 +933,36 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 
 	pair := serializers.ParsePair(request.Pair)
 
+	if err := server.checkLightningNode(ctx, request.LightningNode); err != nil {
+		return nil, err
+	}
+
+	if request.GetRouteHints() && request.GetInvoice() != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "route hints can only be added to invoices created by the daemon")
+	}
+
+	var spliceNode lightning.LightningNode
+	var spliceChannel lightning.ChanId
+	if channelId := request.GetSpliceChannelId(); channelId != "" {
+		if !server.lightningAvailable(ctx) {
+			return nil, status.Errorf(codes.PermissionDenied, "only admins can splice out of channels")
+		}
+		if request.SendFromInternal {
+			return nil, status.Errorf(codes.InvalidArgument, "splice channel can not be used with send from internal")
+		}
+		if pair.From != boltz.CurrencyBtc {
+			return nil, status.Errorf(codes.InvalidArgument, "only BTC swaps can be funded by splicing")
+		}
+		spliceChannel, err = lightning.NewChanIdFromString(channelId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid splice channel id: %s", err)
+		}
+		spliceNode, err = server.GetLightningNode(request.GetLightningNode())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if request.AcceptedPair == nil {
 		request.AcceptedPair, err = server.getSubmarinePair(request.Pair)
 		if err != nil {
@@ -848,7 +1037,11 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 	} else if request.Amount != 0 {
 		logger.Infof("Creating Swap for %d sats", request.Amount)
 
-		invoice, err := server.lightning.CreateInvoice(request.Amount, nil, 0, utils.GetSwapMemo(string(pair.From)))
+		node, err := server.GetLightningNode(request.GetLightningNode())
+		if err != nil {
+			return nil, err
+		}
+		invoice, err := node.CreateInvoice(request.Amount, nil, 0, utils.GetSwapMemo(string(pair.From)), request.GetRouteHints())
 		if err != nil {
 			return nil, err
 		}
@@ -858,6 +1051,14 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 		if request.SendFromInternal {
 			return nil, errors.New("cannot auto send if amount is 0")
 		}
+		node, err := server.GetLightningNode(request.GetLightningNode())
+		if err != nil {
+			return nil, err
+		}
+		// the invoice is created once the amount is known and has to commit to our preimage
+		if node.Name() == string(lightning.NodeTypePhoenixd) {
+			return nil, status.Errorf(codes.InvalidArgument, "amount has to be specified for %s since it can not create invoices with a custom preimage", node.Name())
+		}
 		preimage, preimageHash, err = newPreimage()
 		if err != nil {
 			return nil, err
@@ -873,11 +1074,25 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 		return nil, err
 	}
 
+	if spliceNode != nil {
+		if request.Amount == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "amount has to be specified to fund a swap by splicing")
+		}
+		spliceAmount := request.Amount + utils.CalculateFeeEstimate(request.AcceptedPair.Fees, request.Amount)
+		if swapResponse != nil {
+			spliceAmount = swapResponse.ExpectedAmount
+		}
+		if err := checkSpliceChannel(spliceNode, spliceChannel, spliceAmount, feeRate); err != nil {
+			return nil, err
+		}
+	}
+
 	wallet, err := server.getAnyWallet(ctx, onchain.WalletChecker{
 		Currency:      pair.From,
 		Id:            request.WalletId,
 		AllowReadonly: !request.SendFromInternal,
 	})
+	var spend *policy.Pending
 	if request.SendFromInternal {
 		if err != nil {
 			return nil, err
@@ -886,7 +1101,19 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 		if err := server.checkBalance(wallet, sendAmount, feeRate); err != nil {
 			return nil, err
 		}
-		logger.Infof("Using wallet %+v to pay swap", wallet.GetWalletInfo())
+		info := wallet.GetWalletInfo()
+		check := policy.Spend{TenantId: info.TenantId, WalletId: &info.Id, Currency: pair.From, Amount: sendAmount}
+		if swapResponse != nil {
+			// magic routing hints result in a direct payment to the address of the recipient
+			check.Amount = swapResponse.ExpectedAmount
+			check.Addresses = []string{swapResponse.Address}
+		}
+		spend, err = server.checkSpend(check)
+		if err != nil {
+			return nil, err
+		}
+		defer spend.Release()
+		logger.Infof("Using wallet %+v to pay swap", info)
 	}
 
 	if swapResponse == nil {
@@ -931,6 +1158,8 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 			IsAuto:              isAuto,
 			ServiceFeePercent:   boltz.Percentage(request.AcceptedPair.Fees.Percentage),
 			TenantId:            requireTenantId(ctx),
+			LightningNode:       request.GetLightningNode(),
+			RouteHints:          request.GetRouteHints(),
 		}
 
 		logger.Infof("Created new Swap %s (IsAuto: %v, From: %s, Tenant: %s)", swap.Id, swap.IsAuto, pair.From, requireTenant(ctx).Name)
@@ -938,6 +1167,10 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 		if request.SendFromInternal {
 			id := wallet.GetWalletInfo().Id
 			swap.WalletId = &id
+		} else if spliceNode != nil {
+			// refunds go to the onchain wallet of the node the funds were spliced out of
+			id := spliceNode.GetWalletInfo().Id
+			swap.WalletId = &id
 		}
 
 		swap.ClaimPubKey, err = btcec.ParsePubKey([]byte(response.ClaimPublicKey))
@@ -975,6 +1208,13 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 			}
 		}
 
+		if spliceNode != nil {
+			// the check before creating the swap only used an estimate of the fees
+			if err := checkSpliceChannel(spliceNode, spliceChannel, response.ExpectedAmount, feeRate); err != nil {
+				return nil, err
+			}
+		}
+
 		logger.Debugf("Verified redeem script and address of Swap %s", swap.Id)
 
 		err = server.database.CreateSwap(swap)
@@ -1011,6 +1251,16 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 				}
 				return nil, err
 			}
+			spend.Spend.Amount = swapResponse.ExpectedAmount
+			server.recordSpend(spend, swap.Id)
+		} else if spliceNode != nil {
+			swapResponse.TxId, err = spliceOut(spliceNode, spliceChannel, swapResponse.Address, swapResponse.ExpectedAmount, feeRate)
+			if err != nil {
+				if dbErr := server.database.UpdateSwapState(&swap, boltzrpc.SwapState_ERROR, err.Error()); dbErr != nil {
+					logger.Error(dbErr.Error())
+				}
+				return nil, err
+			}
 		}
 
 		if err := server.nursery.RegisterSwap(swap); err != nil {
@@ -1027,13 +1277,58 @@ func (server *routedBoltzServer) createSwap(ctx context.Context, isAuto bool, re
 		if err != nil {
 			return nil, err
 		}
+		server.recordSpend(spend, swapResponse.TxId)
 
 		logger.Infof("Sent %d to address %s for MRH in: %s", swapResponse.ExpectedAmount, swapResponse.Address, swapResponse.TxId)
+	} else if spliceNode != nil {
+		swapResponse.TxId, err = spliceOut(spliceNode, spliceChannel, swapResponse.Address, swapResponse.ExpectedAmount, feeRate)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return swapResponse, nil
 }
 
+// estimated size of a splice out transaction: the 2-of-2 input of the channel, its new funding output and the swap output
+const spliceOutVsize = 11 + 97 + 43 + 43
+
+// checkSpliceChannel makes sure that the amount and the mining fee of the splice can be paid out of the channel
+func checkSpliceChannel(node lightning.LightningNode, channelId lightning.ChanId, amount uint64, feeRate float64) error {
+	if node.Name() != string(lightning.NodeTypeCln) {
+		return status.Errorf(codes.InvalidArgument, "splicing is only supported by %s", lightning.NodeTypeCln)
+	}
+	channels, err := node.ListChannels()
+	if err != nil {
+		return fmt.Errorf("could not list channels: %w", err)
+	}
+	required := amount + uint64(math.Ceil(spliceOutVsize*feeRate))
+	for _, channel := range channels {
+		if channel.Id == channelId {
+			if channel.OutboundSat < required {
+				return status.Errorf(codes.InvalidArgument, "channel %s has only %d sats outbound, but %d are required including fees", channelId.ToCln(), channel.OutboundSat, required)
+			}
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "channel %s not found", channelId.ToCln())
+}
+
+// spliceOut sends the amount to the address by splicing it out of a channel of the node
+func spliceOut(node lightning.LightningNode, channelId lightning.ChanId, address string, amount uint64, feeRate float64) (string, error) {
+	txId, err := node.SpliceChannel(lightning.SpliceRequest{
+		ChannelId:   channelId,
+		Amount:      -int64(amount),
+		Address:     address,
+		SatPerVbyte: feeRate,
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not splice out of channel %s: %w", channelId, err)
+	}
+	logger.Infof("Spliced %d sats out of channel %s to %s: %s", amount, channelId, address, txId)
+	return txId, nil
+}
+
 func (server *routedBoltzServer) CreateSwap(ctx context.Context, request *boltzrpc.CreateSwapRequest) (*boltzrpc.CreateSwapResponse, error) {
 	return server.createSwap(ctx, false, request)
 }
@@ -1042,6 +1337,18 @@ func (server *routedBoltzServer) lightningAvailable(ctx context.Context) bool {
 	return server.lightning != nil && isAdmin(ctx)
 }
 
+// checkLightningNode makes sure that the lightning node selected in a request exists and can be used
+func (server *routedBoltzServer) checkLightningNode(ctx context.Context, name *string) error {
+	if name == nil || *name == "" {
+		return nil
+	}
+	if !server.lightningAvailable(ctx) {
+		return status.Errorf(codes.PermissionDenied, "lightning nodes can only be selected by admins")
+	}
+	_, err := server.GetLightningNode(*name)
+	return err
+}
+
 func requireTenant(ctx context.Context) database.Tenant {
 	tenant := macaroons.TenantFromContext(ctx)
 	if tenant == nil {
@@ -1059,6 +1366,9 @@ func (server *routedBoltzServer) createReverseSwap(ctx context.Context, isAuto b
 	logger.Infof("Creating Reverse Swap for %d sats to %s", request.Amount, pair.To)
 
 	externalPay := request.GetExternalPay()
+	if err := server.checkLightningNode(ctx, request.LightningNode); err != nil {
+		return nil, err
+	}
 	if !server.lightningAvailable(ctx) {
 		if request.ExternalPay == nil {
 			externalPay = true
@@ -1160,6 +1470,28 @@ func (server *routedBoltzServer) createReverseSwap(ctx context.Context, isAuto b
 		}
 	}
 
+	var spend *policy.Pending
+	if !externalPay {
+		walletId, err := server.nodeWalletId(request.GetLightningNode())
+		if err != nil {
+			return nil, err
+		}
+		check := policy.Spend{
+			TenantId: requireTenantId(ctx),
+			WalletId: walletId,
+			Currency: pair.From,
+			Amount:   request.Amount,
+		}
+		if request.Address != "" {
+			check.Addresses = []string{request.Address}
+		}
+		spend, err = server.checkSpend(check)
+		if err != nil {
+			return nil, err
+		}
+		defer spend.Release()
+	}
+
 	response, err := server.boltz.CreateReverseSwap(createRequest)
 	if err != nil {
 		return nil, err
@@ -1174,27 +1506,52 @@ func (server *routedBoltzServer) createReverseSwap(ctx context.Context, isAuto b
 		return nil, status.Errorf(codes.InvalidArgument, "max routing fee ppm is not supported when using external pay")
 	}
 
+	if request.RoutingFeeLimitCeilingPpm != nil && externalPay {
+		return nil, status.Errorf(codes.InvalidArgument, "routing fee limit ceiling is not supported when using external pay")
+	}
+
+	if request.MaxParts != nil && externalPay {
+		return nil, status.Errorf(codes.InvalidArgument, "max parts is not supported when using external pay")
+	}
+
+	if request.MaxParts != nil {
+		node, err := server.GetLightningNode(request.GetLightningNode())
+		if err != nil {
+			return nil, err
+		}
+		if node.Name() != string(lightning.NodeTypeLnd) {
+			return nil, status.Errorf(codes.InvalidArgument, "max parts is only supported by %s", lightning.NodeTypeLnd)
+		}
+	}
+
+	if request.GetLightningNode() != "" && externalPay {
+		return nil, status.Errorf(codes.InvalidArgument, "lightning node is not supported when using external pay")
+	}
+
 	reverseSwap := database.ReverseSwap{
-		Id:                  response.Id,
-		IsAuto:              isAuto,
-		Pair:                pair,
-		Status:              boltz.SwapCreated,
-		AcceptZeroConf:      request.AcceptZeroConf,
-		PrivateKey:          privateKey,
-		SwapTree:            response.SwapTree.Deserialize(),
-		RefundPubKey:        key,
-		Preimage:            preimage,
-		Invoice:             response.Invoice,
-		ClaimAddress:        claimAddress,
-		OnchainAmount:       response.OnchainAmount,
-		TimeoutBlockHeight:  response.TimeoutBlockHeight,
-		LockupTransactionId: "",
-		ClaimTransactionId:  "",
-		ServiceFeePercent:   boltz.Percentage(request.AcceptedPair.Fees.Percentage),
-		ExternalPay:         externalPay,
-		WalletId:            walletId,
-		TenantId:            requireTenantId(ctx),
-		RoutingFeeLimitPpm:  request.RoutingFeeLimitPpm,
+		Id:                        response.Id,
+		IsAuto:                    isAuto,
+		Pair:                      pair,
+		Status:                    boltz.SwapCreated,
+		AcceptZeroConf:            request.AcceptZeroConf,
+		PrivateKey:                privateKey,
+		SwapTree:                  response.SwapTree.Deserialize(),
+		RefundPubKey:              key,
+		Preimage:                  preimage,
+		Invoice:                   response.Invoice,
+		ClaimAddress:              claimAddress,
+		OnchainAmount:             response.OnchainAmount,
+		TimeoutBlockHeight:        response.TimeoutBlockHeight,
+		LockupTransactionId:       "",
+		ClaimTransactionId:        "",
+		ServiceFeePercent:         boltz.Percentage(request.AcceptedPair.Fees.Percentage),
+		ExternalPay:               externalPay,
+		WalletId:                  walletId,
+		TenantId:                  requireTenantId(ctx),
+		RoutingFeeLimitPpm:        request.RoutingFeeLimitPpm,
+		LightningNode:             request.GetLightningNode(),
+		RoutingFeeLimitCeilingPpm: request.RoutingFeeLimitCeilingPpm,
+		MaxParts:                  request.MaxParts,
 	}
 
 	logger.Infof(
@@ -1255,6 +1612,10 @@ func (server *routedBoltzServer) createReverseSwap(ctx context.Context, isAuto b
 	if err := server.nursery.RegisterReverseSwap(reverseSwap); err != nil {
 		return nil, err
 	}
+	if spend != nil {
+		spend.Spend.Amount = reverseSwap.InvoiceAmount
+		server.recordSpend(spend, reverseSwap.Id)
+	}
 
 	rpcResponse := &boltzrpc.CreateReverseSwapResponse{
 		Id:            reverseSwap.Id,
@@ -1285,11 +1646,72 @@ func (server *routedBoltzServer) CreateReverseSwap(ctx context.Context, request
 	return server.createReverseSwap(ctx, false, request)
 }
 
+func (server *routedBoltzServer) CreateOffer(ctx context.Context, request *boltzrpc.CreateOfferRequest) (*boltzrpc.Offer, error) {
+	if !server.lightningAvailable(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "offers can only be created by admins with a lightning node")
+	}
+	if err := server.checkLightningNode(ctx, request.LightningNode); err != nil {
+		return nil, err
+	}
+	wallet, err := server.getAnyWallet(ctx, onchain.WalletChecker{Id: &request.WalletId, AllowReadonly: true})
+	if err != nil {
+		return nil, err
+	}
+	info := wallet.GetWalletInfo()
+	offer, err := server.offers.Create(offers.CreateRequest{
+		TenantId:      requireTenantId(ctx),
+		LightningNode: request.GetLightningNode(),
+		WalletId:      info.Id,
+		Currency:      info.Currency,
+		Description:   request.Description,
+		AmountSat:     request.GetAmount(),
+	})
+	if err != nil {
+		if errors.Is(err, lightning.ErrUnsupported) {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		return nil, err
+	}
+	return serializeOffer(offer, nil), nil
+}
+
+func (server *routedBoltzServer) ListOffers(ctx context.Context, request *boltzrpc.ListOffersRequest) (*boltzrpc.ListOffersResponse, error) {
+	tenantId := macaroons.TenantIdFromContext(ctx)
+	all, err := server.database.QueryOffers(database.OfferQuery{TenantId: tenantId, Active: request.Active})
+	if err != nil {
+		return nil, err
+	}
+	response := &boltzrpc.ListOffersResponse{}
+	for _, offer := range all {
+		payments, err := server.database.QueryOfferPayments(offer.Id)
+		if err != nil {
+			return nil, err
+		}
+		response.Offers = append(response.Offers, serializeOffer(offer, payments))
+	}
+	return response, nil
+}
+
+func (server *routedBoltzServer) DisableOffer(ctx context.Context, request *boltzrpc.DisableOfferRequest) (*boltzrpc.Offer, error) {
+	offer, err := server.database.GetOffer(request.Id)
+	if err != nil || offer.TenantId != requireTenantId(ctx) {
+		return nil, status.Errorf(codes.NotFound, "offer %s not found", request.Id)
+	}
+	if err := server.offers.Disable(offer); err != nil {
+		return nil, err
+	}
+	payments, err := server.database.QueryOfferPayments(offer.Id)
+	if err != nil {
+		return nil, err
+	}
+	return serializeOffer(offer, payments), nil
+}
+
 func (server *routedBoltzServer) CreateChainSwap(ctx context.Context, request *boltzrpc.CreateChainSwapRequest) (*boltzrpc.ChainSwapInfo, error) {
-	return server.createChainSwap(ctx, false, request)
+	return server.createChainSwap(ctx, false, autoswap.ChainSwapOrigin{}, request)
 }
 
-func (server *routedBoltzServer) createChainSwap(ctx context.Context, isAuto bool, request *boltzrpc.CreateChainSwapRequest) (*boltzrpc.ChainSwapInfo, error) {
+func (server *routedBoltzServer) createChainSwap(ctx context.Context, isAuto bool, origin autoswap.ChainSwapOrigin, request *boltzrpc.CreateChainSwapRequest) (*boltzrpc.ChainSwapInfo, error) {
 
 	tenantId := requireTenantId(ctx)
 
@@ -1377,6 +1799,25 @@ func (server *routedBoltzServer) createChainSwap(ctx context.Context, isAuto boo
 		return nil, errors.New("to address or to wallet required")
 	}
 
+	var spend *policy.Pending
+	if fromWallet != nil {
+		info := fromWallet.GetWalletInfo()
+		check := policy.Spend{
+			TenantId: info.TenantId,
+			WalletId: &info.Id,
+			Currency: pair.From,
+			Amount:   amount,
+		}
+		if request.ToAddress != nil {
+			check.Addresses = []string{request.GetToAddress()}
+		}
+		spend, err = server.checkSpend(check)
+		if err != nil {
+			return nil, err
+		}
+		defer spend.Release()
+	}
+
 	response, err := server.boltz.CreateChainSwap(createChainSwap)
 
 	if err != nil {
@@ -1393,6 +1834,8 @@ func (server *routedBoltzServer) createChainSwap(ctx context.Context, isAuto boo
 		AcceptZeroConf:    request.GetAcceptZeroConf(),
 		ServiceFeePercent: boltz.Percentage(request.AcceptedPair.Fees.Percentage),
 		TenantId:          tenantId,
+		AutoSwapRule:      origin.Rule,
+		LightningNode:     origin.LightningNode,
 	}
 
 	logger.Infof(
@@ -1493,6 +1936,8 @@ func (server *routedBoltzServer) createChainSwap(ctx context.Context, isAuto boo
 			}
 			return nil, err
 		}
+		spend.Spend.Amount = from.Amount
+		server.recordSpend(spend, chainSwap.Id)
 	}
 
 	if err := server.nursery.RegisterChainSwap(chainSwap); err != nil {
@@ -1641,38 +2086,105 @@ func (server *routedBoltzServer) ListWalletTransactions(ctx context.Context, req
 	}
 	response := &boltzrpc.ListWalletTransactionsResponse{}
 	for _, tx := range transactions {
-		result := &boltzrpc.WalletTransaction{
-			Id:            tx.Id,
-			BlockHeight:   tx.BlockHeight,
-			BalanceChange: tx.BalanceChange,
-		}
-		if !tx.Timestamp.IsZero() {
-			result.Timestamp = tx.Timestamp.Unix()
-		}
-		if tx.IsConsolidation {
-			result.Infos = append(result.Infos, &boltzrpc.TransactionInfo{Type: boltzrpc.TransactionType_CONSOLIDATION})
-		}
-		for _, output := range tx.Outputs {
-			result.Outputs = append(result.Outputs, &boltzrpc.TransactionOutput{
-				Address:      output.Address,
-				Amount:       output.Amount,
-				IsOurAddress: output.IsOurAddress,
-			})
+		result := serializeWalletTransaction(tx, swaps)
+		if request.GetExcludeSwapRelated() && isSwapRelated(result) {
+			continue
 		}
-		i := slices.IndexFunc(swaps, func(swap *database.AnySwap) bool {
-			return swap.RefundTransactionid == tx.Id || swap.ClaimTransactionid == tx.Id || swap.LockupTransactionid == tx.Id
+		response.Transactions = append(response.Transactions, result)
+	}
+	return response, nil
+}
+
+func isSwapRelated(tx *boltzrpc.WalletTransaction) bool {
+	return slices.ContainsFunc(tx.Infos, func(info *boltzrpc.TransactionInfo) bool {
+		return info.SwapId != nil
+	})
+}
+
+func serializeWalletTransaction(tx *onchain.WalletTransaction, swaps []*database.AnySwap) *boltzrpc.WalletTransaction {
+	result := &boltzrpc.WalletTransaction{
+		Id:            tx.Id,
+		BlockHeight:   tx.BlockHeight,
+		BalanceChange: tx.BalanceChange,
+	}
+	if !tx.Timestamp.IsZero() {
+		result.Timestamp = tx.Timestamp.Unix()
+	}
+	if tx.IsConsolidation {
+		result.Infos = append(result.Infos, &boltzrpc.TransactionInfo{Type: boltzrpc.TransactionType_CONSOLIDATION})
+	}
+	for _, output := range tx.Outputs {
+		result.Outputs = append(result.Outputs, &boltzrpc.TransactionOutput{
+			Address:      output.Address,
+			Amount:       output.Amount,
+			IsOurAddress: output.IsOurAddress,
 		})
-		if i >= 0 {
-			if request.GetExcludeSwapRelated() {
+	}
+	i := slices.IndexFunc(swaps, func(swap *database.AnySwap) bool {
+		return swap.RefundTransactionid == tx.Id || swap.ClaimTransactionid == tx.Id || swap.LockupTransactionid == tx.Id
+	})
+	if i >= 0 {
+		swap := swaps[i]
+		info := &boltzrpc.TransactionInfo{SwapId: &swap.Id, Type: getTransactionType(swap, tx.Id)}
+		result.Infos = append(result.Infos, info)
+	}
+	return result
+}
+
+var walletTransactionEventTypes = map[onchain.WalletTransactionEventType]boltzrpc.WalletTransactionEventType{
+	onchain.TransactionNew:       boltzrpc.WalletTransactionEventType_NEW,
+	onchain.TransactionConfirmed: boltzrpc.WalletTransactionEventType_CONFIRMED,
+	onchain.TransactionReplaced:  boltzrpc.WalletTransactionEventType_REPLACED,
+}
+
+func (server *routedBoltzServer) SubscribeWalletTransactions(request *boltzrpc.SubscribeWalletTransactionsRequest, stream boltzrpc.Boltz_SubscribeWalletTransactionsServer) error {
+	ctx := stream.Context()
+	tenantId := macaroons.TenantIdFromContext(ctx)
+	if request.Id != nil {
+		if _, err := server.getAnyWallet(ctx, onchain.WalletChecker{Id: request.Id, AllowReadonly: true}); err != nil {
+			return err
+		}
+	}
+
+	updates, stop := server.onchain.WalletTransactionUpdates(onchain.TransactionSubscription{
+		WalletId: request.Id,
+		TenantId: tenantId,
+	})
+	if updates == nil {
+		return status.Errorf(codes.Unavailable, "wallet transaction updates are not available")
+	}
+	defer func() { _ = stop() }()
+	logger.Infof("Starting wallet transaction stream")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-updates:
+			if !ok {
+				if errors.Is(stop(), onchain.ErrTransactionSubscriberOverflow) {
+					return status.Errorf(codes.ResourceExhausted, "stream fell behind and missed events, re-sync with ListWalletTransactions")
+				}
+				return nil
+			}
+			swaps, err := server.database.QuerySwapsByTransactions(database.SwapQuery{TenantId: tenantId}, []string{event.Transaction.Id})
+			if err != nil {
+				return err
+			}
+			transaction := serializeWalletTransaction(event.Transaction, swaps)
+			if request.GetExcludeSwapRelated() && isSwapRelated(transaction) {
 				continue
 			}
-			swap := swaps[i]
-			info := &boltzrpc.TransactionInfo{SwapId: &swap.Id, Type: getTransactionType(swap, tx.Id)}
-			result.Infos = append(result.Infos, info)
+			err = stream.Send(&boltzrpc.WalletTransactionEvent{
+				WalletId:    event.Wallet.Id,
+				Type:        walletTransactionEventTypes[event.Type],
+				Transaction: transaction,
+			})
+			if err != nil {
+				return err
+			}
 		}
-		response.Transactions = append(response.Transactions, result)
 	}
-	return response, nil
 }
 
 func (server *routedBoltzServer) BumpTransaction(ctx context.Context, request *boltzrpc.BumpTransactionRequest) (*boltzrpc.BumpTransactionResponse, error) {
@@ -1854,6 +2366,26 @@ func (server *routedBoltzServer) getWallet(ctx context.Context, checker onchain.
 	return server.getAnyWallet(ctx, checker)
 }
 
+// checkSpend enforces the spending policies of the tenant the funds belong to.
+// Other spends of the tenant are blocked until the returned spend is recorded or released.
+func (server *routedBoltzServer) checkSpend(spend policy.Spend) (*policy.Pending, error) {
+	pending, err := server.policy.Begin(spend)
+	if err != nil {
+		var violation *policy.Violation
+		if errors.As(err, &violation) {
+			return nil, status.Error(codes.PermissionDenied, violation.Error())
+		}
+		return nil, err
+	}
+	return pending, nil
+}
+
+func (server *routedBoltzServer) recordSpend(pending *policy.Pending, reference string) {
+	if err := pending.Commit(reference); err != nil {
+		logger.Errorf("Could not record spending %s of tenant %d: %v", reference, pending.Spend.TenantId, err)
+	}
+}
+
 func (server *routedBoltzServer) getAnyWallet(ctx context.Context, checker onchain.WalletChecker) (onchain.Wallet, error) {
 	if checker.TenantId == nil {
 		checker.TenantId = macaroons.TenantIdFromContext(ctx)
@@ -1949,6 +2481,11 @@ func (server *routedBoltzServer) removeLegacyWallet(ctx context.Context, id data
 }
 
 func (server *routedBoltzServer) WalletSend(ctx context.Context, request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletSendResponse, error) {
+	return server.walletSend(ctx, request, false)
+}
+
+// walletSend sends funds from a wallet. Addresses of our own wallets are not subject to the allowlist of the spending policies.
+func (server *routedBoltzServer) walletSend(ctx context.Context, request *boltzrpc.WalletSendRequest, ownAddress bool) (*boltzrpc.WalletSendResponse, error) {
 	sendWallet, err := server.getWallet(ctx, onchain.WalletChecker{Id: &request.Id})
 	if err != nil {
 		return nil, err
@@ -1963,7 +2500,97 @@ func (server *routedBoltzServer) WalletSend(ctx context.Context, request *boltzr
 	if request.Amount == 0 && !request.GetSendAll() {
 		return nil, status.Errorf(codes.InvalidArgument, "amount required")
 	}
-	txId, err := sendWallet.SendToAddress(onchain.WalletSendArgs{
+	args := onchain.WalletSendArgs{
+		Address:     request.Address,
+		Amount:      request.Amount,
+		SatPerVbyte: feeRate,
+		SendAll:     request.GetSendAll(),
+	}
+	info := sendWallet.GetWalletInfo()
+	check := policy.Spend{TenantId: info.TenantId, WalletId: &info.Id, Currency: info.Currency, Amount: request.Amount}
+	if !ownAddress {
+		check.Addresses = []string{request.Address}
+	}
+	if args.SendAll {
+		check.Amount, _, err = sendWallet.GetSendFee(args)
+		if err != nil {
+			return nil, err
+		}
+	}
+	spend, err := server.checkSpend(check)
+	if err != nil {
+		return nil, err
+	}
+	defer spend.Release()
+	txId, err := sendWallet.SendToAddress(args)
+	if err != nil {
+		return nil, err
+	}
+	server.recordSpend(spend, txId)
+	return &boltzrpc.WalletSendResponse{TxId: txId}, nil
+}
+
+func (server *routedBoltzServer) ConsolidateWallet(ctx context.Context, request *boltzrpc.ConsolidateWalletRequest) (*boltzrpc.WalletSendResponse, error) {
+	wallet, err := server.getWallet(ctx, onchain.WalletChecker{Id: &request.Id})
+	if err != nil {
+		return nil, err
+	}
+	if request.GetMaxUtxos() == 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least 2 utxos are required for a consolidation")
+	}
+	consolidationWallet, ok := wallet.(onchain.ConsolidationWallet)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "wallet %s does not support consolidation", wallet.GetWalletInfo().Name)
+	}
+	feeRate, err := server.estimateFee(request.GetSatPerVbyte(), wallet.GetWalletInfo().Currency)
+	if err != nil {
+		return nil, err
+	}
+	txId, err := consolidationWallet.Consolidate(request.GetMaxUtxos(), feeRate)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infof("Consolidated wallet %s: %s", wallet.GetWalletInfo(), txId)
+	return &boltzrpc.WalletSendResponse{TxId: txId}, nil
+}
+
+func (server *routedBoltzServer) getPsbtWallet(ctx context.Context, id uint64) (onchain.PsbtWallet, error) {
+	wallet, err := server.getWallet(ctx, onchain.WalletChecker{Id: &id, AllowReadonly: true})
+	if err != nil {
+		return nil, err
+	}
+	psbtWallet, ok := wallet.(onchain.PsbtWallet)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "wallet %s does not support psbts", wallet.GetWalletInfo().Name)
+	}
+	return psbtWallet, nil
+}
+
+func serializeWalletPsbt(psbt *onchain.PartiallySignedTransaction) *boltzrpc.WalletPsbt {
+	return &boltzrpc.WalletPsbt{
+		Psbt:     psbt.Psbt,
+		Fee:      psbt.Fee,
+		Amount:   psbt.SendAmount,
+		Complete: psbt.IsComplete,
+	}
+}
+
+func (server *routedBoltzServer) CreateWalletPsbt(ctx context.Context, request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletPsbt, error) {
+	wallet, err := server.getPsbtWallet(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	feeRate, err := server.estimateFee(request.GetSatPerVbyte(), wallet.GetWalletInfo().Currency)
+	if err != nil {
+		return nil, err
+	}
+	if request.Address == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "address required")
+	}
+	if request.Amount == 0 && !request.GetSendAll() {
+		return nil, status.Errorf(codes.InvalidArgument, "amount required")
+	}
+	psbt, err := wallet.CreatePsbt(onchain.WalletSendArgs{
 		Address:     request.Address,
 		Amount:      request.Amount,
 		SatPerVbyte: feeRate,
@@ -1972,6 +2599,53 @@ func (server *routedBoltzServer) WalletSend(ctx context.Context, request *boltzr
 	if err != nil {
 		return nil, err
 	}
+	return serializeWalletPsbt(psbt), nil
+}
+
+func (server *routedBoltzServer) SignWalletPsbt(ctx context.Context, request *boltzrpc.SignWalletPsbtRequest) (*boltzrpc.WalletPsbt, error) {
+	wallet, err := server.getPsbtWallet(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Psbt == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "psbt required")
+	}
+	psbt, err := wallet.SignPsbt(request.Psbt)
+	if err != nil {
+		return nil, err
+	}
+	return serializeWalletPsbt(psbt), nil
+}
+
+func (server *routedBoltzServer) FinalizeWalletPsbt(ctx context.Context, request *boltzrpc.FinalizeWalletPsbtRequest) (*boltzrpc.WalletSendResponse, error) {
+	wallet, err := server.getPsbtWallet(ctx, request.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.Psbts) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one psbt required")
+	}
+	// all psbts spend the same unsigned transaction, so the first one tells us where the funds go
+	details, err := wallet.SignPsbt(request.Psbts[0])
+	if err != nil {
+		return nil, err
+	}
+	info := wallet.GetWalletInfo()
+	check := policy.Spend{TenantId: info.TenantId, WalletId: &info.Id, Currency: info.Currency, Amount: details.SendAmount}
+	for _, recipient := range details.Recipients {
+		check.Addresses = append(check.Addresses, recipient.Address)
+	}
+	spend, err := server.checkSpend(check)
+	if err != nil {
+		return nil, err
+	}
+	defer spend.Release()
+	txId, err := wallet.FinalizePsbt(request.Psbts)
+	if err != nil {
+		return nil, err
+	}
+	server.recordSpend(spend, txId)
+	logger.Infof("Broadcasted finalized psbt of wallet %s: %s", wallet.GetWalletInfo(), txId)
 	return &boltzrpc.WalletSendResponse{TxId: txId}, nil
 }
 
@@ -1998,6 +2672,9 @@ func (server *routedBoltzServer) Stop(context.Context, *empty.Empty) (*empty.Emp
 		server.nursery.Stop()
 		logger.Debugf("Stopped nursery")
 	}
+	if server.offers != nil {
+		server.offers.Stop()
+	}
 	close(server.stop)
 	return &empty.Empty{}, nil
 }
@@ -2064,6 +2741,10 @@ func (server *routedBoltzServer) fullInit() (err error) {
 	if err := server.swapper.LoadConfig(); err != nil {
 		return fmt.Errorf("could not load autoswap config: %v", err)
 	}
+
+	if server.lightning != nil {
+		server.offers.Start()
+	}
 	return nil
 }
 
@@ -2079,6 +2760,45 @@ func (server *routedBoltzServer) setState(state serverState) {
 	server.state = state
 }
 
+// hasNodeWallet reports whether the node has an onchain wallet of its own.
+// phoenixd only splices in and out of its channel, so it is not registered as a wallet
+func hasNodeWallet(node lightning.LightningNode) bool {
+	return node.Name() != string(lightning.NodeTypePhoenixd)
+}
+
+func (server *routedBoltzServer) setupNodeWallet(name string, node lightning.LightningNode) error {
+	info, err := node.GetInfo()
+	if err != nil {
+		return fmt.Errorf("could not get info from lightning: %v", err)
+	}
+	walletInfo := onchain.WalletInfo{
+		Name:     name,
+		Currency: boltz.CurrencyBtc,
+		Readonly: false,
+		TenantId: database.DefaultTenantId,
+	}
+	nodeWallet, err := server.database.GetNodeWallet(info.Pubkey)
+	if err != nil {
+		err = server.database.CreateWallet(&database.Wallet{
+			WalletCredentials: &onchain.WalletCredentials{
+				WalletInfo: walletInfo,
+			},
+			NodePubkey: &info.Pubkey,
+		})
+		if err != nil {
+			return fmt.Errorf("could not create wallet for lightning node: %w", err)
+		}
+		nodeWallet, err = server.database.GetNodeWallet(info.Pubkey)
+		if err != nil {
+			return fmt.Errorf("could not get node wallet form db: %s", err)
+		}
+	}
+	walletInfo.Id = nodeWallet.Id
+	node.SetupWallet(walletInfo)
+	server.onchain.AddWallet(node)
+	return nil
+}
+
 func (server *routedBoltzServer) unlock(password string) error {
 	credentials, err := server.decryptWalletCredentials(password)
 	if err != nil {
@@ -2105,36 +2825,19 @@ func (server *routedBoltzServer) unlock(password string) error {
 		}
 	}
 
-	if server.lightning != nil {
-		info, err := server.lightning.GetInfo()
-		if err != nil {
-			return fmt.Errorf("could not get info from lightning: %v", err)
+	if server.lightning != nil && hasNodeWallet(server.lightning) {
+		if err := server.setupNodeWallet(server.lightning.Name(), server.lightning); err != nil {
+			return err
 		}
-		walletInfo := onchain.WalletInfo{
-			Name:     server.lightning.Name(),
-			Currency: boltz.CurrencyBtc,
-			Readonly: false,
-			TenantId: database.DefaultTenantId,
+	}
+	// the wallets of additional nodes are named after the node so that they are distinguishable
+	for _, name := range server.lightningNodeNames() {
+		if !hasNodeWallet(server.lightningNodes[name]) {
+			continue
 		}
-		nodeWallet, err := server.database.GetNodeWallet(info.Pubkey)
-		if err != nil {
-			err = server.database.CreateWallet(&database.Wallet{
-				WalletCredentials: &onchain.WalletCredentials{
-					WalletInfo: walletInfo,
-				},
-				NodePubkey: &info.Pubkey,
-			})
-			if err != nil {
-				return fmt.Errorf("could not create wallet for lightning node: %w", err)
-			}
-			nodeWallet, err = server.database.GetNodeWallet(info.Pubkey)
-			if err != nil {
-				return fmt.Errorf("could not get node wallet form db: %s", err)
-			}
+		if err := server.setupNodeWallet(name, server.lightningNodes[name]); err != nil {
+			return fmt.Errorf("lightning node %s: %w", name, err)
 		}
-		walletInfo.Id = nodeWallet.Id
-		server.lightning.SetupWallet(walletInfo)
-		server.onchain.AddWallet(server.lightning)
 	}
 
 	server.setState(stateSyncing)
@@ -2445,6 +3148,10 @@ func (server *routedBoltzServer) RemoveTenant(ctx context.Context, request *bolt
 			return status.Errorf(codes.FailedPrecondition, "cannot remove tenant with associated wallets")
 		}
 
+		if err := tx.DeleteTenantPolicies(tenant.Id); err != nil {
+			return err
+		}
+
 		if err := tx.DeleteTenant(int64(tenant.Id)); err != nil {
 			return err
 		}
@@ -2457,6 +3164,163 @@ func (server *routedBoltzServer) RemoveTenant(ctx context.Context, request *bolt
 	return &emptypb.Empty{}, nil
 }
 
+// policyTenantId returns the tenant a policy request refers to. Restricted macaroons can only access their own tenant.
+func policyTenantId(ctx context.Context, tenantId *uint64) (database.Id, error) {
+	if tenantId == nil {
+		return requireTenantId(ctx), nil
+	}
+	if restricted := macaroons.TenantIdFromContext(ctx); restricted != nil && *restricted != *tenantId {
+		return 0, status.Errorf(codes.PermissionDenied, "can not access policies of tenant %d", *tenantId)
+	}
+	return *tenantId, nil
+}
+
+func (server *routedBoltzServer) SetSpendingPolicy(ctx context.Context, request *boltzrpc.SpendingPolicy) (*boltzrpc.SpendingPolicy, error) {
+	tenantId, err := policyTenantId(ctx, request.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := server.database.GetTenant(tenantId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "tenant %d does not exist", tenantId)
+		}
+		return nil, err
+	}
+	if request.WalletId != nil {
+		wallet, err := server.database.GetWallet(request.GetWalletId())
+		if err != nil || wallet.TenantId != tenantId {
+			return nil, status.Errorf(codes.NotFound, "wallet %d of tenant %d does not exist", request.GetWalletId(), tenantId)
+		}
+	}
+	spendingPolicy := &database.SpendingPolicy{
+		TenantId:         tenantId,
+		WalletId:         request.WalletId,
+		DailyLimit:       request.DailyLimit,
+		WeeklyLimit:      request.WeeklyLimit,
+		MaxAmount:        request.MaxAmount,
+		RequireAllowlist: request.RequireAllowlist,
+		AddressCooldown:  time.Duration(request.AddressCooldown) * time.Second,
+	}
+	if err := server.database.SetSpendingPolicy(spendingPolicy); err != nil {
+		return nil, err
+	}
+	logger.Infof("Updated spending policy of tenant %d: %+v", tenantId, request)
+	return serializeSpendingPolicy(spendingPolicy), nil
+}
+
+func (server *routedBoltzServer) ListSpendingPolicies(ctx context.Context, request *boltzrpc.ListSpendingPoliciesRequest) (*boltzrpc.ListSpendingPoliciesResponse, error) {
+	tenantId := request.TenantId
+	if tenantId == nil {
+		tenantId = macaroons.TenantIdFromContext(ctx)
+	} else if _, err := policyTenantId(ctx, tenantId); err != nil {
+		return nil, err
+	}
+	policies, err := server.database.QuerySpendingPolicies(tenantId)
+	if err != nil {
+		return nil, err
+	}
+	response := &boltzrpc.ListSpendingPoliciesResponse{}
+	for _, spendingPolicy := range policies {
+		response.Policies = append(response.Policies, serializeSpendingPolicy(spendingPolicy))
+	}
+	return response, nil
+}
+
+func (server *routedBoltzServer) RemoveSpendingPolicy(ctx context.Context, request *boltzrpc.RemoveSpendingPolicyRequest) (*emptypb.Empty, error) {
+	tenantId, err := policyTenantId(ctx, request.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.database.DeleteSpendingPolicy(tenantId, request.WalletId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "spending policy does not exist")
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (server *routedBoltzServer) serializeAllowedAddress(address *database.AllowedAddress) (*boltzrpc.AllowedAddress, error) {
+	cooldown, err := server.policy.AddressCooldown(address.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	return &boltzrpc.AllowedAddress{
+		Address:   address.Address,
+		Label:     address.Label,
+		CreatedAt: address.CreatedAt.Unix(),
+		UsableAt:  address.CreatedAt.Add(cooldown).Unix(),
+	}, nil
+}
+
+func (server *routedBoltzServer) AddAllowedAddress(ctx context.Context, request *boltzrpc.AddAllowedAddressRequest) (*boltzrpc.AllowedAddress, error) {
+	if !isAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can edit the allowlist")
+	}
+	btcErr := boltz.ValidateAddress(server.network, request.Address, boltz.CurrencyBtc)
+	if liquidErr := boltz.ValidateAddress(server.network, request.Address, boltz.CurrencyLiquid); btcErr != nil && liquidErr != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", request.Address, btcErr)
+	}
+	tenantId, err := policyTenantId(ctx, request.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := server.database.GetAllowedAddress(tenantId, request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "address %s is already allowed", request.Address)
+	}
+	allowed := &database.AllowedAddress{
+		TenantId:  tenantId,
+		Address:   request.Address,
+		Label:     request.GetLabel(),
+		CreatedAt: time.Now(),
+	}
+	if err := server.database.CreateAllowedAddress(allowed); err != nil {
+		return nil, err
+	}
+	return server.serializeAllowedAddress(allowed)
+}
+
+func (server *routedBoltzServer) ListAllowedAddresses(ctx context.Context, request *boltzrpc.ListAllowedAddressesRequest) (*boltzrpc.ListAllowedAddressesResponse, error) {
+	tenantId, err := policyTenantId(ctx, request.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := server.database.QueryAllowedAddresses(tenantId)
+	if err != nil {
+		return nil, err
+	}
+	response := &boltzrpc.ListAllowedAddressesResponse{}
+	for _, address := range addresses {
+		serialized, err := server.serializeAllowedAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		response.Addresses = append(response.Addresses, serialized)
+	}
+	return response, nil
+}
+
+func (server *routedBoltzServer) RemoveAllowedAddress(ctx context.Context, request *boltzrpc.RemoveAllowedAddressRequest) (*emptypb.Empty, error) {
+	if !isAdmin(ctx) {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can edit the allowlist")
+	}
+	tenantId, err := policyTenantId(ctx, request.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.database.DeleteAllowedAddress(tenantId, request.Address); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "address %s is not allowed", request.Address)
+		}
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (server *routedBoltzServer) GetSwapMnemonic(ctx context.Context, request *boltzrpc.GetSwapMnemonicRequest) (*boltzrpc.GetSwapMnemonicResponse, error) {
 	swapMnemonic, err := server.database.GetSwapMnemonic()
 	if err != nil {
@@ -2515,11 +3379,26 @@ func (server *routedBoltzServer) serializeAnySwap(ctx context.Context, swap *dat
 			return nil, err
 		}
 	}
-	return &boltzrpc.GetSwapInfoResponse{
+	response := &boltzrpc.GetSwapInfoResponse{
 		Swap:        serializeSwap(swap),
 		ReverseSwap: serializeReverseSwap(reverseSwap),
 		ChainSwap:   serializeChainSwap(chainSwap),
-	}, nil
+	}
+	if swap != nil {
+		attempts, err := server.database.QueryPaymentAttempts(swap.Id)
+		if err != nil {
+			return nil, err
+		}
+		response.Swap.PaymentAttempts = serializePaymentAttempts(attempts)
+	}
+	if reverseSwap != nil {
+		attempts, err := server.database.QueryPaymentAttempts(reverseSwap.Id)
+		if err != nil {
+			return nil, err
+		}
+		response.ReverseSwap.PaymentAttempts = serializePaymentAttempts(attempts)
+	}
+	return response, nil
 }
 
 func (server *routedBoltzServer) getPairs(pairId boltz.Pair) (*boltzrpc.Fees, *boltzrpc.Limits, error) {
@@ -0,0 +1,39 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
+	lnmock "github.com/BoltzExchange/boltz-client/v2/internal/mocks/lightning"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckSpliceChannel(t *testing.T) {
+	channelId, err := lightning.NewChanIdFromString("100x1x0")
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, name string) *lnmock.MockLightningNode {
+		node := lnmock.NewMockLightningNode(t)
+		node.EXPECT().Name().Return(name)
+		return node
+	}
+
+	t.Run("Unsupported", func(t *testing.T) {
+		node := setup(t, string(lightning.NodeTypeLnd))
+		err := checkSpliceChannel(node, channelId, 1000, 1)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.ErrorContains(t, err, "only supported by CLN")
+	})
+
+	t.Run("Channel", func(t *testing.T) {
+		node := setup(t, string(lightning.NodeTypeCln))
+		node.EXPECT().ListChannels().Return([]*lightning.LightningChannel{{Id: channelId, OutboundSat: 1000 + 2*spliceOutVsize}}, nil)
+		require.NoError(t, checkSpliceChannel(node, channelId, 1000, 2))
+		// the mining fee of the splice has to be covered too
+		require.ErrorContains(t, checkSpliceChannel(node, channelId, 1000, 2.5), "outbound")
+		require.ErrorContains(t, checkSpliceChannel(node, channelId, 1001, 2), "outbound")
+		require.ErrorContains(t, checkSpliceChannel(node, channelId+1, 1000, 2), "not found")
+	})
+}
@@ -1,6 +1,7 @@
 package rpcserver
 
 import (
+	"encoding/hex"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
@@ -65,6 +66,7 @@ func serializeSwap(swap *database.Swap) *boltzrpc.SwapInfo {
 		WalletId:            serializedSwap.WalletId,
 		TenantId:            serializedSwap.TenantId,
 		IsAuto:              serializedSwap.IsAuto,
+		LightningNode:       serializeOptionalString(serializedSwap.LightningNode),
 	}
 
 	return serialized
@@ -127,6 +129,7 @@ func serializeReverseSwap(reverseSwap *database.ReverseSwap) *boltzrpc.ReverseSw
 		ExternalPay:         serializedReverseSwap.ExternalPay,
 		TenantId:            serializedReverseSwap.TenantId,
 		IsAuto:              serializedReverseSwap.IsAuto,
+		LightningNode:       serializeOptionalString(serializedReverseSwap.LightningNode),
 	}
 }
 
@@ -256,3 +259,76 @@ func serializeTenant(tenant *database.Tenant) *boltzrpc.Tenant {
 		Name: tenant.Name,
 	}
 }
+
+func serializeSpendingPolicy(policy *database.SpendingPolicy) *boltzrpc.SpendingPolicy {
+	return &boltzrpc.SpendingPolicy{
+		TenantId:         &policy.TenantId,
+		WalletId:         policy.WalletId,
+		DailyLimit:       policy.DailyLimit,
+		WeeklyLimit:      policy.WeeklyLimit,
+		MaxAmount:        policy.MaxAmount,
+		RequireAllowlist: policy.RequireAllowlist,
+		AddressCooldown:  uint64(policy.AddressCooldown.Seconds()),
+	}
+}
+
+func serializeOffer(offer *database.Offer, payments []*database.OfferPayment) *boltzrpc.Offer {
+	serialized := &boltzrpc.Offer{
+		Id:            offer.Id,
+		Offer:         offer.Offer,
+		Description:   offer.Description,
+		WalletId:      offer.WalletId,
+		LightningNode: serializeOptionalString(offer.LightningNode),
+		Active:        offer.Active,
+		CreatedAt:     serializeTime(offer.CreatedAt),
+	}
+	if offer.AmountSat != 0 {
+		serialized.Amount = &offer.AmountSat
+	}
+	for _, payment := range payments {
+		serialized.Payments = append(serialized.Payments, &boltzrpc.OfferPayment{
+			PaymentHash: hex.EncodeToString(payment.PaymentHash),
+			Amount:      payment.AmountSat,
+			SwapId:      serializeOptionalString(payment.SwapId),
+			Error:       serializeOptionalString(payment.Error),
+			CreatedAt:   serializeTime(payment.CreatedAt),
+			Attempts:    payment.Attempts,
+		})
+	}
+	return serialized
+}
+
+func serializePaymentAttempts(attempts []*database.PaymentAttempt) []*boltzrpc.PaymentAttempt {
+	var serialized []*boltzrpc.PaymentAttempt
+	for _, attempt := range attempts {
+		serialized = append(serialized, &boltzrpc.PaymentAttempt{
+			Attempt:       uint32(attempt.Attempt),
+			FeeLimit:      attempt.FeeLimit,
+			MaxParts:      attempt.MaxParts,
+			State:         string(attempt.State),
+			FeeMsat:       attempt.FeeMsat,
+			Error:         attempt.Error,
+			CreatedAt:     serializeTime(attempt.CreatedAt),
+			SwapId:        attempt.SwapId,
+			Incoming:      attempt.Incoming,
+			Amount:        attempt.AmountSat,
+			FailureReason: attempt.FailureReason,
+			Hops:          serializePaymentHops(attempt.Hops),
+		})
+	}
+	return serialized
+}
+
+func serializePaymentHops(hops []lightning.PaymentHop) []*boltzrpc.PaymentHop {
+	var serialized []*boltzrpc.PaymentHop
+	for _, hop := range hops {
+		serialized = append(serialized, &boltzrpc.PaymentHop{
+			ChanId:     lightning.SerializeChanId(hop.ChanId),
+			Pubkey:     hop.Pubkey,
+			AmountMsat: hop.AmountMsat,
+			FeeMsat:    hop.FeeMsat,
+			Failure:    serializeOptionalString(hop.Failure),
+		})
+	}
+	return serialized
+}
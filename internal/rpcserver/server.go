@@ -22,6 +22,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/internal/esplora"
 	"github.com/BoltzExchange/boltz-client/v2/internal/mempool"
 	"github.com/BoltzExchange/boltz-client/v2/internal/nursery"
+	"github.com/BoltzExchange/boltz-client/v2/internal/offers"
 	bitcoin_wallet "github.com/BoltzExchange/boltz-client/v2/internal/onchain/bitcoin-wallet"
 	liquid_wallet "github.com/BoltzExchange/boltz-client/v2/internal/onchain/liquid-wallet"
 	"google.golang.org/grpc/keepalive"
@@ -30,6 +31,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/v2/internal/lightning"
 	"github.com/BoltzExchange/boltz-client/v2/internal/logger"
 	"github.com/BoltzExchange/boltz-client/v2/internal/onchain"
+	"github.com/BoltzExchange/boltz-client/v2/internal/policy"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltz"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
@@ -38,8 +40,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
 )
 
+const inProcessBufferSize = 1024 * 1024
+
 type RpcServer struct {
 	cfg            *config.Config
 	grpc           *grpc.Server
@@ -64,6 +69,7 @@ func (server *RpcServer) Init() error {
 	swapper := &autoswap.AutoSwap{}
 	server.boltzServer = &routedBoltzServer{
 		database:       server.cfg.Database,
+		policy:         policy.NewEngine(server.cfg.Database),
 		stop:           make(chan bool),
 		state:          stateLightningSyncing,
 		swapper:        swapper,
@@ -129,7 +135,8 @@ func (server *RpcServer) Init() error {
 
 func (server *routedBoltzServer) initLightning(cfg *config.Config) error {
 	if server.lightning != nil {
-		return nil
+		// the main node was set up already, but the additional ones still have to be
+		return server.initLightningNodes(cfg.Nodes)
 	}
 	if cfg.Standalone {
 		if cfg.Network == "" {
@@ -137,25 +144,62 @@ func (server *routedBoltzServer) initLightning(cfg *config.Config) error {
 		}
 		return nil
 	}
-	isClnConfigured := cfg.Cln.RootCert != ""
+	isClnConfigured := cfg.Cln.RootCert != "" || cfg.Cln.RpcFile != ""
 	isLndConfigured := cfg.LND.Macaroon != ""
+	isPhoenixdConfigured := cfg.Phoenixd.Password != ""
 
 	if strings.EqualFold(cfg.Node, "CLN") {
 		server.lightning = cfg.Cln
 	} else if strings.EqualFold(cfg.Node, "LND") {
 		server.lightning = cfg.LND
-	} else if isClnConfigured && isLndConfigured {
-		return errors.New("both CLN and LND are configured. Set --node to specify which node to use")
+	} else if strings.EqualFold(cfg.Node, "phoenixd") {
+		server.lightning = cfg.Phoenixd
+	} else if countTrue(isClnConfigured, isLndConfigured, isPhoenixdConfigured) > 1 {
+		return errors.New("multiple lightning nodes are configured. Set --node to specify which node to use")
 	} else if isClnConfigured {
 		server.lightning = cfg.Cln
 	} else if isLndConfigured {
 		server.lightning = cfg.LND
+	} else if isPhoenixdConfigured {
+		server.lightning = cfg.Phoenixd
 	} else {
 		return errors.New("no Lightning node configured, start with --standalone to run without connecting to a Lightning node")
 	}
+	return server.initLightningNodes(cfg.Nodes)
+}
+
+func (server *routedBoltzServer) initLightningNodes(nodes []*config.NodeOptions) error {
+	server.lightningNodes = make(map[string]lightning.LightningNode)
+	for _, node := range nodes {
+		if node.Name == "" {
+			return errors.New("additional lightning nodes require a name")
+		}
+		if _, ok := server.lightningNodes[node.Name]; ok {
+			return fmt.Errorf("lightning node %s is configured twice", node.Name)
+		}
+		if countTrue(node.LND != nil, node.Cln != nil, node.Phoenixd != nil) != 1 {
+			return fmt.Errorf("lightning node %s needs exactly one of lnd, cln or phoenixd to be configured", node.Name)
+		}
+		if node.LND != nil {
+			server.lightningNodes[node.Name] = node.LND
+		} else if node.Cln != nil {
+			server.lightningNodes[node.Name] = node.Cln
+		} else {
+			server.lightningNodes[node.Name] = node.Phoenixd
+		}
+	}
 	return nil
 }
 
+func countTrue(values ...bool) (count int) {
+	for _, value := range values {
+		if value {
+			count++
+		}
+	}
+	return count
+}
+
 func (server *routedBoltzServer) start(cfg *config.Config) (err error) {
 	if err := server.initLightning(cfg); err != nil {
 		return fmt.Errorf("could not init lightning: %w", err)
@@ -180,6 +224,20 @@ func (server *routedBoltzServer) start(cfg *config.Config) (err error) {
 
 		cfg.Network = info.Network
 	}
+	for _, name := range server.lightningNodeNames() {
+		node := server.lightningNodes[name]
+		info, err := connectLightning(server.stop, node)
+		if err != nil {
+			return fmt.Errorf("could not connect to lightning node %s: %w", name, err)
+		}
+		if server.getState() == stateStopping {
+			return nil
+		}
+		if info.Network != cfg.Network {
+			return fmt.Errorf("lightning node %s is on network %s instead of %s", name, info.Network, cfg.Network)
+		}
+		logger.Infof("Connected to lightning node %s (%v %v): %v", name, node.Name(), info.Version, info.Pubkey)
+	}
 
 	server.network, err = boltz.ParseChain(cfg.Network)
 	if err != nil {
@@ -231,6 +289,14 @@ func (server *routedBoltzServer) start(cfg *config.Config) (err error) {
 		server.boltz,
 		server.database,
 	)
+	server.nursery.SetPaymentRetries(nursery.PaymentRetries{
+		Attempts:           cfg.Lightning.PaymentAttempts,
+		FeeLimitCeilingPpm: cfg.Lightning.RoutingFeeLimitCeilingPpm,
+		MaxParts:           cfg.Lightning.MaxParts,
+	})
+	for name, node := range server.lightningNodes {
+		server.nursery.AddLightningNode(name, node)
+	}
 
 	liquidConfig := liquid_wallet.Config{
 		Network:                server.network,
@@ -251,10 +317,12 @@ func (server *routedBoltzServer) start(cfg *config.Config) (err error) {
 		return fmt.Errorf("prepare bitcoin wallet datadir: %v", err)
 	}
 	bitcoinConfig := bitcoin_wallet.Config{
-		Network:       server.network,
-		DataDir:       btcDir,
-		ChainProvider: server.onchain.Btc.Chain,
-		Electrum:      cfg.Electrum().Btc,
+		Network:                 server.network,
+		DataDir:                 btcDir,
+		ChainProvider:           server.onchain.Btc.Chain,
+		Electrum:                cfg.Electrum().Btc,
+		ConsolidationThreshold:  cfg.AutoConsolidateBtcThreshold,
+		ConsolidationMaxFeeRate: cfg.AutoConsolidateMaxFeeRate,
 	}
 	server.walletBackends[boltz.CurrencyBtc], err = bitcoin_wallet.NewBackend(bitcoinConfig)
 	if err != nil {
@@ -263,6 +331,7 @@ func (server *routedBoltzServer) start(cfg *config.Config) (err error) {
 
 	autoConfPath := path.Join(cfg.DataDir, "autoswap.toml")
 	server.swapper.Init(server.database, server.onchain, autoConfPath, server)
+	server.offers = offers.New(server.database, server)
 
 	return server.unlock("")
 }
@@ -390,6 +459,40 @@ func (server *RpcServer) Start() chan error {
 	return errChannel
 }
 
+// InProcessConnection returns a connection to a gRPC server which can only be reached from within the process.
+// It is served without TLS and authentication, so calls have full access to the default tenant
+func (server *RpcServer) InProcessConnection() (*grpc.ClientConn, error) {
+	inProcess := grpc.NewServer(
+		grpc.UnaryInterceptor(server.boltzServer.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(server.boltzServer.StreamServerInterceptor()),
+	)
+	boltzrpc.RegisterBoltzServer(inProcess, server.boltzServer)
+	autoswaprpc.RegisterAutoSwapServer(inProcess, server.autoswapServer)
+
+	listener := bufconn.Listen(inProcessBufferSize)
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		if err := inProcess.Serve(listener); err != nil {
+			logger.Errorf("In-process RPC server failed: %v", err)
+		}
+	}()
+	go func() {
+		<-server.boltzServer.stop
+		inProcess.GracefulStop()
+	}()
+	return conn, nil
+}
+
 func (server *RpcServer) Stop() error {
 	_, err := server.boltzServer.Stop(context.Background(), nil)
 	return err
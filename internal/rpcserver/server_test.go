@@ -0,0 +1,53 @@
+package rpcserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/v2/internal/cln"
+	"github.com/BoltzExchange/boltz-client/v2/internal/config"
+	"github.com/BoltzExchange/boltz-client/v2/internal/lnd"
+	"github.com/BoltzExchange/boltz-client/v2/internal/phoenixd"
+	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInitLightning(t *testing.T) {
+	cfg := &config.Config{
+		LND:      &lnd.LND{Macaroon: "macaroon"},
+		Cln:      &cln.Cln{},
+		Phoenixd: &phoenixd.Phoenixd{},
+		Nodes:    []*config.NodeOptions{{Name: "second", Cln: &cln.Cln{}}},
+	}
+
+	t.Run("Configured", func(t *testing.T) {
+		server := &routedBoltzServer{}
+		require.NoError(t, server.initLightning(cfg))
+		require.Equal(t, cfg.LND, server.lightning)
+		require.Contains(t, server.lightningNodes, "second")
+	})
+
+	t.Run("Preset", func(t *testing.T) {
+		// the main node is set before starting when running as a plugin
+		server := &routedBoltzServer{lightning: &cln.Cln{}}
+		require.NoError(t, server.initLightning(cfg))
+		require.Contains(t, server.lightningNodes, "second")
+	})
+}
+
+func TestInProcessConnection(t *testing.T) {
+	server := &RpcServer{
+		boltzServer:    &routedBoltzServer{stop: make(chan bool), state: stateLightningSyncing},
+		autoswapServer: &routedAutoSwapServer{},
+	}
+	conn, err := server.InProcessConnection()
+	require.NoError(t, err)
+	t.Cleanup(func() { close(server.boltzServer.stop) })
+
+	// the call reaches the server without TLS or credentials
+	_, err = boltzrpc.NewBoltzClient(conn).GetInfo(context.Background(), &boltzrpc.GetInfoRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.ErrorContains(t, err, "syncing")
+}
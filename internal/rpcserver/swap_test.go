@@ -137,7 +137,7 @@ func TestSwapInfo(t *testing.T) {
 			_, err := connectLightning(nil, node)
 			require.NoError(t, err)
 
-			invoice, err := node.CreateInvoice(100000, nil, 3600, "test")
+			invoice, err := node.CreateInvoice(100000, nil, 3600, "test", false)
 			require.NoError(t, err)
 
 			swap, err = admin.CreateSwap(&boltzrpc.CreateSwapRequest{Invoice: &invoice.PaymentRequest})
@@ -276,7 +276,7 @@ func TestSwap(t *testing.T) {
 		})
 
 		t.Run("ZeroAmount", func(t *testing.T) {
-			invoice, err := node.CreateInvoice(0, nil, 0, "test")
+			invoice, err := node.CreateInvoice(0, nil, 0, "test", false)
 			require.NoError(t, err)
 			_, err = client.CreateSwap(&boltzrpc.CreateSwapRequest{
 				Invoice: &invoice.PaymentRequest,
@@ -288,7 +288,7 @@ func TestSwap(t *testing.T) {
 		t.Run("Valid", func(t *testing.T) {
 			preimage, _, err := newPreimage()
 			require.NoError(t, err)
-			invoice, err := node.CreateInvoice(100000, preimage, 0, "test")
+			invoice, err := node.CreateInvoice(100000, preimage, 0, "test", false)
 			require.NoError(t, err)
 			swap, err := client.CreateSwap(&boltzrpc.CreateSwapRequest{
 				Invoice:          &invoice.PaymentRequest,
@@ -361,7 +361,7 @@ func TestSwap(t *testing.T) {
 		_, err = client.CreateSwap(request)
 		require.Error(t, err)
 
-		invoice, err := node.CreateInvoice(100000, nil, 0, "test")
+		invoice, err := node.CreateInvoice(100000, nil, 0, "test", false)
 		require.NoError(t, err)
 		request.Invoice = &invoice.PaymentRequest
 		swap, err := client.CreateSwap(request)
@@ -23,6 +23,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SwapperType int32
+
+const (
+	SwapperType_LIGHTNING SwapperType = 0
+	SwapperType_CHAIN     SwapperType = 1
+)
+
+// Enum value maps for SwapperType.
+var (
+	SwapperType_name = map[int32]string{
+		0: "LIGHTNING",
+		1: "CHAIN",
+	}
+	SwapperType_value = map[string]int32{
+		"LIGHTNING": 0,
+		"CHAIN":     1,
+	}
+)
+
+func (x SwapperType) Enum() *SwapperType {
+	p := new(SwapperType)
+	*p = x
+	return p
+}
+
+func (x SwapperType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapperType) Descriptor() protoreflect.EnumDescriptor {
+	return file_autoswaprpc_autoswaprpc_proto_enumTypes[0].Descriptor()
+}
+
+func (SwapperType) Type() protoreflect.EnumType {
+	return &file_autoswaprpc_autoswaprpc_proto_enumTypes[0]
+}
+
+func (x SwapperType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapperType.Descriptor instead.
+func (SwapperType) EnumDescriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{0}
+}
+
 type GetRecommendationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -71,6 +117,8 @@ type LightningSwap struct {
 	Type        boltzrpc.SwapType `protobuf:"varint,3,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
 	// Reasons for which the swap is not being executed
 	DismissedReasons []string `protobuf:"bytes,4,rep,name=dismissed_reasons,json=dismissedReasons,proto3" json:"dismissed_reasons,omitempty"`
+	// Currency of the onchain side of the swap
+	Currency boltzrpc.Currency `protobuf:"varint,5,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
 }
 
 func (x *LightningSwap) Reset() {
@@ -133,6 +181,13 @@ func (x *LightningSwap) GetDismissedReasons() []string {
 	return nil
 }
 
+func (x *LightningSwap) GetCurrency() boltzrpc.Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return boltzrpc.Currency(0)
+}
+
 type LightningThresholds struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -199,6 +254,8 @@ type LightningRecommendation struct {
 	Channel *boltzrpc.LightningChannel `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
 	// the thresholds for a swap to be recommended for the `channel`
 	Thresholds *LightningThresholds `protobuf:"bytes,3,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	// Name of the lightning node the `channel` belongs to, empty for the main node
+	LightningNode string `protobuf:"bytes,4,opt,name=lightning_node,json=lightningNode,proto3" json:"lightning_node,omitempty"`
 }
 
 func (x *LightningRecommendation) Reset() {
@@ -254,6 +311,13 @@ func (x *LightningRecommendation) GetThresholds() *LightningThresholds {
 	return nil
 }
 
+func (x *LightningRecommendation) GetLightningNode() string {
+	if x != nil {
+		return x.LightningNode
+	}
+	return ""
+}
+
 type ChainSwap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,6 +327,8 @@ type ChainSwap struct {
 	FeeEstimate uint64 `protobuf:"varint,2,opt,name=fee_estimate,json=feeEstimate,proto3" json:"fee_estimate,omitempty"`
 	// Reasons for which the swap is not being executed
 	DismissedReasons []string `protobuf:"bytes,3,rep,name=dismissed_reasons,json=dismissedReasons,proto3" json:"dismissed_reasons,omitempty"`
+	// Direction of the swap, only from `to_wallet` to `from_wallet` if a `target_ratio` is configured
+	Pair *boltzrpc.Pair `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
 }
 
 func (x *ChainSwap) Reset() {
@@ -318,6 +384,13 @@ func (x *ChainSwap) GetDismissedReasons() []string {
 	return nil
 }
 
+func (x *ChainSwap) GetPair() *boltzrpc.Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
 type ChainRecommendation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -328,6 +401,10 @@ type ChainRecommendation struct {
 	Swap          *ChainSwap        `protobuf:"bytes,1,opt,name=swap,proto3,oneof" json:"swap,omitempty"`
 	WalletBalance *boltzrpc.Balance `protobuf:"bytes,2,opt,name=wallet_balance,json=walletBalance,proto3" json:"wallet_balance,omitempty"`
 	MaxBalance    uint64            `protobuf:"varint,3,opt,name=max_balance,json=maxBalance,proto3" json:"max_balance,omitempty"`
+	// Balance of the `to_wallet`, only populated if a `target_ratio` is configured
+	ToWalletBalance *boltzrpc.Balance `protobuf:"bytes,4,opt,name=to_wallet_balance,json=toWalletBalance,proto3,oneof" json:"to_wallet_balance,omitempty"`
+	// Name of the chain rule which made the recommendation, empty for the default rule
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
 }
 
 func (x *ChainRecommendation) Reset() {
@@ -383,6 +460,20 @@ func (x *ChainRecommendation) GetMaxBalance() uint64 {
 	return 0
 }
 
+func (x *ChainRecommendation) GetToWalletBalance() *boltzrpc.Balance {
+	if x != nil {
+		return x.ToWalletBalance
+	}
+	return nil
+}
+
+func (x *ChainRecommendation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 type Budget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -393,6 +484,9 @@ type Budget struct {
 	StartDate int64               `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
 	EndDate   int64               `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	Stats     *boltzrpc.SwapStats `protobuf:"bytes,5,opt,name=stats,proto3,oneof" json:"stats,omitempty"`
+	// Set if the budget only applies to swaps of this currency or type
+	Currency *boltzrpc.Currency `protobuf:"varint,6,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
+	Type     *boltzrpc.SwapType `protobuf:"varint,7,opt,name=type,proto3,enum=boltzrpc.SwapType,oneof" json:"type,omitempty"`
 }
 
 func (x *Budget) Reset() {
@@ -462,6 +556,20 @@ func (x *Budget) GetStats() *boltzrpc.SwapStats {
 	return nil
 }
 
+func (x *Budget) GetCurrency() boltzrpc.Currency {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return boltzrpc.Currency(0)
+}
+
+func (x *Budget) GetType() boltzrpc.SwapType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return boltzrpc.SwapType(0)
+}
+
 type GetRecommendationsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -666,6 +774,10 @@ type Status struct {
 	Error       *string `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
 	Budget      *Budget `protobuf:"bytes,3,opt,name=budget,proto3,oneof" json:"budget,omitempty"`
 	Description string  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Name of the chain rule or lightning node, empty for the default rule and the main node
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// Additional budgets which only apply to swaps of a certain currency or type
+	Budgets []*Budget `protobuf:"bytes,6,rep,name=budgets,proto3" json:"budgets,omitempty"`
 }
 
 func (x *Status) Reset() {
@@ -728,13 +840,32 @@ func (x *Status) GetDescription() string {
 	return ""
 }
 
+func (x *Status) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Status) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
 type GetStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Lightning *Status `protobuf:"bytes,1,opt,name=lightning,proto3,oneof" json:"lightning,omitempty"`
-	Chain     *Status `protobuf:"bytes,2,opt,name=chain,proto3,oneof" json:"chain,omitempty"`
+	// Status of the default chain rule
+	Chain *Status `protobuf:"bytes,2,opt,name=chain,proto3,oneof" json:"chain,omitempty"`
+	// Status of the named chain rules
+	ChainRules []*Status `protobuf:"bytes,3,rep,name=chain_rules,json=chainRules,proto3" json:"chain_rules,omitempty"`
+	// Status of the lightning autoswap configs of the additional lightning nodes
+	LightningNodes []*Status `protobuf:"bytes,4,rep,name=lightning_nodes,json=lightningNodes,proto3" json:"lightning_nodes,omitempty"`
 }
 
 func (x *GetStatusResponse) Reset() {
@@ -783,6 +914,20 @@ func (x *GetStatusResponse) GetChain() *Status {
 	return nil
 }
 
+func (x *GetStatusResponse) GetChainRules() []*Status {
+	if x != nil {
+		return x.ChainRules
+	}
+	return nil
+}
+
+func (x *GetStatusResponse) GetLightningNodes() []*Status {
+	if x != nil {
+		return x.LightningNodes
+	}
+	return nil
+}
+
 type GetConfigRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1017,6 +1162,26 @@ type ChainConfig struct {
 	Budget         uint64  `protobuf:"varint,7,opt,name=budget,proto3" json:"budget,omitempty"`
 	BudgetInterval uint64  `protobuf:"varint,8,opt,name=budget_interval,json=budgetInterval,proto3" json:"budget_interval,omitempty"`
 	Tenant         *string `protobuf:"bytes,9,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// Recommendations above this amount are not executed automatically but queued for approval. Disabled if 0
+	ApprovalThreshold uint64 `protobuf:"varint,11,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	// Seconds after which pending approvals expire. Defaults to one day
+	ApprovalExpiry uint64 `protobuf:"varint,12,opt,name=approval_expiry,json=approvalExpiry,proto3" json:"approval_expiry,omitempty"`
+	// Keeps the share of the combined confirmed balance of `from_wallet` and `to_wallet` which is held in `from_wallet`
+	// at this percentage by swapping in whichever direction is needed. Requires `to_wallet`, `max_balance` and `reserve_balance` are ignored
+	TargetRatio *float32 `protobuf:"fixed32,13,opt,name=target_ratio,json=targetRatio,proto3,oneof" json:"target_ratio,omitempty"`
+	// Deviation from `target_ratio` in percentage points which is tolerated before a swap is recommended. Defaults to 10
+	TargetBand float32 `protobuf:"fixed32,14,opt,name=target_band,json=targetBand,proto3" json:"target_band,omitempty"`
+	// Swaps are deferred while the fee rate (sat/vbyte) of the currency being sent is above this value. Disabled if 0
+	MaxFeeRate float32 `protobuf:"fixed32,15,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty"`
+	// Time of day windows in UTC in which swaps may be executed, formatted as `HH:MM-HH:MM`. Swaps are allowed at any time if empty
+	SwapWindows []string `protobuf:"bytes,16,rep,name=swap_windows,json=swapWindows,proto3" json:"swap_windows,omitempty"`
+	// Identifies the rule if a tenant has multiple chain rules, each of which has its own wallets, thresholds and budget.
+	// Empty for the default rule
+	Name string `protobuf:"bytes,17,opt,name=name,proto3" json:"name,omitempty"`
+	// Swaps whose fee exceeds this amount in satoshis are not executed, in addition to `max_fee_percent`. Disabled if 0
+	MaxSwapFee uint64 `protobuf:"varint,18,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	// Additional budgets which only apply to swaps sending a certain currency
+	Budgets []*BudgetLimit `protobuf:"bytes,19,rep,name=budgets,proto3" json:"budgets,omitempty"`
 }
 
 func (x *ChainConfig) Reset() {
@@ -1121,6 +1286,69 @@ func (x *ChainConfig) GetTenant() string {
 	return ""
 }
 
+func (x *ChainConfig) GetApprovalThreshold() uint64 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetApprovalExpiry() uint64 {
+	if x != nil {
+		return x.ApprovalExpiry
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetTargetRatio() float32 {
+	if x != nil && x.TargetRatio != nil {
+		return *x.TargetRatio
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetTargetBand() float32 {
+	if x != nil {
+		return x.TargetBand
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetMaxFeeRate() float32 {
+	if x != nil {
+		return x.MaxFeeRate
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetSwapWindows() []string {
+	if x != nil {
+		return x.SwapWindows
+	}
+	return nil
+}
+
+func (x *ChainConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChainConfig) GetMaxSwapFee() uint64 {
+	if x != nil {
+		return x.MaxSwapFee
+	}
+	return 0
+}
+
+func (x *ChainConfig) GetBudgets() []*BudgetLimit {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
 type LightningConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1144,6 +1372,31 @@ type LightningConfig struct {
 	Wallet                 string            `protobuf:"bytes,16,opt,name=wallet,proto3" json:"wallet,omitempty"`
 	MaxSwapAmount          uint64            `protobuf:"varint,17,opt,name=max_swap_amount,json=maxSwapAmount,proto3" json:"max_swap_amount,omitempty"`
 	Tenant                 *string           `protobuf:"bytes,18,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// Overrides for specific peers or channels. A rule matching the channel id takes precedence over one matching the peer.
+	ChannelRules []*LightningChannelRule `protobuf:"bytes,19,rep,name=channel_rules,json=channelRules,proto3" json:"channel_rules,omitempty"`
+	// Recommendations above this amount are not executed automatically but queued for approval. Disabled if 0
+	ApprovalThreshold uint64 `protobuf:"varint,20,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	// Seconds after which pending approvals expire. Defaults to one day
+	ApprovalExpiry uint64 `protobuf:"varint,21,opt,name=approval_expiry,json=approvalExpiry,proto3" json:"approval_expiry,omitempty"`
+	// Swaps are deferred while the onchain fee rate (sat/vbyte) of `currency` is above this value. Disabled if 0
+	MaxFeeRate float32 `protobuf:"fixed32,22,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty"`
+	// Time of day windows in UTC in which swaps may be executed, formatted as `HH:MM-HH:MM`. Swaps are allowed at any time if empty
+	SwapWindows []string `protobuf:"bytes,23,rep,name=swap_windows,json=swapWindows,proto3" json:"swap_windows,omitempty"`
+	// Swaps are never deferred if the balance that is being restored is below this percentage of the capacity
+	HardFloorPercent float32 `protobuf:"fixed32,24,opt,name=hard_floor_percent,json=hardFloorPercent,proto3" json:"hard_floor_percent,omitempty"`
+	// Wallets in other currencies than `currency` which are used instead of `wallet` whenever swapping with them is cheaper
+	AlternativeWallets []string `protobuf:"bytes,25,rep,name=alternative_wallets,json=alternativeWallets,proto3" json:"alternative_wallets,omitempty"`
+	// Opens new channels with funds of an onchain wallet when the total outbound balance is too low
+	ChannelOpening *ChannelOpeningConfig `protobuf:"bytes,26,opt,name=channel_opening,json=channelOpening,proto3,oneof" json:"channel_opening,omitempty"`
+	// Swaps whose fee exceeds this amount in satoshis are not executed, in addition to `max_fee_percent`. Disabled if 0
+	MaxSwapFee uint64 `protobuf:"varint,27,opt,name=max_swap_fee,json=maxSwapFee,proto3" json:"max_swap_fee,omitempty"`
+	// Additional budgets which only apply to swaps of a certain currency or type
+	Budgets []*BudgetLimit `protobuf:"bytes,28,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	// Name of the lightning node whose channels are rebalanced, each node has its own config. Empty for the main node
+	LightningNode string `protobuf:"bytes,29,opt,name=lightning_node,json=lightningNode,proto3" json:"lightning_node,omitempty"`
+	// Include route hints for the private channels of the node in the invoices of submarine swaps.
+	// Required if the node has no public channels
+	RouteHints bool `protobuf:"varint,30,opt,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
 }
 
 func (x *LightningConfig) Reset() {
@@ -1304,399 +1557,2453 @@ func (x *LightningConfig) GetTenant() string {
 	return ""
 }
 
-var File_autoswaprpc_autoswaprpc_proto protoreflect.FileDescriptor
+func (x *LightningConfig) GetChannelRules() []*LightningChannelRule {
+	if x != nil {
+		return x.ChannelRules
+	}
+	return nil
+}
 
-var file_autoswaprpc_autoswaprpc_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75,
-	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x67,
-	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x45, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a,
-	0x11, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73,
-	0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x69,
-	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x73, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1f, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01,
-	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x17, 0x4c,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
-	0x48, 0x00, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72,
-	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
-	0x6c, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x22, 0x73, 0x0a, 0x09,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65,
-	0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x10, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x73, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x77, 0x61,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x48,
-	0x00, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x22, 0xb0,
-	0x01, 0x0a, 0x06, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x42, 0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74,
-	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xc0, 0x01, 0x0a,
-	0x1d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
-	0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
-	0x6e, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22,
-	0x20, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x01, 0x52, 0x06, 0x62, 0x75,
-	0x64, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x22, 0x93,
-	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x09,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x05,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x48, 0x01, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc4, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61,
-	0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12,
-	0x19, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
-	0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22,
-	0xc0, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e,
-	0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00,
-	0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x19,
-	0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
-	0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x22, 0x74, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x05,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75,
-	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x3a, 0x0a, 0x09,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
-	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6c,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xdf, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0xde, 0x05, 0x0a, 0x0f, 0x4c,
-	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
-	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e,
-	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f,
-	0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x75, 0x74, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x15, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78,
-	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x66,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63,
-	0x6b, 0x6f, 0x66, 0x66, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f,
-	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
-	0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x12, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x32, 0xd8, 0x04, 0x0a, 0x08,
-	0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x71, 0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f,
-	0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
-	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77,
-	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x67, 0x68,
-	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x2e, 0x61,
-	0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0c, 0x52, 0x65, 0x6c,
-	0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
-	0x70, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *LightningConfig) GetApprovalThreshold() uint64 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
 }
 
-var (
-	file_autoswaprpc_autoswaprpc_proto_rawDescOnce sync.Once
-	file_autoswaprpc_autoswaprpc_proto_rawDescData = file_autoswaprpc_autoswaprpc_proto_rawDesc
-)
+func (x *LightningConfig) GetApprovalExpiry() uint64 {
+	if x != nil {
+		return x.ApprovalExpiry
+	}
+	return 0
+}
 
-func file_autoswaprpc_autoswaprpc_proto_rawDescGZIP() []byte {
-	file_autoswaprpc_autoswaprpc_proto_rawDescOnce.Do(func() {
-		file_autoswaprpc_autoswaprpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_autoswaprpc_autoswaprpc_proto_rawDescData)
-	})
-	return file_autoswaprpc_autoswaprpc_proto_rawDescData
+func (x *LightningConfig) GetMaxFeeRate() float32 {
+	if x != nil {
+		return x.MaxFeeRate
+	}
+	return 0
 }
 
-var file_autoswaprpc_autoswaprpc_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
-var file_autoswaprpc_autoswaprpc_proto_goTypes = []interface{}{
-	(*GetRecommendationsRequest)(nil),      // 0: autoswaprpc.GetRecommendationsRequest
-	(*LightningSwap)(nil),                  // 1: autoswaprpc.LightningSwap
-	(*LightningThresholds)(nil),            // 2: autoswaprpc.LightningThresholds
-	(*LightningRecommendation)(nil),        // 3: autoswaprpc.LightningRecommendation
-	(*ChainSwap)(nil),                      // 4: autoswaprpc.ChainSwap
-	(*ChainRecommendation)(nil),            // 5: autoswaprpc.ChainRecommendation
-	(*Budget)(nil),                         // 6: autoswaprpc.Budget
-	(*GetRecommendationsResponse)(nil),     // 7: autoswaprpc.GetRecommendationsResponse
-	(*ExecuteRecommendationsRequest)(nil),  // 8: autoswaprpc.ExecuteRecommendationsRequest
-	(*ExecuteRecommendationsResponse)(nil), // 9: autoswaprpc.ExecuteRecommendationsResponse
-	(*GetStatusRequest)(nil),               // 10: autoswaprpc.GetStatusRequest
-	(*Status)(nil),                         // 11: autoswaprpc.Status
-	(*GetStatusResponse)(nil),              // 12: autoswaprpc.GetStatusResponse
-	(*GetConfigRequest)(nil),               // 13: autoswaprpc.GetConfigRequest
-	(*UpdateLightningConfigRequest)(nil),   // 14: autoswaprpc.UpdateLightningConfigRequest
-	(*UpdateChainConfigRequest)(nil),       // 15: autoswaprpc.UpdateChainConfigRequest
-	(*Config)(nil),                         // 16: autoswaprpc.Config
-	(*ChainConfig)(nil),                    // 17: autoswaprpc.ChainConfig
-	(*LightningConfig)(nil),                // 18: autoswaprpc.LightningConfig
-	(boltzrpc.SwapType)(0),                 // 19: boltzrpc.SwapType
-	(*boltzrpc.LightningChannel)(nil),      // 20: boltzrpc.LightningChannel
-	(*boltzrpc.Balance)(nil),               // 21: boltzrpc.Balance
-	(*boltzrpc.SwapStats)(nil),             // 22: boltzrpc.SwapStats
-	(*fieldmaskpb.FieldMask)(nil),          // 23: google.protobuf.FieldMask
-	(boltzrpc.Currency)(0),                 // 24: boltzrpc.Currency
-	(*emptypb.Empty)(nil),                  // 25: google.protobuf.Empty
+func (x *LightningConfig) GetSwapWindows() []string {
+	if x != nil {
+		return x.SwapWindows
+	}
+	return nil
 }
-var file_autoswaprpc_autoswaprpc_proto_depIdxs = []int32{
-	19, // 0: autoswaprpc.LightningSwap.type:type_name -> boltzrpc.SwapType
-	1,  // 1: autoswaprpc.LightningRecommendation.swap:type_name -> autoswaprpc.LightningSwap
-	20, // 2: autoswaprpc.LightningRecommendation.channel:type_name -> boltzrpc.LightningChannel
-	2,  // 3: autoswaprpc.LightningRecommendation.thresholds:type_name -> autoswaprpc.LightningThresholds
-	4,  // 4: autoswaprpc.ChainRecommendation.swap:type_name -> autoswaprpc.ChainSwap
-	21, // 5: autoswaprpc.ChainRecommendation.wallet_balance:type_name -> boltzrpc.Balance
-	22, // 6: autoswaprpc.Budget.stats:type_name -> boltzrpc.SwapStats
-	3,  // 7: autoswaprpc.GetRecommendationsResponse.lightning:type_name -> autoswaprpc.LightningRecommendation
-	5,  // 8: autoswaprpc.GetRecommendationsResponse.chain:type_name -> autoswaprpc.ChainRecommendation
-	3,  // 9: autoswaprpc.ExecuteRecommendationsRequest.lightning:type_name -> autoswaprpc.LightningRecommendation
-	5,  // 10: autoswaprpc.ExecuteRecommendationsRequest.chain:type_name -> autoswaprpc.ChainRecommendation
-	6,  // 11: autoswaprpc.Status.budget:type_name -> autoswaprpc.Budget
-	11, // 12: autoswaprpc.GetStatusResponse.lightning:type_name -> autoswaprpc.Status
-	11, // 13: autoswaprpc.GetStatusResponse.chain:type_name -> autoswaprpc.Status
-	18, // 14: autoswaprpc.UpdateLightningConfigRequest.config:type_name -> autoswaprpc.LightningConfig
-	23, // 15: autoswaprpc.UpdateLightningConfigRequest.field_mask:type_name -> google.protobuf.FieldMask
-	17, // 16: autoswaprpc.UpdateChainConfigRequest.config:type_name -> autoswaprpc.ChainConfig
-	23, // 17: autoswaprpc.UpdateChainConfigRequest.field_mask:type_name -> google.protobuf.FieldMask
-	17, // 18: autoswaprpc.Config.chain:type_name -> autoswaprpc.ChainConfig
-	18, // 19: autoswaprpc.Config.lightning:type_name -> autoswaprpc.LightningConfig
-	24, // 20: autoswaprpc.LightningConfig.currency:type_name -> boltzrpc.Currency
-	0,  // 21: autoswaprpc.AutoSwap.GetRecommendations:input_type -> autoswaprpc.GetRecommendationsRequest
-	8,  // 22: autoswaprpc.AutoSwap.ExecuteRecommendations:input_type -> autoswaprpc.ExecuteRecommendationsRequest
-	10, // 23: autoswaprpc.AutoSwap.GetStatus:input_type -> autoswaprpc.GetStatusRequest
-	14, // 24: autoswaprpc.AutoSwap.UpdateLightningConfig:input_type -> autoswaprpc.UpdateLightningConfigRequest
-	15, // 25: autoswaprpc.AutoSwap.UpdateChainConfig:input_type -> autoswaprpc.UpdateChainConfigRequest
-	13, // 26: autoswaprpc.AutoSwap.GetConfig:input_type -> autoswaprpc.GetConfigRequest
-	25, // 27: autoswaprpc.AutoSwap.ReloadConfig:input_type -> google.protobuf.Empty
-	7,  // 28: autoswaprpc.AutoSwap.GetRecommendations:output_type -> autoswaprpc.GetRecommendationsResponse
-	9,  // 29: autoswaprpc.AutoSwap.ExecuteRecommendations:output_type -> autoswaprpc.ExecuteRecommendationsResponse
-	12, // 30: autoswaprpc.AutoSwap.GetStatus:output_type -> autoswaprpc.GetStatusResponse
-	16, // 31: autoswaprpc.AutoSwap.UpdateLightningConfig:output_type -> autoswaprpc.Config
-	16, // 32: autoswaprpc.AutoSwap.UpdateChainConfig:output_type -> autoswaprpc.Config
-	16, // 33: autoswaprpc.AutoSwap.GetConfig:output_type -> autoswaprpc.Config
-	16, // 34: autoswaprpc.AutoSwap.ReloadConfig:output_type -> autoswaprpc.Config
-	28, // [28:35] is the sub-list for method output_type
-	21, // [21:28] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+
+func (x *LightningConfig) GetHardFloorPercent() float32 {
+	if x != nil {
+		return x.HardFloorPercent
+	}
+	return 0
 }
 
-func init() { file_autoswaprpc_autoswaprpc_proto_init() }
-func file_autoswaprpc_autoswaprpc_proto_init() {
-	if File_autoswaprpc_autoswaprpc_proto != nil {
-		return
+func (x *LightningConfig) GetAlternativeWallets() []string {
+	if x != nil {
+		return x.AlternativeWallets
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_autoswaprpc_autoswaprpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRecommendationsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_autoswaprpc_autoswaprpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LightningSwap); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_autoswaprpc_autoswaprpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LightningThresholds); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_autoswaprpc_autoswaprpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LightningRecommendation); i {
-			case 0:
-				return &v.state
+	return nil
+}
+
+func (x *LightningConfig) GetChannelOpening() *ChannelOpeningConfig {
+	if x != nil {
+		return x.ChannelOpening
+	}
+	return nil
+}
+
+func (x *LightningConfig) GetMaxSwapFee() uint64 {
+	if x != nil {
+		return x.MaxSwapFee
+	}
+	return 0
+}
+
+func (x *LightningConfig) GetBudgets() []*BudgetLimit {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *LightningConfig) GetLightningNode() string {
+	if x != nil {
+		return x.LightningNode
+	}
+	return ""
+}
+
+func (x *LightningConfig) GetRouteHints() bool {
+	if x != nil {
+		return x.RouteHints
+	}
+	return false
+}
+
+// Budgets are evaluated over a rolling window: only the fees of swaps created in the last `budget_interval` seconds count
+type BudgetLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only swaps in this currency count towards the budget. That is the onchain currency for lightning swaps
+	// and the currency being sent for chain swaps
+	Currency *boltzrpc.Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
+	// Only swaps of this type count towards the budget
+	Type   *boltzrpc.SwapType `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType,oneof" json:"type,omitempty"`
+	Budget uint64             `protobuf:"varint,3,opt,name=budget,proto3" json:"budget,omitempty"`
+	// Defaults to the `budget_interval` of the config
+	BudgetInterval uint64 `protobuf:"varint,4,opt,name=budget_interval,json=budgetInterval,proto3" json:"budget_interval,omitempty"`
+}
+
+func (x *BudgetLimit) Reset() {
+	*x = BudgetLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BudgetLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BudgetLimit) ProtoMessage() {}
+
+func (x *BudgetLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BudgetLimit.ProtoReflect.Descriptor instead.
+func (*BudgetLimit) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BudgetLimit) GetCurrency() boltzrpc.Currency {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return boltzrpc.Currency(0)
+}
+
+func (x *BudgetLimit) GetType() boltzrpc.SwapType {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return boltzrpc.SwapType(0)
+}
+
+func (x *BudgetLimit) GetBudget() uint64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *BudgetLimit) GetBudgetInterval() uint64 {
+	if x != nil {
+		return x.BudgetInterval
+	}
+	return 0
+}
+
+type ChannelOpeningConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Channels are opened while the total outbound balance of all channels is below this amount
+	MinOutbound uint64 `protobuf:"varint,1,opt,name=min_outbound,json=minOutbound,proto3" json:"min_outbound,omitempty"`
+	// Size of each opened channel in satoshis
+	ChannelSize uint64 `protobuf:"varint,2,opt,name=channel_size,json=channelSize,proto3" json:"channel_size,omitempty"`
+	// Peers to open channels with, the one we have the least capacity with is picked
+	Peers []*ChannelPeer `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	// Wallet whose funds above `reserve_balance` are moved to the onchain wallet of the lightning node.
+	// Liquid funds are chain swapped, BTC funds are sent directly
+	Wallet         string `protobuf:"bytes,4,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	ReserveBalance uint64 `protobuf:"varint,5,opt,name=reserve_balance,json=reserveBalance,proto3" json:"reserve_balance,omitempty"`
+	// Open unannounced channels
+	Private bool `protobuf:"varint,6,opt,name=private,proto3" json:"private,omitempty"`
+	// Splice the funds into the largest existing channel with the picked peer instead of opening a new one.
+	// New channels are still opened with peers we have no channel with. Only supported by CLN
+	Splice bool `protobuf:"varint,7,opt,name=splice,proto3" json:"splice,omitempty"`
+}
+
+func (x *ChannelOpeningConfig) Reset() {
+	*x = ChannelOpeningConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelOpeningConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelOpeningConfig) ProtoMessage() {}
+
+func (x *ChannelOpeningConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelOpeningConfig.ProtoReflect.Descriptor instead.
+func (*ChannelOpeningConfig) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChannelOpeningConfig) GetMinOutbound() uint64 {
+	if x != nil {
+		return x.MinOutbound
+	}
+	return 0
+}
+
+func (x *ChannelOpeningConfig) GetChannelSize() uint64 {
+	if x != nil {
+		return x.ChannelSize
+	}
+	return 0
+}
+
+func (x *ChannelOpeningConfig) GetPeers() []*ChannelPeer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *ChannelOpeningConfig) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *ChannelOpeningConfig) GetReserveBalance() uint64 {
+	if x != nil {
+		return x.ReserveBalance
+	}
+	return 0
+}
+
+func (x *ChannelOpeningConfig) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *ChannelOpeningConfig) GetSplice() bool {
+	if x != nil {
+		return x.Splice
+	}
+	return false
+}
+
+type ChannelPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public key of the peer
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// Address (`host:port`) used to connect to the peer, not needed if the node is connected already
+	Host *string `protobuf:"bytes,2,opt,name=host,proto3,oneof" json:"host,omitempty"`
+}
+
+func (x *ChannelPeer) Reset() {
+	*x = ChannelPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChannelPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChannelPeer) ProtoMessage() {}
+
+func (x *ChannelPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChannelPeer.ProtoReflect.Descriptor instead.
+func (*ChannelPeer) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChannelPeer) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *ChannelPeer) GetHost() string {
+	if x != nil && x.Host != nil {
+		return *x.Host
+	}
+	return ""
+}
+
+type LightningChannelRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Matches all channels with this peer
+	PeerId *string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3,oneof" json:"peer_id,omitempty"`
+	// Matches a single channel, in lnd or cln format
+	ChannelId *string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	// Ignore matching channels entirely, also when calculating the total balance
+	Exclude bool `protobuf:"varint,3,opt,name=exclude,proto3" json:"exclude,omitempty"`
+	// The following fields override the respective values of the config and are only supported for per channel rebalancing
+	OutboundBalance        *uint64  `protobuf:"varint,4,opt,name=outbound_balance,json=outboundBalance,proto3,oneof" json:"outbound_balance,omitempty"`
+	InboundBalance         *uint64  `protobuf:"varint,5,opt,name=inbound_balance,json=inboundBalance,proto3,oneof" json:"inbound_balance,omitempty"`
+	OutboundBalancePercent *float32 `protobuf:"fixed32,6,opt,name=outbound_balance_percent,json=outboundBalancePercent,proto3,oneof" json:"outbound_balance_percent,omitempty"`
+	InboundBalancePercent  *float32 `protobuf:"fixed32,7,opt,name=inbound_balance_percent,json=inboundBalancePercent,proto3,oneof" json:"inbound_balance_percent,omitempty"`
+	SwapType               *string  `protobuf:"bytes,8,opt,name=swap_type,json=swapType,proto3,oneof" json:"swap_type,omitempty"`
+	MaxSwapAmount          *uint64  `protobuf:"varint,9,opt,name=max_swap_amount,json=maxSwapAmount,proto3,oneof" json:"max_swap_amount,omitempty"`
+}
+
+func (x *LightningChannelRule) Reset() {
+	*x = LightningChannelRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LightningChannelRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightningChannelRule) ProtoMessage() {}
+
+func (x *LightningChannelRule) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightningChannelRule.ProtoReflect.Descriptor instead.
+func (*LightningChannelRule) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *LightningChannelRule) GetPeerId() string {
+	if x != nil && x.PeerId != nil {
+		return *x.PeerId
+	}
+	return ""
+}
+
+func (x *LightningChannelRule) GetChannelId() string {
+	if x != nil && x.ChannelId != nil {
+		return *x.ChannelId
+	}
+	return ""
+}
+
+func (x *LightningChannelRule) GetExclude() bool {
+	if x != nil {
+		return x.Exclude
+	}
+	return false
+}
+
+func (x *LightningChannelRule) GetOutboundBalance() uint64 {
+	if x != nil && x.OutboundBalance != nil {
+		return *x.OutboundBalance
+	}
+	return 0
+}
+
+func (x *LightningChannelRule) GetInboundBalance() uint64 {
+	if x != nil && x.InboundBalance != nil {
+		return *x.InboundBalance
+	}
+	return 0
+}
+
+func (x *LightningChannelRule) GetOutboundBalancePercent() float32 {
+	if x != nil && x.OutboundBalancePercent != nil {
+		return *x.OutboundBalancePercent
+	}
+	return 0
+}
+
+func (x *LightningChannelRule) GetInboundBalancePercent() float32 {
+	if x != nil && x.InboundBalancePercent != nil {
+		return *x.InboundBalancePercent
+	}
+	return 0
+}
+
+func (x *LightningChannelRule) GetSwapType() string {
+	if x != nil && x.SwapType != nil {
+		return *x.SwapType
+	}
+	return ""
+}
+
+func (x *LightningChannelRule) GetMaxSwapAmount() uint64 {
+	if x != nil && x.MaxSwapAmount != nil {
+		return *x.MaxSwapAmount
+	}
+	return 0
+}
+
+type GetSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSnapshotRequest) Reset() {
+	*x = GetSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSnapshotRequest) ProtoMessage() {}
+
+func (x *GetSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{23}
+}
+
+type WalletSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Balance *boltzrpc.Balance `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *WalletSnapshot) Reset() {
+	*x = WalletSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletSnapshot) ProtoMessage() {}
+
+func (x *WalletSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletSnapshot.ProtoReflect.Descriptor instead.
+func (*WalletSnapshot) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WalletSnapshot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WalletSnapshot) GetBalance() *boltzrpc.Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix timestamp at which the snapshot was taken
+	Timestamp int64                        `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Channels  []*boltzrpc.LightningChannel `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	Wallets   []*WalletSnapshot            `protobuf:"bytes,3,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Pairs     *boltzrpc.GetPairsResponse   `protobuf:"bytes,4,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	// onchain fee estimates in sat/vbyte by currency
+	FeeRates map[string]float64 `protobuf:"bytes,5,rep,name=fee_rates,json=feeRates,proto3" json:"fee_rates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Snapshot) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Snapshot) GetChannels() []*boltzrpc.LightningChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *Snapshot) GetWallets() []*WalletSnapshot {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *Snapshot) GetPairs() *boltzrpc.GetPairsResponse {
+	if x != nil {
+		return x.Pairs
+	}
+	return nil
+}
+
+func (x *Snapshot) GetFeeRates() map[string]float64 {
+	if x != nil {
+		return x.FeeRates
+	}
+	return nil
+}
+
+type SimulateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The configuration to simulate, defaults to the current one
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	// Snapshots ordered by their timestamp. Empty fields are carried over from the previous snapshot.
+	Snapshots []*Snapshot `protobuf:"bytes,2,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *SimulateRequest) Reset() {
+	*x = SimulateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateRequest) ProtoMessage() {}
+
+func (x *SimulateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateRequest.ProtoReflect.Descriptor instead.
+func (*SimulateRequest) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SimulateRequest) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *SimulateRequest) GetSnapshots() []*Snapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type SimulatedSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp   int64               `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type        boltzrpc.SwapType   `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Amount      uint64              `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeEstimate uint64              `protobuf:"varint,4,opt,name=fee_estimate,json=feeEstimate,proto3" json:"fee_estimate,omitempty"`
+	ChannelId   *boltzrpc.ChannelId `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	// Reasons for which the swap would not have been executed
+	DismissedReasons []string `protobuf:"bytes,6,rep,name=dismissed_reasons,json=dismissedReasons,proto3" json:"dismissed_reasons,omitempty"`
+}
+
+func (x *SimulatedSwap) Reset() {
+	*x = SimulatedSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulatedSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatedSwap) ProtoMessage() {}
+
+func (x *SimulatedSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulatedSwap.ProtoReflect.Descriptor instead.
+func (*SimulatedSwap) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SimulatedSwap) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetType() boltzrpc.SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return boltzrpc.SwapType(0)
+}
+
+func (x *SimulatedSwap) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetFeeEstimate() uint64 {
+	if x != nil {
+		return x.FeeEstimate
+	}
+	return 0
+}
+
+func (x *SimulatedSwap) GetChannelId() *boltzrpc.ChannelId {
+	if x != nil {
+		return x.ChannelId
+	}
+	return nil
+}
+
+func (x *SimulatedSwap) GetDismissedReasons() []string {
+	if x != nil {
+		return x.DismissedReasons
+	}
+	return nil
+}
+
+type SimulationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All recommended swaps, the ones without `dismissed_reasons` would have been executed
+	Swaps []*SimulatedSwap `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	// Total fees of the executed swaps
+	TotalFees uint64 `protobuf:"varint,2,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	// State of each budget interval at the end of the simulation
+	Budgets []*Budget `protobuf:"bytes,3,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	// Errors which occurred while evaluating snapshots
+	Errors []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *SimulationResult) Reset() {
+	*x = SimulationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationResult) ProtoMessage() {}
+
+func (x *SimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationResult.ProtoReflect.Descriptor instead.
+func (*SimulationResult) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SimulationResult) GetSwaps() []*SimulatedSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *SimulationResult) GetTotalFees() uint64 {
+	if x != nil {
+		return x.TotalFees
+	}
+	return 0
+}
+
+func (x *SimulationResult) GetBudgets() []*Budget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+func (x *SimulationResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SimulateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lightning *SimulationResult   `protobuf:"bytes,1,opt,name=lightning,proto3,oneof" json:"lightning,omitempty"`
+	Chain     []*SimulationResult `protobuf:"bytes,2,rep,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *SimulateResponse) Reset() {
+	*x = SimulateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateResponse) ProtoMessage() {}
+
+func (x *SimulateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateResponse.ProtoReflect.Descriptor instead.
+func (*SimulateResponse) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SimulateResponse) GetLightning() *SimulationResult {
+	if x != nil {
+		return x.Lightning
+	}
+	return nil
+}
+
+func (x *SimulateResponse) GetChain() []*SimulationResult {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+type ListAutoSwapEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only return events of the given swapper
+	Swapper *SwapperType `protobuf:"varint,1,opt,name=swapper,proto3,enum=autoswaprpc.SwapperType,oneof" json:"swapper,omitempty"`
+	// Only return events which were recorded at or after the given unix timestamp
+	Since *int64 `protobuf:"varint,2,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Skip cycles in which no swap was recommended and no error occurred
+	ExcludeEmpty bool    `protobuf:"varint,3,opt,name=exclude_empty,json=excludeEmpty,proto3" json:"exclude_empty,omitempty"`
+	Limit        *uint64 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset       *uint64 `protobuf:"varint,5,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+}
+
+func (x *ListAutoSwapEventsRequest) Reset() {
+	*x = ListAutoSwapEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAutoSwapEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAutoSwapEventsRequest) ProtoMessage() {}
+
+func (x *ListAutoSwapEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAutoSwapEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAutoSwapEventsRequest) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListAutoSwapEventsRequest) GetSwapper() SwapperType {
+	if x != nil && x.Swapper != nil {
+		return *x.Swapper
+	}
+	return SwapperType_LIGHTNING
+}
+
+func (x *ListAutoSwapEventsRequest) GetSince() int64 {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return 0
+}
+
+func (x *ListAutoSwapEventsRequest) GetExcludeEmpty() bool {
+	if x != nil {
+		return x.ExcludeEmpty
+	}
+	return false
+}
+
+func (x *ListAutoSwapEventsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListAutoSwapEventsRequest) GetOffset() uint64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type AutoSwapEventSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        boltzrpc.SwapType   `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Amount      uint64              `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeEstimate uint64              `protobuf:"varint,3,opt,name=fee_estimate,json=feeEstimate,proto3" json:"fee_estimate,omitempty"`
+	ChannelId   *boltzrpc.ChannelId `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	// Reasons for which the swap was not executed
+	DismissedReasons []string `protobuf:"bytes,5,rep,name=dismissed_reasons,json=dismissedReasons,proto3" json:"dismissed_reasons,omitempty"`
+	// Id of the created swap, only set if the recommendation was executed.
+	// For rules which send directly between wallets of the same currency, this is the id of the transaction
+	SwapId *string `protobuf:"bytes,6,opt,name=swap_id,json=swapId,proto3,oneof" json:"swap_id,omitempty"`
+}
+
+func (x *AutoSwapEventSwap) Reset() {
+	*x = AutoSwapEventSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSwapEventSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSwapEventSwap) ProtoMessage() {}
+
+func (x *AutoSwapEventSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSwapEventSwap.ProtoReflect.Descriptor instead.
+func (*AutoSwapEventSwap) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AutoSwapEventSwap) GetType() boltzrpc.SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return boltzrpc.SwapType(0)
+}
+
+func (x *AutoSwapEventSwap) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AutoSwapEventSwap) GetFeeEstimate() uint64 {
+	if x != nil {
+		return x.FeeEstimate
+	}
+	return 0
+}
+
+func (x *AutoSwapEventSwap) GetChannelId() *boltzrpc.ChannelId {
+	if x != nil {
+		return x.ChannelId
+	}
+	return nil
+}
+
+func (x *AutoSwapEventSwap) GetDismissedReasons() []string {
+	if x != nil {
+		return x.DismissedReasons
+	}
+	return nil
+}
+
+func (x *AutoSwapEventSwap) GetSwapId() string {
+	if x != nil && x.SwapId != nil {
+		return *x.SwapId
+	}
+	return ""
+}
+
+type AutoSwapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Swapper   SwapperType `protobuf:"varint,2,opt,name=swapper,proto3,enum=autoswaprpc.SwapperType" json:"swapper,omitempty"`
+	TenantId  uint64      `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	CreatedAt int64       `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set if the cycle could not be completed
+	Error *string `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Swaps recommended during the cycle
+	Swaps []*AutoSwapEventSwap `protobuf:"bytes,6,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	// Name of the chain rule which was evaluated, empty for the default rule
+	Rule string `protobuf:"bytes,7,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *AutoSwapEvent) Reset() {
+	*x = AutoSwapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoSwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoSwapEvent) ProtoMessage() {}
+
+func (x *AutoSwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoSwapEvent.ProtoReflect.Descriptor instead.
+func (*AutoSwapEvent) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AutoSwapEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AutoSwapEvent) GetSwapper() SwapperType {
+	if x != nil {
+		return x.Swapper
+	}
+	return SwapperType_LIGHTNING
+}
+
+func (x *AutoSwapEvent) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *AutoSwapEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AutoSwapEvent) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *AutoSwapEvent) GetSwaps() []*AutoSwapEventSwap {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *AutoSwapEvent) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type ListAutoSwapEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AutoSwapEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListAutoSwapEventsResponse) Reset() {
+	*x = ListAutoSwapEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAutoSwapEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAutoSwapEventsResponse) ProtoMessage() {}
+
+func (x *ListAutoSwapEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAutoSwapEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAutoSwapEventsResponse) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListAutoSwapEventsResponse) GetEvents() []*AutoSwapEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type ListPendingApprovalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPendingApprovalsRequest) Reset() {
+	*x = ListPendingApprovalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsRequest) ProtoMessage() {}
+
+func (x *ListPendingApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{34}
+}
+
+type PendingApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Swapper     SwapperType         `protobuf:"varint,2,opt,name=swapper,proto3,enum=autoswaprpc.SwapperType" json:"swapper,omitempty"`
+	TenantId    uint64              `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Type        boltzrpc.SwapType   `protobuf:"varint,4,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Amount      uint64              `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeEstimate uint64              `protobuf:"varint,6,opt,name=fee_estimate,json=feeEstimate,proto3" json:"fee_estimate,omitempty"`
+	ChannelId   *boltzrpc.ChannelId `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3,oneof" json:"channel_id,omitempty"`
+	CreatedAt   int64               `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt   int64               `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Name of the chain rule which made the recommendation, empty for the default rule
+	Rule string `protobuf:"bytes,10,opt,name=rule,proto3" json:"rule,omitempty"`
+	// Onchain currency of a lightning swap or the currency which is sent by a chain swap
+	Currency boltzrpc.Currency `protobuf:"varint,11,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
+}
+
+func (x *PendingApproval) Reset() {
+	*x = PendingApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PendingApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingApproval) ProtoMessage() {}
+
+func (x *PendingApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingApproval.ProtoReflect.Descriptor instead.
+func (*PendingApproval) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PendingApproval) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetSwapper() SwapperType {
+	if x != nil {
+		return x.Swapper
+	}
+	return SwapperType_LIGHTNING
+}
+
+func (x *PendingApproval) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetType() boltzrpc.SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return boltzrpc.SwapType(0)
+}
+
+func (x *PendingApproval) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetFeeEstimate() uint64 {
+	if x != nil {
+		return x.FeeEstimate
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetChannelId() *boltzrpc.ChannelId {
+	if x != nil {
+		return x.ChannelId
+	}
+	return nil
+}
+
+func (x *PendingApproval) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PendingApproval) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PendingApproval) GetCurrency() boltzrpc.Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return boltzrpc.Currency(0)
+}
+
+type ListPendingApprovalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approvals []*PendingApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (x *ListPendingApprovalsResponse) Reset() {
+	*x = ListPendingApprovalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPendingApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingApprovalsResponse) ProtoMessage() {}
+
+func (x *ListPendingApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListPendingApprovalsResponse) GetApprovals() []*PendingApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type ApproveRecommendationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApproveRecommendationRequest) Reset() {
+	*x = ApproveRecommendationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRecommendationRequest) ProtoMessage() {}
+
+func (x *ApproveRecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRecommendationRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ApproveRecommendationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ApproveRecommendationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapId string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+}
+
+func (x *ApproveRecommendationResponse) Reset() {
+	*x = ApproveRecommendationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveRecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRecommendationResponse) ProtoMessage() {}
+
+func (x *ApproveRecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRecommendationResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ApproveRecommendationResponse) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+type RejectRecommendationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejectRecommendationRequest) Reset() {
+	*x = RejectRecommendationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectRecommendationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRecommendationRequest) ProtoMessage() {}
+
+func (x *RejectRecommendationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRecommendationRequest.ProtoReflect.Descriptor instead.
+func (*RejectRecommendationRequest) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RejectRecommendationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectRecommendationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RejectRecommendationResponse) Reset() {
+	*x = RejectRecommendationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectRecommendationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRecommendationResponse) ProtoMessage() {}
+
+func (x *RejectRecommendationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_autoswaprpc_autoswaprpc_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRecommendationResponse.ProtoReflect.Descriptor instead.
+func (*RejectRecommendationResponse) Descriptor() ([]byte, []int) {
+	return file_autoswaprpc_autoswaprpc_proto_rawDescGZIP(), []int{40}
+}
+
+var File_autoswaprpc_autoswaprpc_proto protoreflect.FileDescriptor
+
+var file_autoswaprpc_autoswaprpc_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0b, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x1b, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x69,
+	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x73, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x17, 0x4c,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70,
+	0x48, 0x00, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65,
+	0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x66, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22, 0x98, 0x02,
+	0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x04, 0x73,
+	0x77, 0x61, 0x70, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x11, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x48,
+	0x01, 0x52, 0x0f, 0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x06, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x01, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x48, 0x02, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xc0,
+	0x01, 0x0a, 0x1d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x42, 0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x05,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x22, 0x20, 0x0a, 0x1e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x01, 0x52, 0x06,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x2d, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x01, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0f, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e,
+	0x69, 0x6e, 0x67, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x12, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xc4, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x74, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73,
+	0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0xc0, 0x05, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x26, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61,
+	0x70, 0x46, 0x65, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x22, 0xa5, 0x0a, 0x0a, 0x0f, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f,
+	0x6c, 0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x6c, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x15, 0x69, 0x6e,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5a, 0x65, 0x72,
+	0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x68, 0x61, 0x72,
+	0x64, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a,
+	0x13, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x12, 0x61, 0x6c, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x4f,
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65,
+	0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x01, 0x52, 0x0e, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65,
+	0x65, 0x12, 0x32, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73, 0x18, 0x1c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x07, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xc6, 0x01, 0x0a,
+	0x0b, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x33, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xff, 0x01, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x4f, 0x70, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x65, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x17,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x22, 0xba, 0x04, 0x0a, 0x14, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x2e, 0x0a, 0x10, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x02, 0x52, 0x0f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03,
+	0x52, 0x0e, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x16, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x17, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x05, 0x52, 0x15, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x06, 0x52, 0x08, 0x73, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x0d, 0x6d, 0x61,
+	0x78, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42,
+	0x1a, 0x0a, 0x18, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x14, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x61, 0x0a, 0x0e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xc8, 0x02, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73,
+	0x12, 0x30, 0x0a, 0x05, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61,
+	0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x70, 0x61, 0x69,
+	0x72, 0x73, 0x12, 0x40, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x46, 0x65, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x66, 0x65, 0x65, 0x52,
+	0x61, 0x74, 0x65, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x61, 0x75, 0x74,
+	0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x85, 0x02, 0x0a, 0x0d, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66,
+	0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22,
+	0xaa, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x97, 0x01, 0x0a,
+	0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x09, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0xf7, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x48,
+	0x00, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x19, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0x95, 0x02, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x65,
+	0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64,
+	0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x1c, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x74,
+	0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x73, 0x77,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77,
+	0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x9f, 0x03, 0x0a, 0x0f, 0x50,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32,
+	0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x73, 0x77, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x2e, 0x0a,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x5a, 0x0a, 0x1c,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x09,
+	0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x61,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x2e, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70,
+	0x49, 0x64, 0x22, 0x2d, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0x27, 0x0a, 0x0b, 0x53, 0x77, 0x61, 0x70, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x32, 0x99, 0x09, 0x0a, 0x08, 0x41,
+	0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71,
+	0x0a, 0x16, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73,
+	0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4f, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x45, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x1f, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x47, 0x0a, 0x08,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73,
+	0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75,
+	0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x15, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x14, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x2e, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x61, 0x75,
+	0x74, 0x6f, 0x73, 0x77, 0x61, 0x70, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x73, 0x77, 0x61,
+	0x70, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_autoswaprpc_autoswaprpc_proto_rawDescOnce sync.Once
+	file_autoswaprpc_autoswaprpc_proto_rawDescData = file_autoswaprpc_autoswaprpc_proto_rawDesc
+)
+
+func file_autoswaprpc_autoswaprpc_proto_rawDescGZIP() []byte {
+	file_autoswaprpc_autoswaprpc_proto_rawDescOnce.Do(func() {
+		file_autoswaprpc_autoswaprpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_autoswaprpc_autoswaprpc_proto_rawDescData)
+	})
+	return file_autoswaprpc_autoswaprpc_proto_rawDescData
+}
+
+var file_autoswaprpc_autoswaprpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_autoswaprpc_autoswaprpc_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_autoswaprpc_autoswaprpc_proto_goTypes = []interface{}{
+	(SwapperType)(0),                       // 0: autoswaprpc.SwapperType
+	(*GetRecommendationsRequest)(nil),      // 1: autoswaprpc.GetRecommendationsRequest
+	(*LightningSwap)(nil),                  // 2: autoswaprpc.LightningSwap
+	(*LightningThresholds)(nil),            // 3: autoswaprpc.LightningThresholds
+	(*LightningRecommendation)(nil),        // 4: autoswaprpc.LightningRecommendation
+	(*ChainSwap)(nil),                      // 5: autoswaprpc.ChainSwap
+	(*ChainRecommendation)(nil),            // 6: autoswaprpc.ChainRecommendation
+	(*Budget)(nil),                         // 7: autoswaprpc.Budget
+	(*GetRecommendationsResponse)(nil),     // 8: autoswaprpc.GetRecommendationsResponse
+	(*ExecuteRecommendationsRequest)(nil),  // 9: autoswaprpc.ExecuteRecommendationsRequest
+	(*ExecuteRecommendationsResponse)(nil), // 10: autoswaprpc.ExecuteRecommendationsResponse
+	(*GetStatusRequest)(nil),               // 11: autoswaprpc.GetStatusRequest
+	(*Status)(nil),                         // 12: autoswaprpc.Status
+	(*GetStatusResponse)(nil),              // 13: autoswaprpc.GetStatusResponse
+	(*GetConfigRequest)(nil),               // 14: autoswaprpc.GetConfigRequest
+	(*UpdateLightningConfigRequest)(nil),   // 15: autoswaprpc.UpdateLightningConfigRequest
+	(*UpdateChainConfigRequest)(nil),       // 16: autoswaprpc.UpdateChainConfigRequest
+	(*Config)(nil),                         // 17: autoswaprpc.Config
+	(*ChainConfig)(nil),                    // 18: autoswaprpc.ChainConfig
+	(*LightningConfig)(nil),                // 19: autoswaprpc.LightningConfig
+	(*BudgetLimit)(nil),                    // 20: autoswaprpc.BudgetLimit
+	(*ChannelOpeningConfig)(nil),           // 21: autoswaprpc.ChannelOpeningConfig
+	(*ChannelPeer)(nil),                    // 22: autoswaprpc.ChannelPeer
+	(*LightningChannelRule)(nil),           // 23: autoswaprpc.LightningChannelRule
+	(*GetSnapshotRequest)(nil),             // 24: autoswaprpc.GetSnapshotRequest
+	(*WalletSnapshot)(nil),                 // 25: autoswaprpc.WalletSnapshot
+	(*Snapshot)(nil),                       // 26: autoswaprpc.Snapshot
+	(*SimulateRequest)(nil),                // 27: autoswaprpc.SimulateRequest
+	(*SimulatedSwap)(nil),                  // 28: autoswaprpc.SimulatedSwap
+	(*SimulationResult)(nil),               // 29: autoswaprpc.SimulationResult
+	(*SimulateResponse)(nil),               // 30: autoswaprpc.SimulateResponse
+	(*ListAutoSwapEventsRequest)(nil),      // 31: autoswaprpc.ListAutoSwapEventsRequest
+	(*AutoSwapEventSwap)(nil),              // 32: autoswaprpc.AutoSwapEventSwap
+	(*AutoSwapEvent)(nil),                  // 33: autoswaprpc.AutoSwapEvent
+	(*ListAutoSwapEventsResponse)(nil),     // 34: autoswaprpc.ListAutoSwapEventsResponse
+	(*ListPendingApprovalsRequest)(nil),    // 35: autoswaprpc.ListPendingApprovalsRequest
+	(*PendingApproval)(nil),                // 36: autoswaprpc.PendingApproval
+	(*ListPendingApprovalsResponse)(nil),   // 37: autoswaprpc.ListPendingApprovalsResponse
+	(*ApproveRecommendationRequest)(nil),   // 38: autoswaprpc.ApproveRecommendationRequest
+	(*ApproveRecommendationResponse)(nil),  // 39: autoswaprpc.ApproveRecommendationResponse
+	(*RejectRecommendationRequest)(nil),    // 40: autoswaprpc.RejectRecommendationRequest
+	(*RejectRecommendationResponse)(nil),   // 41: autoswaprpc.RejectRecommendationResponse
+	nil,                                    // 42: autoswaprpc.Snapshot.FeeRatesEntry
+	(boltzrpc.SwapType)(0),                 // 43: boltzrpc.SwapType
+	(boltzrpc.Currency)(0),                 // 44: boltzrpc.Currency
+	(*boltzrpc.LightningChannel)(nil),      // 45: boltzrpc.LightningChannel
+	(*boltzrpc.Pair)(nil),                  // 46: boltzrpc.Pair
+	(*boltzrpc.Balance)(nil),               // 47: boltzrpc.Balance
+	(*boltzrpc.SwapStats)(nil),             // 48: boltzrpc.SwapStats
+	(*fieldmaskpb.FieldMask)(nil),          // 49: google.protobuf.FieldMask
+	(*boltzrpc.GetPairsResponse)(nil),      // 50: boltzrpc.GetPairsResponse
+	(*boltzrpc.ChannelId)(nil),             // 51: boltzrpc.ChannelId
+	(*emptypb.Empty)(nil),                  // 52: google.protobuf.Empty
+}
+var file_autoswaprpc_autoswaprpc_proto_depIdxs = []int32{
+	43, // 0: autoswaprpc.LightningSwap.type:type_name -> boltzrpc.SwapType
+	44, // 1: autoswaprpc.LightningSwap.currency:type_name -> boltzrpc.Currency
+	2,  // 2: autoswaprpc.LightningRecommendation.swap:type_name -> autoswaprpc.LightningSwap
+	45, // 3: autoswaprpc.LightningRecommendation.channel:type_name -> boltzrpc.LightningChannel
+	3,  // 4: autoswaprpc.LightningRecommendation.thresholds:type_name -> autoswaprpc.LightningThresholds
+	46, // 5: autoswaprpc.ChainSwap.pair:type_name -> boltzrpc.Pair
+	5,  // 6: autoswaprpc.ChainRecommendation.swap:type_name -> autoswaprpc.ChainSwap
+	47, // 7: autoswaprpc.ChainRecommendation.wallet_balance:type_name -> boltzrpc.Balance
+	47, // 8: autoswaprpc.ChainRecommendation.to_wallet_balance:type_name -> boltzrpc.Balance
+	48, // 9: autoswaprpc.Budget.stats:type_name -> boltzrpc.SwapStats
+	44, // 10: autoswaprpc.Budget.currency:type_name -> boltzrpc.Currency
+	43, // 11: autoswaprpc.Budget.type:type_name -> boltzrpc.SwapType
+	4,  // 12: autoswaprpc.GetRecommendationsResponse.lightning:type_name -> autoswaprpc.LightningRecommendation
+	6,  // 13: autoswaprpc.GetRecommendationsResponse.chain:type_name -> autoswaprpc.ChainRecommendation
+	4,  // 14: autoswaprpc.ExecuteRecommendationsRequest.lightning:type_name -> autoswaprpc.LightningRecommendation
+	6,  // 15: autoswaprpc.ExecuteRecommendationsRequest.chain:type_name -> autoswaprpc.ChainRecommendation
+	7,  // 16: autoswaprpc.Status.budget:type_name -> autoswaprpc.Budget
+	7,  // 17: autoswaprpc.Status.budgets:type_name -> autoswaprpc.Budget
+	12, // 18: autoswaprpc.GetStatusResponse.lightning:type_name -> autoswaprpc.Status
+	12, // 19: autoswaprpc.GetStatusResponse.chain:type_name -> autoswaprpc.Status
+	12, // 20: autoswaprpc.GetStatusResponse.chain_rules:type_name -> autoswaprpc.Status
+	12, // 21: autoswaprpc.GetStatusResponse.lightning_nodes:type_name -> autoswaprpc.Status
+	19, // 22: autoswaprpc.UpdateLightningConfigRequest.config:type_name -> autoswaprpc.LightningConfig
+	49, // 23: autoswaprpc.UpdateLightningConfigRequest.field_mask:type_name -> google.protobuf.FieldMask
+	18, // 24: autoswaprpc.UpdateChainConfigRequest.config:type_name -> autoswaprpc.ChainConfig
+	49, // 25: autoswaprpc.UpdateChainConfigRequest.field_mask:type_name -> google.protobuf.FieldMask
+	18, // 26: autoswaprpc.Config.chain:type_name -> autoswaprpc.ChainConfig
+	19, // 27: autoswaprpc.Config.lightning:type_name -> autoswaprpc.LightningConfig
+	20, // 28: autoswaprpc.ChainConfig.budgets:type_name -> autoswaprpc.BudgetLimit
+	44, // 29: autoswaprpc.LightningConfig.currency:type_name -> boltzrpc.Currency
+	23, // 30: autoswaprpc.LightningConfig.channel_rules:type_name -> autoswaprpc.LightningChannelRule
+	21, // 31: autoswaprpc.LightningConfig.channel_opening:type_name -> autoswaprpc.ChannelOpeningConfig
+	20, // 32: autoswaprpc.LightningConfig.budgets:type_name -> autoswaprpc.BudgetLimit
+	44, // 33: autoswaprpc.BudgetLimit.currency:type_name -> boltzrpc.Currency
+	43, // 34: autoswaprpc.BudgetLimit.type:type_name -> boltzrpc.SwapType
+	22, // 35: autoswaprpc.ChannelOpeningConfig.peers:type_name -> autoswaprpc.ChannelPeer
+	47, // 36: autoswaprpc.WalletSnapshot.balance:type_name -> boltzrpc.Balance
+	45, // 37: autoswaprpc.Snapshot.channels:type_name -> boltzrpc.LightningChannel
+	25, // 38: autoswaprpc.Snapshot.wallets:type_name -> autoswaprpc.WalletSnapshot
+	50, // 39: autoswaprpc.Snapshot.pairs:type_name -> boltzrpc.GetPairsResponse
+	42, // 40: autoswaprpc.Snapshot.fee_rates:type_name -> autoswaprpc.Snapshot.FeeRatesEntry
+	17, // 41: autoswaprpc.SimulateRequest.config:type_name -> autoswaprpc.Config
+	26, // 42: autoswaprpc.SimulateRequest.snapshots:type_name -> autoswaprpc.Snapshot
+	43, // 43: autoswaprpc.SimulatedSwap.type:type_name -> boltzrpc.SwapType
+	51, // 44: autoswaprpc.SimulatedSwap.channel_id:type_name -> boltzrpc.ChannelId
+	28, // 45: autoswaprpc.SimulationResult.swaps:type_name -> autoswaprpc.SimulatedSwap
+	7,  // 46: autoswaprpc.SimulationResult.budgets:type_name -> autoswaprpc.Budget
+	29, // 47: autoswaprpc.SimulateResponse.lightning:type_name -> autoswaprpc.SimulationResult
+	29, // 48: autoswaprpc.SimulateResponse.chain:type_name -> autoswaprpc.SimulationResult
+	0,  // 49: autoswaprpc.ListAutoSwapEventsRequest.swapper:type_name -> autoswaprpc.SwapperType
+	43, // 50: autoswaprpc.AutoSwapEventSwap.type:type_name -> boltzrpc.SwapType
+	51, // 51: autoswaprpc.AutoSwapEventSwap.channel_id:type_name -> boltzrpc.ChannelId
+	0,  // 52: autoswaprpc.AutoSwapEvent.swapper:type_name -> autoswaprpc.SwapperType
+	32, // 53: autoswaprpc.AutoSwapEvent.swaps:type_name -> autoswaprpc.AutoSwapEventSwap
+	33, // 54: autoswaprpc.ListAutoSwapEventsResponse.events:type_name -> autoswaprpc.AutoSwapEvent
+	0,  // 55: autoswaprpc.PendingApproval.swapper:type_name -> autoswaprpc.SwapperType
+	43, // 56: autoswaprpc.PendingApproval.type:type_name -> boltzrpc.SwapType
+	51, // 57: autoswaprpc.PendingApproval.channel_id:type_name -> boltzrpc.ChannelId
+	44, // 58: autoswaprpc.PendingApproval.currency:type_name -> boltzrpc.Currency
+	36, // 59: autoswaprpc.ListPendingApprovalsResponse.approvals:type_name -> autoswaprpc.PendingApproval
+	1,  // 60: autoswaprpc.AutoSwap.GetRecommendations:input_type -> autoswaprpc.GetRecommendationsRequest
+	9,  // 61: autoswaprpc.AutoSwap.ExecuteRecommendations:input_type -> autoswaprpc.ExecuteRecommendationsRequest
+	11, // 62: autoswaprpc.AutoSwap.GetStatus:input_type -> autoswaprpc.GetStatusRequest
+	15, // 63: autoswaprpc.AutoSwap.UpdateLightningConfig:input_type -> autoswaprpc.UpdateLightningConfigRequest
+	16, // 64: autoswaprpc.AutoSwap.UpdateChainConfig:input_type -> autoswaprpc.UpdateChainConfigRequest
+	14, // 65: autoswaprpc.AutoSwap.GetConfig:input_type -> autoswaprpc.GetConfigRequest
+	52, // 66: autoswaprpc.AutoSwap.ReloadConfig:input_type -> google.protobuf.Empty
+	24, // 67: autoswaprpc.AutoSwap.GetSnapshot:input_type -> autoswaprpc.GetSnapshotRequest
+	27, // 68: autoswaprpc.AutoSwap.Simulate:input_type -> autoswaprpc.SimulateRequest
+	31, // 69: autoswaprpc.AutoSwap.ListAutoSwapEvents:input_type -> autoswaprpc.ListAutoSwapEventsRequest
+	35, // 70: autoswaprpc.AutoSwap.ListPendingApprovals:input_type -> autoswaprpc.ListPendingApprovalsRequest
+	38, // 71: autoswaprpc.AutoSwap.ApproveRecommendation:input_type -> autoswaprpc.ApproveRecommendationRequest
+	40, // 72: autoswaprpc.AutoSwap.RejectRecommendation:input_type -> autoswaprpc.RejectRecommendationRequest
+	8,  // 73: autoswaprpc.AutoSwap.GetRecommendations:output_type -> autoswaprpc.GetRecommendationsResponse
+	10, // 74: autoswaprpc.AutoSwap.ExecuteRecommendations:output_type -> autoswaprpc.ExecuteRecommendationsResponse
+	13, // 75: autoswaprpc.AutoSwap.GetStatus:output_type -> autoswaprpc.GetStatusResponse
+	17, // 76: autoswaprpc.AutoSwap.UpdateLightningConfig:output_type -> autoswaprpc.Config
+	17, // 77: autoswaprpc.AutoSwap.UpdateChainConfig:output_type -> autoswaprpc.Config
+	17, // 78: autoswaprpc.AutoSwap.GetConfig:output_type -> autoswaprpc.Config
+	17, // 79: autoswaprpc.AutoSwap.ReloadConfig:output_type -> autoswaprpc.Config
+	26, // 80: autoswaprpc.AutoSwap.GetSnapshot:output_type -> autoswaprpc.Snapshot
+	30, // 81: autoswaprpc.AutoSwap.Simulate:output_type -> autoswaprpc.SimulateResponse
+	34, // 82: autoswaprpc.AutoSwap.ListAutoSwapEvents:output_type -> autoswaprpc.ListAutoSwapEventsResponse
+	37, // 83: autoswaprpc.AutoSwap.ListPendingApprovals:output_type -> autoswaprpc.ListPendingApprovalsResponse
+	39, // 84: autoswaprpc.AutoSwap.ApproveRecommendation:output_type -> autoswaprpc.ApproveRecommendationResponse
+	41, // 85: autoswaprpc.AutoSwap.RejectRecommendation:output_type -> autoswaprpc.RejectRecommendationResponse
+	73, // [73:86] is the sub-list for method output_type
+	60, // [60:73] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_autoswaprpc_autoswaprpc_proto_init() }
+func file_autoswaprpc_autoswaprpc_proto_init() {
+	if File_autoswaprpc_autoswaprpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightningSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightningThresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightningRecommendation); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -1885,6 +4192,270 @@ func file_autoswaprpc_autoswaprpc_proto_init() {
 				return nil
 			}
 		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BudgetLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelOpeningConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightningChannelRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulatedSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAutoSwapEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSwapEventSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoSwapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAutoSwapEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingApprovalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PendingApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingApprovalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveRecommendationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveRecommendationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectRecommendationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_autoswaprpc_autoswaprpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectRecommendationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_autoswaprpc_autoswaprpc_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_autoswaprpc_autoswaprpc_proto_msgTypes[3].OneofWrappers = []interface{}{}
@@ -1897,18 +4468,29 @@ func file_autoswaprpc_autoswaprpc_proto_init() {
 	file_autoswaprpc_autoswaprpc_proto_msgTypes[15].OneofWrappers = []interface{}{}
 	file_autoswaprpc_autoswaprpc_proto_msgTypes[17].OneofWrappers = []interface{}{}
 	file_autoswaprpc_autoswaprpc_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[29].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_autoswaprpc_autoswaprpc_proto_msgTypes[35].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_autoswaprpc_autoswaprpc_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   19,
+			NumEnums:      1,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_autoswaprpc_autoswaprpc_proto_goTypes,
 		DependencyIndexes: file_autoswaprpc_autoswaprpc_proto_depIdxs,
+		EnumInfos:         file_autoswaprpc_autoswaprpc_proto_enumTypes,
 		MessageInfos:      file_autoswaprpc_autoswaprpc_proto_msgTypes,
 	}.Build()
 	File_autoswaprpc_autoswaprpc_proto = out.File
@@ -27,6 +27,12 @@ const (
 	AutoSwap_UpdateChainConfig_FullMethodName      = "/autoswaprpc.AutoSwap/UpdateChainConfig"
 	AutoSwap_GetConfig_FullMethodName              = "/autoswaprpc.AutoSwap/GetConfig"
 	AutoSwap_ReloadConfig_FullMethodName           = "/autoswaprpc.AutoSwap/ReloadConfig"
+	AutoSwap_GetSnapshot_FullMethodName            = "/autoswaprpc.AutoSwap/GetSnapshot"
+	AutoSwap_Simulate_FullMethodName               = "/autoswaprpc.AutoSwap/Simulate"
+	AutoSwap_ListAutoSwapEvents_FullMethodName     = "/autoswaprpc.AutoSwap/ListAutoSwapEvents"
+	AutoSwap_ListPendingApprovals_FullMethodName   = "/autoswaprpc.AutoSwap/ListPendingApprovals"
+	AutoSwap_ApproveRecommendation_FullMethodName  = "/autoswaprpc.AutoSwap/ApproveRecommendation"
+	AutoSwap_RejectRecommendation_FullMethodName   = "/autoswaprpc.AutoSwap/RejectRecommendation"
 )
 
 // AutoSwapClient is the client API for AutoSwap service.
@@ -54,6 +60,28 @@ type AutoSwapClient interface {
 	//
 	//Reloads the configuration from disk.
 	ReloadConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Config, error)
+	//
+	//Returns a snapshot of the current channel balances, wallet balances, fee estimates and pair information.
+	//Recorded snapshots can be replayed with `Simulate`.
+	GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error)
+	//
+	//Replays recorded snapshots through the autoswap decision logic without creating any swaps
+	//and reports which swaps would have been executed, their cost and how the budget was used.
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	//
+	//Returns the recorded evaluation cycles of the autoswappers, most recent first. Every cycle lists the swaps which were recommended,
+	//why they were dismissed and the ids of the swaps which were created. Cycles are kept for 30 days.
+	ListAutoSwapEvents(ctx context.Context, in *ListAutoSwapEventsRequest, opts ...grpc.CallOption) (*ListAutoSwapEventsResponse, error)
+	//
+	//Returns the recommendations above the `approval_threshold` of the config which are waiting to be approved or rejected.
+	ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error)
+	//
+	//Executes a pending recommendation. The recommendation is evaluated again and only executed if it is still valid,
+	//the amount of the created swap does not exceed the approved amount.
+	ApproveRecommendation(ctx context.Context, in *ApproveRecommendationRequest, opts ...grpc.CallOption) (*ApproveRecommendationResponse, error)
+	//
+	//Rejects a pending recommendation. The same recommendation will not be queued for approval again until the rejected one would have expired.
+	RejectRecommendation(ctx context.Context, in *RejectRecommendationRequest, opts ...grpc.CallOption) (*RejectRecommendationResponse, error)
 }
 
 type autoSwapClient struct {
@@ -127,6 +155,60 @@ func (c *autoSwapClient) ReloadConfig(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *autoSwapClient) GetSnapshot(ctx context.Context, in *GetSnapshotRequest, opts ...grpc.CallOption) (*Snapshot, error) {
+	out := new(Snapshot)
+	err := c.cc.Invoke(ctx, AutoSwap_GetSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoSwapClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, AutoSwap_Simulate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoSwapClient) ListAutoSwapEvents(ctx context.Context, in *ListAutoSwapEventsRequest, opts ...grpc.CallOption) (*ListAutoSwapEventsResponse, error) {
+	out := new(ListAutoSwapEventsResponse)
+	err := c.cc.Invoke(ctx, AutoSwap_ListAutoSwapEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoSwapClient) ListPendingApprovals(ctx context.Context, in *ListPendingApprovalsRequest, opts ...grpc.CallOption) (*ListPendingApprovalsResponse, error) {
+	out := new(ListPendingApprovalsResponse)
+	err := c.cc.Invoke(ctx, AutoSwap_ListPendingApprovals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoSwapClient) ApproveRecommendation(ctx context.Context, in *ApproveRecommendationRequest, opts ...grpc.CallOption) (*ApproveRecommendationResponse, error) {
+	out := new(ApproveRecommendationResponse)
+	err := c.cc.Invoke(ctx, AutoSwap_ApproveRecommendation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *autoSwapClient) RejectRecommendation(ctx context.Context, in *RejectRecommendationRequest, opts ...grpc.CallOption) (*RejectRecommendationResponse, error) {
+	out := new(RejectRecommendationResponse)
+	err := c.cc.Invoke(ctx, AutoSwap_RejectRecommendation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AutoSwapServer is the server API for AutoSwap service.
 // All implementations must embed UnimplementedAutoSwapServer
 // for forward compatibility
@@ -152,6 +234,28 @@ type AutoSwapServer interface {
 	//
 	//Reloads the configuration from disk.
 	ReloadConfig(context.Context, *emptypb.Empty) (*Config, error)
+	//
+	//Returns a snapshot of the current channel balances, wallet balances, fee estimates and pair information.
+	//Recorded snapshots can be replayed with `Simulate`.
+	GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error)
+	//
+	//Replays recorded snapshots through the autoswap decision logic without creating any swaps
+	//and reports which swaps would have been executed, their cost and how the budget was used.
+	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	//
+	//Returns the recorded evaluation cycles of the autoswappers, most recent first. Every cycle lists the swaps which were recommended,
+	//why they were dismissed and the ids of the swaps which were created. Cycles are kept for 30 days.
+	ListAutoSwapEvents(context.Context, *ListAutoSwapEventsRequest) (*ListAutoSwapEventsResponse, error)
+	//
+	//Returns the recommendations above the `approval_threshold` of the config which are waiting to be approved or rejected.
+	ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error)
+	//
+	//Executes a pending recommendation. The recommendation is evaluated again and only executed if it is still valid,
+	//the amount of the created swap does not exceed the approved amount.
+	ApproveRecommendation(context.Context, *ApproveRecommendationRequest) (*ApproveRecommendationResponse, error)
+	//
+	//Rejects a pending recommendation. The same recommendation will not be queued for approval again until the rejected one would have expired.
+	RejectRecommendation(context.Context, *RejectRecommendationRequest) (*RejectRecommendationResponse, error)
 	mustEmbedUnimplementedAutoSwapServer()
 }
 
@@ -180,6 +284,24 @@ func (UnimplementedAutoSwapServer) GetConfig(context.Context, *GetConfigRequest)
 func (UnimplementedAutoSwapServer) ReloadConfig(context.Context, *emptypb.Empty) (*Config, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReloadConfig not implemented")
 }
+func (UnimplementedAutoSwapServer) GetSnapshot(context.Context, *GetSnapshotRequest) (*Snapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshot not implemented")
+}
+func (UnimplementedAutoSwapServer) Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+func (UnimplementedAutoSwapServer) ListAutoSwapEvents(context.Context, *ListAutoSwapEventsRequest) (*ListAutoSwapEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoSwapEvents not implemented")
+}
+func (UnimplementedAutoSwapServer) ListPendingApprovals(context.Context, *ListPendingApprovalsRequest) (*ListPendingApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingApprovals not implemented")
+}
+func (UnimplementedAutoSwapServer) ApproveRecommendation(context.Context, *ApproveRecommendationRequest) (*ApproveRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRecommendation not implemented")
+}
+func (UnimplementedAutoSwapServer) RejectRecommendation(context.Context, *RejectRecommendationRequest) (*RejectRecommendationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRecommendation not implemented")
+}
 func (UnimplementedAutoSwapServer) mustEmbedUnimplementedAutoSwapServer() {}
 
 // UnsafeAutoSwapServer may be embedded to opt out of forward compatibility for this service.
@@ -319,6 +441,114 @@ func _AutoSwap_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AutoSwap_GetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoSwapServer).GetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoSwap_GetSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoSwapServer).GetSnapshot(ctx, req.(*GetSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoSwap_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoSwapServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoSwap_Simulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoSwapServer).Simulate(ctx, req.(*SimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoSwap_ListAutoSwapEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoSwapEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoSwapServer).ListAutoSwapEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoSwap_ListAutoSwapEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoSwapServer).ListAutoSwapEvents(ctx, req.(*ListAutoSwapEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoSwap_ListPendingApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoSwapServer).ListPendingApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoSwap_ListPendingApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoSwapServer).ListPendingApprovals(ctx, req.(*ListPendingApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoSwap_ApproveRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoSwapServer).ApproveRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoSwap_ApproveRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoSwapServer).ApproveRecommendation(ctx, req.(*ApproveRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AutoSwap_RejectRecommendation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRecommendationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AutoSwapServer).RejectRecommendation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AutoSwap_RejectRecommendation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AutoSwapServer).RejectRecommendation(ctx, req.(*RejectRecommendationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AutoSwap_ServiceDesc is the grpc.ServiceDesc for AutoSwap service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -354,6 +584,30 @@ var AutoSwap_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReloadConfig",
 			Handler:    _AutoSwap_ReloadConfig_Handler,
 		},
+		{
+			MethodName: "GetSnapshot",
+			Handler:    _AutoSwap_GetSnapshot_Handler,
+		},
+		{
+			MethodName: "Simulate",
+			Handler:    _AutoSwap_Simulate_Handler,
+		},
+		{
+			MethodName: "ListAutoSwapEvents",
+			Handler:    _AutoSwap_ListAutoSwapEvents_Handler,
+		},
+		{
+			MethodName: "ListPendingApprovals",
+			Handler:    _AutoSwap_ListPendingApprovals_Handler,
+		},
+		{
+			MethodName: "ApproveRecommendation",
+			Handler:    _AutoSwap_ApproveRecommendation_Handler,
+		},
+		{
+			MethodName: "RejectRecommendation",
+			Handler:    _AutoSwap_RejectRecommendation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "autoswaprpc/autoswaprpc.proto",
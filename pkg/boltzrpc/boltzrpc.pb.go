@@ -328,6 +328,58 @@ func (TransactionType) EnumDescriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
 }
 
+type WalletTransactionEventType int32
+
+const (
+	// The transaction was seen for the first time
+	WalletTransactionEventType_NEW WalletTransactionEventType = 0
+	// A previously unconfirmed transaction got confirmed
+	WalletTransactionEventType_CONFIRMED WalletTransactionEventType = 1
+	// A previously unconfirmed transaction is no longer part of the wallet, either because it was replaced or evicted from the mempool
+	WalletTransactionEventType_REPLACED WalletTransactionEventType = 2
+)
+
+// Enum value maps for WalletTransactionEventType.
+var (
+	WalletTransactionEventType_name = map[int32]string{
+		0: "NEW",
+		1: "CONFIRMED",
+		2: "REPLACED",
+	}
+	WalletTransactionEventType_value = map[string]int32{
+		"NEW":       0,
+		"CONFIRMED": 1,
+		"REPLACED":  2,
+	}
+)
+
+func (x WalletTransactionEventType) Enum() *WalletTransactionEventType {
+	p := new(WalletTransactionEventType)
+	*p = x
+	return p
+}
+
+func (x WalletTransactionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletTransactionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[6].Descriptor()
+}
+
+func (WalletTransactionEventType) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[6]
+}
+
+func (x WalletTransactionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletTransactionEventType.Descriptor instead.
+func (WalletTransactionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{6}
+}
+
 type CreateTenantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -554,17 +606,30 @@ func (x *RemoveTenantRequest) GetName() string {
 	return ""
 }
 
-type Tenant struct {
+type SpendingPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *Tenant) Reset() {
-	*x = Tenant{}
+	// Tenant the policy applies to. Defaults to the tenant of the macaroon.
+	TenantId *uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Wallet the policy applies to. If not set, the policy applies to all spendings of the tenant, including lightning payments.
+	WalletId *uint64 `protobuf:"varint,2,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	// Maximum amount in satoshis which can be spent within 24 hours
+	DailyLimit *uint64 `protobuf:"varint,3,opt,name=daily_limit,json=dailyLimit,proto3,oneof" json:"daily_limit,omitempty"`
+	// Maximum amount in satoshis which can be spent within 7 days
+	WeeklyLimit *uint64 `protobuf:"varint,4,opt,name=weekly_limit,json=weeklyLimit,proto3,oneof" json:"weekly_limit,omitempty"`
+	// Maximum amount in satoshis of a single spending
+	MaxAmount *uint64 `protobuf:"varint,5,opt,name=max_amount,json=maxAmount,proto3,oneof" json:"max_amount,omitempty"`
+	// Only allow sending to addresses which are part of the allowlist of the tenant
+	RequireAllowlist bool `protobuf:"varint,6,opt,name=require_allowlist,json=requireAllowlist,proto3" json:"require_allowlist,omitempty"`
+	// Number of seconds which have to pass after an address was added to the allowlist before funds can be sent to it.
+	// Setting a cooldown also rejects addresses which are not part of the allowlist.
+	AddressCooldown uint64 `protobuf:"varint,7,opt,name=address_cooldown,json=addressCooldown,proto3" json:"address_cooldown,omitempty"`
+}
+
+func (x *SpendingPolicy) Reset() {
+	*x = SpendingPolicy{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,13 +637,13 @@ func (x *Tenant) Reset() {
 	}
 }
 
-func (x *Tenant) String() string {
+func (x *SpendingPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tenant) ProtoMessage() {}
+func (*SpendingPolicy) ProtoMessage() {}
 
-func (x *Tenant) ProtoReflect() protoreflect.Message {
+func (x *SpendingPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -590,35 +655,70 @@ func (x *Tenant) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
-func (*Tenant) Descriptor() ([]byte, []int) {
+// Deprecated: Use SpendingPolicy.ProtoReflect.Descriptor instead.
+func (*SpendingPolicy) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Tenant) GetId() uint64 {
-	if x != nil {
-		return x.Id
+func (x *SpendingPolicy) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
 	return 0
 }
 
-func (x *Tenant) GetName() string {
+func (x *SpendingPolicy) GetWalletId() uint64 {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetDailyLimit() uint64 {
+	if x != nil && x.DailyLimit != nil {
+		return *x.DailyLimit
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetWeeklyLimit() uint64 {
+	if x != nil && x.WeeklyLimit != nil {
+		return *x.WeeklyLimit
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetMaxAmount() uint64 {
+	if x != nil && x.MaxAmount != nil {
+		return *x.MaxAmount
+	}
+	return 0
+}
+
+func (x *SpendingPolicy) GetRequireAllowlist() bool {
 	if x != nil {
-		return x.Name
+		return x.RequireAllowlist
 	}
-	return ""
+	return false
 }
 
-type MacaroonPermissions struct {
+func (x *SpendingPolicy) GetAddressCooldown() uint64 {
+	if x != nil {
+		return x.AddressCooldown
+	}
+	return 0
+}
+
+type ListSpendingPoliciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action MacaroonAction `protobuf:"varint,2,opt,name=action,proto3,enum=boltzrpc.MacaroonAction" json:"action,omitempty"`
+	TenantId *uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 }
 
-func (x *MacaroonPermissions) Reset() {
-	*x = MacaroonPermissions{}
+func (x *ListSpendingPoliciesRequest) Reset() {
+	*x = ListSpendingPoliciesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -626,13 +726,13 @@ func (x *MacaroonPermissions) Reset() {
 	}
 }
 
-func (x *MacaroonPermissions) String() string {
+func (x *ListSpendingPoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MacaroonPermissions) ProtoMessage() {}
+func (*ListSpendingPoliciesRequest) ProtoMessage() {}
 
-func (x *MacaroonPermissions) ProtoReflect() protoreflect.Message {
+func (x *ListSpendingPoliciesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -644,29 +744,28 @@ func (x *MacaroonPermissions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MacaroonPermissions.ProtoReflect.Descriptor instead.
-func (*MacaroonPermissions) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSpendingPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListSpendingPoliciesRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MacaroonPermissions) GetAction() MacaroonAction {
-	if x != nil {
-		return x.Action
+func (x *ListSpendingPoliciesRequest) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return MacaroonAction_READ
+	return 0
 }
 
-type BakeMacaroonRequest struct {
+type ListSpendingPoliciesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TenantId    *uint64                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
-	Permissions []*MacaroonPermissions `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Policies []*SpendingPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
 }
 
-func (x *BakeMacaroonRequest) Reset() {
-	*x = BakeMacaroonRequest{}
+func (x *ListSpendingPoliciesResponse) Reset() {
+	*x = ListSpendingPoliciesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -674,13 +773,13 @@ func (x *BakeMacaroonRequest) Reset() {
 	}
 }
 
-func (x *BakeMacaroonRequest) String() string {
+func (x *ListSpendingPoliciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BakeMacaroonRequest) ProtoMessage() {}
+func (*ListSpendingPoliciesResponse) ProtoMessage() {}
 
-func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
+func (x *ListSpendingPoliciesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,35 +791,31 @@ func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BakeMacaroonRequest.ProtoReflect.Descriptor instead.
-func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSpendingPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListSpendingPoliciesResponse) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BakeMacaroonRequest) GetTenantId() uint64 {
-	if x != nil && x.TenantId != nil {
-		return *x.TenantId
-	}
-	return 0
-}
-
-func (x *BakeMacaroonRequest) GetPermissions() []*MacaroonPermissions {
+func (x *ListSpendingPoliciesResponse) GetPolicies() []*SpendingPolicy {
 	if x != nil {
-		return x.Permissions
+		return x.Policies
 	}
 	return nil
 }
 
-type BakeMacaroonResponse struct {
+type RemoveSpendingPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Macaroon string `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// Defaults to the tenant of the macaroon.
+	TenantId *uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	// Remove the policy of this wallet. The tenant wide policy will be removed if not specified.
+	WalletId *uint64 `protobuf:"varint,2,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
 }
 
-func (x *BakeMacaroonResponse) Reset() {
-	*x = BakeMacaroonResponse{}
+func (x *RemoveSpendingPolicyRequest) Reset() {
+	*x = RemoveSpendingPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -728,13 +823,13 @@ func (x *BakeMacaroonResponse) Reset() {
 	}
 }
 
-func (x *BakeMacaroonResponse) String() string {
+func (x *RemoveSpendingPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BakeMacaroonResponse) ProtoMessage() {}
+func (*RemoveSpendingPolicyRequest) ProtoMessage() {}
 
-func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveSpendingPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,29 +841,40 @@ func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BakeMacaroonResponse.ProtoReflect.Descriptor instead.
-func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSpendingPolicyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSpendingPolicyRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BakeMacaroonResponse) GetMacaroon() string {
-	if x != nil {
-		return x.Macaroon
+func (x *RemoveSpendingPolicyRequest) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return ""
+	return 0
 }
 
-type Pair struct {
+func (x *RemoveSpendingPolicyRequest) GetWalletId() uint64 {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return 0
+}
+
+type AllowedAddress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From Currency `protobuf:"varint,1,opt,name=from,proto3,enum=boltzrpc.Currency" json:"from,omitempty"`
-	To   Currency `protobuf:"varint,2,opt,name=to,proto3,enum=boltzrpc.Currency" json:"to,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label   string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Unix timestamp at which the address was added
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unix timestamp after which funds can be sent to the address, according to the current address cooldown
+	UsableAt int64 `protobuf:"varint,4,opt,name=usable_at,json=usableAt,proto3" json:"usable_at,omitempty"`
 }
 
-func (x *Pair) Reset() {
-	*x = Pair{}
+func (x *AllowedAddress) Reset() {
+	*x = AllowedAddress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -776,13 +882,13 @@ func (x *Pair) Reset() {
 	}
 }
 
-func (x *Pair) String() string {
+func (x *AllowedAddress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Pair) ProtoMessage() {}
+func (*AllowedAddress) ProtoMessage() {}
 
-func (x *Pair) ProtoReflect() protoreflect.Message {
+func (x *AllowedAddress) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -794,61 +900,52 @@ func (x *Pair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
-func (*Pair) Descriptor() ([]byte, []int) {
+// Deprecated: Use AllowedAddress.ProtoReflect.Descriptor instead.
+func (*AllowedAddress) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Pair) GetFrom() Currency {
+func (x *AllowedAddress) GetAddress() string {
 	if x != nil {
-		return x.From
+		return x.Address
 	}
-	return Currency_BTC
+	return ""
 }
 
-func (x *Pair) GetTo() Currency {
+func (x *AllowedAddress) GetLabel() string {
 	if x != nil {
-		return x.To
+		return x.Label
 	}
-	return Currency_BTC
+	return ""
 }
 
-type SwapInfo struct {
+func (x *AllowedAddress) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AllowedAddress) GetUsableAt() int64 {
+	if x != nil {
+		return x.UsableAt
+	}
+	return 0
+}
+
+type AddAllowedAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pair  *Pair     `protobuf:"bytes,22,opt,name=pair,proto3" json:"pair,omitempty"`
-	State SwapState `protobuf:"varint,2,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
-	Error string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	// Latest status message of the Boltz backend
-	Status              string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	PrivateKey          string `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	Preimage            string `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	RedeemScript        string `protobuf:"bytes,7,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
-	Invoice             string `protobuf:"bytes,8,opt,name=invoice,proto3" json:"invoice,omitempty"`
-	LockupAddress       string `protobuf:"bytes,9,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
-	ExpectedAmount      uint64 `protobuf:"varint,10,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
-	TimeoutBlockHeight  uint32 `protobuf:"varint,11,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
-	LockupTransactionId string `protobuf:"bytes,12,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
-	// If the swap times out or fails for some other reason, the daemon will automatically refund the coins sent to the
-	// `lockup_address` back to the configured wallet or the address specified in the `refund_address` field.
-	RefundTransactionId string       `protobuf:"bytes,13,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
-	RefundAddress       *string      `protobuf:"bytes,19,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
-	ChanIds             []*ChannelId `protobuf:"bytes,14,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
-	BlindingKey         *string      `protobuf:"bytes,15,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
-	CreatedAt           int64        `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ServiceFee          *int64       `protobuf:"varint,17,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
-	OnchainFee          *uint64      `protobuf:"varint,18,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
-	// internal wallet which was used to pay the swap
-	WalletId *uint64 `protobuf:"varint,20,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
-	TenantId uint64  `protobuf:"varint,21,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	IsAuto   bool    `protobuf:"varint,23,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	Address string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label   *string `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// Defaults to the tenant of the macaroon.
+	TenantId *uint64 `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 }
 
-func (x *SwapInfo) Reset() {
-	*x = SwapInfo{}
+func (x *AddAllowedAddressRequest) Reset() {
+	*x = AddAllowedAddressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -856,13 +953,13 @@ func (x *SwapInfo) Reset() {
 	}
 }
 
-func (x *SwapInfo) String() string {
+func (x *AddAllowedAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapInfo) ProtoMessage() {}
+func (*AddAllowedAddressRequest) ProtoMessage() {}
 
-func (x *SwapInfo) ProtoReflect() protoreflect.Message {
+func (x *AddAllowedAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,198 +971,154 @@ func (x *SwapInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
-func (*SwapInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAllowedAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddAllowedAddressRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SwapInfo) GetId() string {
+func (x *AddAllowedAddressRequest) GetAddress() string {
 	if x != nil {
-		return x.Id
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SwapInfo) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
+func (x *AddAllowedAddressRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *SwapInfo) GetState() SwapState {
-	if x != nil {
-		return x.State
+func (x *AddAllowedAddressRequest) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return SwapState_PENDING
+	return 0
 }
 
-func (x *SwapInfo) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
+type ListAllowedAddressesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SwapInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+	// Defaults to the tenant of the macaroon.
+	TenantId *uint64 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 }
 
-func (x *SwapInfo) GetPrivateKey() string {
-	if x != nil {
-		return x.PrivateKey
+func (x *ListAllowedAddressesRequest) Reset() {
+	*x = ListAllowedAddressesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SwapInfo) GetPreimage() string {
-	if x != nil {
-		return x.Preimage
-	}
-	return ""
+func (x *ListAllowedAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SwapInfo) GetRedeemScript() string {
-	if x != nil {
-		return x.RedeemScript
+func (*ListAllowedAddressesRequest) ProtoMessage() {}
+
+func (x *ListAllowedAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SwapInfo) GetInvoice() string {
-	if x != nil {
-		return x.Invoice
-	}
-	return ""
+// Deprecated: Use ListAllowedAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllowedAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SwapInfo) GetLockupAddress() string {
-	if x != nil {
-		return x.LockupAddress
-	}
-	return ""
-}
-
-func (x *SwapInfo) GetExpectedAmount() uint64 {
-	if x != nil {
-		return x.ExpectedAmount
-	}
-	return 0
-}
-
-func (x *SwapInfo) GetTimeoutBlockHeight() uint32 {
-	if x != nil {
-		return x.TimeoutBlockHeight
+func (x *ListAllowedAddressesRequest) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
 	return 0
 }
 
-func (x *SwapInfo) GetLockupTransactionId() string {
-	if x != nil {
-		return x.LockupTransactionId
-	}
-	return ""
-}
-
-func (x *SwapInfo) GetRefundTransactionId() string {
-	if x != nil {
-		return x.RefundTransactionId
-	}
-	return ""
-}
-
-func (x *SwapInfo) GetRefundAddress() string {
-	if x != nil && x.RefundAddress != nil {
-		return *x.RefundAddress
-	}
-	return ""
-}
-
-func (x *SwapInfo) GetChanIds() []*ChannelId {
-	if x != nil {
-		return x.ChanIds
-	}
-	return nil
-}
+type ListAllowedAddressesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *SwapInfo) GetBlindingKey() string {
-	if x != nil && x.BlindingKey != nil {
-		return *x.BlindingKey
-	}
-	return ""
+	Addresses []*AllowedAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
 
-func (x *SwapInfo) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
+func (x *ListAllowedAddressesResponse) Reset() {
+	*x = ListAllowedAddressesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *SwapInfo) GetServiceFee() int64 {
-	if x != nil && x.ServiceFee != nil {
-		return *x.ServiceFee
-	}
-	return 0
+func (x *ListAllowedAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SwapInfo) GetOnchainFee() uint64 {
-	if x != nil && x.OnchainFee != nil {
-		return *x.OnchainFee
-	}
-	return 0
-}
+func (*ListAllowedAddressesResponse) ProtoMessage() {}
 
-func (x *SwapInfo) GetWalletId() uint64 {
-	if x != nil && x.WalletId != nil {
-		return *x.WalletId
+func (x *ListAllowedAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SwapInfo) GetTenantId() uint64 {
-	if x != nil {
-		return x.TenantId
-	}
-	return 0
+// Deprecated: Use ListAllowedAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllowedAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SwapInfo) GetIsAuto() bool {
+func (x *ListAllowedAddressesResponse) GetAddresses() []*AllowedAddress {
 	if x != nil {
-		return x.IsAuto
+		return x.Addresses
 	}
-	return false
+	return nil
 }
 
-type GetPairInfoRequest struct {
+type RemoveAllowedAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
-	Pair *Pair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Defaults to the tenant of the macaroon.
+	TenantId *uint64 `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
 }
 
-func (x *GetPairInfoRequest) Reset() {
-	*x = GetPairInfoRequest{}
+func (x *RemoveAllowedAddressRequest) Reset() {
+	*x = RemoveAllowedAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[11]
+		mi := &file_boltzrpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPairInfoRequest) String() string {
+func (x *RemoveAllowedAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPairInfoRequest) ProtoMessage() {}
+func (*RemoveAllowedAddressRequest) ProtoMessage() {}
 
-func (x *GetPairInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[11]
+func (x *RemoveAllowedAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,53 +1129,56 @@ func (x *GetPairInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPairInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetPairInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use RemoveAllowedAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAllowedAddressRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetPairInfoRequest) GetType() SwapType {
+func (x *RemoveAllowedAddressRequest) GetAddress() string {
 	if x != nil {
-		return x.Type
+		return x.Address
 	}
-	return SwapType_SUBMARINE
+	return ""
 }
 
-func (x *GetPairInfoRequest) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
+func (x *RemoveAllowedAddressRequest) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return nil
+	return 0
 }
 
-type PairInfo struct {
+type CreateOfferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pair   *Pair     `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
-	Fees   *SwapFees `protobuf:"bytes,2,opt,name=fees,proto3" json:"fees,omitempty"`
-	Limits *Limits   `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
-	Hash   string    `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Amount of the offer in satoshis. The payer can choose the amount if not set
+	Amount      *uint64 `protobuf:"varint,1,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Description string  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Id of the wallet which the payments are swapped to
+	WalletId uint64 `protobuf:"varint,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	// Name of the lightning node which creates the offer. The main node is used if not set
+	LightningNode *string `protobuf:"bytes,4,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
 }
 
-func (x *PairInfo) Reset() {
-	*x = PairInfo{}
+func (x *CreateOfferRequest) Reset() {
+	*x = CreateOfferRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[12]
+		mi := &file_boltzrpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PairInfo) String() string {
+func (x *CreateOfferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PairInfo) ProtoMessage() {}
+func (*CreateOfferRequest) ProtoMessage() {}
 
-func (x *PairInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[12]
+func (x *CreateOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1133,72 +1189,73 @@ func (x *PairInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PairInfo.ProtoReflect.Descriptor instead.
-func (*PairInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateOfferRequest.ProtoReflect.Descriptor instead.
+func (*CreateOfferRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *PairInfo) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
+func (x *CreateOfferRequest) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *PairInfo) GetFees() *SwapFees {
+func (x *CreateOfferRequest) GetDescription() string {
 	if x != nil {
-		return x.Fees
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *PairInfo) GetLimits() *Limits {
+func (x *CreateOfferRequest) GetWalletId() uint64 {
 	if x != nil {
-		return x.Limits
+		return x.WalletId
 	}
-	return nil
+	return 0
 }
 
-func (x *PairInfo) GetHash() string {
-	if x != nil {
-		return x.Hash
+func (x *CreateOfferRequest) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
 	}
 	return ""
 }
 
-type GetSwapQuoteRequest struct {
+type OfferPayment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
-	Pair *Pair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	// Specify exactly one of send_amount or receive_amount
-	//
-	// Types that are assignable to Amount:
-	//
-	//	*GetSwapQuoteRequest_SendAmount
-	//	*GetSwapQuoteRequest_ReceiveAmount
-	Amount isGetSwapQuoteRequest_Amount `protobuf_oneof:"amount"`
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	Amount      uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Id of the reverse swap which was created for the payment
+	SwapId *string `protobuf:"bytes,3,opt,name=swap_id,json=swapId,proto3,oneof" json:"swap_id,omitempty"`
+	// Error of the last attempt to create a reverse swap for the payment.
+	// The creation is retried until `attempts` reaches 5
+	Error     *string `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedAt int64   `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Number of times the creation of a reverse swap was attempted
+	Attempts uint64 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
-func (x *GetSwapQuoteRequest) Reset() {
-	*x = GetSwapQuoteRequest{}
+func (x *OfferPayment) Reset() {
+	*x = OfferPayment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[13]
+		mi := &file_boltzrpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSwapQuoteRequest) String() string {
+func (x *OfferPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSwapQuoteRequest) ProtoMessage() {}
+func (*OfferPayment) ProtoMessage() {}
 
-func (x *GetSwapQuoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[13]
+func (x *OfferPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,98 +1266,87 @@ func (x *GetSwapQuoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSwapQuoteRequest.ProtoReflect.Descriptor instead.
-func (*GetSwapQuoteRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use OfferPayment.ProtoReflect.Descriptor instead.
+func (*OfferPayment) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetSwapQuoteRequest) GetType() SwapType {
+func (x *OfferPayment) GetPaymentHash() string {
 	if x != nil {
-		return x.Type
+		return x.PaymentHash
 	}
-	return SwapType_SUBMARINE
+	return ""
 }
 
-func (x *GetSwapQuoteRequest) GetPair() *Pair {
+func (x *OfferPayment) GetAmount() uint64 {
 	if x != nil {
-		return x.Pair
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *GetSwapQuoteRequest) GetAmount() isGetSwapQuoteRequest_Amount {
-	if m != nil {
-		return m.Amount
+func (x *OfferPayment) GetSwapId() string {
+	if x != nil && x.SwapId != nil {
+		return *x.SwapId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSwapQuoteRequest) GetSendAmount() uint64 {
-	if x, ok := x.GetAmount().(*GetSwapQuoteRequest_SendAmount); ok {
-		return x.SendAmount
+func (x *OfferPayment) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSwapQuoteRequest) GetReceiveAmount() uint64 {
-	if x, ok := x.GetAmount().(*GetSwapQuoteRequest_ReceiveAmount); ok {
-		return x.ReceiveAmount
+func (x *OfferPayment) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-type isGetSwapQuoteRequest_Amount interface {
-	isGetSwapQuoteRequest_Amount()
-}
-
-type GetSwapQuoteRequest_SendAmount struct {
-	// The amount you want to send
-	SendAmount uint64 `protobuf:"varint,3,opt,name=send_amount,json=sendAmount,proto3,oneof"`
-}
-
-type GetSwapQuoteRequest_ReceiveAmount struct {
-	// The amount you want to receive
-	ReceiveAmount uint64 `protobuf:"varint,4,opt,name=receive_amount,json=receiveAmount,proto3,oneof"`
+func (x *OfferPayment) GetAttempts() uint64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
 }
 
-func (*GetSwapQuoteRequest_SendAmount) isGetSwapQuoteRequest_Amount() {}
-
-func (*GetSwapQuoteRequest_ReceiveAmount) isGetSwapQuoteRequest_Amount() {}
-
-type GetSwapQuoteResponse struct {
+type Offer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The amount you will send
-	SendAmount uint64 `protobuf:"varint,1,opt,name=send_amount,json=sendAmount,proto3" json:"send_amount,omitempty"`
-	// The amount you will receive
-	ReceiveAmount uint64 `protobuf:"varint,2,opt,name=receive_amount,json=receiveAmount,proto3" json:"receive_amount,omitempty"`
-	// Service fee charged by Boltz
-	BoltzFee uint64 `protobuf:"varint,3,opt,name=boltz_fee,json=boltzFee,proto3" json:"boltz_fee,omitempty"`
-	// Network/miner fees
-	NetworkFee uint64 `protobuf:"varint,4,opt,name=network_fee,json=networkFee,proto3" json:"network_fee,omitempty"`
-	// The pair info with current limits
-	PairInfo *PairInfo `protobuf:"bytes,5,opt,name=pair_info,json=pairInfo,proto3" json:"pair_info,omitempty"`
+	// Id of the offer assigned by the lightning node
+	Id            string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Offer         string          `protobuf:"bytes,2,opt,name=offer,proto3" json:"offer,omitempty"`
+	Description   string          `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        *uint64         `protobuf:"varint,4,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	WalletId      uint64          `protobuf:"varint,5,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	LightningNode *string         `protobuf:"bytes,6,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
+	Active        bool            `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     int64           `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Payments      []*OfferPayment `protobuf:"bytes,9,rep,name=payments,proto3" json:"payments,omitempty"`
 }
 
-func (x *GetSwapQuoteResponse) Reset() {
-	*x = GetSwapQuoteResponse{}
+func (x *Offer) Reset() {
+	*x = Offer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[14]
+		mi := &file_boltzrpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSwapQuoteResponse) String() string {
+func (x *Offer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSwapQuoteResponse) ProtoMessage() {}
+func (*Offer) ProtoMessage() {}
 
-func (x *GetSwapQuoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[14]
+func (x *Offer) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,80 +1357,99 @@ func (x *GetSwapQuoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSwapQuoteResponse.ProtoReflect.Descriptor instead.
-func (*GetSwapQuoteResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Offer.ProtoReflect.Descriptor instead.
+func (*Offer) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetSwapQuoteResponse) GetSendAmount() uint64 {
+func (x *Offer) GetId() string {
 	if x != nil {
-		return x.SendAmount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSwapQuoteResponse) GetReceiveAmount() uint64 {
+func (x *Offer) GetOffer() string {
 	if x != nil {
-		return x.ReceiveAmount
+		return x.Offer
+	}
+	return ""
+}
+
+func (x *Offer) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Offer) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
 	return 0
 }
 
-func (x *GetSwapQuoteResponse) GetBoltzFee() uint64 {
+func (x *Offer) GetWalletId() uint64 {
 	if x != nil {
-		return x.BoltzFee
+		return x.WalletId
 	}
 	return 0
 }
 
-func (x *GetSwapQuoteResponse) GetNetworkFee() uint64 {
+func (x *Offer) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+func (x *Offer) GetActive() bool {
 	if x != nil {
-		return x.NetworkFee
+		return x.Active
+	}
+	return false
+}
+
+func (x *Offer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *GetSwapQuoteResponse) GetPairInfo() *PairInfo {
+func (x *Offer) GetPayments() []*OfferPayment {
 	if x != nil {
-		return x.PairInfo
+		return x.Payments
 	}
 	return nil
 }
 
-// Channel creations are an optional extension to a submarine swap in the data types of boltz-client.
-//
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-type ChannelCreationInfo struct {
+type ListOffersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ID of the swap to which this channel belongs
-	SwapId                 string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
-	Status                 string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	InboundLiquidity       uint32 `protobuf:"varint,3,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
-	Private                bool   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
-	FundingTransactionId   string `protobuf:"bytes,5,opt,name=funding_transaction_id,json=fundingTransactionId,proto3" json:"funding_transaction_id,omitempty"`
-	FundingTransactionVout uint32 `protobuf:"varint,6,opt,name=funding_transaction_vout,json=fundingTransactionVout,proto3" json:"funding_transaction_vout,omitempty"`
+	Active *bool `protobuf:"varint,1,opt,name=active,proto3,oneof" json:"active,omitempty"`
 }
 
-func (x *ChannelCreationInfo) Reset() {
-	*x = ChannelCreationInfo{}
+func (x *ListOffersRequest) Reset() {
+	*x = ListOffersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[15]
+		mi := &file_boltzrpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelCreationInfo) String() string {
+func (x *ListOffersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelCreationInfo) ProtoMessage() {}
+func (*ListOffersRequest) ProtoMessage() {}
 
-func (x *ChannelCreationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[15]
+func (x *ListOffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1395,80 +1460,90 @@ func (x *ChannelCreationInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelCreationInfo.ProtoReflect.Descriptor instead.
-func (*ChannelCreationInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListOffersRequest.ProtoReflect.Descriptor instead.
+func (*ListOffersRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ChannelCreationInfo) GetSwapId() string {
-	if x != nil {
-		return x.SwapId
+func (x *ListOffersRequest) GetActive() bool {
+	if x != nil && x.Active != nil {
+		return *x.Active
 	}
-	return ""
+	return false
 }
 
-func (x *ChannelCreationInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+type ListOffersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offers []*Offer `protobuf:"bytes,1,rep,name=offers,proto3" json:"offers,omitempty"`
 }
 
-func (x *ChannelCreationInfo) GetInboundLiquidity() uint32 {
-	if x != nil {
-		return x.InboundLiquidity
+func (x *ListOffersResponse) Reset() {
+	*x = ListOffersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ChannelCreationInfo) GetPrivate() bool {
-	if x != nil {
-		return x.Private
-	}
-	return false
+func (x *ListOffersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChannelCreationInfo) GetFundingTransactionId() string {
-	if x != nil {
-		return x.FundingTransactionId
+func (*ListOffersResponse) ProtoMessage() {}
+
+func (x *ListOffersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ChannelCreationInfo) GetFundingTransactionVout() uint32 {
+// Deprecated: Use ListOffersResponse.ProtoReflect.Descriptor instead.
+func (*ListOffersResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListOffersResponse) GetOffers() []*Offer {
 	if x != nil {
-		return x.FundingTransactionVout
+		return x.Offers
 	}
-	return 0
+	return nil
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-type CombinedChannelSwapInfo struct {
+type DisableOfferRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Swap            *SwapInfo            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
-	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,2,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *CombinedChannelSwapInfo) Reset() {
-	*x = CombinedChannelSwapInfo{}
+func (x *DisableOfferRequest) Reset() {
+	*x = DisableOfferRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[16]
+		mi := &file_boltzrpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CombinedChannelSwapInfo) String() string {
+func (x *DisableOfferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CombinedChannelSwapInfo) ProtoMessage() {}
+func (*DisableOfferRequest) ProtoMessage() {}
 
-func (x *CombinedChannelSwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[16]
+func (x *DisableOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1479,75 +1554,44 @@ func (x *CombinedChannelSwapInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CombinedChannelSwapInfo.ProtoReflect.Descriptor instead.
-func (*CombinedChannelSwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *CombinedChannelSwapInfo) GetSwap() *SwapInfo {
-	if x != nil {
-		return x.Swap
-	}
-	return nil
+// Deprecated: Use DisableOfferRequest.ProtoReflect.Descriptor instead.
+func (*DisableOfferRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *CombinedChannelSwapInfo) GetChannelCreation() *ChannelCreationInfo {
+func (x *DisableOfferRequest) GetId() string {
 	if x != nil {
-		return x.ChannelCreation
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type ReverseSwapInfo struct {
+type Tenant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State SwapState `protobuf:"varint,2,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
-	Error string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	// Latest status message of the Boltz backend
-	Status              string       `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	PrivateKey          string       `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	Preimage            string       `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	RedeemScript        string       `protobuf:"bytes,7,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
-	Invoice             string       `protobuf:"bytes,8,opt,name=invoice,proto3" json:"invoice,omitempty"`
-	ClaimAddress        string       `protobuf:"bytes,9,opt,name=claim_address,json=claimAddress,proto3" json:"claim_address,omitempty"`
-	OnchainAmount       uint64       `protobuf:"varint,10,opt,name=onchain_amount,json=onchainAmount,proto3" json:"onchain_amount,omitempty"`
-	InvoiceAmount       uint64       `protobuf:"varint,25,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
-	TimeoutBlockHeight  uint32       `protobuf:"varint,11,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
-	LockupTransactionId string       `protobuf:"bytes,12,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
-	ClaimTransactionId  string       `protobuf:"bytes,13,opt,name=claim_transaction_id,json=claimTransactionId,proto3" json:"claim_transaction_id,omitempty"`
-	Pair                *Pair        `protobuf:"bytes,14,opt,name=pair,proto3" json:"pair,omitempty"`
-	ChanIds             []*ChannelId `protobuf:"bytes,15,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
-	BlindingKey         *string      `protobuf:"bytes,16,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
-	CreatedAt           int64        `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PaidAt              *int64       `protobuf:"varint,23,opt,name=paid_at,json=paidAt,proto3,oneof" json:"paid_at,omitempty"` // the time when the invoice was paid
-	ServiceFee          *int64       `protobuf:"varint,18,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
-	OnchainFee          *uint64      `protobuf:"varint,19,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
-	RoutingFeeMsat      *uint64      `protobuf:"varint,20,opt,name=routing_fee_msat,json=routingFeeMsat,proto3,oneof" json:"routing_fee_msat,omitempty"`
-	ExternalPay         bool         `protobuf:"varint,21,opt,name=external_pay,json=externalPay,proto3" json:"external_pay,omitempty"`
-	TenantId            uint64       `protobuf:"varint,22,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	IsAuto              bool         `protobuf:"varint,24,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *ReverseSwapInfo) Reset() {
-	*x = ReverseSwapInfo{}
+func (x *Tenant) Reset() {
+	*x = Tenant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[17]
+		mi := &file_boltzrpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReverseSwapInfo) String() string {
+func (x *Tenant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReverseSwapInfo) ProtoMessage() {}
+func (*Tenant) ProtoMessage() {}
 
-func (x *ReverseSwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[17]
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,212 +1602,200 @@ func (x *ReverseSwapInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReverseSwapInfo.ProtoReflect.Descriptor instead.
-func (*ReverseSwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ReverseSwapInfo) GetId() string {
+func (x *Tenant) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ReverseSwapInfo) GetState() SwapState {
+func (x *Tenant) GetName() string {
 	if x != nil {
-		return x.State
+		return x.Name
 	}
-	return SwapState_PENDING
+	return ""
 }
 
-func (x *ReverseSwapInfo) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
+type MacaroonPermissions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action MacaroonAction `protobuf:"varint,2,opt,name=action,proto3,enum=boltzrpc.MacaroonAction" json:"action,omitempty"`
 }
 
-func (x *ReverseSwapInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *MacaroonPermissions) Reset() {
+	*x = MacaroonPermissions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ReverseSwapInfo) GetPrivateKey() string {
-	if x != nil {
-		return x.PrivateKey
-	}
-	return ""
+func (x *MacaroonPermissions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ReverseSwapInfo) GetPreimage() string {
-	if x != nil {
-		return x.Preimage
+func (*MacaroonPermissions) ProtoMessage() {}
+
+func (x *MacaroonPermissions) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ReverseSwapInfo) GetRedeemScript() string {
-	if x != nil {
-		return x.RedeemScript
-	}
-	return ""
+// Deprecated: Use MacaroonPermissions.ProtoReflect.Descriptor instead.
+func (*MacaroonPermissions) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ReverseSwapInfo) GetInvoice() string {
+func (x *MacaroonPermissions) GetAction() MacaroonAction {
 	if x != nil {
-		return x.Invoice
+		return x.Action
 	}
-	return ""
+	return MacaroonAction_READ
 }
 
-func (x *ReverseSwapInfo) GetClaimAddress() string {
-	if x != nil {
-		return x.ClaimAddress
-	}
-	return ""
-}
+type BakeMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ReverseSwapInfo) GetOnchainAmount() uint64 {
-	if x != nil {
-		return x.OnchainAmount
-	}
-	return 0
+	TenantId    *uint64                `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`
+	Permissions []*MacaroonPermissions `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (x *ReverseSwapInfo) GetInvoiceAmount() uint64 {
-	if x != nil {
-		return x.InvoiceAmount
+func (x *BakeMacaroonRequest) Reset() {
+	*x = BakeMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ReverseSwapInfo) GetTimeoutBlockHeight() uint32 {
-	if x != nil {
-		return x.TimeoutBlockHeight
-	}
-	return 0
+func (x *BakeMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ReverseSwapInfo) GetLockupTransactionId() string {
-	if x != nil {
-		return x.LockupTransactionId
+func (*BakeMacaroonRequest) ProtoMessage() {}
+
+func (x *BakeMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ReverseSwapInfo) GetClaimTransactionId() string {
-	if x != nil {
-		return x.ClaimTransactionId
-	}
-	return ""
+// Deprecated: Use BakeMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ReverseSwapInfo) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
+func (x *BakeMacaroonRequest) GetTenantId() uint64 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
 	}
-	return nil
+	return 0
 }
 
-func (x *ReverseSwapInfo) GetChanIds() []*ChannelId {
+func (x *BakeMacaroonRequest) GetPermissions() []*MacaroonPermissions {
 	if x != nil {
-		return x.ChanIds
+		return x.Permissions
 	}
 	return nil
 }
 
-func (x *ReverseSwapInfo) GetBlindingKey() string {
-	if x != nil && x.BlindingKey != nil {
-		return *x.BlindingKey
-	}
-	return ""
-}
-
-func (x *ReverseSwapInfo) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
+type BakeMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ReverseSwapInfo) GetPaidAt() int64 {
-	if x != nil && x.PaidAt != nil {
-		return *x.PaidAt
-	}
-	return 0
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
 }
 
-func (x *ReverseSwapInfo) GetServiceFee() int64 {
-	if x != nil && x.ServiceFee != nil {
-		return *x.ServiceFee
+func (x *BakeMacaroonResponse) Reset() {
+	*x = BakeMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ReverseSwapInfo) GetOnchainFee() uint64 {
-	if x != nil && x.OnchainFee != nil {
-		return *x.OnchainFee
-	}
-	return 0
+func (x *BakeMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ReverseSwapInfo) GetRoutingFeeMsat() uint64 {
-	if x != nil && x.RoutingFeeMsat != nil {
-		return *x.RoutingFeeMsat
-	}
-	return 0
-}
+func (*BakeMacaroonResponse) ProtoMessage() {}
 
-func (x *ReverseSwapInfo) GetExternalPay() bool {
-	if x != nil {
-		return x.ExternalPay
+func (x *BakeMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ReverseSwapInfo) GetTenantId() uint64 {
-	if x != nil {
-		return x.TenantId
-	}
-	return 0
+// Deprecated: Use BakeMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ReverseSwapInfo) GetIsAuto() bool {
+func (x *BakeMacaroonResponse) GetMacaroon() string {
 	if x != nil {
-		return x.IsAuto
+		return x.Macaroon
 	}
-	return false
+	return ""
 }
 
-type BlockHeights struct {
+type Pair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Btc    uint32  `protobuf:"varint,1,opt,name=btc,proto3" json:"btc,omitempty"`
-	Liquid *uint32 `protobuf:"varint,2,opt,name=liquid,proto3,oneof" json:"liquid,omitempty"`
+	From Currency `protobuf:"varint,1,opt,name=from,proto3,enum=boltzrpc.Currency" json:"from,omitempty"`
+	To   Currency `protobuf:"varint,2,opt,name=to,proto3,enum=boltzrpc.Currency" json:"to,omitempty"`
 }
 
-func (x *BlockHeights) Reset() {
-	*x = BlockHeights{}
+func (x *Pair) Reset() {
+	*x = Pair{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[18]
+		mi := &file_boltzrpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockHeights) String() string {
+func (x *Pair) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockHeights) ProtoMessage() {}
+func (*Pair) ProtoMessage() {}
 
-func (x *BlockHeights) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[18]
+func (x *Pair) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1774,48 +1806,80 @@ func (x *BlockHeights) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockHeights.ProtoReflect.Descriptor instead.
-func (*BlockHeights) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Pair.ProtoReflect.Descriptor instead.
+func (*Pair) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *BlockHeights) GetBtc() uint32 {
+func (x *Pair) GetFrom() Currency {
 	if x != nil {
-		return x.Btc
+		return x.From
 	}
-	return 0
+	return Currency_BTC
 }
 
-func (x *BlockHeights) GetLiquid() uint32 {
-	if x != nil && x.Liquid != nil {
-		return *x.Liquid
+func (x *Pair) GetTo() Currency {
+	if x != nil {
+		return x.To
 	}
-	return 0
+	return Currency_BTC
 }
 
-type GetInfoRequest struct {
+type SwapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pair  *Pair     `protobuf:"bytes,22,opt,name=pair,proto3" json:"pair,omitempty"`
+	State SwapState `protobuf:"varint,2,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
+	Error string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Latest status message of the Boltz backend
+	Status              string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PrivateKey          string `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Preimage            string `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	RedeemScript        string `protobuf:"bytes,7,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	Invoice             string `protobuf:"bytes,8,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	LockupAddress       string `protobuf:"bytes,9,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	ExpectedAmount      uint64 `protobuf:"varint,10,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	TimeoutBlockHeight  uint32 `protobuf:"varint,11,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	LockupTransactionId string `protobuf:"bytes,12,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
+	// If the swap times out or fails for some other reason, the daemon will automatically refund the coins sent to the
+	// `lockup_address` back to the configured wallet or the address specified in the `refund_address` field.
+	RefundTransactionId string       `protobuf:"bytes,13,opt,name=refund_transaction_id,json=refundTransactionId,proto3" json:"refund_transaction_id,omitempty"`
+	RefundAddress       *string      `protobuf:"bytes,19,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
+	ChanIds             []*ChannelId `protobuf:"bytes,14,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	BlindingKey         *string      `protobuf:"bytes,15,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
+	CreatedAt           int64        `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ServiceFee          *int64       `protobuf:"varint,17,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
+	OnchainFee          *uint64      `protobuf:"varint,18,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
+	// internal wallet which was used to pay the swap
+	WalletId *uint64 `protobuf:"varint,20,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	TenantId uint64  `protobuf:"varint,21,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	IsAuto   bool    `protobuf:"varint,23,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	// Name of the lightning node the swap is bound to, not set for the main node
+	LightningNode *string `protobuf:"bytes,24,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
+	// Settlement of the invoice by Boltz. Not populated by `ListSwaps`
+	PaymentAttempts []*PaymentAttempt `protobuf:"bytes,25,rep,name=payment_attempts,json=paymentAttempts,proto3" json:"payment_attempts,omitempty"`
 }
 
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
+func (x *SwapInfo) Reset() {
+	*x = SwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[19]
+		mi := &file_boltzrpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoRequest) String() string {
+func (x *SwapInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoRequest) ProtoMessage() {}
+func (*SwapInfo) ProtoMessage() {}
 
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[19]
+func (x *SwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1826,213 +1890,216 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
+func (*SwapInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{25}
 }
 
-type GetInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SwapInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
 
-	Version    string `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
-	Node       string `protobuf:"bytes,10,opt,name=node,proto3" json:"node,omitempty"`
-	Network    string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	NodePubkey string `protobuf:"bytes,7,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
-	// one of: running, disabled, error
-	AutoSwapStatus string `protobuf:"bytes,11,opt,name=auto_swap_status,json=autoSwapStatus,proto3" json:"auto_swap_status,omitempty"`
-	// mapping of the currency to the latest block height.
-	BlockHeights *BlockHeights `protobuf:"bytes,8,opt,name=block_heights,json=blockHeights,proto3" json:"block_heights,omitempty"`
-	// swaps that need a manual interaction to refund
-	RefundableSwaps []string `protobuf:"bytes,12,rep,name=refundable_swaps,json=refundableSwaps,proto3" json:"refundable_swaps,omitempty"`
-	// the currently authenticated tenant
-	Tenant *Tenant `protobuf:"bytes,13,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
-	// swaps that need a manual interaction to claim
-	ClaimableSwaps []string `protobuf:"bytes,14,rep,name=claimable_swaps,json=claimableSwaps,proto3" json:"claimable_swaps,omitempty"`
-	// startup warnings that require user attention
-	Warnings []string `protobuf:"bytes,15,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	LndPubkey string `protobuf:"bytes,3,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	BlockHeight uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	PendingSwaps []string `protobuf:"bytes,5,rep,name=pending_swaps,json=pendingSwaps,proto3" json:"pending_swaps,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	PendingReverseSwaps []string `protobuf:"bytes,6,rep,name=pending_reverse_swaps,json=pendingReverseSwaps,proto3" json:"pending_reverse_swaps,omitempty"`
-}
-
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SwapInfo) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
 	}
+	return nil
 }
 
-func (x *GetInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SwapInfo) GetState() SwapState {
+	if x != nil {
+		return x.State
+	}
+	return SwapState_PENDING
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
-
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SwapInfo) GetError() string {
+	if x != nil {
+		return x.Error
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{20}
+func (x *SwapInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *GetInfoResponse) GetVersion() string {
+func (x *SwapInfo) GetPrivateKey() string {
 	if x != nil {
-		return x.Version
+		return x.PrivateKey
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetNode() string {
+func (x *SwapInfo) GetPreimage() string {
 	if x != nil {
-		return x.Node
+		return x.Preimage
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetNetwork() string {
+func (x *SwapInfo) GetRedeemScript() string {
 	if x != nil {
-		return x.Network
+		return x.RedeemScript
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetNodePubkey() string {
+func (x *SwapInfo) GetInvoice() string {
 	if x != nil {
-		return x.NodePubkey
+		return x.Invoice
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetAutoSwapStatus() string {
+func (x *SwapInfo) GetLockupAddress() string {
 	if x != nil {
-		return x.AutoSwapStatus
+		return x.LockupAddress
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetBlockHeights() *BlockHeights {
+func (x *SwapInfo) GetExpectedAmount() uint64 {
 	if x != nil {
-		return x.BlockHeights
+		return x.ExpectedAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetInfoResponse) GetRefundableSwaps() []string {
+func (x *SwapInfo) GetTimeoutBlockHeight() uint32 {
 	if x != nil {
-		return x.RefundableSwaps
+		return x.TimeoutBlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *GetInfoResponse) GetTenant() *Tenant {
+func (x *SwapInfo) GetLockupTransactionId() string {
 	if x != nil {
-		return x.Tenant
+		return x.LockupTransactionId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInfoResponse) GetClaimableSwaps() []string {
+func (x *SwapInfo) GetRefundTransactionId() string {
 	if x != nil {
-		return x.ClaimableSwaps
+		return x.RefundTransactionId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInfoResponse) GetWarnings() []string {
+func (x *SwapInfo) GetRefundAddress() string {
+	if x != nil && x.RefundAddress != nil {
+		return *x.RefundAddress
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetChanIds() []*ChannelId {
 	if x != nil {
-		return x.Warnings
+		return x.ChanIds
 	}
 	return nil
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
+func (x *SwapInfo) GetBlindingKey() string {
+	if x != nil && x.BlindingKey != nil {
+		return *x.BlindingKey
 	}
 	return ""
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetLndPubkey() string {
+func (x *SwapInfo) GetCreatedAt() int64 {
 	if x != nil {
-		return x.LndPubkey
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetBlockHeight() uint32 {
+func (x *SwapInfo) GetServiceFee() int64 {
+	if x != nil && x.ServiceFee != nil {
+		return *x.ServiceFee
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetOnchainFee() uint64 {
+	if x != nil && x.OnchainFee != nil {
+		return *x.OnchainFee
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetWalletId() uint64 {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetTenantId() uint64 {
 	if x != nil {
-		return x.BlockHeight
+		return x.TenantId
 	}
 	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetPendingSwaps() []string {
+func (x *SwapInfo) GetIsAuto() bool {
 	if x != nil {
-		return x.PendingSwaps
+		return x.IsAuto
 	}
-	return nil
+	return false
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetPendingReverseSwaps() []string {
+func (x *SwapInfo) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetPaymentAttempts() []*PaymentAttempt {
 	if x != nil {
-		return x.PendingReverseSwaps
+		return x.PaymentAttempts
 	}
 	return nil
 }
 
-type Limits struct {
+type GetPairInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Minimal               uint64 `protobuf:"varint,1,opt,name=minimal,proto3" json:"minimal,omitempty"`
-	Maximal               uint64 `protobuf:"varint,2,opt,name=maximal,proto3" json:"maximal,omitempty"`
-	MaximalZeroConfAmount uint64 `protobuf:"varint,3,opt,name=maximal_zero_conf_amount,json=maximalZeroConfAmount,proto3" json:"maximal_zero_conf_amount,omitempty"`
+	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Pair *Pair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Amount in satoshis to estimate the routing fee of paying the invoice of a reverse swap for
+	Amount *uint64 `protobuf:"varint,3,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	// Name of the lightning node which would pay the invoice. Defaults to the main node
+	LightningNode *string `protobuf:"bytes,4,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
 }
 
-func (x *Limits) Reset() {
-	*x = Limits{}
+func (x *GetPairInfoRequest) Reset() {
+	*x = GetPairInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[21]
+		mi := &file_boltzrpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Limits) String() string {
+func (x *GetPairInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Limits) ProtoMessage() {}
+func (*GetPairInfoRequest) ProtoMessage() {}
 
-func (x *Limits) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[21]
+func (x *GetPairInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2043,58 +2110,67 @@ func (x *Limits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
-func (*Limits) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetPairInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPairInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Limits) GetMinimal() uint64 {
+func (x *GetPairInfoRequest) GetType() SwapType {
 	if x != nil {
-		return x.Minimal
+		return x.Type
 	}
-	return 0
+	return SwapType_SUBMARINE
 }
 
-func (x *Limits) GetMaximal() uint64 {
+func (x *GetPairInfoRequest) GetPair() *Pair {
 	if x != nil {
-		return x.Maximal
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *Limits) GetMaximalZeroConfAmount() uint64 {
-	if x != nil {
-		return x.MaximalZeroConfAmount
+func (x *GetPairInfoRequest) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
 	}
 	return 0
 }
 
-type SwapFees struct {
+func (x *GetPairInfoRequest) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+type RoutingFeeEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Percentage float64 `protobuf:"fixed64,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	MinerFees  uint64  `protobuf:"varint,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
+	// Estimated fee of the cheapest route to Boltz in millisatoshis
+	FeeMsat uint64 `protobuf:"varint,1,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// Probability between 0 and 1 that a payment along the route succeeds. Only estimated by LND
+	SuccessProbability *float64 `protobuf:"fixed64,2,opt,name=success_probability,json=successProbability,proto3,oneof" json:"success_probability,omitempty"`
 }
 
-func (x *SwapFees) Reset() {
-	*x = SwapFees{}
+func (x *RoutingFeeEstimate) Reset() {
+	*x = RoutingFeeEstimate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[22]
+		mi := &file_boltzrpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapFees) String() string {
+func (x *RoutingFeeEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapFees) ProtoMessage() {}
+func (*RoutingFeeEstimate) ProtoMessage() {}
 
-func (x *SwapFees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[22]
+func (x *RoutingFeeEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,52 +2181,55 @@ func (x *SwapFees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapFees.ProtoReflect.Descriptor instead.
-func (*SwapFees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use RoutingFeeEstimate.ProtoReflect.Descriptor instead.
+func (*RoutingFeeEstimate) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SwapFees) GetPercentage() float64 {
+func (x *RoutingFeeEstimate) GetFeeMsat() uint64 {
 	if x != nil {
-		return x.Percentage
+		return x.FeeMsat
 	}
 	return 0
 }
 
-func (x *SwapFees) GetMinerFees() uint64 {
-	if x != nil {
-		return x.MinerFees
+func (x *RoutingFeeEstimate) GetSuccessProbability() float64 {
+	if x != nil && x.SuccessProbability != nil {
+		return *x.SuccessProbability
 	}
 	return 0
 }
 
-type GetPairsResponse struct {
+type PairInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submarine []*PairInfo `protobuf:"bytes,1,rep,name=submarine,proto3" json:"submarine,omitempty"`
-	Reverse   []*PairInfo `protobuf:"bytes,2,rep,name=reverse,proto3" json:"reverse,omitempty"`
-	Chain     []*PairInfo `protobuf:"bytes,3,rep,name=chain,proto3" json:"chain,omitempty"`
+	Pair   *Pair     `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Fees   *SwapFees `protobuf:"bytes,2,opt,name=fees,proto3" json:"fees,omitempty"`
+	Limits *Limits   `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	Hash   string    `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Only populated for reverse swaps if `amount` is set in the request and the route could be probed
+	RoutingFee *RoutingFeeEstimate `protobuf:"bytes,5,opt,name=routing_fee,json=routingFee,proto3,oneof" json:"routing_fee,omitempty"`
 }
 
-func (x *GetPairsResponse) Reset() {
-	*x = GetPairsResponse{}
+func (x *PairInfo) Reset() {
+	*x = PairInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[23]
+		mi := &file_boltzrpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPairsResponse) String() string {
+func (x *PairInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPairsResponse) ProtoMessage() {}
+func (*PairInfo) ProtoMessage() {}
 
-func (x *GetPairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[23]
+func (x *PairInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,58 +2240,81 @@ func (x *GetPairsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPairsResponse.ProtoReflect.Descriptor instead.
-func (*GetPairsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PairInfo.ProtoReflect.Descriptor instead.
+func (*PairInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetPairsResponse) GetSubmarine() []*PairInfo {
+func (x *PairInfo) GetPair() *Pair {
 	if x != nil {
-		return x.Submarine
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetPairsResponse) GetReverse() []*PairInfo {
+func (x *PairInfo) GetFees() *SwapFees {
 	if x != nil {
-		return x.Reverse
+		return x.Fees
 	}
 	return nil
 }
 
-func (x *GetPairsResponse) GetChain() []*PairInfo {
+func (x *PairInfo) GetLimits() *Limits {
 	if x != nil {
-		return x.Chain
+		return x.Limits
 	}
 	return nil
 }
 
-type MinerFees struct {
+func (x *PairInfo) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *PairInfo) GetRoutingFee() *RoutingFeeEstimate {
+	if x != nil {
+		return x.RoutingFee
+	}
+	return nil
+}
+
+type GetSwapQuoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Normal  uint32 `protobuf:"varint,1,opt,name=normal,proto3" json:"normal,omitempty"`
-	Reverse uint32 `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Pair *Pair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Specify exactly one of send_amount or receive_amount
+	//
+	// Types that are assignable to Amount:
+	//
+	//	*GetSwapQuoteRequest_SendAmount
+	//	*GetSwapQuoteRequest_ReceiveAmount
+	Amount isGetSwapQuoteRequest_Amount `protobuf_oneof:"amount"`
+	// Name of the lightning node which would pay the invoice of a reverse swap. Defaults to the main node
+	LightningNode *string `protobuf:"bytes,5,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
 }
 
-func (x *MinerFees) Reset() {
-	*x = MinerFees{}
+func (x *GetSwapQuoteRequest) Reset() {
+	*x = GetSwapQuoteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[24]
+		mi := &file_boltzrpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MinerFees) String() string {
+func (x *GetSwapQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinerFees) ProtoMessage() {}
+func (*GetSwapQuoteRequest) ProtoMessage() {}
 
-func (x *MinerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[24]
+func (x *GetSwapQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2223,51 +2325,108 @@ func (x *MinerFees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinerFees.ProtoReflect.Descriptor instead.
-func (*MinerFees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetSwapQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetSwapQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MinerFees) GetNormal() uint32 {
+func (x *GetSwapQuoteRequest) GetType() SwapType {
 	if x != nil {
-		return x.Normal
+		return x.Type
 	}
-	return 0
+	return SwapType_SUBMARINE
 }
 
-func (x *MinerFees) GetReverse() uint32 {
+func (x *GetSwapQuoteRequest) GetPair() *Pair {
 	if x != nil {
-		return x.Reverse
+		return x.Pair
+	}
+	return nil
+}
+
+func (m *GetSwapQuoteRequest) GetAmount() isGetSwapQuoteRequest_Amount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (x *GetSwapQuoteRequest) GetSendAmount() uint64 {
+	if x, ok := x.GetAmount().(*GetSwapQuoteRequest_SendAmount); ok {
+		return x.SendAmount
 	}
 	return 0
 }
 
-type Fees struct {
+func (x *GetSwapQuoteRequest) GetReceiveAmount() uint64 {
+	if x, ok := x.GetAmount().(*GetSwapQuoteRequest_ReceiveAmount); ok {
+		return x.ReceiveAmount
+	}
+	return 0
+}
+
+func (x *GetSwapQuoteRequest) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+type isGetSwapQuoteRequest_Amount interface {
+	isGetSwapQuoteRequest_Amount()
+}
+
+type GetSwapQuoteRequest_SendAmount struct {
+	// The amount you want to send
+	SendAmount uint64 `protobuf:"varint,3,opt,name=send_amount,json=sendAmount,proto3,oneof"`
+}
+
+type GetSwapQuoteRequest_ReceiveAmount struct {
+	// The amount you want to receive
+	ReceiveAmount uint64 `protobuf:"varint,4,opt,name=receive_amount,json=receiveAmount,proto3,oneof"`
+}
+
+func (*GetSwapQuoteRequest_SendAmount) isGetSwapQuoteRequest_Amount() {}
+
+func (*GetSwapQuoteRequest_ReceiveAmount) isGetSwapQuoteRequest_Amount() {}
+
+type GetSwapQuoteResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Percentage float32    `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	Miner      *MinerFees `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	// The amount you will send
+	SendAmount uint64 `protobuf:"varint,1,opt,name=send_amount,json=sendAmount,proto3" json:"send_amount,omitempty"`
+	// The amount you will receive
+	ReceiveAmount uint64 `protobuf:"varint,2,opt,name=receive_amount,json=receiveAmount,proto3" json:"receive_amount,omitempty"`
+	// Service fee charged by Boltz
+	BoltzFee uint64 `protobuf:"varint,3,opt,name=boltz_fee,json=boltzFee,proto3" json:"boltz_fee,omitempty"`
+	// Network/miner fees
+	NetworkFee uint64 `protobuf:"varint,4,opt,name=network_fee,json=networkFee,proto3" json:"network_fee,omitempty"`
+	// The pair info with current limits
+	PairInfo *PairInfo `protobuf:"bytes,5,opt,name=pair_info,json=pairInfo,proto3" json:"pair_info,omitempty"`
+	// Estimated lightning routing fee which is paid on top of the send amount.
+	// Only populated for reverse swaps if a route to Boltz could be probed
+	RoutingFee *RoutingFeeEstimate `protobuf:"bytes,6,opt,name=routing_fee,json=routingFee,proto3,oneof" json:"routing_fee,omitempty"`
 }
 
-func (x *Fees) Reset() {
-	*x = Fees{}
+func (x *GetSwapQuoteResponse) Reset() {
+	*x = GetSwapQuoteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[25]
+		mi := &file_boltzrpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Fees) String() string {
+func (x *GetSwapQuoteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fees) ProtoMessage() {}
+func (*GetSwapQuoteResponse) ProtoMessage() {}
 
-func (x *Fees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[25]
+func (x *GetSwapQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,89 +2437,87 @@ func (x *Fees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fees.ProtoReflect.Descriptor instead.
-func (*Fees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use GetSwapQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetSwapQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Fees) GetPercentage() float32 {
+func (x *GetSwapQuoteResponse) GetSendAmount() uint64 {
 	if x != nil {
-		return x.Percentage
+		return x.SendAmount
 	}
 	return 0
 }
 
-func (x *Fees) GetMiner() *MinerFees {
+func (x *GetSwapQuoteResponse) GetReceiveAmount() uint64 {
 	if x != nil {
-		return x.Miner
+		return x.ReceiveAmount
 	}
-	return nil
-}
-
-type GetServiceInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *GetServiceInfoRequest) Reset() {
-	*x = GetServiceInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[26]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetSwapQuoteResponse) GetBoltzFee() uint64 {
+	if x != nil {
+		return x.BoltzFee
 	}
+	return 0
 }
 
-func (x *GetServiceInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetSwapQuoteResponse) GetNetworkFee() uint64 {
+	if x != nil {
+		return x.NetworkFee
+	}
+	return 0
 }
 
-func (*GetServiceInfoRequest) ProtoMessage() {}
-
-func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[26]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetSwapQuoteResponse) GetPairInfo() *PairInfo {
+	if x != nil {
+		return x.PairInfo
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetServiceInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetServiceInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{26}
+func (x *GetSwapQuoteResponse) GetRoutingFee() *RoutingFeeEstimate {
+	if x != nil {
+		return x.RoutingFee
+	}
+	return nil
 }
 
-type GetServiceInfoResponse struct {
+// Channel creations are an optional extension to a submarine swap in the data types of boltz-client.
+//
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+type ChannelCreationInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fees   *Fees   `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees,omitempty"`
-	Limits *Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	// ID of the swap to which this channel belongs
+	SwapId                 string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	Status                 string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	InboundLiquidity       uint32 `protobuf:"varint,3,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	Private                bool   `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	FundingTransactionId   string `protobuf:"bytes,5,opt,name=funding_transaction_id,json=fundingTransactionId,proto3" json:"funding_transaction_id,omitempty"`
+	FundingTransactionVout uint32 `protobuf:"varint,6,opt,name=funding_transaction_vout,json=fundingTransactionVout,proto3" json:"funding_transaction_vout,omitempty"`
 }
 
-func (x *GetServiceInfoResponse) Reset() {
-	*x = GetServiceInfoResponse{}
+func (x *ChannelCreationInfo) Reset() {
+	*x = ChannelCreationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[27]
+		mi := &file_boltzrpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServiceInfoResponse) String() string {
+func (x *ChannelCreationInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceInfoResponse) ProtoMessage() {}
+func (*ChannelCreationInfo) ProtoMessage() {}
 
-func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[27]
+func (x *ChannelCreationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2371,66 +2528,80 @@ func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ChannelCreationInfo.ProtoReflect.Descriptor instead.
+func (*ChannelCreationInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetServiceInfoResponse) GetFees() *Fees {
+func (x *ChannelCreationInfo) GetSwapId() string {
 	if x != nil {
-		return x.Fees
+		return x.SwapId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetServiceInfoResponse) GetLimits() *Limits {
+func (x *ChannelCreationInfo) GetStatus() string {
 	if x != nil {
-		return x.Limits
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type AnySwapInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id     string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type   SwapType  `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
-	Pair   *Pair     `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	State  SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
-	Error  *string   `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
-	Status string    `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	// The expected amount to be sent to the lockup address for submarine and chain swaps and
-	// the invoice amount for reverse swaps.
-	FromAmount uint64 `protobuf:"varint,7,opt,name=from_amount,json=fromAmount,proto3" json:"from_amount,omitempty"`
-	// `from_amount` minus the service and network fee.
-	ToAmount   uint64 `protobuf:"varint,13,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
-	CreatedAt  int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ServiceFee *int64 `protobuf:"varint,9,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
-	// includes the routing fee for reverse swaps
-	OnchainFee *uint64 `protobuf:"varint,10,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
-	IsAuto     bool    `protobuf:"varint,11,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
-	TenantId   uint64  `protobuf:"varint,12,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+func (x *ChannelCreationInfo) GetInboundLiquidity() uint32 {
+	if x != nil {
+		return x.InboundLiquidity
+	}
+	return 0
 }
 
-func (x *AnySwapInfo) Reset() {
-	*x = AnySwapInfo{}
+func (x *ChannelCreationInfo) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *ChannelCreationInfo) GetFundingTransactionId() string {
+	if x != nil {
+		return x.FundingTransactionId
+	}
+	return ""
+}
+
+func (x *ChannelCreationInfo) GetFundingTransactionVout() uint32 {
+	if x != nil {
+		return x.FundingTransactionVout
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+type CombinedChannelSwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swap            *SwapInfo            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,2,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
+}
+
+func (x *CombinedChannelSwapInfo) Reset() {
+	*x = CombinedChannelSwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[28]
+		mi := &file_boltzrpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AnySwapInfo) String() string {
+func (x *CombinedChannelSwapInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AnySwapInfo) ProtoMessage() {}
+func (*CombinedChannelSwapInfo) ProtoMessage() {}
 
-func (x *AnySwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[28]
+func (x *CombinedChannelSwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2441,230 +2612,328 @@ func (x *AnySwapInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AnySwapInfo.ProtoReflect.Descriptor instead.
-func (*AnySwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CombinedChannelSwapInfo.ProtoReflect.Descriptor instead.
+func (*CombinedChannelSwapInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AnySwapInfo) GetId() string {
+func (x *CombinedChannelSwapInfo) GetSwap() *SwapInfo {
 	if x != nil {
-		return x.Id
+		return x.Swap
 	}
-	return ""
+	return nil
 }
 
-func (x *AnySwapInfo) GetType() SwapType {
+func (x *CombinedChannelSwapInfo) GetChannelCreation() *ChannelCreationInfo {
 	if x != nil {
-		return x.Type
+		return x.ChannelCreation
 	}
-	return SwapType_SUBMARINE
+	return nil
 }
 
-func (x *AnySwapInfo) GetPair() *Pair {
+type ReverseSwapInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State SwapState `protobuf:"varint,2,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
+	Error string    `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Latest status message of the Boltz backend
+	Status              string       `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PrivateKey          string       `protobuf:"bytes,5,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Preimage            string       `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	RedeemScript        string       `protobuf:"bytes,7,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	Invoice             string       `protobuf:"bytes,8,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	ClaimAddress        string       `protobuf:"bytes,9,opt,name=claim_address,json=claimAddress,proto3" json:"claim_address,omitempty"`
+	OnchainAmount       uint64       `protobuf:"varint,10,opt,name=onchain_amount,json=onchainAmount,proto3" json:"onchain_amount,omitempty"`
+	InvoiceAmount       uint64       `protobuf:"varint,25,opt,name=invoice_amount,json=invoiceAmount,proto3" json:"invoice_amount,omitempty"`
+	TimeoutBlockHeight  uint32       `protobuf:"varint,11,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	LockupTransactionId string       `protobuf:"bytes,12,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3" json:"lockup_transaction_id,omitempty"`
+	ClaimTransactionId  string       `protobuf:"bytes,13,opt,name=claim_transaction_id,json=claimTransactionId,proto3" json:"claim_transaction_id,omitempty"`
+	Pair                *Pair        `protobuf:"bytes,14,opt,name=pair,proto3" json:"pair,omitempty"`
+	ChanIds             []*ChannelId `protobuf:"bytes,15,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	BlindingKey         *string      `protobuf:"bytes,16,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
+	CreatedAt           int64        `protobuf:"varint,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaidAt              *int64       `protobuf:"varint,23,opt,name=paid_at,json=paidAt,proto3,oneof" json:"paid_at,omitempty"` // the time when the invoice was paid
+	ServiceFee          *int64       `protobuf:"varint,18,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
+	OnchainFee          *uint64      `protobuf:"varint,19,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
+	RoutingFeeMsat      *uint64      `protobuf:"varint,20,opt,name=routing_fee_msat,json=routingFeeMsat,proto3,oneof" json:"routing_fee_msat,omitempty"`
+	ExternalPay         bool         `protobuf:"varint,21,opt,name=external_pay,json=externalPay,proto3" json:"external_pay,omitempty"`
+	TenantId            uint64       `protobuf:"varint,22,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	IsAuto              bool         `protobuf:"varint,24,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	// Name of the lightning node the swap is bound to, not set for the main node
+	LightningNode *string `protobuf:"bytes,26,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
+	// Attempts of the daemon to pay the invoice. Not populated by `ListSwaps`
+	PaymentAttempts []*PaymentAttempt `protobuf:"bytes,27,rep,name=payment_attempts,json=paymentAttempts,proto3" json:"payment_attempts,omitempty"`
+}
+
+func (x *ReverseSwapInfo) Reset() {
+	*x = ReverseSwapInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReverseSwapInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseSwapInfo) ProtoMessage() {}
+
+func (x *ReverseSwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseSwapInfo.ProtoReflect.Descriptor instead.
+func (*ReverseSwapInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReverseSwapInfo) GetId() string {
 	if x != nil {
-		return x.Pair
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *AnySwapInfo) GetState() SwapState {
+func (x *ReverseSwapInfo) GetState() SwapState {
 	if x != nil {
 		return x.State
 	}
 	return SwapState_PENDING
 }
 
-func (x *AnySwapInfo) GetError() string {
-	if x != nil && x.Error != nil {
-		return *x.Error
+func (x *ReverseSwapInfo) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *AnySwapInfo) GetStatus() string {
+func (x *ReverseSwapInfo) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *AnySwapInfo) GetFromAmount() uint64 {
+func (x *ReverseSwapInfo) GetPrivateKey() string {
 	if x != nil {
-		return x.FromAmount
+		return x.PrivateKey
 	}
-	return 0
+	return ""
 }
 
-func (x *AnySwapInfo) GetToAmount() uint64 {
+func (x *ReverseSwapInfo) GetPreimage() string {
 	if x != nil {
-		return x.ToAmount
+		return x.Preimage
 	}
-	return 0
+	return ""
 }
 
-func (x *AnySwapInfo) GetCreatedAt() int64 {
+func (x *ReverseSwapInfo) GetRedeemScript() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RedeemScript
 	}
-	return 0
+	return ""
 }
 
-func (x *AnySwapInfo) GetServiceFee() int64 {
-	if x != nil && x.ServiceFee != nil {
-		return *x.ServiceFee
+func (x *ReverseSwapInfo) GetInvoice() string {
+	if x != nil {
+		return x.Invoice
 	}
-	return 0
+	return ""
 }
 
-func (x *AnySwapInfo) GetOnchainFee() uint64 {
-	if x != nil && x.OnchainFee != nil {
-		return *x.OnchainFee
+func (x *ReverseSwapInfo) GetClaimAddress() string {
+	if x != nil {
+		return x.ClaimAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *AnySwapInfo) GetIsAuto() bool {
+func (x *ReverseSwapInfo) GetOnchainAmount() uint64 {
 	if x != nil {
-		return x.IsAuto
+		return x.OnchainAmount
 	}
-	return false
+	return 0
 }
 
-func (x *AnySwapInfo) GetTenantId() uint64 {
+func (x *ReverseSwapInfo) GetInvoiceAmount() uint64 {
 	if x != nil {
-		return x.TenantId
+		return x.InvoiceAmount
 	}
 	return 0
 }
 
-type ListSwapsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReverseSwapInfo) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
+}
 
-	From    *Currency    `protobuf:"varint,1,opt,name=from,proto3,enum=boltzrpc.Currency,oneof" json:"from,omitempty"`
-	To      *Currency    `protobuf:"varint,2,opt,name=to,proto3,enum=boltzrpc.Currency,oneof" json:"to,omitempty"`
-	State   *SwapState   `protobuf:"varint,4,opt,name=state,proto3,enum=boltzrpc.SwapState,oneof" json:"state,omitempty"`
-	Include IncludeSwaps `protobuf:"varint,5,opt,name=include,proto3,enum=boltzrpc.IncludeSwaps" json:"include,omitempty"`
-	Limit   *uint64      `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset  *uint64      `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	// whether to return swaps in the shared `all_swaps` list or in the detailed lists.
-	// the `limit` and `offset` are only considered when `unify` is true.
-	Unify *bool `protobuf:"varint,8,opt,name=unify,proto3,oneof" json:"unify,omitempty"`
+func (x *ReverseSwapInfo) GetLockupTransactionId() string {
+	if x != nil {
+		return x.LockupTransactionId
+	}
+	return ""
 }
 
-func (x *ListSwapsRequest) Reset() {
-	*x = ListSwapsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[29]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReverseSwapInfo) GetClaimTransactionId() string {
+	if x != nil {
+		return x.ClaimTransactionId
 	}
+	return ""
 }
 
-func (x *ListSwapsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReverseSwapInfo) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*ListSwapsRequest) ProtoMessage() {}
+func (x *ReverseSwapInfo) GetChanIds() []*ChannelId {
+	if x != nil {
+		return x.ChanIds
+	}
+	return nil
+}
 
-func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[29]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReverseSwapInfo) GetBlindingKey() string {
+	if x != nil && x.BlindingKey != nil {
+		return *x.BlindingKey
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{29}
+func (x *ReverseSwapInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *ListSwapsRequest) GetFrom() Currency {
-	if x != nil && x.From != nil {
-		return *x.From
+func (x *ReverseSwapInfo) GetPaidAt() int64 {
+	if x != nil && x.PaidAt != nil {
+		return *x.PaidAt
 	}
-	return Currency_BTC
+	return 0
 }
 
-func (x *ListSwapsRequest) GetTo() Currency {
-	if x != nil && x.To != nil {
-		return *x.To
+func (x *ReverseSwapInfo) GetServiceFee() int64 {
+	if x != nil && x.ServiceFee != nil {
+		return *x.ServiceFee
 	}
-	return Currency_BTC
+	return 0
 }
 
-func (x *ListSwapsRequest) GetState() SwapState {
-	if x != nil && x.State != nil {
-		return *x.State
+func (x *ReverseSwapInfo) GetOnchainFee() uint64 {
+	if x != nil && x.OnchainFee != nil {
+		return *x.OnchainFee
 	}
-	return SwapState_PENDING
+	return 0
 }
 
-func (x *ListSwapsRequest) GetInclude() IncludeSwaps {
-	if x != nil {
-		return x.Include
+func (x *ReverseSwapInfo) GetRoutingFeeMsat() uint64 {
+	if x != nil && x.RoutingFeeMsat != nil {
+		return *x.RoutingFeeMsat
 	}
-	return IncludeSwaps_ALL
+	return 0
 }
 
-func (x *ListSwapsRequest) GetLimit() uint64 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *ReverseSwapInfo) GetExternalPay() bool {
+	if x != nil {
+		return x.ExternalPay
 	}
-	return 0
+	return false
 }
 
-func (x *ListSwapsRequest) GetOffset() uint64 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+func (x *ReverseSwapInfo) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
 	}
 	return 0
 }
 
-func (x *ListSwapsRequest) GetUnify() bool {
-	if x != nil && x.Unify != nil {
-		return *x.Unify
+func (x *ReverseSwapInfo) GetIsAuto() bool {
+	if x != nil {
+		return x.IsAuto
 	}
 	return false
 }
 
-type ListSwapsResponse struct {
+func (x *ReverseSwapInfo) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+func (x *ReverseSwapInfo) GetPaymentAttempts() []*PaymentAttempt {
+	if x != nil {
+		return x.PaymentAttempts
+	}
+	return nil
+}
+
+// Single payment call of the daemon, which the lightning node can split into multiple HTLCs
+type PaymentAttempt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Swaps []*SwapInfo `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	ChannelCreations []*CombinedChannelSwapInfo `protobuf:"bytes,2,rep,name=channel_creations,json=channelCreations,proto3" json:"channel_creations,omitempty"`
-	ReverseSwaps     []*ReverseSwapInfo         `protobuf:"bytes,3,rep,name=reverse_swaps,json=reverseSwaps,proto3" json:"reverse_swaps,omitempty"`
-	ChainSwaps       []*ChainSwapInfo           `protobuf:"bytes,4,rep,name=chain_swaps,json=chainSwaps,proto3" json:"chain_swaps,omitempty"`
-	// populated when `unify` is set to true in the request
-	AllSwaps []*AnySwapInfo `protobuf:"bytes,5,rep,name=all_swaps,json=allSwaps,proto3" json:"all_swaps,omitempty"`
-}
-
-func (x *ListSwapsResponse) Reset() {
-	*x = ListSwapsResponse{}
+	// Number of the attempt, starting at 1
+	Attempt uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Fee limit of the attempt in satoshis
+	FeeLimit uint64  `protobuf:"varint,2,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	MaxParts *uint32 `protobuf:"varint,3,opt,name=max_parts,json=maxParts,proto3,oneof" json:"max_parts,omitempty"`
+	// One of `pending`, `succeeded` or `failed`
+	State     string  `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	FeeMsat   *uint64 `protobuf:"varint,5,opt,name=fee_msat,json=feeMsat,proto3,oneof" json:"fee_msat,omitempty"`
+	Error     string  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt int64   `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SwapId    string  `protobuf:"bytes,8,opt,name=swap_id,json=swapId,proto3" json:"swap_id,omitempty"`
+	// Whether the invoice of the client was paid by Boltz instead of the client paying an invoice
+	Incoming bool `protobuf:"varint,9,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// Amount of the invoice in satoshis
+	Amount uint64 `protobuf:"varint,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Reason the lightning node reported for the failure of the payment
+	FailureReason string `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Route of the HTLC which settled the payment or, if none did, of the latest HTLC.
+	// Only reported by LND, empty for other nodes
+	Hops []*PaymentHop `protobuf:"bytes,12,rep,name=hops,proto3" json:"hops,omitempty"`
+}
+
+func (x *PaymentAttempt) Reset() {
+	*x = PaymentAttempt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[30]
+		mi := &file_boltzrpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsResponse) String() string {
+func (x *PaymentAttempt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsResponse) ProtoMessage() {}
+func (*PaymentAttempt) ProtoMessage() {}
 
-func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[30]
+func (x *PaymentAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2675,72 +2944,125 @@ func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use PaymentAttempt.ProtoReflect.Descriptor instead.
+func (*PaymentAttempt) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListSwapsResponse) GetSwaps() []*SwapInfo {
+func (x *PaymentAttempt) GetAttempt() uint32 {
 	if x != nil {
-		return x.Swaps
+		return x.Attempt
 	}
-	return nil
+	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *ListSwapsResponse) GetChannelCreations() []*CombinedChannelSwapInfo {
+func (x *PaymentAttempt) GetFeeLimit() uint64 {
 	if x != nil {
-		return x.ChannelCreations
+		return x.FeeLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *ListSwapsResponse) GetReverseSwaps() []*ReverseSwapInfo {
+func (x *PaymentAttempt) GetMaxParts() uint32 {
+	if x != nil && x.MaxParts != nil {
+		return *x.MaxParts
+	}
+	return 0
+}
+
+func (x *PaymentAttempt) GetState() string {
 	if x != nil {
-		return x.ReverseSwaps
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSwapsResponse) GetChainSwaps() []*ChainSwapInfo {
+func (x *PaymentAttempt) GetFeeMsat() uint64 {
+	if x != nil && x.FeeMsat != nil {
+		return *x.FeeMsat
+	}
+	return 0
+}
+
+func (x *PaymentAttempt) GetError() string {
 	if x != nil {
-		return x.ChainSwaps
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSwapsResponse) GetAllSwaps() []*AnySwapInfo {
+func (x *PaymentAttempt) GetCreatedAt() int64 {
 	if x != nil {
-		return x.AllSwaps
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PaymentAttempt) GetSwapId() string {
+	if x != nil {
+		return x.SwapId
+	}
+	return ""
+}
+
+func (x *PaymentAttempt) GetIncoming() bool {
+	if x != nil {
+		return x.Incoming
+	}
+	return false
+}
+
+func (x *PaymentAttempt) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentAttempt) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *PaymentAttempt) GetHops() []*PaymentHop {
+	if x != nil {
+		return x.Hops
 	}
 	return nil
 }
 
-type GetStatsRequest struct {
+type PaymentHop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Include IncludeSwaps `protobuf:"varint,1,opt,name=include,proto3,enum=boltzrpc.IncludeSwaps" json:"include,omitempty"`
+	ChanId     *ChannelId `protobuf:"bytes,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	Pubkey     string     `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	AmountMsat uint64     `protobuf:"varint,3,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	FeeMsat    uint64     `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// Error returned by the node of the hop if the payment failed there
+	Failure *string `protobuf:"bytes,5,opt,name=failure,proto3,oneof" json:"failure,omitempty"`
 }
 
-func (x *GetStatsRequest) Reset() {
-	*x = GetStatsRequest{}
+func (x *PaymentHop) Reset() {
+	*x = PaymentHop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[31]
+		mi := &file_boltzrpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsRequest) String() string {
+func (x *PaymentHop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsRequest) ProtoMessage() {}
+func (*PaymentHop) ProtoMessage() {}
 
-func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[31]
+func (x *PaymentHop) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,43 +3073,73 @@ func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PaymentHop.ProtoReflect.Descriptor instead.
+func (*PaymentHop) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetStatsRequest) GetInclude() IncludeSwaps {
+func (x *PaymentHop) GetChanId() *ChannelId {
 	if x != nil {
-		return x.Include
+		return x.ChanId
 	}
-	return IncludeSwaps_ALL
+	return nil
 }
 
-type GetStatsResponse struct {
+func (x *PaymentHop) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *PaymentHop) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *PaymentHop) GetFeeMsat() uint64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+func (x *PaymentHop) GetFailure() string {
+	if x != nil && x.Failure != nil {
+		return *x.Failure
+	}
+	return ""
+}
+
+type ListSwapPaymentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stats *SwapStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	SwapId *string `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,oneof" json:"swap_id,omitempty"`
+	Limit  *uint64 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset *uint64 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
 }
 
-func (x *GetStatsResponse) Reset() {
-	*x = GetStatsResponse{}
+func (x *ListSwapPaymentsRequest) Reset() {
+	*x = ListSwapPaymentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[32]
+		mi := &file_boltzrpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStatsResponse) String() string {
+func (x *ListSwapPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsResponse) ProtoMessage() {}
+func (*ListSwapPaymentsRequest) ProtoMessage() {}
 
-func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[32]
+func (x *ListSwapPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2798,51 +3150,57 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListSwapPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetStatsResponse) GetStats() *SwapStats {
-	if x != nil {
-		return x.Stats
+func (x *ListSwapPaymentsRequest) GetSwapId() string {
+	if x != nil && x.SwapId != nil {
+		return *x.SwapId
 	}
-	return nil
+	return ""
 }
 
-type RefundSwapRequest struct {
+func (x *ListSwapPaymentsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListSwapPaymentsRequest) GetOffset() uint64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type ListSwapPaymentsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are assignable to Destination:
-	//
-	//	*RefundSwapRequest_Address
-	//	*RefundSwapRequest_WalletId
-	Destination isRefundSwapRequest_Destination `protobuf_oneof:"destination"`
-	// Manually specify the lockup transaction of the swap to be refunded.
-	// This can be used in cases where the client missed the lockup of the swap or a swap received multiple lockups.
-	LockupTransactionId *string `protobuf:"bytes,4,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3,oneof" json:"lockup_transaction_id,omitempty"`
+	Payments []*PaymentAttempt `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
 }
 
-func (x *RefundSwapRequest) Reset() {
-	*x = RefundSwapRequest{}
+func (x *ListSwapPaymentsResponse) Reset() {
+	*x = ListSwapPaymentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[33]
+		mi := &file_boltzrpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefundSwapRequest) String() string {
+func (x *ListSwapPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundSwapRequest) ProtoMessage() {}
+func (*ListSwapPaymentsResponse) ProtoMessage() {}
 
-func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[33]
+func (x *ListSwapPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,92 +3211,44 @@ func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundSwapRequest.ProtoReflect.Descriptor instead.
-func (*RefundSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListSwapPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RefundSwapRequest) GetId() string {
+func (x *ListSwapPaymentsResponse) GetPayments() []*PaymentAttempt {
 	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (m *RefundSwapRequest) GetDestination() isRefundSwapRequest_Destination {
-	if m != nil {
-		return m.Destination
+		return x.Payments
 	}
 	return nil
 }
 
-func (x *RefundSwapRequest) GetAddress() string {
-	if x, ok := x.GetDestination().(*RefundSwapRequest_Address); ok {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *RefundSwapRequest) GetWalletId() uint64 {
-	if x, ok := x.GetDestination().(*RefundSwapRequest_WalletId); ok {
-		return x.WalletId
-	}
-	return 0
-}
-
-func (x *RefundSwapRequest) GetLockupTransactionId() string {
-	if x != nil && x.LockupTransactionId != nil {
-		return *x.LockupTransactionId
-	}
-	return ""
-}
-
-type isRefundSwapRequest_Destination interface {
-	isRefundSwapRequest_Destination()
-}
-
-type RefundSwapRequest_Address struct {
-	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
-}
-
-type RefundSwapRequest_WalletId struct {
-	WalletId uint64 `protobuf:"varint,3,opt,name=wallet_id,json=walletId,proto3,oneof"`
-}
-
-func (*RefundSwapRequest_Address) isRefundSwapRequest_Destination() {}
-
-func (*RefundSwapRequest_WalletId) isRefundSwapRequest_Destination() {}
-
-type ClaimSwapsRequest struct {
+type BlockHeights struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SwapIds []string `protobuf:"bytes,1,rep,name=swap_ids,json=swapIds,proto3" json:"swap_ids,omitempty"`
-	// Types that are assignable to Destination:
-	//
-	//	*ClaimSwapsRequest_Address
-	//	*ClaimSwapsRequest_WalletId
-	Destination isClaimSwapsRequest_Destination `protobuf_oneof:"destination"`
+	Btc    uint32  `protobuf:"varint,1,opt,name=btc,proto3" json:"btc,omitempty"`
+	Liquid *uint32 `protobuf:"varint,2,opt,name=liquid,proto3,oneof" json:"liquid,omitempty"`
 }
 
-func (x *ClaimSwapsRequest) Reset() {
-	*x = ClaimSwapsRequest{}
+func (x *BlockHeights) Reset() {
+	*x = BlockHeights{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[34]
+		mi := &file_boltzrpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClaimSwapsRequest) String() string {
+func (x *BlockHeights) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimSwapsRequest) ProtoMessage() {}
+func (*BlockHeights) ProtoMessage() {}
 
-func (x *ClaimSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[34]
+func (x *BlockHeights) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2949,80 +3259,48 @@ func (x *ClaimSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ClaimSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use BlockHeights.ProtoReflect.Descriptor instead.
+func (*BlockHeights) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ClaimSwapsRequest) GetSwapIds() []string {
+func (x *BlockHeights) GetBtc() uint32 {
 	if x != nil {
-		return x.SwapIds
-	}
-	return nil
-}
-
-func (m *ClaimSwapsRequest) GetDestination() isClaimSwapsRequest_Destination {
-	if m != nil {
-		return m.Destination
-	}
-	return nil
-}
-
-func (x *ClaimSwapsRequest) GetAddress() string {
-	if x, ok := x.GetDestination().(*ClaimSwapsRequest_Address); ok {
-		return x.Address
+		return x.Btc
 	}
-	return ""
+	return 0
 }
 
-func (x *ClaimSwapsRequest) GetWalletId() uint64 {
-	if x, ok := x.GetDestination().(*ClaimSwapsRequest_WalletId); ok {
-		return x.WalletId
+func (x *BlockHeights) GetLiquid() uint32 {
+	if x != nil && x.Liquid != nil {
+		return *x.Liquid
 	}
 	return 0
 }
 
-type isClaimSwapsRequest_Destination interface {
-	isClaimSwapsRequest_Destination()
-}
-
-type ClaimSwapsRequest_Address struct {
-	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
-}
-
-type ClaimSwapsRequest_WalletId struct {
-	WalletId uint64 `protobuf:"varint,3,opt,name=wallet_id,json=walletId,proto3,oneof"`
-}
-
-func (*ClaimSwapsRequest_Address) isClaimSwapsRequest_Destination() {}
-
-func (*ClaimSwapsRequest_WalletId) isClaimSwapsRequest_Destination() {}
-
-type ClaimSwapsResponse struct {
+type GetInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *ClaimSwapsResponse) Reset() {
-	*x = ClaimSwapsResponse{}
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[35]
+		mi := &file_boltzrpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ClaimSwapsResponse) String() string {
+func (x *GetInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClaimSwapsResponse) ProtoMessage() {}
+func (*GetInfoRequest) ProtoMessage() {}
 
-func (x *ClaimSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[35]
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3033,49 +3311,63 @@ func (x *ClaimSwapsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClaimSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ClaimSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *ClaimSwapsResponse) GetTransactionId() string {
-	if x != nil {
-		return x.TransactionId
-	}
-	return ""
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{39}
 }
 
-type GetSwapInfoRequest struct {
+type GetInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	Version    string `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
+	Node       string `protobuf:"bytes,10,opt,name=node,proto3" json:"node,omitempty"`
+	Network    string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	NodePubkey string `protobuf:"bytes,7,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// one of: running, disabled, error
+	AutoSwapStatus string `protobuf:"bytes,11,opt,name=auto_swap_status,json=autoSwapStatus,proto3" json:"auto_swap_status,omitempty"`
+	// mapping of the currency to the latest block height.
+	BlockHeights *BlockHeights `protobuf:"bytes,8,opt,name=block_heights,json=blockHeights,proto3" json:"block_heights,omitempty"`
+	// swaps that need a manual interaction to refund
+	RefundableSwaps []string `protobuf:"bytes,12,rep,name=refundable_swaps,json=refundableSwaps,proto3" json:"refundable_swaps,omitempty"`
+	// the currently authenticated tenant
+	Tenant *Tenant `protobuf:"bytes,13,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// swaps that need a manual interaction to claim
+	ClaimableSwaps []string `protobuf:"bytes,14,rep,name=claimable_swaps,json=claimableSwaps,proto3" json:"claimable_swaps,omitempty"`
+	// startup warnings that require user attention
+	Warnings []string `protobuf:"bytes,15,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// names of the additional lightning nodes which can be selected when creating swaps
+	LightningNodes []string `protobuf:"bytes,16,rep,name=lightning_nodes,json=lightningNodes,proto3" json:"lightning_nodes,omitempty"`
 	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are assignable to Identifier:
-	//
-	//	*GetSwapInfoRequest_SwapId
-	//	*GetSwapInfoRequest_PaymentHash
-	Identifier isGetSwapInfoRequest_Identifier `protobuf_oneof:"identifier"`
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	LndPubkey string `protobuf:"bytes,3,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	BlockHeight uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	PendingSwaps []string `protobuf:"bytes,5,rep,name=pending_swaps,json=pendingSwaps,proto3" json:"pending_swaps,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	PendingReverseSwaps []string `protobuf:"bytes,6,rep,name=pending_reverse_swaps,json=pendingReverseSwaps,proto3" json:"pending_reverse_swaps,omitempty"`
 }
 
-func (x *GetSwapInfoRequest) Reset() {
-	*x = GetSwapInfoRequest{}
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[36]
+		mi := &file_boltzrpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSwapInfoRequest) String() string {
+func (x *GetInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSwapInfoRequest) ProtoMessage() {}
+func (*GetInfoResponse) ProtoMessage() {}
 
-func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[36]
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3086,155 +3378,155 @@ func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSwapInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSwapInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{40}
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetSwapInfoRequest) GetId() string {
+func (x *GetInfoResponse) GetVersion() string {
 	if x != nil {
-		return x.Id
+		return x.Version
 	}
 	return ""
 }
 
-func (m *GetSwapInfoRequest) GetIdentifier() isGetSwapInfoRequest_Identifier {
-	if m != nil {
-		return m.Identifier
+func (x *GetInfoResponse) GetNode() string {
+	if x != nil {
+		return x.Node
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSwapInfoRequest) GetSwapId() string {
-	if x, ok := x.GetIdentifier().(*GetSwapInfoRequest_SwapId); ok {
-		return x.SwapId
+func (x *GetInfoResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
 	}
 	return ""
 }
 
-func (x *GetSwapInfoRequest) GetPaymentHash() []byte {
-	if x, ok := x.GetIdentifier().(*GetSwapInfoRequest_PaymentHash); ok {
-		return x.PaymentHash
+func (x *GetInfoResponse) GetNodePubkey() string {
+	if x != nil {
+		return x.NodePubkey
 	}
-	return nil
+	return ""
 }
 
-type isGetSwapInfoRequest_Identifier interface {
-	isGetSwapInfoRequest_Identifier()
+func (x *GetInfoResponse) GetAutoSwapStatus() string {
+	if x != nil {
+		return x.AutoSwapStatus
+	}
+	return ""
 }
 
-type GetSwapInfoRequest_SwapId struct {
-	SwapId string `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,oneof"`
+func (x *GetInfoResponse) GetBlockHeights() *BlockHeights {
+	if x != nil {
+		return x.BlockHeights
+	}
+	return nil
 }
 
-type GetSwapInfoRequest_PaymentHash struct {
-	// Only implemented for submarine swaps
-	PaymentHash []byte `protobuf:"bytes,3,opt,name=payment_hash,json=paymentHash,proto3,oneof"`
+func (x *GetInfoResponse) GetRefundableSwaps() []string {
+	if x != nil {
+		return x.RefundableSwaps
+	}
+	return nil
 }
 
-func (*GetSwapInfoRequest_SwapId) isGetSwapInfoRequest_Identifier() {}
-
-func (*GetSwapInfoRequest_PaymentHash) isGetSwapInfoRequest_Identifier() {}
-
-type GetSwapInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Swap            *SwapInfo            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
-	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,2,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
-	ReverseSwap     *ReverseSwapInfo     `protobuf:"bytes,3,opt,name=reverse_swap,json=reverseSwap,proto3" json:"reverse_swap,omitempty"`
-	ChainSwap       *ChainSwapInfo       `protobuf:"bytes,4,opt,name=chain_swap,json=chainSwap,proto3" json:"chain_swap,omitempty"`
+func (x *GetInfoResponse) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
 }
 
-func (x *GetSwapInfoResponse) Reset() {
-	*x = GetSwapInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetInfoResponse) GetClaimableSwaps() []string {
+	if x != nil {
+		return x.ClaimableSwaps
 	}
+	return nil
 }
 
-func (x *GetSwapInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetInfoResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
 }
 
-func (*GetSwapInfoResponse) ProtoMessage() {}
-
-func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetInfoResponse) GetLightningNodes() []string {
+	if x != nil {
+		return x.LightningNodes
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetSwapInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetSwapInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
 }
 
-func (x *GetSwapInfoResponse) GetSwap() *SwapInfo {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetLndPubkey() string {
 	if x != nil {
-		return x.Swap
+		return x.LndPubkey
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSwapInfoResponse) GetChannelCreation() *ChannelCreationInfo {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetBlockHeight() uint32 {
 	if x != nil {
-		return x.ChannelCreation
+		return x.BlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSwapInfoResponse) GetReverseSwap() *ReverseSwapInfo {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetPendingSwaps() []string {
 	if x != nil {
-		return x.ReverseSwap
+		return x.PendingSwaps
 	}
 	return nil
 }
 
-func (x *GetSwapInfoResponse) GetChainSwap() *ChainSwapInfo {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetPendingReverseSwaps() []string {
 	if x != nil {
-		return x.ChainSwap
+		return x.PendingReverseSwaps
 	}
 	return nil
 }
 
-type DepositRequest struct {
+type Limits struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Percentage of inbound liquidity the channel that is opened in case the invoice cannot be paid should have.
-	// 25 by default.
-	InboundLiquidity uint32 `protobuf:"varint,1,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	Minimal               uint64 `protobuf:"varint,1,opt,name=minimal,proto3" json:"minimal,omitempty"`
+	Maximal               uint64 `protobuf:"varint,2,opt,name=maximal,proto3" json:"maximal,omitempty"`
+	MaximalZeroConfAmount uint64 `protobuf:"varint,3,opt,name=maximal_zero_conf_amount,json=maximalZeroConfAmount,proto3" json:"maximal_zero_conf_amount,omitempty"`
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
+func (x *Limits) Reset() {
+	*x = Limits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[38]
+		mi := &file_boltzrpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositRequest) String() string {
+func (x *Limits) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*Limits) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[38]
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3245,45 +3537,58 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DepositRequest) GetInboundLiquidity() uint32 {
+func (x *Limits) GetMinimal() uint64 {
 	if x != nil {
-		return x.InboundLiquidity
+		return x.Minimal
 	}
 	return 0
 }
 
-type DepositResponse struct {
+func (x *Limits) GetMaximal() uint64 {
+	if x != nil {
+		return x.Maximal
+	}
+	return 0
+}
+
+func (x *Limits) GetMaximalZeroConfAmount() uint64 {
+	if x != nil {
+		return x.MaximalZeroConfAmount
+	}
+	return 0
+}
+
+type SwapFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	TimeoutBlockHeight uint32 `protobuf:"varint,3,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	Percentage float64 `protobuf:"fixed64,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	MinerFees  uint64  `protobuf:"varint,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
+func (x *SwapFees) Reset() {
+	*x = SwapFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[39]
+		mi := &file_boltzrpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositResponse) String() string {
+func (x *SwapFees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositResponse) ProtoMessage() {}
+func (*SwapFees) ProtoMessage() {}
 
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[39]
+func (x *SwapFees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,81 +3599,52 @@ func (x *DepositResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *DepositResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use SwapFees.ProtoReflect.Descriptor instead.
+func (*SwapFees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DepositResponse) GetAddress() string {
+func (x *SwapFees) GetPercentage() float64 {
 	if x != nil {
-		return x.Address
+		return x.Percentage
 	}
-	return ""
+	return 0
 }
 
-func (x *DepositResponse) GetTimeoutBlockHeight() uint32 {
+func (x *SwapFees) GetMinerFees() uint64 {
 	if x != nil {
-		return x.TimeoutBlockHeight
+		return x.MinerFees
 	}
 	return 0
 }
 
-type CreateSwapRequest struct {
+type GetPairsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// amount of sats to be received on lightning.
-	// related: `invoice` field
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Pair   *Pair  `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	// the daemon will pay the swap using the onchain wallet specified in the `wallet` field
-	// or the first internal wallet with the correct currency otherwise.
-	SendFromInternal bool `protobuf:"varint,4,opt,name=send_from_internal,json=sendFromInternal,proto3" json:"send_from_internal,omitempty"`
-	// address where the coins should go if the swap fails. Refunds will go to any of the daemons wallets otherwise.
-	RefundAddress *string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
-	// wallet to pay swap from. only used if `send_from_internal` is set to true
-	WalletId *uint64 `protobuf:"varint,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
-	// bolt11 invoice, lnurl, or lnaddress to use for the swap.
-	// required in standalone mode.
-	// when connected to a lightning node, a new invoice for `amount` sats will be fetched
-	// the `amount` field has to be populated in case of a lnurl and lnaddress
-	Invoice *string `protobuf:"bytes,7,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	ZeroConf *bool `protobuf:"varint,8,opt,name=zero_conf,json=zeroConf,proto3,oneof" json:"zero_conf,omitempty"`
-	// Fee rate to use when sending from internal wallet
-	SatPerVbyte *float64 `protobuf:"fixed64,9,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
-	// Rates to accept for the swap. Queries latest from boltz otherwise
-	// The recommended way to use this is to pass a user approved value from a previous `GetPairInfo` call
-	AcceptedPair *PairInfo `protobuf:"bytes,10,opt,name=accepted_pair,json=acceptedPair,proto3,oneof" json:"accepted_pair,omitempty"`
-	// Ignore any magic routing hints found in the specified `invoice`.
-	IgnoreMrh *bool `protobuf:"varint,11,opt,name=ignore_mrh,json=ignoreMrh,proto3,oneof" json:"ignore_mrh,omitempty"`
+	Submarine []*PairInfo `protobuf:"bytes,1,rep,name=submarine,proto3" json:"submarine,omitempty"`
+	Reverse   []*PairInfo `protobuf:"bytes,2,rep,name=reverse,proto3" json:"reverse,omitempty"`
+	Chain     []*PairInfo `protobuf:"bytes,3,rep,name=chain,proto3" json:"chain,omitempty"`
 }
 
-func (x *CreateSwapRequest) Reset() {
-	*x = CreateSwapRequest{}
+func (x *GetPairsResponse) Reset() {
+	*x = GetPairsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[40]
+		mi := &file_boltzrpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSwapRequest) String() string {
+func (x *GetPairsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSwapRequest) ProtoMessage() {}
+func (*GetPairsResponse) ProtoMessage() {}
 
-func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[40]
+func (x *GetPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3379,114 +3655,113 @@ func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetPairsResponse.ProtoReflect.Descriptor instead.
+func (*GetPairsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreateSwapRequest) GetAmount() uint64 {
+func (x *GetPairsResponse) GetSubmarine() []*PairInfo {
 	if x != nil {
-		return x.Amount
+		return x.Submarine
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateSwapRequest) GetPair() *Pair {
+func (x *GetPairsResponse) GetReverse() []*PairInfo {
 	if x != nil {
-		return x.Pair
+		return x.Reverse
 	}
 	return nil
 }
 
-func (x *CreateSwapRequest) GetSendFromInternal() bool {
+func (x *GetPairsResponse) GetChain() []*PairInfo {
 	if x != nil {
-		return x.SendFromInternal
+		return x.Chain
 	}
-	return false
+	return nil
 }
 
-func (x *CreateSwapRequest) GetRefundAddress() string {
-	if x != nil && x.RefundAddress != nil {
-		return *x.RefundAddress
-	}
-	return ""
+type MinerFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Normal  uint32 `protobuf:"varint,1,opt,name=normal,proto3" json:"normal,omitempty"`
+	Reverse uint32 `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
 }
 
-func (x *CreateSwapRequest) GetWalletId() uint64 {
-	if x != nil && x.WalletId != nil {
-		return *x.WalletId
+func (x *MinerFees) Reset() {
+	*x = MinerFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *CreateSwapRequest) GetInvoice() string {
-	if x != nil && x.Invoice != nil {
-		return *x.Invoice
-	}
-	return ""
+func (x *MinerFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *CreateSwapRequest) GetZeroConf() bool {
-	if x != nil && x.ZeroConf != nil {
-		return *x.ZeroConf
+func (*MinerFees) ProtoMessage() {}
+
+func (x *MinerFees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSwapRequest) GetSatPerVbyte() float64 {
-	if x != nil && x.SatPerVbyte != nil {
-		return *x.SatPerVbyte
-	}
-	return 0
+// Deprecated: Use MinerFees.ProtoReflect.Descriptor instead.
+func (*MinerFees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreateSwapRequest) GetAcceptedPair() *PairInfo {
+func (x *MinerFees) GetNormal() uint32 {
 	if x != nil {
-		return x.AcceptedPair
+		return x.Normal
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateSwapRequest) GetIgnoreMrh() bool {
-	if x != nil && x.IgnoreMrh != nil {
-		return *x.IgnoreMrh
+func (x *MinerFees) GetReverse() uint32 {
+	if x != nil {
+		return x.Reverse
 	}
-	return false
+	return 0
 }
 
-type CreateSwapResponse struct {
+type Fees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	ExpectedAmount uint64 `protobuf:"varint,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
-	Bip21          string `protobuf:"bytes,4,opt,name=bip21,proto3" json:"bip21,omitempty"`
-	// lockup transaction id. Only populated when `send_from_internal` was specified in the request
-	TxId               string  `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	TimeoutBlockHeight uint32  `protobuf:"varint,6,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
-	TimeoutHours       float32 `protobuf:"fixed32,7,opt,name=timeout_hours,json=timeoutHours,proto3" json:"timeout_hours,omitempty"`
+	Percentage float32    `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Miner      *MinerFees `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
 }
 
-func (x *CreateSwapResponse) Reset() {
-	*x = CreateSwapResponse{}
+func (x *Fees) Reset() {
+	*x = Fees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[41]
+		mi := &file_boltzrpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSwapResponse) String() string {
+func (x *Fees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSwapResponse) ProtoMessage() {}
+func (*Fees) ProtoMessage() {}
 
-func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[41]
+func (x *Fees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,89 +3772,89 @@ func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSwapResponse.ProtoReflect.Descriptor instead.
-func (*CreateSwapResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use Fees.ProtoReflect.Descriptor instead.
+func (*Fees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateSwapResponse) GetId() string {
+func (x *Fees) GetPercentage() float32 {
 	if x != nil {
-		return x.Id
+		return x.Percentage
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSwapResponse) GetAddress() string {
+func (x *Fees) GetMiner() *MinerFees {
 	if x != nil {
-		return x.Address
+		return x.Miner
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSwapResponse) GetExpectedAmount() uint64 {
-	if x != nil {
-		return x.ExpectedAmount
-	}
-	return 0
+type GetServiceInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateSwapResponse) GetBip21() string {
-	if x != nil {
-		return x.Bip21
+func (x *GetServiceInfoRequest) Reset() {
+	*x = GetServiceInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateSwapResponse) GetTxId() string {
-	if x != nil {
-		return x.TxId
-	}
-	return ""
+func (x *GetServiceInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CreateSwapResponse) GetTimeoutBlockHeight() uint32 {
-	if x != nil {
-		return x.TimeoutBlockHeight
+func (*GetServiceInfoRequest) ProtoMessage() {}
+
+func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSwapResponse) GetTimeoutHours() float32 {
-	if x != nil {
-		return x.TimeoutHours
-	}
-	return 0
+// Deprecated: Use GetServiceInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{46}
 }
 
-type CreateChannelRequest struct {
+type GetServiceInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Percentage of inbound liquidity the channel that is opened should have.
-	// 25 by default.
-	InboundLiquidity uint32 `protobuf:"varint,2,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
-	Private          bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	Fees   *Fees   `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees,omitempty"`
+	Limits *Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
+func (x *GetServiceInfoResponse) Reset() {
+	*x = GetServiceInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[42]
+		mi := &file_boltzrpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *GetServiceInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*GetServiceInfoResponse) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[42]
+func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3590,86 +3865,66 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *CreateChannelRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+// Deprecated: Use GetServiceInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceInfoResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateChannelRequest) GetInboundLiquidity() uint32 {
+func (x *GetServiceInfoResponse) GetFees() *Fees {
 	if x != nil {
-		return x.InboundLiquidity
+		return x.Fees
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateChannelRequest) GetPrivate() bool {
+func (x *GetServiceInfoResponse) GetLimits() *Limits {
 	if x != nil {
-		return x.Private
+		return x.Limits
 	}
-	return false
+	return nil
 }
 
-type CreateReverseSwapRequest struct {
+type AnySwapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// amount of satoshis to swap
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// If no value is set, the daemon will query a new address from the lightning node
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// Whether the daemon should broadcast the claim transaction immediately after the lockup transaction is in the mempool.
-	// Should only be used for smaller amounts as it involves trust in boltz.
-	AcceptZeroConf bool  `protobuf:"varint,3,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
-	Pair           *Pair `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	// a list of channel ids which are allowed for paying the invoice. can be in either cln or lnd style.
-	ChanIds []string `protobuf:"bytes,5,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
-	// wallet from which the onchain address should be generated - only considered if `address` is not set
-	WalletId *uint64 `protobuf:"varint,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
-	// Whether the daemon should return immediately after creating the swap or wait until the swap is successful or failed.
-	// It will always return immediately if `accept_zero_conf` is not set.
-	ReturnImmediately *bool `protobuf:"varint,7,opt,name=return_immediately,json=returnImmediately,proto3,oneof" json:"return_immediately,omitempty"`
-	// If set, the daemon will not pay the invoice of the swap and return the invoice to be paid. This implicitly sets `return_immediately` to true.
-	ExternalPay *bool `protobuf:"varint,8,opt,name=external_pay,json=externalPay,proto3,oneof" json:"external_pay,omitempty"`
-	// Description of the invoice which will be created for the swap
-	Description *string `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	// Description hash of the invoice which will be created for the swap. Takes precedence over `description`
-	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,json=descriptionHash,proto3,oneof" json:"description_hash,omitempty"`
-	// Expiry of the reverse swap invoice in seconds
-	InvoiceExpiry *uint64 `protobuf:"varint,11,opt,name=invoice_expiry,json=invoiceExpiry,proto3,oneof" json:"invoice_expiry,omitempty"`
-	// Rates to accept for the swap. Queries latest from boltz otherwise
-	// The recommended way to use this is to pass a user approved value from a previous `GetPairInfo` call
-	AcceptedPair *PairInfo `protobuf:"bytes,12,opt,name=accepted_pair,json=acceptedPair,proto3,oneof" json:"accepted_pair,omitempty"`
-	// The routing fee limit for paying the lightning invoice in ppm (parts per million)
-	RoutingFeeLimitPpm *uint64 `protobuf:"varint,13,opt,name=routing_fee_limit_ppm,json=routingFeeLimitPpm,proto3,oneof" json:"routing_fee_limit_ppm,omitempty"`
-	// add a magic routing hint to the lightning invoice if `external_pay` is true and an internal `wallet` is used.
-	AddMagicRoutingHint *bool `protobuf:"varint,14,opt,name=add_magic_routing_hint,json=addMagicRoutingHint,proto3,oneof" json:"add_magic_routing_hint,omitempty"`
+	Id     string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type   SwapType  `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Pair   *Pair     `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	State  SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
+	Error  *string   `protobuf:"bytes,5,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Status string    `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// The expected amount to be sent to the lockup address for submarine and chain swaps and
+	// the invoice amount for reverse swaps.
+	FromAmount uint64 `protobuf:"varint,7,opt,name=from_amount,json=fromAmount,proto3" json:"from_amount,omitempty"`
+	// `from_amount` minus the service and network fee.
+	ToAmount   uint64 `protobuf:"varint,13,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ServiceFee *int64 `protobuf:"varint,9,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
+	// includes the routing fee for reverse swaps
+	OnchainFee *uint64 `protobuf:"varint,10,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
+	IsAuto     bool    `protobuf:"varint,11,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	TenantId   uint64  `protobuf:"varint,12,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
 }
 
-func (x *CreateReverseSwapRequest) Reset() {
-	*x = CreateReverseSwapRequest{}
+func (x *AnySwapInfo) Reset() {
+	*x = AnySwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[43]
+		mi := &file_boltzrpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReverseSwapRequest) String() string {
+func (x *AnySwapInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReverseSwapRequest) ProtoMessage() {}
+func (*AnySwapInfo) ProtoMessage() {}
 
-func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[43]
+func (x *AnySwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3680,141 +3935,135 @@ func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReverseSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateReverseSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use AnySwapInfo.ProtoReflect.Descriptor instead.
+func (*AnySwapInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreateReverseSwapRequest) GetAmount() uint64 {
+func (x *AnySwapInfo) GetId() string {
 	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreateReverseSwapRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateReverseSwapRequest) GetAcceptZeroConf() bool {
+func (x *AnySwapInfo) GetType() SwapType {
 	if x != nil {
-		return x.AcceptZeroConf
+		return x.Type
 	}
-	return false
+	return SwapType_SUBMARINE
 }
 
-func (x *CreateReverseSwapRequest) GetPair() *Pair {
+func (x *AnySwapInfo) GetPair() *Pair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *CreateReverseSwapRequest) GetChanIds() []string {
+func (x *AnySwapInfo) GetState() SwapState {
 	if x != nil {
-		return x.ChanIds
+		return x.State
 	}
-	return nil
+	return SwapState_PENDING
 }
 
-func (x *CreateReverseSwapRequest) GetWalletId() uint64 {
-	if x != nil && x.WalletId != nil {
-		return *x.WalletId
+func (x *AnySwapInfo) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateReverseSwapRequest) GetReturnImmediately() bool {
-	if x != nil && x.ReturnImmediately != nil {
-		return *x.ReturnImmediately
+func (x *AnySwapInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-func (x *CreateReverseSwapRequest) GetExternalPay() bool {
-	if x != nil && x.ExternalPay != nil {
-		return *x.ExternalPay
+func (x *AnySwapInfo) GetFromAmount() uint64 {
+	if x != nil {
+		return x.FromAmount
 	}
-	return false
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *AnySwapInfo) GetToAmount() uint64 {
+	if x != nil {
+		return x.ToAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetDescriptionHash() []byte {
+func (x *AnySwapInfo) GetCreatedAt() int64 {
 	if x != nil {
-		return x.DescriptionHash
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetInvoiceExpiry() uint64 {
-	if x != nil && x.InvoiceExpiry != nil {
-		return *x.InvoiceExpiry
+func (x *AnySwapInfo) GetServiceFee() int64 {
+	if x != nil && x.ServiceFee != nil {
+		return *x.ServiceFee
 	}
 	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetAcceptedPair() *PairInfo {
-	if x != nil {
-		return x.AcceptedPair
+func (x *AnySwapInfo) GetOnchainFee() uint64 {
+	if x != nil && x.OnchainFee != nil {
+		return *x.OnchainFee
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetRoutingFeeLimitPpm() uint64 {
-	if x != nil && x.RoutingFeeLimitPpm != nil {
-		return *x.RoutingFeeLimitPpm
+func (x *AnySwapInfo) GetIsAuto() bool {
+	if x != nil {
+		return x.IsAuto
 	}
-	return 0
+	return false
 }
 
-func (x *CreateReverseSwapRequest) GetAddMagicRoutingHint() bool {
-	if x != nil && x.AddMagicRoutingHint != nil {
-		return *x.AddMagicRoutingHint
+func (x *AnySwapInfo) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
 	}
-	return false
+	return 0
 }
 
-type CreateReverseSwapResponse struct {
+type ListSwapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	LockupAddress string `protobuf:"bytes,2,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
-	// Only populated when zero-conf is accepted and return_immediately is set to false
-	RoutingFeeMilliSat *uint64 `protobuf:"varint,3,opt,name=routing_fee_milli_sat,json=routingFeeMilliSat,proto3,oneof" json:"routing_fee_milli_sat,omitempty"`
-	// Only populated when zero-conf is accepted and return_immediately is set to false
-	ClaimTransactionId *string `protobuf:"bytes,4,opt,name=claim_transaction_id,json=claimTransactionId,proto3,oneof" json:"claim_transaction_id,omitempty"`
-	// Invoice to be paid. Only populated when `external_pay` is set to true
-	Invoice *string `protobuf:"bytes,5,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
+	From    *Currency    `protobuf:"varint,1,opt,name=from,proto3,enum=boltzrpc.Currency,oneof" json:"from,omitempty"`
+	To      *Currency    `protobuf:"varint,2,opt,name=to,proto3,enum=boltzrpc.Currency,oneof" json:"to,omitempty"`
+	State   *SwapState   `protobuf:"varint,4,opt,name=state,proto3,enum=boltzrpc.SwapState,oneof" json:"state,omitempty"`
+	Include IncludeSwaps `protobuf:"varint,5,opt,name=include,proto3,enum=boltzrpc.IncludeSwaps" json:"include,omitempty"`
+	Limit   *uint64      `protobuf:"varint,6,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset  *uint64      `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	// whether to return swaps in the shared `all_swaps` list or in the detailed lists.
+	// the `limit` and `offset` are only considered when `unify` is true.
+	Unify *bool `protobuf:"varint,8,opt,name=unify,proto3,oneof" json:"unify,omitempty"`
 }
 
-func (x *CreateReverseSwapResponse) Reset() {
-	*x = CreateReverseSwapResponse{}
+func (x *ListSwapsRequest) Reset() {
+	*x = ListSwapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[44]
+		mi := &file_boltzrpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReverseSwapResponse) String() string {
+func (x *ListSwapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReverseSwapResponse) ProtoMessage() {}
+func (*ListSwapsRequest) ProtoMessage() {}
 
-func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[44]
+func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3825,95 +4074,91 @@ func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReverseSwapResponse.ProtoReflect.Descriptor instead.
-func (*CreateReverseSwapResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateReverseSwapResponse) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *ListSwapsRequest) GetFrom() Currency {
+	if x != nil && x.From != nil {
+		return *x.From
 	}
-	return ""
+	return Currency_BTC
 }
 
-func (x *CreateReverseSwapResponse) GetLockupAddress() string {
+func (x *ListSwapsRequest) GetTo() Currency {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return Currency_BTC
+}
+
+func (x *ListSwapsRequest) GetState() SwapState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return SwapState_PENDING
+}
+
+func (x *ListSwapsRequest) GetInclude() IncludeSwaps {
 	if x != nil {
-		return x.LockupAddress
+		return x.Include
 	}
-	return ""
+	return IncludeSwaps_ALL
 }
 
-func (x *CreateReverseSwapResponse) GetRoutingFeeMilliSat() uint64 {
-	if x != nil && x.RoutingFeeMilliSat != nil {
-		return *x.RoutingFeeMilliSat
+func (x *ListSwapsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-func (x *CreateReverseSwapResponse) GetClaimTransactionId() string {
-	if x != nil && x.ClaimTransactionId != nil {
-		return *x.ClaimTransactionId
+func (x *ListSwapsRequest) GetOffset() uint64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateReverseSwapResponse) GetInvoice() string {
-	if x != nil && x.Invoice != nil {
-		return *x.Invoice
+func (x *ListSwapsRequest) GetUnify() bool {
+	if x != nil && x.Unify != nil {
+		return *x.Unify
 	}
-	return ""
+	return false
 }
 
-type CreateChainSwapRequest struct {
+type ListSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Amount of satoshis to swap. It is the amount expected to be sent to the lockup address.
-	// If left empty, any amount within the limits will be accepted.
-	Amount *uint64 `protobuf:"varint,1,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
-	Pair   *Pair   `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	// Address where funds will be swept to if the swap succeeds
-	ToAddress *string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"`
-	// Address where the coins should be refunded to if the swap fails.
-	RefundAddress *string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
-	// Wallet from which the swap should be paid from. Ignored if `external_pay` is set to true.
-	// If the swap fails, funds will be refunded to this wallet as well.
-	FromWalletId *uint64 `protobuf:"varint,5,opt,name=from_wallet_id,json=fromWalletId,proto3,oneof" json:"from_wallet_id,omitempty"`
-	// Wallet where the the funds will go if the swap succeeds.
-	ToWalletId *uint64 `protobuf:"varint,6,opt,name=to_wallet_id,json=toWalletId,proto3,oneof" json:"to_wallet_id,omitempty"`
-	// Whether the daemon should broadcast the claim transaction immediately after the lockup transaction is in the mempool.
-	// Should only be used for smaller amounts as it involves trust in Boltz.
-	AcceptZeroConf *bool `protobuf:"varint,7,opt,name=accept_zero_conf,json=acceptZeroConf,proto3,oneof" json:"accept_zero_conf,omitempty"`
-	// If set, the daemon will not pay the swap from an internal wallet.
-	ExternalPay *bool `protobuf:"varint,8,opt,name=external_pay,json=externalPay,proto3,oneof" json:"external_pay,omitempty"`
+	Swaps []*SwapInfo `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
 	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	LockupZeroConf *bool `protobuf:"varint,9,opt,name=lockup_zero_conf,json=lockupZeroConf,proto3,oneof" json:"lockup_zero_conf,omitempty"`
-	// Fee rate to use when sending from internal wallet
-	SatPerVbyte *float64 `protobuf:"fixed64,10,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
-	// Rates to accept for the swap. Queries latest from boltz otherwise
-	// The recommended way to use this is to pass a user approved value from a previous `GetPairInfo` call
-	AcceptedPair *PairInfo `protobuf:"bytes,11,opt,name=accepted_pair,json=acceptedPair,proto3,oneof" json:"accepted_pair,omitempty"`
+	ChannelCreations []*CombinedChannelSwapInfo `protobuf:"bytes,2,rep,name=channel_creations,json=channelCreations,proto3" json:"channel_creations,omitempty"`
+	ReverseSwaps     []*ReverseSwapInfo         `protobuf:"bytes,3,rep,name=reverse_swaps,json=reverseSwaps,proto3" json:"reverse_swaps,omitempty"`
+	ChainSwaps       []*ChainSwapInfo           `protobuf:"bytes,4,rep,name=chain_swaps,json=chainSwaps,proto3" json:"chain_swaps,omitempty"`
+	// populated when `unify` is set to true in the request
+	AllSwaps []*AnySwapInfo `protobuf:"bytes,5,rep,name=all_swaps,json=allSwaps,proto3" json:"all_swaps,omitempty"`
 }
 
-func (x *CreateChainSwapRequest) Reset() {
-	*x = CreateChainSwapRequest{}
+func (x *ListSwapsResponse) Reset() {
+	*x = ListSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[45]
+		mi := &file_boltzrpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChainSwapRequest) String() string {
+func (x *ListSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChainSwapRequest) ProtoMessage() {}
+func (*ListSwapsResponse) ProtoMessage() {}
 
-func (x *CreateChainSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[45]
+func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3924,127 +4169,72 @@ func (x *CreateChainSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChainSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateChainSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *CreateChainSwapRequest) GetAmount() uint64 {
-	if x != nil && x.Amount != nil {
-		return *x.Amount
+func (x *ListSwapsResponse) GetSwaps() []*SwapInfo {
+	if x != nil {
+		return x.Swaps
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateChainSwapRequest) GetPair() *Pair {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *ListSwapsResponse) GetChannelCreations() []*CombinedChannelSwapInfo {
 	if x != nil {
-		return x.Pair
+		return x.ChannelCreations
 	}
 	return nil
 }
 
-func (x *CreateChainSwapRequest) GetToAddress() string {
-	if x != nil && x.ToAddress != nil {
-		return *x.ToAddress
+func (x *ListSwapsResponse) GetReverseSwaps() []*ReverseSwapInfo {
+	if x != nil {
+		return x.ReverseSwaps
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateChainSwapRequest) GetRefundAddress() string {
-	if x != nil && x.RefundAddress != nil {
-		return *x.RefundAddress
-	}
-	return ""
-}
-
-func (x *CreateChainSwapRequest) GetFromWalletId() uint64 {
-	if x != nil && x.FromWalletId != nil {
-		return *x.FromWalletId
-	}
-	return 0
-}
-
-func (x *CreateChainSwapRequest) GetToWalletId() uint64 {
-	if x != nil && x.ToWalletId != nil {
-		return *x.ToWalletId
-	}
-	return 0
-}
-
-func (x *CreateChainSwapRequest) GetAcceptZeroConf() bool {
-	if x != nil && x.AcceptZeroConf != nil {
-		return *x.AcceptZeroConf
-	}
-	return false
-}
-
-func (x *CreateChainSwapRequest) GetExternalPay() bool {
-	if x != nil && x.ExternalPay != nil {
-		return *x.ExternalPay
-	}
-	return false
-}
-
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *CreateChainSwapRequest) GetLockupZeroConf() bool {
-	if x != nil && x.LockupZeroConf != nil {
-		return *x.LockupZeroConf
-	}
-	return false
-}
-
-func (x *CreateChainSwapRequest) GetSatPerVbyte() float64 {
-	if x != nil && x.SatPerVbyte != nil {
-		return *x.SatPerVbyte
+func (x *ListSwapsResponse) GetChainSwaps() []*ChainSwapInfo {
+	if x != nil {
+		return x.ChainSwaps
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateChainSwapRequest) GetAcceptedPair() *PairInfo {
+func (x *ListSwapsResponse) GetAllSwaps() []*AnySwapInfo {
 	if x != nil {
-		return x.AcceptedPair
+		return x.AllSwaps
 	}
 	return nil
 }
 
-type ChainSwapInfo struct {
+type GetStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Pair              *Pair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	State             SwapState      `protobuf:"varint,3,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
-	Error             string         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	Status            string         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Preimage          string         `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	IsAuto            bool           `protobuf:"varint,8,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
-	ServiceFee        *int64         `protobuf:"varint,9,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
-	ServiceFeePercent float64        `protobuf:"fixed64,10,opt,name=service_fee_percent,json=serviceFeePercent,proto3" json:"service_fee_percent,omitempty"`
-	OnchainFee        *uint64        `protobuf:"varint,11,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
-	CreatedAt         int64          `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	TenantId          uint64         `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
-	FromData          *ChainSwapData `protobuf:"bytes,14,opt,name=from_data,json=fromData,proto3" json:"from_data,omitempty"`
-	ToData            *ChainSwapData `protobuf:"bytes,15,opt,name=to_data,json=toData,proto3" json:"to_data,omitempty"`
+	Include IncludeSwaps `protobuf:"varint,1,opt,name=include,proto3,enum=boltzrpc.IncludeSwaps" json:"include,omitempty"`
 }
 
-func (x *ChainSwapInfo) Reset() {
-	*x = ChainSwapInfo{}
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[46]
+		mi := &file_boltzrpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChainSwapInfo) String() string {
+func (x *GetStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainSwapInfo) ProtoMessage() {}
+func (*GetStatsRequest) ProtoMessage() {}
 
-func (x *ChainSwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[46]
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4055,145 +4245,98 @@ func (x *ChainSwapInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainSwapInfo.ProtoReflect.Descriptor instead.
-func (*ChainSwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *ChainSwapInfo) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ChainSwapInfo) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *ChainSwapInfo) GetState() SwapState {
-	if x != nil {
-		return x.State
-	}
-	return SwapState_PENDING
-}
-
-func (x *ChainSwapInfo) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *ChainSwapInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ChainSwapInfo) GetPreimage() string {
+func (x *GetStatsRequest) GetInclude() IncludeSwaps {
 	if x != nil {
-		return x.Preimage
+		return x.Include
 	}
-	return ""
+	return IncludeSwaps_ALL
 }
 
-func (x *ChainSwapInfo) GetIsAuto() bool {
-	if x != nil {
-		return x.IsAuto
-	}
-	return false
-}
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ChainSwapInfo) GetServiceFee() int64 {
-	if x != nil && x.ServiceFee != nil {
-		return *x.ServiceFee
-	}
-	return 0
+	Stats *SwapStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *ChainSwapInfo) GetServiceFeePercent() float64 {
-	if x != nil {
-		return x.ServiceFeePercent
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ChainSwapInfo) GetOnchainFee() uint64 {
-	if x != nil && x.OnchainFee != nil {
-		return *x.OnchainFee
-	}
-	return 0
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChainSwapInfo) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
+func (*GetStatsResponse) ProtoMessage() {}
 
-func (x *ChainSwapInfo) GetTenantId() uint64 {
-	if x != nil {
-		return x.TenantId
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ChainSwapInfo) GetFromData() *ChainSwapData {
-	if x != nil {
-		return x.FromData
-	}
-	return nil
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ChainSwapInfo) GetToData() *ChainSwapData {
+func (x *GetStatsResponse) GetStats() *SwapStats {
 	if x != nil {
-		return x.ToData
+		return x.Stats
 	}
 	return nil
 }
 
-type ChainSwapData struct {
+type RefundSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                  string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Currency            Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
-	PrivateKey          string   `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	TheirPublicKey      string   `protobuf:"bytes,4,opt,name=their_public_key,json=theirPublicKey,proto3" json:"their_public_key,omitempty"`
-	Amount              uint64   `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	TimeoutBlockHeight  uint32   `protobuf:"varint,7,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
-	LockupTransactionId *string  `protobuf:"bytes,8,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3,oneof" json:"lockup_transaction_id,omitempty"`
-	TransactionId       *string  `protobuf:"bytes,9,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
-	WalletId            *uint64  `protobuf:"varint,20,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
-	Address             *string  `protobuf:"bytes,12,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	BlindingKey         *string  `protobuf:"bytes,13,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
-	LockupAddress       string   `protobuf:"bytes,14,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Destination:
+	//
+	//	*RefundSwapRequest_Address
+	//	*RefundSwapRequest_WalletId
+	Destination isRefundSwapRequest_Destination `protobuf_oneof:"destination"`
+	// Manually specify the lockup transaction of the swap to be refunded.
+	// This can be used in cases where the client missed the lockup of the swap or a swap received multiple lockups.
+	LockupTransactionId *string `protobuf:"bytes,4,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3,oneof" json:"lockup_transaction_id,omitempty"`
 }
 
-func (x *ChainSwapData) Reset() {
-	*x = ChainSwapData{}
+func (x *RefundSwapRequest) Reset() {
+	*x = RefundSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[47]
+		mi := &file_boltzrpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChainSwapData) String() string {
+func (x *RefundSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainSwapData) ProtoMessage() {}
+func (*RefundSwapRequest) ProtoMessage() {}
 
-func (x *ChainSwapData) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[47]
+func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4204,123 +4347,92 @@ func (x *ChainSwapData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainSwapData.ProtoReflect.Descriptor instead.
-func (*ChainSwapData) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use RefundSwapRequest.ProtoReflect.Descriptor instead.
+func (*RefundSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ChainSwapData) GetId() string {
+func (x *RefundSwapRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *ChainSwapData) GetCurrency() Currency {
-	if x != nil {
-		return x.Currency
+func (m *RefundSwapRequest) GetDestination() isRefundSwapRequest_Destination {
+	if m != nil {
+		return m.Destination
 	}
-	return Currency_BTC
+	return nil
 }
 
-func (x *ChainSwapData) GetPrivateKey() string {
-	if x != nil {
-		return x.PrivateKey
+func (x *RefundSwapRequest) GetAddress() string {
+	if x, ok := x.GetDestination().(*RefundSwapRequest_Address); ok {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *ChainSwapData) GetTheirPublicKey() string {
-	if x != nil {
-		return x.TheirPublicKey
-	}
-	return ""
-}
-
-func (x *ChainSwapData) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *ChainSwapData) GetTimeoutBlockHeight() uint32 {
-	if x != nil {
-		return x.TimeoutBlockHeight
+func (x *RefundSwapRequest) GetWalletId() uint64 {
+	if x, ok := x.GetDestination().(*RefundSwapRequest_WalletId); ok {
+		return x.WalletId
 	}
 	return 0
 }
 
-func (x *ChainSwapData) GetLockupTransactionId() string {
+func (x *RefundSwapRequest) GetLockupTransactionId() string {
 	if x != nil && x.LockupTransactionId != nil {
 		return *x.LockupTransactionId
 	}
 	return ""
 }
 
-func (x *ChainSwapData) GetTransactionId() string {
-	if x != nil && x.TransactionId != nil {
-		return *x.TransactionId
-	}
-	return ""
+type isRefundSwapRequest_Destination interface {
+	isRefundSwapRequest_Destination()
 }
 
-func (x *ChainSwapData) GetWalletId() uint64 {
-	if x != nil && x.WalletId != nil {
-		return *x.WalletId
-	}
-	return 0
+type RefundSwapRequest_Address struct {
+	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
 }
 
-func (x *ChainSwapData) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
-	}
-	return ""
+type RefundSwapRequest_WalletId struct {
+	WalletId uint64 `protobuf:"varint,3,opt,name=wallet_id,json=walletId,proto3,oneof"`
 }
 
-func (x *ChainSwapData) GetBlindingKey() string {
-	if x != nil && x.BlindingKey != nil {
-		return *x.BlindingKey
-	}
-	return ""
-}
+func (*RefundSwapRequest_Address) isRefundSwapRequest_Destination() {}
 
-func (x *ChainSwapData) GetLockupAddress() string {
-	if x != nil {
-		return x.LockupAddress
-	}
-	return ""
-}
+func (*RefundSwapRequest_WalletId) isRefundSwapRequest_Destination() {}
 
-type ChannelId struct {
+type ClaimSwapsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cln style: 832347x2473x1
-	Cln string `protobuf:"bytes,1,opt,name=cln,proto3" json:"cln,omitempty"`
-	// lnd style: 915175205006540801
-	Lnd uint64 `protobuf:"varint,2,opt,name=lnd,proto3" json:"lnd,omitempty"`
+	SwapIds []string `protobuf:"bytes,1,rep,name=swap_ids,json=swapIds,proto3" json:"swap_ids,omitempty"`
+	// Types that are assignable to Destination:
+	//
+	//	*ClaimSwapsRequest_Address
+	//	*ClaimSwapsRequest_WalletId
+	Destination isClaimSwapsRequest_Destination `protobuf_oneof:"destination"`
 }
 
-func (x *ChannelId) Reset() {
-	*x = ChannelId{}
+func (x *ClaimSwapsRequest) Reset() {
+	*x = ClaimSwapsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[48]
+		mi := &file_boltzrpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelId) String() string {
+func (x *ClaimSwapsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelId) ProtoMessage() {}
+func (*ClaimSwapsRequest) ProtoMessage() {}
 
-func (x *ChannelId) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[48]
+func (x *ClaimSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4331,54 +4443,80 @@ func (x *ChannelId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelId.ProtoReflect.Descriptor instead.
-func (*ChannelId) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ClaimSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ClaimSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ChannelId) GetCln() string {
+func (x *ClaimSwapsRequest) GetSwapIds() []string {
 	if x != nil {
-		return x.Cln
+		return x.SwapIds
+	}
+	return nil
+}
+
+func (m *ClaimSwapsRequest) GetDestination() isClaimSwapsRequest_Destination {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func (x *ClaimSwapsRequest) GetAddress() string {
+	if x, ok := x.GetDestination().(*ClaimSwapsRequest_Address); ok {
+		return x.Address
 	}
 	return ""
 }
 
-func (x *ChannelId) GetLnd() uint64 {
-	if x != nil {
-		return x.Lnd
+func (x *ClaimSwapsRequest) GetWalletId() uint64 {
+	if x, ok := x.GetDestination().(*ClaimSwapsRequest_WalletId); ok {
+		return x.WalletId
 	}
 	return 0
 }
 
-type LightningChannel struct {
+type isClaimSwapsRequest_Destination interface {
+	isClaimSwapsRequest_Destination()
+}
+
+type ClaimSwapsRequest_Address struct {
+	Address string `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
+}
+
+type ClaimSwapsRequest_WalletId struct {
+	WalletId uint64 `protobuf:"varint,3,opt,name=wallet_id,json=walletId,proto3,oneof"`
+}
+
+func (*ClaimSwapsRequest_Address) isClaimSwapsRequest_Destination() {}
+
+func (*ClaimSwapsRequest_WalletId) isClaimSwapsRequest_Destination() {}
+
+type ClaimSwapsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          *ChannelId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Capacity    uint64     `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	OutboundSat uint64     `protobuf:"varint,3,opt,name=outbound_sat,json=outboundSat,proto3" json:"outbound_sat,omitempty"`
-	InboundSat  uint64     `protobuf:"varint,4,opt,name=inbound_sat,json=inboundSat,proto3" json:"inbound_sat,omitempty"`
-	PeerId      string     `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	TransactionId string `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
 }
 
-func (x *LightningChannel) Reset() {
-	*x = LightningChannel{}
+func (x *ClaimSwapsResponse) Reset() {
+	*x = ClaimSwapsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[49]
+		mi := &file_boltzrpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LightningChannel) String() string {
+func (x *ClaimSwapsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LightningChannel) ProtoMessage() {}
+func (*ClaimSwapsResponse) ProtoMessage() {}
 
-func (x *LightningChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[49]
+func (x *ClaimSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4389,76 +4527,49 @@ func (x *LightningChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LightningChannel.ProtoReflect.Descriptor instead.
-func (*LightningChannel) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *LightningChannel) GetId() *ChannelId {
-	if x != nil {
-		return x.Id
-	}
-	return nil
-}
-
-func (x *LightningChannel) GetCapacity() uint64 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *LightningChannel) GetOutboundSat() uint64 {
-	if x != nil {
-		return x.OutboundSat
-	}
-	return 0
-}
-
-func (x *LightningChannel) GetInboundSat() uint64 {
-	if x != nil {
-		return x.InboundSat
-	}
-	return 0
+// Deprecated: Use ClaimSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ClaimSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *LightningChannel) GetPeerId() string {
+func (x *ClaimSwapsResponse) GetTransactionId() string {
 	if x != nil {
-		return x.PeerId
+		return x.TransactionId
 	}
 	return ""
 }
 
-type SwapStats struct {
+type GetSwapInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalFees    int64  `protobuf:"varint,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
-	TotalAmount  uint64 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	AvgFees      int64  `protobuf:"varint,3,opt,name=avg_fees,json=avgFees,proto3" json:"avg_fees,omitempty"`
-	AvgAmount    uint64 `protobuf:"varint,4,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`
-	Count        uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	SuccessCount uint64 `protobuf:"varint,6,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Identifier:
+	//
+	//	*GetSwapInfoRequest_SwapId
+	//	*GetSwapInfoRequest_PaymentHash
+	Identifier isGetSwapInfoRequest_Identifier `protobuf_oneof:"identifier"`
 }
 
-func (x *SwapStats) Reset() {
-	*x = SwapStats{}
+func (x *GetSwapInfoRequest) Reset() {
+	*x = GetSwapInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[50]
+		mi := &file_boltzrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapStats) String() string {
+func (x *GetSwapInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapStats) ProtoMessage() {}
+func (*GetSwapInfoRequest) ProtoMessage() {}
 
-func (x *SwapStats) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[50]
+func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4469,81 +4580,85 @@ func (x *SwapStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapStats.ProtoReflect.Descriptor instead.
-func (*SwapStats) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetSwapInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSwapInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SwapStats) GetTotalFees() int64 {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetSwapInfoRequest) GetId() string {
 	if x != nil {
-		return x.TotalFees
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *SwapStats) GetTotalAmount() uint64 {
-	if x != nil {
-		return x.TotalAmount
+func (m *GetSwapInfoRequest) GetIdentifier() isGetSwapInfoRequest_Identifier {
+	if m != nil {
+		return m.Identifier
 	}
-	return 0
+	return nil
 }
 
-func (x *SwapStats) GetAvgFees() int64 {
-	if x != nil {
-		return x.AvgFees
+func (x *GetSwapInfoRequest) GetSwapId() string {
+	if x, ok := x.GetIdentifier().(*GetSwapInfoRequest_SwapId); ok {
+		return x.SwapId
 	}
-	return 0
+	return ""
 }
 
-func (x *SwapStats) GetAvgAmount() uint64 {
-	if x != nil {
-		return x.AvgAmount
+func (x *GetSwapInfoRequest) GetPaymentHash() []byte {
+	if x, ok := x.GetIdentifier().(*GetSwapInfoRequest_PaymentHash); ok {
+		return x.PaymentHash
 	}
-	return 0
+	return nil
 }
 
-func (x *SwapStats) GetCount() uint64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+type isGetSwapInfoRequest_Identifier interface {
+	isGetSwapInfoRequest_Identifier()
 }
 
-func (x *SwapStats) GetSuccessCount() uint64 {
-	if x != nil {
-		return x.SuccessCount
-	}
-	return 0
+type GetSwapInfoRequest_SwapId struct {
+	SwapId string `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,oneof"`
 }
 
-type Budget struct {
+type GetSwapInfoRequest_PaymentHash struct {
+	// Only implemented for submarine swaps
+	PaymentHash []byte `protobuf:"bytes,3,opt,name=payment_hash,json=paymentHash,proto3,oneof"`
+}
+
+func (*GetSwapInfoRequest_SwapId) isGetSwapInfoRequest_Identifier() {}
+
+func (*GetSwapInfoRequest_PaymentHash) isGetSwapInfoRequest_Identifier() {}
+
+type GetSwapInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Remaining int64  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
-	StartDate int64  `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate   int64  `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Swap            *SwapInfo            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,2,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
+	ReverseSwap     *ReverseSwapInfo     `protobuf:"bytes,3,opt,name=reverse_swap,json=reverseSwap,proto3" json:"reverse_swap,omitempty"`
+	ChainSwap       *ChainSwapInfo       `protobuf:"bytes,4,opt,name=chain_swap,json=chainSwap,proto3" json:"chain_swap,omitempty"`
 }
 
-func (x *Budget) Reset() {
-	*x = Budget{}
+func (x *GetSwapInfoResponse) Reset() {
+	*x = GetSwapInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[51]
+		mi := &file_boltzrpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Budget) String() string {
+func (x *GetSwapInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Budget) ProtoMessage() {}
+func (*GetSwapInfoResponse) ProtoMessage() {}
 
-func (x *Budget) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[51]
+func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4554,100 +4669,66 @@ func (x *Budget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
-func (*Budget) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetSwapInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSwapInfoResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *Budget) GetTotal() uint64 {
+func (x *GetSwapInfoResponse) GetSwap() *SwapInfo {
 	if x != nil {
-		return x.Total
+		return x.Swap
 	}
-	return 0
+	return nil
 }
 
-func (x *Budget) GetRemaining() int64 {
+func (x *GetSwapInfoResponse) GetChannelCreation() *ChannelCreationInfo {
 	if x != nil {
-		return x.Remaining
+		return x.ChannelCreation
 	}
-	return 0
+	return nil
 }
 
-func (x *Budget) GetStartDate() int64 {
+func (x *GetSwapInfoResponse) GetReverseSwap() *ReverseSwapInfo {
 	if x != nil {
-		return x.StartDate
+		return x.ReverseSwap
 	}
-	return 0
+	return nil
 }
 
-func (x *Budget) GetEndDate() int64 {
+func (x *GetSwapInfoResponse) GetChainSwap() *ChainSwapInfo {
 	if x != nil {
-		return x.EndDate
+		return x.ChainSwap
 	}
-	return 0
+	return nil
 }
 
-// WalletCredentials describes a BIP32 deterministic wallet that can be either:
-// - hot (private keys present), derived from a BIP-39 mnemonic, or
-// - watch-only (public keys only), imported via a descriptor.
-//
-// Hot wallet:
-// - Provide `mnemonic`. The master key is derived per BIP-39.
-// - Optional: provide `core_descriptor` to override the default address scheme.
-// If omitted, a chain-specific default will be derived.
-//
-// Watch-only wallet:
-// - Provide only `core_descriptor` (no `mnemonic`).
-//
-// Mainchain (Bitcoin):
-// - Default descriptor: Bitcoin Core descriptor using a BIP-84 derivation path.
-// - Descriptor format:
-// https://github.com/bitcoin/bitcoin/blob/master/doc/descriptors.md
-//
-// Liquid (Elements):
-// - Default descriptor:
-// CT Descriptor using a BIP-84 derivation path and SLIP77 for blinding key derivation.
-// - Descriptor format:
-// https://github.com/ElementsProject/ELIPs/blob/main/elip-0150.mediawiki
-//
-// Descriptor requirements:
-// - Must be valid according to the currency-specific format
-// - Should describe the wallet’s external keychain.
-// - For hot wallets, the descriptor must be derived from the mnemonic’s master key.
-type WalletCredentials struct {
+type DepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the mnemonic to derive the wallet master private key (BIP39).
-	Mnemonic *string `protobuf:"bytes,1,opt,name=mnemonic,proto3,oneof" json:"mnemonic,omitempty"`
-	// public key descriptor for the wallets external keychain.
-	CoreDescriptor *string `protobuf:"bytes,3,opt,name=core_descriptor,json=coreDescriptor,proto3,oneof" json:"core_descriptor,omitempty"`
-	// Only used to migrate legacy wallets imported before descriptor-based backends.
-	//
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	Subaccount *uint64 `protobuf:"varint,4,opt,name=subaccount,proto3,oneof" json:"subaccount,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	Xpub *string `protobuf:"bytes,2,opt,name=xpub,proto3,oneof" json:"xpub,omitempty"`
+	// Percentage of inbound liquidity the channel that is opened in case the invoice cannot be paid should have.
+	// 25 by default.
+	InboundLiquidity uint32 `protobuf:"varint,1,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
 }
 
-func (x *WalletCredentials) Reset() {
-	*x = WalletCredentials{}
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[52]
+		mi := &file_boltzrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletCredentials) String() string {
+func (x *DepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletCredentials) ProtoMessage() {}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *WalletCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[52]
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4658,69 +4739,45 @@ func (x *WalletCredentials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletCredentials.ProtoReflect.Descriptor instead.
-func (*WalletCredentials) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *WalletCredentials) GetMnemonic() string {
-	if x != nil && x.Mnemonic != nil {
-		return *x.Mnemonic
-	}
-	return ""
-}
-
-func (x *WalletCredentials) GetCoreDescriptor() string {
-	if x != nil && x.CoreDescriptor != nil {
-		return *x.CoreDescriptor
-	}
-	return ""
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{58}
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *WalletCredentials) GetSubaccount() uint64 {
-	if x != nil && x.Subaccount != nil {
-		return *x.Subaccount
+func (x *DepositRequest) GetInboundLiquidity() uint32 {
+	if x != nil {
+		return x.InboundLiquidity
 	}
 	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *WalletCredentials) GetXpub() string {
-	if x != nil && x.Xpub != nil {
-		return *x.Xpub
-	}
-	return ""
-}
-
-type WalletParams struct {
+type DepositResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Currency Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
-	// the password to encrypt the wallet with. If there are existing encrypted wallets, the same password has to be used.
-	Password *string `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TimeoutBlockHeight uint32 `protobuf:"varint,3,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
 }
 
-func (x *WalletParams) Reset() {
-	*x = WalletParams{}
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[53]
+		mi := &file_boltzrpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletParams) String() string {
+func (x *DepositResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletParams) ProtoMessage() {}
+func (*DepositResponse) ProtoMessage() {}
 
-func (x *WalletParams) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[53]
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4731,58 +4788,89 @@ func (x *WalletParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletParams.ProtoReflect.Descriptor instead.
-func (*WalletParams) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *WalletParams) GetName() string {
+func (x *DepositResponse) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *WalletParams) GetCurrency() Currency {
+func (x *DepositResponse) GetAddress() string {
 	if x != nil {
-		return x.Currency
+		return x.Address
 	}
-	return Currency_BTC
+	return ""
 }
 
-func (x *WalletParams) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *DepositResponse) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
 	}
-	return ""
+	return 0
 }
 
-type ImportWalletRequest struct {
+type CreateSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Credentials *WalletCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Params      *WalletParams      `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+	// amount of sats to be received on lightning.
+	// related: `invoice` field
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Pair   *Pair  `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// the daemon will pay the swap using the onchain wallet specified in the `wallet` field
+	// or the first internal wallet with the correct currency otherwise.
+	SendFromInternal bool `protobuf:"varint,4,opt,name=send_from_internal,json=sendFromInternal,proto3" json:"send_from_internal,omitempty"`
+	// address where the coins should go if the swap fails. Refunds will go to any of the daemons wallets otherwise.
+	RefundAddress *string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
+	// wallet to pay swap from. only used if `send_from_internal` is set to true
+	WalletId *uint64 `protobuf:"varint,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	// bolt11 invoice, lnurl, or lnaddress to use for the swap.
+	// required in standalone mode.
+	// when connected to a lightning node, a new invoice for `amount` sats will be fetched
+	// the `amount` field has to be populated in case of a lnurl and lnaddress
+	Invoice *string `protobuf:"bytes,7,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	ZeroConf *bool `protobuf:"varint,8,opt,name=zero_conf,json=zeroConf,proto3,oneof" json:"zero_conf,omitempty"`
+	// Fee rate to use when sending from internal wallet
+	SatPerVbyte *float64 `protobuf:"fixed64,9,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+	// Rates to accept for the swap. Queries latest from boltz otherwise
+	// The recommended way to use this is to pass a user approved value from a previous `GetPairInfo` call
+	AcceptedPair *PairInfo `protobuf:"bytes,10,opt,name=accepted_pair,json=acceptedPair,proto3,oneof" json:"accepted_pair,omitempty"`
+	// Ignore any magic routing hints found in the specified `invoice`.
+	IgnoreMrh *bool `protobuf:"varint,11,opt,name=ignore_mrh,json=ignoreMrh,proto3,oneof" json:"ignore_mrh,omitempty"`
+	// Name of the lightning node which creates the invoice if none is specified. Defaults to the main node
+	LightningNode *string `protobuf:"bytes,12,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
+	// Include route hints for the private channels of the lightning node in the invoice created by the daemon.
+	// Required for Boltz to find a route to nodes which only have private channels. Can not be used with `invoice`
+	RouteHints *bool `protobuf:"varint,13,opt,name=route_hints,json=routeHints,proto3,oneof" json:"route_hints,omitempty"`
+	// Fund the lockup by splicing the funds out of this channel of the lightning node (in lnd or cln format)
+	// instead of sending them from a wallet. Only supported by CLN. Can not be used with `send_from_internal`
+	SpliceChannelId *string `protobuf:"bytes,14,opt,name=splice_channel_id,json=spliceChannelId,proto3,oneof" json:"splice_channel_id,omitempty"`
 }
 
-func (x *ImportWalletRequest) Reset() {
-	*x = ImportWalletRequest{}
+func (x *CreateSwapRequest) Reset() {
+	*x = CreateSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[54]
+		mi := &file_boltzrpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportWalletRequest) String() string {
+func (x *CreateSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportWalletRequest) ProtoMessage() {}
+func (*CreateSwapRequest) ProtoMessage() {}
 
-func (x *ImportWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[54]
+func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4793,98 +4881,135 @@ func (x *ImportWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportWalletRequest.ProtoReflect.Descriptor instead.
-func (*ImportWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use CreateSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ImportWalletRequest) GetCredentials() *WalletCredentials {
+func (x *CreateSwapRequest) GetAmount() uint64 {
 	if x != nil {
-		return x.Credentials
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *ImportWalletRequest) GetParams() *WalletParams {
+func (x *CreateSwapRequest) GetPair() *Pair {
 	if x != nil {
-		return x.Params
+		return x.Pair
 	}
 	return nil
 }
 
-type CreateWalletRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Params *WalletParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+func (x *CreateSwapRequest) GetSendFromInternal() bool {
+	if x != nil {
+		return x.SendFromInternal
+	}
+	return false
 }
 
-func (x *CreateWalletRequest) Reset() {
-	*x = CreateWalletRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[55]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateSwapRequest) GetRefundAddress() string {
+	if x != nil && x.RefundAddress != nil {
+		return *x.RefundAddress
 	}
+	return ""
 }
 
-func (x *CreateWalletRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateSwapRequest) GetWalletId() uint64 {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return 0
 }
 
-func (*CreateWalletRequest) ProtoMessage() {}
+func (x *CreateSwapRequest) GetInvoice() string {
+	if x != nil && x.Invoice != nil {
+		return *x.Invoice
+	}
+	return ""
+}
 
-func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[55]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *CreateSwapRequest) GetZeroConf() bool {
+	if x != nil && x.ZeroConf != nil {
+		return *x.ZeroConf
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use CreateWalletRequest.ProtoReflect.Descriptor instead.
-func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{55}
+func (x *CreateSwapRequest) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
 }
 
-func (x *CreateWalletRequest) GetParams() *WalletParams {
+func (x *CreateSwapRequest) GetAcceptedPair() *PairInfo {
 	if x != nil {
-		return x.Params
+		return x.AcceptedPair
 	}
 	return nil
 }
 
-type CreateWalletResponse struct {
+func (x *CreateSwapRequest) GetIgnoreMrh() bool {
+	if x != nil && x.IgnoreMrh != nil {
+		return *x.IgnoreMrh
+	}
+	return false
+}
+
+func (x *CreateSwapRequest) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+func (x *CreateSwapRequest) GetRouteHints() bool {
+	if x != nil && x.RouteHints != nil {
+		return *x.RouteHints
+	}
+	return false
+}
+
+func (x *CreateSwapRequest) GetSpliceChannelId() string {
+	if x != nil && x.SpliceChannelId != nil {
+		return *x.SpliceChannelId
+	}
+	return ""
+}
+
+type CreateSwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mnemonic string  `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
-	Wallet   *Wallet `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ExpectedAmount uint64 `protobuf:"varint,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	Bip21          string `protobuf:"bytes,4,opt,name=bip21,proto3" json:"bip21,omitempty"`
+	// lockup transaction id. Only populated when `send_from_internal` was specified in the request
+	TxId               string  `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	TimeoutBlockHeight uint32  `protobuf:"varint,6,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	TimeoutHours       float32 `protobuf:"fixed32,7,opt,name=timeout_hours,json=timeoutHours,proto3" json:"timeout_hours,omitempty"`
 }
 
-func (x *CreateWalletResponse) Reset() {
-	*x = CreateWalletResponse{}
+func (x *CreateSwapResponse) Reset() {
+	*x = CreateSwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[56]
+		mi := &file_boltzrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateWalletResponse) String() string {
+func (x *CreateSwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWalletResponse) ProtoMessage() {}
+func (*CreateSwapResponse) ProtoMessage() {}
 
-func (x *CreateWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[56]
+func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4895,89 +5020,89 @@ func (x *CreateWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWalletResponse.ProtoReflect.Descriptor instead.
-func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use CreateSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *CreateWalletResponse) GetMnemonic() string {
+func (x *CreateSwapResponse) GetId() string {
 	if x != nil {
-		return x.Mnemonic
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateWalletResponse) GetWallet() *Wallet {
+func (x *CreateSwapResponse) GetAddress() string {
 	if x != nil {
-		return x.Wallet
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type ImportWalletResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CreateSwapResponse) GetExpectedAmount() uint64 {
+	if x != nil {
+		return x.ExpectedAmount
+	}
+	return 0
 }
 
-func (x *ImportWalletResponse) Reset() {
-	*x = ImportWalletResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[57]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateSwapResponse) GetBip21() string {
+	if x != nil {
+		return x.Bip21
 	}
+	return ""
 }
 
-func (x *ImportWalletResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateSwapResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
 }
 
-func (*ImportWalletResponse) ProtoMessage() {}
-
-func (x *ImportWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[57]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateSwapResponse) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ImportWalletResponse.ProtoReflect.Descriptor instead.
-func (*ImportWalletResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{57}
+func (x *CreateSwapResponse) GetTimeoutHours() float32 {
+	if x != nil {
+		return x.TimeoutHours
+	}
+	return 0
 }
 
-type GetWalletsRequest struct {
+type CreateChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Currency        *Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
-	IncludeReadonly *bool     `protobuf:"varint,2,opt,name=include_readonly,json=includeReadonly,proto3,oneof" json:"include_readonly,omitempty"`
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Percentage of inbound liquidity the channel that is opened should have.
+	// 25 by default.
+	InboundLiquidity uint32 `protobuf:"varint,2,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	Private          bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
 }
 
-func (x *GetWalletsRequest) Reset() {
-	*x = GetWalletsRequest{}
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[58]
+		mi := &file_boltzrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWalletsRequest) String() string {
+func (x *CreateChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletsRequest) ProtoMessage() {}
+func (*CreateChannelRequest) ProtoMessage() {}
 
-func (x *GetWalletsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[58]
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4988,109 +5113,92 @@ func (x *GetWalletsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletsRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetWalletsRequest) GetCurrency() Currency {
-	if x != nil && x.Currency != nil {
-		return *x.Currency
+func (x *CreateChannelRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
 	}
-	return Currency_BTC
+	return 0
 }
 
-func (x *GetWalletsRequest) GetIncludeReadonly() bool {
-	if x != nil && x.IncludeReadonly != nil {
-		return *x.IncludeReadonly
+func (x *CreateChannelRequest) GetInboundLiquidity() uint32 {
+	if x != nil {
+		return x.InboundLiquidity
+	}
+	return 0
+}
+
+func (x *CreateChannelRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
 	}
 	return false
 }
 
-type GetWalletRequest struct {
+type CreateReverseSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Id   *uint64 `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
-}
-
-func (x *GetWalletRequest) Reset() {
-	*x = GetWalletRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[59]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetWalletRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetWalletRequest) ProtoMessage() {}
-
-func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[59]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *GetWalletRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *GetWalletRequest) GetId() uint64 {
-	if x != nil && x.Id != nil {
-		return *x.Id
-	}
-	return 0
-}
-
-type WalletSendFee struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// amount of sats which would be sent
+	// amount of satoshis to swap
 	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Fee    uint64 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	// the fee rate used for the estimation in sat/vbyte
-	FeeRate float64 `protobuf:"fixed64,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	// If no value is set, the daemon will query a new address from the lightning node
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the daemon should broadcast the claim transaction immediately after the lockup transaction is in the mempool.
+	// Should only be used for smaller amounts as it involves trust in boltz.
+	AcceptZeroConf bool  `protobuf:"varint,3,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
+	Pair           *Pair `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	// a list of channel ids which are allowed for paying the invoice. can be in either cln or lnd style.
+	ChanIds []string `protobuf:"bytes,5,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	// wallet from which the onchain address should be generated - only considered if `address` is not set
+	WalletId *uint64 `protobuf:"varint,6,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	// Whether the daemon should return immediately after creating the swap or wait until the swap is successful or failed.
+	// It will always return immediately if `accept_zero_conf` is not set.
+	ReturnImmediately *bool `protobuf:"varint,7,opt,name=return_immediately,json=returnImmediately,proto3,oneof" json:"return_immediately,omitempty"`
+	// If set, the daemon will not pay the invoice of the swap and return the invoice to be paid. This implicitly sets `return_immediately` to true.
+	ExternalPay *bool `protobuf:"varint,8,opt,name=external_pay,json=externalPay,proto3,oneof" json:"external_pay,omitempty"`
+	// Description of the invoice which will be created for the swap
+	Description *string `protobuf:"bytes,9,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Description hash of the invoice which will be created for the swap. Takes precedence over `description`
+	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,json=descriptionHash,proto3,oneof" json:"description_hash,omitempty"`
+	// Expiry of the reverse swap invoice in seconds
+	InvoiceExpiry *uint64 `protobuf:"varint,11,opt,name=invoice_expiry,json=invoiceExpiry,proto3,oneof" json:"invoice_expiry,omitempty"`
+	// Rates to accept for the swap. Queries latest from boltz otherwise
+	// The recommended way to use this is to pass a user approved value from a previous `GetPairInfo` call
+	AcceptedPair *PairInfo `protobuf:"bytes,12,opt,name=accepted_pair,json=acceptedPair,proto3,oneof" json:"accepted_pair,omitempty"`
+	// The routing fee limit for paying the lightning invoice in ppm (parts per million)
+	RoutingFeeLimitPpm *uint64 `protobuf:"varint,13,opt,name=routing_fee_limit_ppm,json=routingFeeLimitPpm,proto3,oneof" json:"routing_fee_limit_ppm,omitempty"`
+	// add a magic routing hint to the lightning invoice if `external_pay` is true and an internal `wallet` is used.
+	AddMagicRoutingHint *bool `protobuf:"varint,14,opt,name=add_magic_routing_hint,json=addMagicRoutingHint,proto3,oneof" json:"add_magic_routing_hint,omitempty"`
+	// Name of the lightning node which pays the invoice. Defaults to the main node
+	LightningNode *string `protobuf:"bytes,15,opt,name=lightning_node,json=lightningNode,proto3,oneof" json:"lightning_node,omitempty"`
+	// If paying the invoice fails, it is retried with a fee limit escalating up to this ceiling in ppm
+	RoutingFeeLimitCeilingPpm *uint64 `protobuf:"varint,16,opt,name=routing_fee_limit_ceiling_ppm,json=routingFeeLimitCeilingPpm,proto3,oneof" json:"routing_fee_limit_ceiling_ppm,omitempty"`
+	// Maximal number of parts the payment is split into. Only supported by LND
+	MaxParts *uint32 `protobuf:"varint,17,opt,name=max_parts,json=maxParts,proto3,oneof" json:"max_parts,omitempty"`
 }
 
-func (x *WalletSendFee) Reset() {
-	*x = WalletSendFee{}
+func (x *CreateReverseSwapRequest) Reset() {
+	*x = CreateReverseSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[60]
+		mi := &file_boltzrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletSendFee) String() string {
+func (x *CreateReverseSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletSendFee) ProtoMessage() {}
+func (*CreateReverseSwapRequest) ProtoMessage() {}
 
-func (x *WalletSendFee) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[60]
+func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5101,138 +5209,162 @@ func (x *WalletSendFee) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletSendFee.ProtoReflect.Descriptor instead.
-func (*WalletSendFee) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use CreateReverseSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateReverseSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *WalletSendFee) GetAmount() uint64 {
+func (x *CreateReverseSwapRequest) GetAmount() uint64 {
 	if x != nil {
 		return x.Amount
 	}
 	return 0
 }
 
-func (x *WalletSendFee) GetFee() uint64 {
+func (x *CreateReverseSwapRequest) GetAddress() string {
 	if x != nil {
-		return x.Fee
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *WalletSendFee) GetFeeRate() float64 {
+func (x *CreateReverseSwapRequest) GetAcceptZeroConf() bool {
 	if x != nil {
-		return x.FeeRate
+		return x.AcceptZeroConf
 	}
-	return 0
+	return false
 }
 
-type ListWalletTransactionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CreateReverseSwapRequest) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
 
-	Id                 uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExcludeSwapRelated *bool   `protobuf:"varint,2,opt,name=exclude_swap_related,json=excludeSwapRelated,proto3,oneof" json:"exclude_swap_related,omitempty"`
-	Limit              *uint64 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	Offset             *uint64 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+func (x *CreateReverseSwapRequest) GetChanIds() []string {
+	if x != nil {
+		return x.ChanIds
+	}
+	return nil
 }
 
-func (x *ListWalletTransactionsRequest) Reset() {
-	*x = ListWalletTransactionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[61]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateReverseSwapRequest) GetWalletId() uint64 {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
 	}
+	return 0
 }
 
-func (x *ListWalletTransactionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateReverseSwapRequest) GetReturnImmediately() bool {
+	if x != nil && x.ReturnImmediately != nil {
+		return *x.ReturnImmediately
+	}
+	return false
 }
 
-func (*ListWalletTransactionsRequest) ProtoMessage() {}
+func (x *CreateReverseSwapRequest) GetExternalPay() bool {
+	if x != nil && x.ExternalPay != nil {
+		return *x.ExternalPay
+	}
+	return false
+}
 
-func (x *ListWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[61]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateReverseSwapRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListWalletTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*ListWalletTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{61}
+func (x *CreateReverseSwapRequest) GetDescriptionHash() []byte {
+	if x != nil {
+		return x.DescriptionHash
+	}
+	return nil
 }
 
-func (x *ListWalletTransactionsRequest) GetId() uint64 {
+func (x *CreateReverseSwapRequest) GetInvoiceExpiry() uint64 {
+	if x != nil && x.InvoiceExpiry != nil {
+		return *x.InvoiceExpiry
+	}
+	return 0
+}
+
+func (x *CreateReverseSwapRequest) GetAcceptedPair() *PairInfo {
 	if x != nil {
-		return x.Id
+		return x.AcceptedPair
+	}
+	return nil
+}
+
+func (x *CreateReverseSwapRequest) GetRoutingFeeLimitPpm() uint64 {
+	if x != nil && x.RoutingFeeLimitPpm != nil {
+		return *x.RoutingFeeLimitPpm
 	}
 	return 0
 }
 
-func (x *ListWalletTransactionsRequest) GetExcludeSwapRelated() bool {
-	if x != nil && x.ExcludeSwapRelated != nil {
-		return *x.ExcludeSwapRelated
+func (x *CreateReverseSwapRequest) GetAddMagicRoutingHint() bool {
+	if x != nil && x.AddMagicRoutingHint != nil {
+		return *x.AddMagicRoutingHint
 	}
 	return false
 }
 
-func (x *ListWalletTransactionsRequest) GetLimit() uint64 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *CreateReverseSwapRequest) GetLightningNode() string {
+	if x != nil && x.LightningNode != nil {
+		return *x.LightningNode
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapRequest) GetRoutingFeeLimitCeilingPpm() uint64 {
+	if x != nil && x.RoutingFeeLimitCeilingPpm != nil {
+		return *x.RoutingFeeLimitCeilingPpm
 	}
 	return 0
 }
 
-func (x *ListWalletTransactionsRequest) GetOffset() uint64 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+func (x *CreateReverseSwapRequest) GetMaxParts() uint32 {
+	if x != nil && x.MaxParts != nil {
+		return *x.MaxParts
 	}
 	return 0
 }
 
-type WalletTransaction struct {
+type CreateReverseSwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// balance change of the wallet in satoshis.
-	// its the sum of all output values minus the sum of all input values which are controlled by the wallet.
-	// positive values indicate incoming transactions, negative values outgoing transactions
-	BalanceChange int64 `protobuf:"varint,2,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
-	// The timestamp of the block the transaction was confirmed in or 0 if it is unconfirmed.
-	Timestamp   int64                `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Outputs     []*TransactionOutput `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	BlockHeight uint32               `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// additional information about the tx (type, related swaps etc.)
-	Infos []*TransactionInfo `protobuf:"bytes,7,rep,name=infos,proto3" json:"infos,omitempty"`
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LockupAddress string `protobuf:"bytes,2,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	// Only populated when zero-conf is accepted and return_immediately is set to false
+	RoutingFeeMilliSat *uint64 `protobuf:"varint,3,opt,name=routing_fee_milli_sat,json=routingFeeMilliSat,proto3,oneof" json:"routing_fee_milli_sat,omitempty"`
+	// Only populated when zero-conf is accepted and return_immediately is set to false
+	ClaimTransactionId *string `protobuf:"bytes,4,opt,name=claim_transaction_id,json=claimTransactionId,proto3,oneof" json:"claim_transaction_id,omitempty"`
+	// Invoice to be paid. Only populated when `external_pay` is set to true
+	Invoice *string `protobuf:"bytes,5,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
 }
 
-func (x *WalletTransaction) Reset() {
-	*x = WalletTransaction{}
+func (x *CreateReverseSwapResponse) Reset() {
+	*x = CreateReverseSwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[62]
+		mi := &file_boltzrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletTransaction) String() string {
+func (x *CreateReverseSwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletTransaction) ProtoMessage() {}
+func (*CreateReverseSwapResponse) ProtoMessage() {}
 
-func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[62]
+func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5243,85 +5375,95 @@ func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
-func (*WalletTransaction) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use CreateReverseSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateReverseSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *WalletTransaction) GetId() string {
+func (x *CreateReverseSwapResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *WalletTransaction) GetBalanceChange() int64 {
+func (x *CreateReverseSwapResponse) GetLockupAddress() string {
 	if x != nil {
-		return x.BalanceChange
+		return x.LockupAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *WalletTransaction) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
+func (x *CreateReverseSwapResponse) GetRoutingFeeMilliSat() uint64 {
+	if x != nil && x.RoutingFeeMilliSat != nil {
+		return *x.RoutingFeeMilliSat
 	}
 	return 0
 }
 
-func (x *WalletTransaction) GetOutputs() []*TransactionOutput {
-	if x != nil {
-		return x.Outputs
-	}
-	return nil
-}
-
-func (x *WalletTransaction) GetBlockHeight() uint32 {
-	if x != nil {
-		return x.BlockHeight
+func (x *CreateReverseSwapResponse) GetClaimTransactionId() string {
+	if x != nil && x.ClaimTransactionId != nil {
+		return *x.ClaimTransactionId
 	}
-	return 0
+	return ""
 }
 
-func (x *WalletTransaction) GetInfos() []*TransactionInfo {
-	if x != nil {
-		return x.Infos
+func (x *CreateReverseSwapResponse) GetInvoice() string {
+	if x != nil && x.Invoice != nil {
+		return *x.Invoice
 	}
-	return nil
+	return ""
 }
 
-type BumpTransactionRequest struct {
+type CreateChainSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Previous:
-	//
-	//	*BumpTransactionRequest_TxId
-	//	*BumpTransactionRequest_SwapId
-	Previous isBumpTransactionRequest_Previous `protobuf_oneof:"previous"`
-	// Fee rate for the new transaction. if not specified, the daemon will query the fee rate from the configured provider
-	// and bump the fee by at least 1 sat/vbyte.
-	SatPerVbyte *float64 `protobuf:"fixed64,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+	// Amount of satoshis to swap. It is the amount expected to be sent to the lockup address.
+	// If left empty, any amount within the limits will be accepted.
+	Amount *uint64 `protobuf:"varint,1,opt,name=amount,proto3,oneof" json:"amount,omitempty"`
+	Pair   *Pair   `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Address where funds will be swept to if the swap succeeds
+	ToAddress *string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"`
+	// Address where the coins should be refunded to if the swap fails.
+	RefundAddress *string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
+	// Wallet from which the swap should be paid from. Ignored if `external_pay` is set to true.
+	// If the swap fails, funds will be refunded to this wallet as well.
+	FromWalletId *uint64 `protobuf:"varint,5,opt,name=from_wallet_id,json=fromWalletId,proto3,oneof" json:"from_wallet_id,omitempty"`
+	// Wallet where the the funds will go if the swap succeeds.
+	ToWalletId *uint64 `protobuf:"varint,6,opt,name=to_wallet_id,json=toWalletId,proto3,oneof" json:"to_wallet_id,omitempty"`
+	// Whether the daemon should broadcast the claim transaction immediately after the lockup transaction is in the mempool.
+	// Should only be used for smaller amounts as it involves trust in Boltz.
+	AcceptZeroConf *bool `protobuf:"varint,7,opt,name=accept_zero_conf,json=acceptZeroConf,proto3,oneof" json:"accept_zero_conf,omitempty"`
+	// If set, the daemon will not pay the swap from an internal wallet.
+	ExternalPay *bool `protobuf:"varint,8,opt,name=external_pay,json=externalPay,proto3,oneof" json:"external_pay,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	LockupZeroConf *bool `protobuf:"varint,9,opt,name=lockup_zero_conf,json=lockupZeroConf,proto3,oneof" json:"lockup_zero_conf,omitempty"`
+	// Fee rate to use when sending from internal wallet
+	SatPerVbyte *float64 `protobuf:"fixed64,10,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+	// Rates to accept for the swap. Queries latest from boltz otherwise
+	// The recommended way to use this is to pass a user approved value from a previous `GetPairInfo` call
+	AcceptedPair *PairInfo `protobuf:"bytes,11,opt,name=accepted_pair,json=acceptedPair,proto3,oneof" json:"accepted_pair,omitempty"`
 }
 
-func (x *BumpTransactionRequest) Reset() {
-	*x = BumpTransactionRequest{}
+func (x *CreateChainSwapRequest) Reset() {
+	*x = CreateChainSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[63]
+		mi := &file_boltzrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BumpTransactionRequest) String() string {
+func (x *CreateChainSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BumpTransactionRequest) ProtoMessage() {}
+func (*CreateChainSwapRequest) ProtoMessage() {}
 
-func (x *BumpTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[63]
+func (x *CreateChainSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5332,82 +5474,127 @@ func (x *BumpTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BumpTransactionRequest.ProtoReflect.Descriptor instead.
-func (*BumpTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use CreateChainSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateChainSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (m *BumpTransactionRequest) GetPrevious() isBumpTransactionRequest_Previous {
-	if m != nil {
-		return m.Previous
+func (x *CreateChainSwapRequest) GetAmount() uint64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *CreateChainSwapRequest) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *BumpTransactionRequest) GetTxId() string {
-	if x, ok := x.GetPrevious().(*BumpTransactionRequest_TxId); ok {
-		return x.TxId
+func (x *CreateChainSwapRequest) GetToAddress() string {
+	if x != nil && x.ToAddress != nil {
+		return *x.ToAddress
 	}
 	return ""
 }
 
-func (x *BumpTransactionRequest) GetSwapId() string {
-	if x, ok := x.GetPrevious().(*BumpTransactionRequest_SwapId); ok {
-		return x.SwapId
+func (x *CreateChainSwapRequest) GetRefundAddress() string {
+	if x != nil && x.RefundAddress != nil {
+		return *x.RefundAddress
 	}
 	return ""
 }
 
-func (x *BumpTransactionRequest) GetSatPerVbyte() float64 {
-	if x != nil && x.SatPerVbyte != nil {
-		return *x.SatPerVbyte
+func (x *CreateChainSwapRequest) GetFromWalletId() uint64 {
+	if x != nil && x.FromWalletId != nil {
+		return *x.FromWalletId
 	}
 	return 0
 }
 
-type isBumpTransactionRequest_Previous interface {
-	isBumpTransactionRequest_Previous()
+func (x *CreateChainSwapRequest) GetToWalletId() uint64 {
+	if x != nil && x.ToWalletId != nil {
+		return *x.ToWalletId
+	}
+	return 0
 }
 
-type BumpTransactionRequest_TxId struct {
-	// Id of the transaction to bump. The transaction has to belong to one of the clients wallets
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,oneof"`
+func (x *CreateChainSwapRequest) GetAcceptZeroConf() bool {
+	if x != nil && x.AcceptZeroConf != nil {
+		return *x.AcceptZeroConf
+	}
+	return false
 }
 
-type BumpTransactionRequest_SwapId struct {
-	// Depending on the state of the swap, the lockup, refund or claim transaction will be bumped
-	SwapId string `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,oneof"`
+func (x *CreateChainSwapRequest) GetExternalPay() bool {
+	if x != nil && x.ExternalPay != nil {
+		return *x.ExternalPay
+	}
+	return false
 }
 
-func (*BumpTransactionRequest_TxId) isBumpTransactionRequest_Previous() {}
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *CreateChainSwapRequest) GetLockupZeroConf() bool {
+	if x != nil && x.LockupZeroConf != nil {
+		return *x.LockupZeroConf
+	}
+	return false
+}
 
-func (*BumpTransactionRequest_SwapId) isBumpTransactionRequest_Previous() {}
+func (x *CreateChainSwapRequest) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
 
-type BumpTransactionResponse struct {
+func (x *CreateChainSwapRequest) GetAcceptedPair() *PairInfo {
+	if x != nil {
+		return x.AcceptedPair
+	}
+	return nil
+}
+
+type ChainSwapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Id                string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pair              *Pair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	State             SwapState      `protobuf:"varint,3,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
+	Error             string         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Status            string         `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Preimage          string         `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	IsAuto            bool           `protobuf:"varint,8,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	ServiceFee        *int64         `protobuf:"varint,9,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
+	ServiceFeePercent float64        `protobuf:"fixed64,10,opt,name=service_fee_percent,json=serviceFeePercent,proto3" json:"service_fee_percent,omitempty"`
+	OnchainFee        *uint64        `protobuf:"varint,11,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
+	CreatedAt         int64          `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	TenantId          uint64         `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	FromData          *ChainSwapData `protobuf:"bytes,14,opt,name=from_data,json=fromData,proto3" json:"from_data,omitempty"`
+	ToData            *ChainSwapData `protobuf:"bytes,15,opt,name=to_data,json=toData,proto3" json:"to_data,omitempty"`
 }
 
-func (x *BumpTransactionResponse) Reset() {
-	*x = BumpTransactionResponse{}
+func (x *ChainSwapInfo) Reset() {
+	*x = ChainSwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[64]
+		mi := &file_boltzrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BumpTransactionResponse) String() string {
+func (x *ChainSwapInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BumpTransactionResponse) ProtoMessage() {}
+func (*ChainSwapInfo) ProtoMessage() {}
 
-func (x *BumpTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[64]
+func (x *ChainSwapInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5418,148 +5605,130 @@ func (x *BumpTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BumpTransactionResponse.ProtoReflect.Descriptor instead.
-func (*BumpTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ChainSwapInfo.ProtoReflect.Descriptor instead.
+func (*ChainSwapInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *BumpTransactionResponse) GetTxId() string {
+func (x *ChainSwapInfo) GetId() string {
 	if x != nil {
-		return x.TxId
+		return x.Id
 	}
 	return ""
 }
 
-type TransactionInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// will be populated for LOCKUP, REFUND and CLAIM
-	SwapId *string         `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,oneof" json:"swap_id,omitempty"`
-	Type   TransactionType `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.TransactionType" json:"type,omitempty"`
-}
-
-func (x *TransactionInfo) Reset() {
-	*x = TransactionInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[65]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChainSwapInfo) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
 	}
+	return nil
 }
 
-func (x *TransactionInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TransactionInfo) ProtoMessage() {}
-
-func (x *TransactionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[65]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChainSwapInfo) GetState() SwapState {
+	if x != nil {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return SwapState_PENDING
 }
 
-// Deprecated: Use TransactionInfo.ProtoReflect.Descriptor instead.
-func (*TransactionInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{65}
+func (x *ChainSwapInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *TransactionInfo) GetSwapId() string {
-	if x != nil && x.SwapId != nil {
-		return *x.SwapId
+func (x *ChainSwapInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *TransactionInfo) GetType() TransactionType {
+func (x *ChainSwapInfo) GetPreimage() string {
 	if x != nil {
-		return x.Type
+		return x.Preimage
 	}
-	return TransactionType_UNKNOWN
+	return ""
 }
 
-type TransactionOutput struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// whether the address is controlled by the wallet
-	IsOurAddress bool `protobuf:"varint,3,opt,name=is_our_address,json=isOurAddress,proto3" json:"is_our_address,omitempty"`
+func (x *ChainSwapInfo) GetIsAuto() bool {
+	if x != nil {
+		return x.IsAuto
+	}
+	return false
 }
 
-func (x *TransactionOutput) Reset() {
-	*x = TransactionOutput{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[66]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChainSwapInfo) GetServiceFee() int64 {
+	if x != nil && x.ServiceFee != nil {
+		return *x.ServiceFee
 	}
+	return 0
 }
 
-func (x *TransactionOutput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChainSwapInfo) GetServiceFeePercent() float64 {
+	if x != nil {
+		return x.ServiceFeePercent
+	}
+	return 0
 }
 
-func (*TransactionOutput) ProtoMessage() {}
-
-func (x *TransactionOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[66]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChainSwapInfo) GetOnchainFee() uint64 {
+	if x != nil && x.OnchainFee != nil {
+		return *x.OnchainFee
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TransactionOutput.ProtoReflect.Descriptor instead.
-func (*TransactionOutput) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{66}
+func (x *ChainSwapInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-func (x *TransactionOutput) GetAddress() string {
+func (x *ChainSwapInfo) GetTenantId() uint64 {
 	if x != nil {
-		return x.Address
+		return x.TenantId
 	}
-	return ""
+	return 0
 }
 
-func (x *TransactionOutput) GetAmount() uint64 {
+func (x *ChainSwapInfo) GetFromData() *ChainSwapData {
 	if x != nil {
-		return x.Amount
+		return x.FromData
 	}
-	return 0
+	return nil
 }
 
-func (x *TransactionOutput) GetIsOurAddress() bool {
+func (x *ChainSwapInfo) GetToData() *ChainSwapData {
 	if x != nil {
-		return x.IsOurAddress
+		return x.ToData
 	}
-	return false
+	return nil
 }
 
-type ListWalletTransactionsResponse struct {
+type ChainSwapData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transactions []*WalletTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Id                  string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency            Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
+	PrivateKey          string   `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	TheirPublicKey      string   `protobuf:"bytes,4,opt,name=their_public_key,json=theirPublicKey,proto3" json:"their_public_key,omitempty"`
+	Amount              uint64   `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	TimeoutBlockHeight  uint32   `protobuf:"varint,7,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	LockupTransactionId *string  `protobuf:"bytes,8,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3,oneof" json:"lockup_transaction_id,omitempty"`
+	TransactionId       *string  `protobuf:"bytes,9,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	WalletId            *uint64  `protobuf:"varint,20,opt,name=wallet_id,json=walletId,proto3,oneof" json:"wallet_id,omitempty"`
+	Address             *string  `protobuf:"bytes,12,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	BlindingKey         *string  `protobuf:"bytes,13,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
+	LockupAddress       string   `protobuf:"bytes,14,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
 }
 
-func (x *ListWalletTransactionsResponse) Reset() {
-	*x = ListWalletTransactionsResponse{}
+func (x *ChainSwapData) Reset() {
+	*x = ChainSwapData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5567,13 +5736,13 @@ func (x *ListWalletTransactionsResponse) Reset() {
 	}
 }
 
-func (x *ListWalletTransactionsResponse) String() string {
+func (x *ChainSwapData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWalletTransactionsResponse) ProtoMessage() {}
+func (*ChainSwapData) ProtoMessage() {}
 
-func (x *ListWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *ChainSwapData) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5585,29 +5754,108 @@ func (x *ListWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWalletTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*ListWalletTransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChainSwapData.ProtoReflect.Descriptor instead.
+func (*ChainSwapData) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ListWalletTransactionsResponse) GetTransactions() []*WalletTransaction {
+func (x *ChainSwapData) GetId() string {
 	if x != nil {
-		return x.Transactions
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetWalletCredentialsRequest struct {
+func (x *ChainSwapData) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_BTC
+}
+
+func (x *ChainSwapData) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *ChainSwapData) GetTheirPublicKey() string {
+	if x != nil {
+		return x.TheirPublicKey
+	}
+	return ""
+}
+
+func (x *ChainSwapData) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ChainSwapData) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
+}
+
+func (x *ChainSwapData) GetLockupTransactionId() string {
+	if x != nil && x.LockupTransactionId != nil {
+		return *x.LockupTransactionId
+	}
+	return ""
+}
+
+func (x *ChainSwapData) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *ChainSwapData) GetWalletId() uint64 {
+	if x != nil && x.WalletId != nil {
+		return *x.WalletId
+	}
+	return 0
+}
+
+func (x *ChainSwapData) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *ChainSwapData) GetBlindingKey() string {
+	if x != nil && x.BlindingKey != nil {
+		return *x.BlindingKey
+	}
+	return ""
+}
+
+func (x *ChainSwapData) GetLockupAddress() string {
+	if x != nil {
+		return x.LockupAddress
+	}
+	return ""
+}
+
+type ChannelId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// cln style: 832347x2473x1
+	Cln string `protobuf:"bytes,1,opt,name=cln,proto3" json:"cln,omitempty"`
+	// lnd style: 915175205006540801
+	Lnd uint64 `protobuf:"varint,2,opt,name=lnd,proto3" json:"lnd,omitempty"`
 }
 
-func (x *GetWalletCredentialsRequest) Reset() {
-	*x = GetWalletCredentialsRequest{}
+func (x *ChannelId) Reset() {
+	*x = ChannelId{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5615,13 +5863,13 @@ func (x *GetWalletCredentialsRequest) Reset() {
 	}
 }
 
-func (x *GetWalletCredentialsRequest) String() string {
+func (x *ChannelId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletCredentialsRequest) ProtoMessage() {}
+func (*ChannelId) ProtoMessage() {}
 
-func (x *GetWalletCredentialsRequest) ProtoReflect() protoreflect.Message {
+func (x *ChannelId) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5633,35 +5881,39 @@ func (x *GetWalletCredentialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletCredentialsRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletCredentialsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChannelId.ProtoReflect.Descriptor instead.
+func (*ChannelId) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetWalletCredentialsRequest) GetId() uint64 {
+func (x *ChannelId) GetCln() string {
 	if x != nil {
-		return x.Id
+		return x.Cln
 	}
-	return 0
+	return ""
 }
 
-func (x *GetWalletCredentialsRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *ChannelId) GetLnd() uint64 {
+	if x != nil {
+		return x.Lnd
 	}
-	return ""
+	return 0
 }
 
-type RemoveWalletRequest struct {
+type LightningChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          *ChannelId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity    uint64     `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	OutboundSat uint64     `protobuf:"varint,3,opt,name=outbound_sat,json=outboundSat,proto3" json:"outbound_sat,omitempty"`
+	InboundSat  uint64     `protobuf:"varint,4,opt,name=inbound_sat,json=inboundSat,proto3" json:"inbound_sat,omitempty"`
+	PeerId      string     `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 }
 
-func (x *RemoveWalletRequest) Reset() {
-	*x = RemoveWalletRequest{}
+func (x *LightningChannel) Reset() {
+	*x = LightningChannel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5669,13 +5921,13 @@ func (x *RemoveWalletRequest) Reset() {
 	}
 }
 
-func (x *RemoveWalletRequest) String() string {
+func (x *LightningChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWalletRequest) ProtoMessage() {}
+func (*LightningChannel) ProtoMessage() {}
 
-func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
+func (x *LightningChannel) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5687,37 +5939,61 @@ func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWalletRequest.ProtoReflect.Descriptor instead.
-func (*RemoveWalletRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LightningChannel.ProtoReflect.Descriptor instead.
+func (*LightningChannel) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RemoveWalletRequest) GetId() uint64 {
+func (x *LightningChannel) GetId() *ChannelId {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return nil
 }
 
-type WalletSendRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LightningChannel) GetCapacity() uint64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
 
-	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// Amount of satoshis to be sent to 'address`
-	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Fee rate to use for the transaction
-	SatPerVbyte *float64 `protobuf:"fixed64,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
-	// Sends all available funds to the address. The `amount` field is ignored.
-	SendAll *bool `protobuf:"varint,5,opt,name=send_all,json=sendAll,proto3,oneof" json:"send_all,omitempty"`
-	// whether `address` is the lockup of a swap.
-	IsSwapAddress *bool `protobuf:"varint,6,opt,name=is_swap_address,json=isSwapAddress,proto3,oneof" json:"is_swap_address,omitempty"`
+func (x *LightningChannel) GetOutboundSat() uint64 {
+	if x != nil {
+		return x.OutboundSat
+	}
+	return 0
 }
 
-func (x *WalletSendRequest) Reset() {
-	*x = WalletSendRequest{}
+func (x *LightningChannel) GetInboundSat() uint64 {
+	if x != nil {
+		return x.InboundSat
+	}
+	return 0
+}
+
+func (x *LightningChannel) GetPeerId() string {
+	if x != nil {
+		return x.PeerId
+	}
+	return ""
+}
+
+type SwapStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalFees    int64  `protobuf:"varint,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	TotalAmount  uint64 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	AvgFees      int64  `protobuf:"varint,3,opt,name=avg_fees,json=avgFees,proto3" json:"avg_fees,omitempty"`
+	AvgAmount    uint64 `protobuf:"varint,4,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`
+	Count        uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	SuccessCount uint64 `protobuf:"varint,6,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+}
+
+func (x *SwapStats) Reset() {
+	*x = SwapStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5725,13 +6001,13 @@ func (x *WalletSendRequest) Reset() {
 	}
 }
 
-func (x *WalletSendRequest) String() string {
+func (x *SwapStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletSendRequest) ProtoMessage() {}
+func (*SwapStats) ProtoMessage() {}
 
-func (x *WalletSendRequest) ProtoReflect() protoreflect.Message {
+func (x *SwapStats) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5743,63 +6019,66 @@ func (x *WalletSendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletSendRequest.ProtoReflect.Descriptor instead.
-func (*WalletSendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SwapStats.ProtoReflect.Descriptor instead.
+func (*SwapStats) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *WalletSendRequest) GetId() uint64 {
+func (x *SwapStats) GetTotalFees() int64 {
 	if x != nil {
-		return x.Id
+		return x.TotalFees
 	}
 	return 0
 }
 
-func (x *WalletSendRequest) GetAddress() string {
+func (x *SwapStats) GetTotalAmount() uint64 {
 	if x != nil {
-		return x.Address
+		return x.TotalAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *WalletSendRequest) GetAmount() uint64 {
+func (x *SwapStats) GetAvgFees() int64 {
 	if x != nil {
-		return x.Amount
+		return x.AvgFees
 	}
 	return 0
 }
 
-func (x *WalletSendRequest) GetSatPerVbyte() float64 {
-	if x != nil && x.SatPerVbyte != nil {
-		return *x.SatPerVbyte
+func (x *SwapStats) GetAvgAmount() uint64 {
+	if x != nil {
+		return x.AvgAmount
 	}
 	return 0
 }
 
-func (x *WalletSendRequest) GetSendAll() bool {
-	if x != nil && x.SendAll != nil {
-		return *x.SendAll
+func (x *SwapStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-func (x *WalletSendRequest) GetIsSwapAddress() bool {
-	if x != nil && x.IsSwapAddress != nil {
-		return *x.IsSwapAddress
+func (x *SwapStats) GetSuccessCount() uint64 {
+	if x != nil {
+		return x.SuccessCount
 	}
-	return false
+	return 0
 }
 
-type WalletSendResponse struct {
+type Budget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Remaining int64  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	StartDate int64  `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   int64  `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *WalletSendResponse) Reset() {
-	*x = WalletSendResponse{}
+func (x *Budget) Reset() {
+	*x = Budget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5807,13 +6086,13 @@ func (x *WalletSendResponse) Reset() {
 	}
 }
 
-func (x *WalletSendResponse) String() string {
+func (x *Budget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletSendResponse) ProtoMessage() {}
+func (*Budget) ProtoMessage() {}
 
-func (x *WalletSendResponse) ProtoReflect() protoreflect.Message {
+func (x *Budget) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5825,28 +6104,92 @@ func (x *WalletSendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletSendResponse.ProtoReflect.Descriptor instead.
-func (*WalletSendResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *WalletSendResponse) GetTxId() string {
+func (x *Budget) GetTotal() uint64 {
 	if x != nil {
-		return x.TxId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type WalletReceiveRequest struct {
+func (x *Budget) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Budget) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
+	}
+	return 0
+}
+
+func (x *Budget) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
+	}
+	return 0
+}
+
+// WalletCredentials describes a BIP32 deterministic wallet that can be either:
+// - hot (private keys present), derived from a BIP-39 mnemonic, or
+// - watch-only (public keys only), imported via a descriptor.
+//
+// Hot wallet:
+// - Provide `mnemonic`. The master key is derived per BIP-39.
+// - Optional: provide `core_descriptor` to override the default address scheme.
+// If omitted, a chain-specific default will be derived.
+//
+// Watch-only wallet:
+// - Provide only `core_descriptor` (no `mnemonic`).
+//
+// Multisig wallet:
+// - Provide a multisig or miniscript `core_descriptor` (e.g. `wsh(sortedmulti(...))` or `tr(...)` with script paths)
+// and optionally the `mnemonic` of one of its keys.
+// - The daemon only signs with the keys derived from the mnemonic; the remaining signatures
+// have to be collected using `CreateWalletPsbt`, `SignWalletPsbt` and `FinalizeWalletPsbt`.
+//
+// Mainchain (Bitcoin):
+// - Default descriptor: Bitcoin Core descriptor using a BIP-84 derivation path.
+// - Descriptor format:
+// https://github.com/bitcoin/bitcoin/blob/master/doc/descriptors.md
+//
+// Liquid (Elements):
+// - Default descriptor:
+// CT Descriptor using a BIP-84 derivation path and SLIP77 for blinding key derivation.
+// - Descriptor format:
+// https://github.com/ElementsProject/ELIPs/blob/main/elip-0150.mediawiki
+//
+// Descriptor requirements:
+// - Must be valid according to the currency-specific format
+// - Should describe the wallet’s external keychain.
+// - For hot wallets, the descriptor must be derived from the mnemonic’s master key.
+// For multisig descriptors, at least one of its keys has to be derived from it.
+type WalletCredentials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the mnemonic to derive the wallet master private key (BIP39).
+	Mnemonic *string `protobuf:"bytes,1,opt,name=mnemonic,proto3,oneof" json:"mnemonic,omitempty"`
+	// public key descriptor for the wallets external keychain.
+	CoreDescriptor *string `protobuf:"bytes,3,opt,name=core_descriptor,json=coreDescriptor,proto3,oneof" json:"core_descriptor,omitempty"`
+	// Only used to migrate legacy wallets imported before descriptor-based backends.
+	//
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	Subaccount *uint64 `protobuf:"varint,4,opt,name=subaccount,proto3,oneof" json:"subaccount,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	Xpub *string `protobuf:"bytes,2,opt,name=xpub,proto3,oneof" json:"xpub,omitempty"`
 }
 
-func (x *WalletReceiveRequest) Reset() {
-	*x = WalletReceiveRequest{}
+func (x *WalletCredentials) Reset() {
+	*x = WalletCredentials{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5854,13 +6197,13 @@ func (x *WalletReceiveRequest) Reset() {
 	}
 }
 
-func (x *WalletReceiveRequest) String() string {
+func (x *WalletCredentials) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletReceiveRequest) ProtoMessage() {}
+func (*WalletCredentials) ProtoMessage() {}
 
-func (x *WalletReceiveRequest) ProtoReflect() protoreflect.Message {
+func (x *WalletCredentials) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5872,28 +6215,54 @@ func (x *WalletReceiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletReceiveRequest.ProtoReflect.Descriptor instead.
-func (*WalletReceiveRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WalletCredentials.ProtoReflect.Descriptor instead.
+func (*WalletCredentials) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *WalletReceiveRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
+func (x *WalletCredentials) GetMnemonic() string {
+	if x != nil && x.Mnemonic != nil {
+		return *x.Mnemonic
+	}
+	return ""
+}
+
+func (x *WalletCredentials) GetCoreDescriptor() string {
+	if x != nil && x.CoreDescriptor != nil {
+		return *x.CoreDescriptor
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *WalletCredentials) GetSubaccount() uint64 {
+	if x != nil && x.Subaccount != nil {
+		return *x.Subaccount
 	}
 	return 0
 }
 
-type WalletReceiveResponse struct {
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *WalletCredentials) GetXpub() string {
+	if x != nil && x.Xpub != nil {
+		return *x.Xpub
+	}
+	return ""
+}
+
+type WalletParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Currency Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
+	// the password to encrypt the wallet with. If there are existing encrypted wallets, the same password has to be used.
+	Password *string `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *WalletReceiveResponse) Reset() {
-	*x = WalletReceiveResponse{}
+func (x *WalletParams) Reset() {
+	*x = WalletParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5901,13 +6270,13 @@ func (x *WalletReceiveResponse) Reset() {
 	}
 }
 
-func (x *WalletReceiveResponse) String() string {
+func (x *WalletParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletReceiveResponse) ProtoMessage() {}
+func (*WalletParams) ProtoMessage() {}
 
-func (x *WalletReceiveResponse) ProtoReflect() protoreflect.Message {
+func (x *WalletParams) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5919,33 +6288,43 @@ func (x *WalletReceiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletReceiveResponse.ProtoReflect.Descriptor instead.
-func (*WalletReceiveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WalletParams.ProtoReflect.Descriptor instead.
+func (*WalletParams) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *WalletReceiveResponse) GetAddress() string {
+func (x *WalletParams) GetName() string {
 	if x != nil {
-		return x.Address
+		return x.Name
 	}
 	return ""
 }
 
-type Wallet struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id       uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name     string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Currency Currency `protobuf:"varint,3,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
-	Readonly bool     `protobuf:"varint,4,opt,name=readonly,proto3" json:"readonly,omitempty"`
-	Balance  *Balance `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
-	TenantId uint64   `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+func (x *WalletParams) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_BTC
 }
 
-func (x *Wallet) Reset() {
-	*x = Wallet{}
+func (x *WalletParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+type ImportWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials *WalletCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Params      *WalletParams      `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ImportWalletRequest) Reset() {
+	*x = ImportWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5953,13 +6332,13 @@ func (x *Wallet) Reset() {
 	}
 }
 
-func (x *Wallet) String() string {
+func (x *ImportWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Wallet) ProtoMessage() {}
+func (*ImportWalletRequest) ProtoMessage() {}
 
-func (x *Wallet) ProtoReflect() protoreflect.Message {
+func (x *ImportWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5971,63 +6350,35 @@ func (x *Wallet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
-func (*Wallet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportWalletRequest.ProtoReflect.Descriptor instead.
+func (*ImportWalletRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *Wallet) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Wallet) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Wallet) GetCurrency() Currency {
-	if x != nil {
-		return x.Currency
-	}
-	return Currency_BTC
-}
-
-func (x *Wallet) GetReadonly() bool {
-	if x != nil {
-		return x.Readonly
-	}
-	return false
-}
-
-func (x *Wallet) GetBalance() *Balance {
+func (x *ImportWalletRequest) GetCredentials() *WalletCredentials {
 	if x != nil {
-		return x.Balance
+		return x.Credentials
 	}
 	return nil
 }
 
-func (x *Wallet) GetTenantId() uint64 {
+func (x *ImportWalletRequest) GetParams() *WalletParams {
 	if x != nil {
-		return x.TenantId
+		return x.Params
 	}
-	return 0
+	return nil
 }
 
-type Wallets struct {
+type CreateWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Wallets []*Wallet `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Params *WalletParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (x *Wallets) Reset() {
-	*x = Wallets{}
+func (x *CreateWalletRequest) Reset() {
+	*x = CreateWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6035,13 +6386,13 @@ func (x *Wallets) Reset() {
 	}
 }
 
-func (x *Wallets) String() string {
+func (x *CreateWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Wallets) ProtoMessage() {}
+func (*CreateWalletRequest) ProtoMessage() {}
 
-func (x *Wallets) ProtoReflect() protoreflect.Message {
+func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6053,30 +6404,29 @@ func (x *Wallets) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Wallets.ProtoReflect.Descriptor instead.
-func (*Wallets) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateWalletRequest.ProtoReflect.Descriptor instead.
+func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *Wallets) GetWallets() []*Wallet {
+func (x *CreateWalletRequest) GetParams() *WalletParams {
 	if x != nil {
-		return x.Wallets
+		return x.Params
 	}
 	return nil
 }
 
-type Balance struct {
+type CreateWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total       uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Confirmed   uint64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
-	Unconfirmed uint64 `protobuf:"varint,3,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
+	Mnemonic string  `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Wallet   *Wallet `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
 }
 
-func (x *Balance) Reset() {
-	*x = Balance{}
+func (x *CreateWalletResponse) Reset() {
+	*x = CreateWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6084,13 +6434,13 @@ func (x *Balance) Reset() {
 	}
 }
 
-func (x *Balance) String() string {
+func (x *CreateWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Balance) ProtoMessage() {}
+func (*CreateWalletResponse) ProtoMessage() {}
 
-func (x *Balance) ProtoReflect() protoreflect.Message {
+func (x *CreateWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6102,40 +6452,33 @@ func (x *Balance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
-func (*Balance) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateWalletResponse.ProtoReflect.Descriptor instead.
+func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *Balance) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *Balance) GetConfirmed() uint64 {
+func (x *CreateWalletResponse) GetMnemonic() string {
 	if x != nil {
-		return x.Confirmed
+		return x.Mnemonic
 	}
-	return 0
+	return ""
 }
 
-func (x *Balance) GetUnconfirmed() uint64 {
+func (x *CreateWalletResponse) GetWallet() *Wallet {
 	if x != nil {
-		return x.Unconfirmed
+		return x.Wallet
 	}
-	return 0
+	return nil
 }
 
-type RemoveWalletResponse struct {
+type ImportWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RemoveWalletResponse) Reset() {
-	*x = RemoveWalletResponse{}
+func (x *ImportWalletResponse) Reset() {
+	*x = ImportWalletResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6143,13 +6486,13 @@ func (x *RemoveWalletResponse) Reset() {
 	}
 }
 
-func (x *RemoveWalletResponse) String() string {
+func (x *ImportWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWalletResponse) ProtoMessage() {}
+func (*ImportWalletResponse) ProtoMessage() {}
 
-func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6161,21 +6504,22 @@ func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWalletResponse.ProtoReflect.Descriptor instead.
-func (*RemoveWalletResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportWalletResponse.ProtoReflect.Descriptor instead.
+func (*ImportWalletResponse) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{77}
 }
 
-type UnlockRequest struct {
+type GetWalletsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Currency        *Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
+	IncludeReadonly *bool     `protobuf:"varint,2,opt,name=include_readonly,json=includeReadonly,proto3,oneof" json:"include_readonly,omitempty"`
 }
 
-func (x *UnlockRequest) Reset() {
-	*x = UnlockRequest{}
+func (x *GetWalletsRequest) Reset() {
+	*x = GetWalletsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6183,13 +6527,13 @@ func (x *UnlockRequest) Reset() {
 	}
 }
 
-func (x *UnlockRequest) String() string {
+func (x *GetWalletsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockRequest) ProtoMessage() {}
+func (*GetWalletsRequest) ProtoMessage() {}
 
-func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWalletsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6201,28 +6545,36 @@ func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
-func (*UnlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWalletsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletsRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UnlockRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *GetWalletsRequest) GetCurrency() Currency {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
 	}
-	return ""
+	return Currency_BTC
 }
 
-type VerifyWalletPasswordRequest struct {
+func (x *GetWalletsRequest) GetIncludeReadonly() bool {
+	if x != nil && x.IncludeReadonly != nil {
+		return *x.IncludeReadonly
+	}
+	return false
+}
+
+type GetWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Name *string `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Id   *uint64 `protobuf:"varint,2,opt,name=id,proto3,oneof" json:"id,omitempty"`
 }
 
-func (x *VerifyWalletPasswordRequest) Reset() {
-	*x = VerifyWalletPasswordRequest{}
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6230,13 +6582,13 @@ func (x *VerifyWalletPasswordRequest) Reset() {
 	}
 }
 
-func (x *VerifyWalletPasswordRequest) String() string {
+func (x *GetWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyWalletPasswordRequest) ProtoMessage() {}
+func (*GetWalletRequest) ProtoMessage() {}
 
-func (x *VerifyWalletPasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6248,28 +6600,39 @@ func (x *VerifyWalletPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyWalletPasswordRequest.ProtoReflect.Descriptor instead.
-func (*VerifyWalletPasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *VerifyWalletPasswordRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *GetWalletRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-type VerifyWalletPasswordResponse struct {
+func (x *GetWalletRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+type WalletSendFee struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	// amount of sats which would be sent
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee    uint64 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// the fee rate used for the estimation in sat/vbyte
+	FeeRate float64 `protobuf:"fixed64,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
 }
 
-func (x *VerifyWalletPasswordResponse) Reset() {
-	*x = VerifyWalletPasswordResponse{}
+func (x *WalletSendFee) Reset() {
+	*x = WalletSendFee{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6277,13 +6640,13 @@ func (x *VerifyWalletPasswordResponse) Reset() {
 	}
 }
 
-func (x *VerifyWalletPasswordResponse) String() string {
+func (x *WalletSendFee) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyWalletPasswordResponse) ProtoMessage() {}
+func (*WalletSendFee) ProtoMessage() {}
 
-func (x *VerifyWalletPasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *WalletSendFee) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6295,29 +6658,45 @@ func (x *VerifyWalletPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyWalletPasswordResponse.ProtoReflect.Descriptor instead.
-func (*VerifyWalletPasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WalletSendFee.ProtoReflect.Descriptor instead.
+func (*WalletSendFee) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *VerifyWalletPasswordResponse) GetCorrect() bool {
+func (x *WalletSendFee) GetAmount() uint64 {
 	if x != nil {
-		return x.Correct
+		return x.Amount
 	}
-	return false
-}
-
-type ChangeWalletPasswordRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
-	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	return 0
 }
 
-func (x *ChangeWalletPasswordRequest) Reset() {
-	*x = ChangeWalletPasswordRequest{}
+func (x *WalletSendFee) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *WalletSendFee) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type ListWalletTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExcludeSwapRelated *bool   `protobuf:"varint,2,opt,name=exclude_swap_related,json=excludeSwapRelated,proto3,oneof" json:"exclude_swap_related,omitempty"`
+	Limit              *uint64 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Offset             *uint64 `protobuf:"varint,4,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+}
+
+func (x *ListWalletTransactionsRequest) Reset() {
+	*x = ListWalletTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6325,13 +6704,13 @@ func (x *ChangeWalletPasswordRequest) Reset() {
 	}
 }
 
-func (x *ChangeWalletPasswordRequest) String() string {
+func (x *ListWalletTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeWalletPasswordRequest) ProtoMessage() {}
+func (*ListWalletTransactionsRequest) ProtoMessage() {}
 
-func (x *ChangeWalletPasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *ListWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6343,33 +6722,59 @@ func (x *ChangeWalletPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeWalletPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangeWalletPasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWalletTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ChangeWalletPasswordRequest) GetOld() string {
+func (x *ListWalletTransactionsRequest) GetId() uint64 {
 	if x != nil {
-		return x.Old
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeWalletPasswordRequest) GetNew() string {
-	if x != nil {
-		return x.New
+func (x *ListWalletTransactionsRequest) GetExcludeSwapRelated() bool {
+	if x != nil && x.ExcludeSwapRelated != nil {
+		return *x.ExcludeSwapRelated
 	}
-	return ""
+	return false
 }
 
-type GetSwapMnemonicRequest struct {
+func (x *ListWalletTransactionsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListWalletTransactionsRequest) GetOffset() uint64 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+type WalletTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// balance change of the wallet in satoshis.
+	// its the sum of all output values minus the sum of all input values which are controlled by the wallet.
+	// positive values indicate incoming transactions, negative values outgoing transactions
+	BalanceChange int64 `protobuf:"varint,2,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+	// The timestamp of the block the transaction was confirmed in or 0 if it is unconfirmed.
+	Timestamp   int64                `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Outputs     []*TransactionOutput `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	BlockHeight uint32               `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// additional information about the tx (type, related swaps etc.)
+	Infos []*TransactionInfo `protobuf:"bytes,7,rep,name=infos,proto3" json:"infos,omitempty"`
 }
 
-func (x *GetSwapMnemonicRequest) Reset() {
-	*x = GetSwapMnemonicRequest{}
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6377,13 +6782,13 @@ func (x *GetSwapMnemonicRequest) Reset() {
 	}
 }
 
-func (x *GetSwapMnemonicRequest) String() string {
+func (x *WalletTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSwapMnemonicRequest) ProtoMessage() {}
+func (*WalletTransaction) ProtoMessage() {}
 
-func (x *GetSwapMnemonicRequest) ProtoReflect() protoreflect.Message {
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6395,21 +6800,65 @@ func (x *GetSwapMnemonicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSwapMnemonicRequest.ProtoReflect.Descriptor instead.
-func (*GetSwapMnemonicRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{82}
 }
 
-type GetSwapMnemonicResponse struct {
+func (x *WalletTransaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetBalanceChange() int64 {
+	if x != nil {
+		return x.BalanceChange
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetOutputs() []*TransactionOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *WalletTransaction) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetInfos() []*TransactionInfo {
+	if x != nil {
+		return x.Infos
+	}
+	return nil
+}
+
+type SubscribeWalletTransactionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	// Only stream transactions of this wallet. All wallets of the tenant will be included if not specified.
+	Id                 *uint64 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	ExcludeSwapRelated *bool   `protobuf:"varint,2,opt,name=exclude_swap_related,json=excludeSwapRelated,proto3,oneof" json:"exclude_swap_related,omitempty"`
 }
 
-func (x *GetSwapMnemonicResponse) Reset() {
-	*x = GetSwapMnemonicResponse{}
+func (x *SubscribeWalletTransactionsRequest) Reset() {
+	*x = SubscribeWalletTransactionsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6417,13 +6866,13 @@ func (x *GetSwapMnemonicResponse) Reset() {
 	}
 }
 
-func (x *GetSwapMnemonicResponse) String() string {
+func (x *SubscribeWalletTransactionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSwapMnemonicResponse) ProtoMessage() {}
+func (*SubscribeWalletTransactionsRequest) ProtoMessage() {}
 
-func (x *GetSwapMnemonicResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeWalletTransactionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6435,32 +6884,37 @@ func (x *GetSwapMnemonicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSwapMnemonicResponse.ProtoReflect.Descriptor instead.
-func (*GetSwapMnemonicResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeWalletTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeWalletTransactionsRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetSwapMnemonicResponse) GetMnemonic() string {
-	if x != nil {
-		return x.Mnemonic
+func (x *SubscribeWalletTransactionsRequest) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
-	return ""
+	return 0
 }
 
-type SetSwapMnemonicRequest struct {
+func (x *SubscribeWalletTransactionsRequest) GetExcludeSwapRelated() bool {
+	if x != nil && x.ExcludeSwapRelated != nil {
+		return *x.ExcludeSwapRelated
+	}
+	return false
+}
+
+type WalletTransactionEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Mnemonic:
-	//
-	//	*SetSwapMnemonicRequest_Existing
-	//	*SetSwapMnemonicRequest_Generate
-	Mnemonic isSetSwapMnemonicRequest_Mnemonic `protobuf_oneof:"mnemonic"`
+	WalletId    uint64                     `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Type        WalletTransactionEventType `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.WalletTransactionEventType" json:"type,omitempty"`
+	Transaction *WalletTransaction         `protobuf:"bytes,3,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *SetSwapMnemonicRequest) Reset() {
-	*x = SetSwapMnemonicRequest{}
+func (x *WalletTransactionEvent) Reset() {
+	*x = WalletTransactionEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6468,13 +6922,13 @@ func (x *SetSwapMnemonicRequest) Reset() {
 	}
 }
 
-func (x *SetSwapMnemonicRequest) String() string {
+func (x *WalletTransactionEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSwapMnemonicRequest) ProtoMessage() {}
+func (*WalletTransactionEvent) ProtoMessage() {}
 
-func (x *SetSwapMnemonicRequest) ProtoReflect() protoreflect.Message {
+func (x *WalletTransactionEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6486,58 +6940,49 @@ func (x *SetSwapMnemonicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSwapMnemonicRequest.ProtoReflect.Descriptor instead.
-func (*SetSwapMnemonicRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WalletTransactionEvent.ProtoReflect.Descriptor instead.
+func (*WalletTransactionEvent) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{84}
 }
 
-func (m *SetSwapMnemonicRequest) GetMnemonic() isSetSwapMnemonicRequest_Mnemonic {
-	if m != nil {
-		return m.Mnemonic
+func (x *WalletTransactionEvent) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
 	}
-	return nil
+	return 0
 }
 
-func (x *SetSwapMnemonicRequest) GetExisting() string {
-	if x, ok := x.GetMnemonic().(*SetSwapMnemonicRequest_Existing); ok {
-		return x.Existing
+func (x *WalletTransactionEvent) GetType() WalletTransactionEventType {
+	if x != nil {
+		return x.Type
 	}
-	return ""
+	return WalletTransactionEventType_NEW
 }
 
-func (x *SetSwapMnemonicRequest) GetGenerate() bool {
-	if x, ok := x.GetMnemonic().(*SetSwapMnemonicRequest_Generate); ok {
-		return x.Generate
+func (x *WalletTransactionEvent) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
 	}
-	return false
-}
-
-type isSetSwapMnemonicRequest_Mnemonic interface {
-	isSetSwapMnemonicRequest_Mnemonic()
-}
-
-type SetSwapMnemonicRequest_Existing struct {
-	Existing string `protobuf:"bytes,1,opt,name=existing,proto3,oneof"`
-}
-
-type SetSwapMnemonicRequest_Generate struct {
-	Generate bool `protobuf:"varint,2,opt,name=generate,proto3,oneof"`
+	return nil
 }
 
-func (*SetSwapMnemonicRequest_Existing) isSetSwapMnemonicRequest_Mnemonic() {}
-
-func (*SetSwapMnemonicRequest_Generate) isSetSwapMnemonicRequest_Mnemonic() {}
-
-type SetSwapMnemonicResponse struct {
+type BumpTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	// Types that are assignable to Previous:
+	//
+	//	*BumpTransactionRequest_TxId
+	//	*BumpTransactionRequest_SwapId
+	Previous isBumpTransactionRequest_Previous `protobuf_oneof:"previous"`
+	// Fee rate for the new transaction. if not specified, the daemon will query the fee rate from the configured provider
+	// and bump the fee by at least 1 sat/vbyte.
+	SatPerVbyte *float64 `protobuf:"fixed64,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *SetSwapMnemonicResponse) Reset() {
-	*x = SetSwapMnemonicResponse{}
+func (x *BumpTransactionRequest) Reset() {
+	*x = BumpTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6545,13 +6990,13 @@ func (x *SetSwapMnemonicResponse) Reset() {
 	}
 }
 
-func (x *SetSwapMnemonicResponse) String() string {
+func (x *BumpTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSwapMnemonicResponse) ProtoMessage() {}
+func (*BumpTransactionRequest) ProtoMessage() {}
 
-func (x *SetSwapMnemonicResponse) ProtoReflect() protoreflect.Message {
+func (x *BumpTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6563,23 +7008,1505 @@ func (x *SetSwapMnemonicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSwapMnemonicResponse.ProtoReflect.Descriptor instead.
-func (*SetSwapMnemonicResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BumpTransactionRequest.ProtoReflect.Descriptor instead.
+func (*BumpTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SetSwapMnemonicResponse) GetMnemonic() string {
-	if x != nil {
-		return x.Mnemonic
+func (m *BumpTransactionRequest) GetPrevious() isBumpTransactionRequest_Previous {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (x *BumpTransactionRequest) GetTxId() string {
+	if x, ok := x.GetPrevious().(*BumpTransactionRequest_TxId); ok {
+		return x.TxId
 	}
 	return ""
 }
 
-var File_boltzrpc_proto protoreflect.FileDescriptor
+func (x *BumpTransactionRequest) GetSwapId() string {
+	if x, ok := x.GetPrevious().(*BumpTransactionRequest_SwapId); ok {
+		return x.SwapId
+	}
+	return ""
+}
 
-var file_boltzrpc_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+func (x *BumpTransactionRequest) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
+
+type isBumpTransactionRequest_Previous interface {
+	isBumpTransactionRequest_Previous()
+}
+
+type BumpTransactionRequest_TxId struct {
+	// Id of the transaction to bump. The transaction has to belong to one of the clients wallets
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3,oneof"`
+}
+
+type BumpTransactionRequest_SwapId struct {
+	// Depending on the state of the swap, the lockup, refund or claim transaction will be bumped
+	SwapId string `protobuf:"bytes,2,opt,name=swap_id,json=swapId,proto3,oneof"`
+}
+
+func (*BumpTransactionRequest_TxId) isBumpTransactionRequest_Previous() {}
+
+func (*BumpTransactionRequest_SwapId) isBumpTransactionRequest_Previous() {}
+
+type BumpTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *BumpTransactionResponse) Reset() {
+	*x = BumpTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpTransactionResponse) ProtoMessage() {}
+
+func (x *BumpTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpTransactionResponse.ProtoReflect.Descriptor instead.
+func (*BumpTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *BumpTransactionResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type ConsolidateWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Maximum number of utxos to consolidate, which has to be at least 2. The smallest confirmed utxos are consolidated first.
+	// All confirmed utxos will be consolidated if not specified.
+	MaxUtxos *uint64 `protobuf:"varint,2,opt,name=max_utxos,json=maxUtxos,proto3,oneof" json:"max_utxos,omitempty"`
+	// Fee rate to use for the transaction. if not specified, the daemon will query the fee rate from the configured provider
+	SatPerVbyte *float64 `protobuf:"fixed64,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+}
+
+func (x *ConsolidateWalletRequest) Reset() {
+	*x = ConsolidateWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConsolidateWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsolidateWalletRequest) ProtoMessage() {}
+
+func (x *ConsolidateWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsolidateWalletRequest.ProtoReflect.Descriptor instead.
+func (*ConsolidateWalletRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ConsolidateWalletRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConsolidateWalletRequest) GetMaxUtxos() uint64 {
+	if x != nil && x.MaxUtxos != nil {
+		return *x.MaxUtxos
+	}
+	return 0
+}
+
+func (x *ConsolidateWalletRequest) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
+
+type TransactionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// will be populated for LOCKUP, REFUND and CLAIM
+	SwapId *string         `protobuf:"bytes,1,opt,name=swap_id,json=swapId,proto3,oneof" json:"swap_id,omitempty"`
+	Type   TransactionType `protobuf:"varint,2,opt,name=type,proto3,enum=boltzrpc.TransactionType" json:"type,omitempty"`
+}
+
+func (x *TransactionInfo) Reset() {
+	*x = TransactionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionInfo) ProtoMessage() {}
+
+func (x *TransactionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionInfo.ProtoReflect.Descriptor instead.
+func (*TransactionInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TransactionInfo) GetSwapId() string {
+	if x != nil && x.SwapId != nil {
+		return *x.SwapId
+	}
+	return ""
+}
+
+func (x *TransactionInfo) GetType() TransactionType {
+	if x != nil {
+		return x.Type
+	}
+	return TransactionType_UNKNOWN
+}
+
+type TransactionOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// whether the address is controlled by the wallet
+	IsOurAddress bool `protobuf:"varint,3,opt,name=is_our_address,json=isOurAddress,proto3" json:"is_our_address,omitempty"`
+}
+
+func (x *TransactionOutput) Reset() {
+	*x = TransactionOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionOutput) ProtoMessage() {}
+
+func (x *TransactionOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionOutput.ProtoReflect.Descriptor instead.
+func (*TransactionOutput) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *TransactionOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TransactionOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransactionOutput) GetIsOurAddress() bool {
+	if x != nil {
+		return x.IsOurAddress
+	}
+	return false
+}
+
+type ListWalletTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*WalletTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *ListWalletTransactionsResponse) Reset() {
+	*x = ListWalletTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWalletTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletTransactionsResponse) ProtoMessage() {}
+
+func (x *ListWalletTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListWalletTransactionsResponse) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetWalletCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+}
+
+func (x *GetWalletCredentialsRequest) Reset() {
+	*x = GetWalletCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWalletCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletCredentialsRequest) ProtoMessage() {}
+
+func (x *GetWalletCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetWalletCredentialsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetWalletCredentialsRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+type RemoveWalletRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveWalletRequest) Reset() {
+	*x = RemoveWalletRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWalletRequest) ProtoMessage() {}
+
+func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWalletRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWalletRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RemoveWalletRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WalletSendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount of satoshis to be sent to 'address`
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Fee rate to use for the transaction
+	SatPerVbyte *float64 `protobuf:"fixed64,4,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+	// Sends all available funds to the address. The `amount` field is ignored.
+	SendAll *bool `protobuf:"varint,5,opt,name=send_all,json=sendAll,proto3,oneof" json:"send_all,omitempty"`
+	// whether `address` is the lockup of a swap.
+	IsSwapAddress *bool `protobuf:"varint,6,opt,name=is_swap_address,json=isSwapAddress,proto3,oneof" json:"is_swap_address,omitempty"`
+}
+
+func (x *WalletSendRequest) Reset() {
+	*x = WalletSendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletSendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletSendRequest) ProtoMessage() {}
+
+func (x *WalletSendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletSendRequest.ProtoReflect.Descriptor instead.
+func (*WalletSendRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *WalletSendRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletSendRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WalletSendRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletSendRequest) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *WalletSendRequest) GetSendAll() bool {
+	if x != nil && x.SendAll != nil {
+		return *x.SendAll
+	}
+	return false
+}
+
+func (x *WalletSendRequest) GetIsSwapAddress() bool {
+	if x != nil && x.IsSwapAddress != nil {
+		return *x.IsSwapAddress
+	}
+	return false
+}
+
+type WalletSendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *WalletSendResponse) Reset() {
+	*x = WalletSendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletSendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletSendResponse) ProtoMessage() {}
+
+func (x *WalletSendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletSendResponse.ProtoReflect.Descriptor instead.
+func (*WalletSendResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *WalletSendResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type WalletPsbt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base64 encoded PSBT (PSET for liquid)
+	Psbt string `protobuf:"bytes,1,opt,name=psbt,proto3" json:"psbt,omitempty"`
+	Fee  uint64 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	// amount of sats which will be sent
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// whether all signatures required to finalize the transaction are present
+	Complete bool `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+}
+
+func (x *WalletPsbt) Reset() {
+	*x = WalletPsbt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletPsbt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletPsbt) ProtoMessage() {}
+
+func (x *WalletPsbt) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletPsbt.ProtoReflect.Descriptor instead.
+func (*WalletPsbt) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *WalletPsbt) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+func (x *WalletPsbt) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *WalletPsbt) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletPsbt) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type SignWalletPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Psbt string `protobuf:"bytes,2,opt,name=psbt,proto3" json:"psbt,omitempty"`
+}
+
+func (x *SignWalletPsbtRequest) Reset() {
+	*x = SignWalletPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignWalletPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignWalletPsbtRequest) ProtoMessage() {}
+
+func (x *SignWalletPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignWalletPsbtRequest.ProtoReflect.Descriptor instead.
+func (*SignWalletPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SignWalletPsbtRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SignWalletPsbtRequest) GetPsbt() string {
+	if x != nil {
+		return x.Psbt
+	}
+	return ""
+}
+
+type FinalizeWalletPsbtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the PSBTs will be combined before finalizing, so each of them can contain the signatures of a different cosigner
+	Psbts []string `protobuf:"bytes,2,rep,name=psbts,proto3" json:"psbts,omitempty"`
+}
+
+func (x *FinalizeWalletPsbtRequest) Reset() {
+	*x = FinalizeWalletPsbtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FinalizeWalletPsbtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinalizeWalletPsbtRequest) ProtoMessage() {}
+
+func (x *FinalizeWalletPsbtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinalizeWalletPsbtRequest.ProtoReflect.Descriptor instead.
+func (*FinalizeWalletPsbtRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *FinalizeWalletPsbtRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FinalizeWalletPsbtRequest) GetPsbts() []string {
+	if x != nil {
+		return x.Psbts
+	}
+	return nil
+}
+
+type WalletReceiveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *WalletReceiveRequest) Reset() {
+	*x = WalletReceiveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletReceiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletReceiveRequest) ProtoMessage() {}
+
+func (x *WalletReceiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletReceiveRequest.ProtoReflect.Descriptor instead.
+func (*WalletReceiveRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *WalletReceiveRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WalletReceiveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *WalletReceiveResponse) Reset() {
+	*x = WalletReceiveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletReceiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletReceiveResponse) ProtoMessage() {}
+
+func (x *WalletReceiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletReceiveResponse.ProtoReflect.Descriptor instead.
+func (*WalletReceiveResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *WalletReceiveResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type Wallet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Currency Currency `protobuf:"varint,3,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
+	Readonly bool     `protobuf:"varint,4,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Balance  *Balance `protobuf:"bytes,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	TenantId uint64   `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *Wallet) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Wallet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Wallet) GetCurrency() Currency {
+	if x != nil {
+		return x.Currency
+	}
+	return Currency_BTC
+}
+
+func (x *Wallet) GetReadonly() bool {
+	if x != nil {
+		return x.Readonly
+	}
+	return false
+}
+
+func (x *Wallet) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *Wallet) GetTenantId() uint64 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+type Wallets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wallets []*Wallet `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+}
+
+func (x *Wallets) Reset() {
+	*x = Wallets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Wallets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallets) ProtoMessage() {}
+
+func (x *Wallets) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallets.ProtoReflect.Descriptor instead.
+func (*Wallets) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Wallets) GetWallets() []*Wallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type Balance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Confirmed   uint64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Unconfirmed uint64 `protobuf:"varint,3,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Balance) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Balance) GetConfirmed() uint64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *Balance) GetUnconfirmed() uint64 {
+	if x != nil {
+		return x.Unconfirmed
+	}
+	return 0
+}
+
+type RemoveWalletResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveWalletResponse) Reset() {
+	*x = RemoveWalletResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveWalletResponse) ProtoMessage() {}
+
+func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveWalletResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWalletResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{103}
+}
+
+type UnlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *UnlockRequest) Reset() {
+	*x = UnlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRequest) ProtoMessage() {}
+
+func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UnlockRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type VerifyWalletPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (x *VerifyWalletPasswordRequest) Reset() {
+	*x = VerifyWalletPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyWalletPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyWalletPasswordRequest) ProtoMessage() {}
+
+func (x *VerifyWalletPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyWalletPasswordRequest.ProtoReflect.Descriptor instead.
+func (*VerifyWalletPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *VerifyWalletPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type VerifyWalletPasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+}
+
+func (x *VerifyWalletPasswordResponse) Reset() {
+	*x = VerifyWalletPasswordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyWalletPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyWalletPasswordResponse) ProtoMessage() {}
+
+func (x *VerifyWalletPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyWalletPasswordResponse.ProtoReflect.Descriptor instead.
+func (*VerifyWalletPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *VerifyWalletPasswordResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+type ChangeWalletPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+}
+
+func (x *ChangeWalletPasswordRequest) Reset() {
+	*x = ChangeWalletPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeWalletPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeWalletPasswordRequest) ProtoMessage() {}
+
+func (x *ChangeWalletPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeWalletPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeWalletPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ChangeWalletPasswordRequest) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *ChangeWalletPasswordRequest) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+type GetSwapMnemonicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSwapMnemonicRequest) Reset() {
+	*x = GetSwapMnemonicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSwapMnemonicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwapMnemonicRequest) ProtoMessage() {}
+
+func (x *GetSwapMnemonicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwapMnemonicRequest.ProtoReflect.Descriptor instead.
+func (*GetSwapMnemonicRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{108}
+}
+
+type GetSwapMnemonicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+}
+
+func (x *GetSwapMnemonicResponse) Reset() {
+	*x = GetSwapMnemonicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSwapMnemonicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwapMnemonicResponse) ProtoMessage() {}
+
+func (x *GetSwapMnemonicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwapMnemonicResponse.ProtoReflect.Descriptor instead.
+func (*GetSwapMnemonicResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetSwapMnemonicResponse) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+type SetSwapMnemonicRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Mnemonic:
+	//
+	//	*SetSwapMnemonicRequest_Existing
+	//	*SetSwapMnemonicRequest_Generate
+	Mnemonic isSetSwapMnemonicRequest_Mnemonic `protobuf_oneof:"mnemonic"`
+}
+
+func (x *SetSwapMnemonicRequest) Reset() {
+	*x = SetSwapMnemonicRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSwapMnemonicRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSwapMnemonicRequest) ProtoMessage() {}
+
+func (x *SetSwapMnemonicRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSwapMnemonicRequest.ProtoReflect.Descriptor instead.
+func (*SetSwapMnemonicRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{110}
+}
+
+func (m *SetSwapMnemonicRequest) GetMnemonic() isSetSwapMnemonicRequest_Mnemonic {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return nil
+}
+
+func (x *SetSwapMnemonicRequest) GetExisting() string {
+	if x, ok := x.GetMnemonic().(*SetSwapMnemonicRequest_Existing); ok {
+		return x.Existing
+	}
+	return ""
+}
+
+func (x *SetSwapMnemonicRequest) GetGenerate() bool {
+	if x, ok := x.GetMnemonic().(*SetSwapMnemonicRequest_Generate); ok {
+		return x.Generate
+	}
+	return false
+}
+
+type isSetSwapMnemonicRequest_Mnemonic interface {
+	isSetSwapMnemonicRequest_Mnemonic()
+}
+
+type SetSwapMnemonicRequest_Existing struct {
+	Existing string `protobuf:"bytes,1,opt,name=existing,proto3,oneof"`
+}
+
+type SetSwapMnemonicRequest_Generate struct {
+	Generate bool `protobuf:"varint,2,opt,name=generate,proto3,oneof"`
+}
+
+func (*SetSwapMnemonicRequest_Existing) isSetSwapMnemonicRequest_Mnemonic() {}
+
+func (*SetSwapMnemonicRequest_Generate) isSetSwapMnemonicRequest_Mnemonic() {}
+
+type SetSwapMnemonicResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+}
+
+func (x *SetSwapMnemonicResponse) Reset() {
+	*x = SetSwapMnemonicResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSwapMnemonicResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSwapMnemonicResponse) ProtoMessage() {}
+
+func (x *SetSwapMnemonicResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSwapMnemonicResponse.ProtoReflect.Descriptor instead.
+func (*SetSwapMnemonicResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *SetSwapMnemonicResponse) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+var File_boltzrpc_proto protoreflect.FileDescriptor
+
+var file_boltzrpc_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
 	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
@@ -6594,117 +8521,279 @@ var file_boltzrpc_proto_rawDesc = []byte{
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e,
 	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2c,
-	0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47, 0x0a, 0x13,
-	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4d,
-	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61,
-	0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x3f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x32,
-	0x0a, 0x14, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f,
-	0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x04, 0x50, 0x61, 0x69, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x96, 0x07, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
-	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a,
-	0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32,
-	0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49,
-	0x64, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02,
-	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46,
-	0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x11, 0x0a,
-	0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
-	0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65,
-	0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x22,
-	0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a,
-	0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x22, 0x94, 0x01, 0x0a, 0x08, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22,
-	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x0b,
-	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x27, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xea,
+	0x02, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0a, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x77,
+	0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x03, 0x52, 0x0b, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x6c, 0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
+	0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x1b, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x1c, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x22, 0x7d, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0x7c, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x74, 0x22, 0x89, 0x01,
+	0x0a, 0x18, 0x41, 0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x56, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x22, 0x67, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xba, 0x01, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x4e, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x0c, 0x4f, 0x66, 0x66, 0x65, 0x72,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbe, 0x02, 0x0a,
+	0x05, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x3b, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x3d, 0x0a, 0x12, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x2c, 0x0a, 0x06, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x47,
+	0x0a, 0x13, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6b, 0x65,
+	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x22, 0x32, 0x0a, 0x14, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x04, 0x50, 0x61, 0x69, 0x72, 0x12, 0x26, 0x0a, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x9a, 0x08, 0x0a, 0x08, 0x53, 0x77, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x02, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x08, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74,
+	0x6f, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x12,
+	0x2a, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x10, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52,
+	0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x66, 0x65, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x22,
+	0x7d, 0x0a, 0x12, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x34, 0x0a, 0x13, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52,
+	0x12, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xe8,
+	0x01, 0x0a, 0x08, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12,
+	0x26, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65,
+	0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x42, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xf6, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x21, 0x0a,
+	0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x27, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x22, 0xa1, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75,
 	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
 	0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e,
@@ -6717,887 +8806,1046 @@ var file_boltzrpc_proto_rawDesc = []byte{
 	0x65, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
 	0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x81, 0x02, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77,
-	0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61,
-	0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69,
-	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x14, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x75, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x76, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x75, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f,
-	0x75, 0x74, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x62, 0x69,
-	0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xe0, 0x07, 0x0a, 0x0f, 0x52, 0x65, 0x76,
-	0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65,
-	0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
-	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6c,
-	0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04,
-	0x70, 0x61, 0x69, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73,
-	0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x70, 0x61, 0x69, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6f,
-	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x03, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0e, 0x72,
-	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x50, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x18, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70,
-	0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x22, 0x48, 0x0a, 0x0c, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62,
-	0x74, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x62, 0x74, 0x63, 0x12, 0x1b, 0x0a,
-	0x06, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52,
-	0x06, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd2, 0x04, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x77, 0x61,
-	0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x61, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b,
-	0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x0c, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
-	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06,
-	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0a, 0x6c, 0x6e, 0x64, 0x5f, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09,
-	0x6c, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x27, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x15, 0x70, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61,
-	0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x13, 0x70, 0x65,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x75, 0x0a, 0x06,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61,
-	0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x61,
-	0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x41, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x22, 0x9c,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d,
-	0x61, 0x72, 0x69, 0x6e, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
-	0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0x3d, 0x0a,
-	0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f,
-	0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d,
-	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x04,
-	0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22,
-	0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x73,
-	0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x22, 0xd0, 0x03, 0x0a, 0x0b, 0x41, 0x6e, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18,
+	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43,
+	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48,
+	0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xe4, 0x08, 0x0a,
+	0x0f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72,
-	0x6f, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74,
-	0x6f, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x74, 0x6f, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0a,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
-	0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x02, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
-	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x66, 0x65, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30,
-	0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x66,
-	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x66, 0x79,
-	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x74, 0x6f, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a,
-	0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x6e, 0x69, 0x66, 0x79, 0x22, 0xbf, 0x02, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
-	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x52, 0x0a, 0x11,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x02, 0x18, 0x01, 0x52, 0x10,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73,
-	0x12, 0x38, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x09, 0x61, 0x6c,
-	0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x79, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x73, 0x22, 0x43,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c,
-	0x75, 0x64, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0x0a, 0x16, 0x5f,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x78, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x77,
-	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x77,
-	0x61, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x77,
-	0x61, 0x70, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x1d, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64,
-	0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x3b, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
-	0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49,
-	0x64, 0x12, 0x23, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04,
-	0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c,
-	0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x0a,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x53, 0x77, 0x61, 0x70, 0x22, 0x3d, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
-	0x69, 0x74, 0x79, 0x22, 0x6d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x22, 0x89, 0x04, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04,
-	0x70, 0x61, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20,
-	0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x48, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x02, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x24, 0x0a, 0x09, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x48, 0x03, 0x52, 0x08, 0x7a, 0x65, 0x72, 0x6f, 0x43, 0x6f,
-	0x6e, 0x66, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0b,
-	0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3c,
-	0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x05, 0x52, 0x0c, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a,
-	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x72, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x06, 0x52, 0x09, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x72, 0x68, 0x88, 0x01, 0x01,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x42, 0x10, 0x0a,
-	0x0e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x72, 0x68, 0x22, 0xe9,
-	0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x69, 0x70, 0x32,
-	0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x12, 0x13,
-	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x78, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x75, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
-	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x22, 0x9b, 0x06, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x19,
-	0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x72,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c,
-	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x26, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x50, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2a,
-	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x06, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
-	0x64, 0x50, 0x61, 0x69, 0x72, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x70,
-	0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x70, 0x6d, 0x88, 0x01, 0x01,
-	0x12, 0x38, 0x0a, 0x16, 0x61, 0x64, 0x64, 0x5f, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x5f, 0x72, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x08, 0x52, 0x13, 0x61, 0x64, 0x64, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x48, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x72,
-	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x5f, 0x70, 0x70, 0x6d, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x61, 0x64, 0x64, 0x5f, 0x6d, 0x61, 0x67,
-	0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x22,
-	0x9f, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65,
-	0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14,
-	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x63, 0x6c,
+	0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
+	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c,
 	0x61, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x88,
-	0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66,
-	0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x42, 0x17, 0x0a, 0x15,
-	0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x22, 0x9c, 0x05, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x22, 0x0a,
-	0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x72, 0x65, 0x66,
-	0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
-	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04,
-	0x52, 0x0a, 0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
-	0x2d, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x88, 0x01, 0x01, 0x12, 0x26,
-	0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x50, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x02, 0x18, 0x01, 0x48, 0x07, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5a, 0x65,
-	0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74,
-	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01,
-	0x48, 0x08, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70,
-	0x61, 0x69, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x09, 0x52,
-	0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x88, 0x01, 0x01,
-	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x11, 0x0a,
-	0x0f, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72,
-	0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x6f, 0x63, 0x6b,
-	0x75, 0x70, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72,
-	0x22, 0x91, 0x04, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
+	0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04,
+	0x70, 0x61, 0x69, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x70,
+	0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06,
+	0x70, 0x61, 0x69, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x04, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x2a, 0x0a,
+	0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x10, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x1b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x0f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x6f, 0x64, 0x65, 0x22, 0x8d, 0x03, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61,
+	0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28,
+	0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x6f, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x6f, 0x70, 0x12, 0x2c, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x65, 0x65,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22,
+	0x90, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x22, 0x50, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x48, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x74, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x62, 0x74, 0x63, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x22, 0x10,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xfb, 0x04, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x28, 0x0a,
+	0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x77, 0x61,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12,
+	0x2d, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x06,
+	0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0a, 0x6c, 0x6e, 0x64, 0x5f,
+	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x09, 0x6c, 0x6e, 0x64, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x15, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73,
+	0x77, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x13,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x22, 0x75,
+	0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x18,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73,
+	0x22, 0x9c, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22,
+	0x3d, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x51,
+	0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65,
+	0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x22, 0xd0, 0x03, 0x0a, 0x0b, 0x41, 0x6e, 0x79, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
 	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x6f, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x74, 0x6f, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
+	0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xd6, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01,
+	0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x48, 0x02, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x30, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x75, 0x6e,
+	0x69, 0x66, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x05, 0x75, 0x6e, 0x69,
+	0x66, 0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x6e, 0x69, 0x66, 0x79, 0x22, 0xbf,
+	0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x52,
+	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x09,
+	0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6e, 0x79, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x73,
+	0x22, 0x43, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x07, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x78, 0x0a, 0x11, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x3b, 0x0a, 0x12, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x76,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x77, 0x61,
+	0x70, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x42, 0x0c, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xfd, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x36,
+	0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x22, 0x3d, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x6d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x22, 0xc5, 0x05, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69,
-	0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
-	0x41, 0x75, 0x74, 0x6f, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x01, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x09,
-	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x30, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x6f,
-	0x44, 0x61, 0x74, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x66, 0x65, 0x65, 0x22, 0xb1, 0x04, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77,
-	0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x69, 0x72,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x74, 0x68, 0x65, 0x69, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x15, 0x6c,
-	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x6f,
-	0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
-	0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
-	0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63,
-	0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x2f, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6c, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x6c, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x6e, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x10, 0x4c, 0x69,
-	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12,
-	0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53,
-	0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
-	0x53, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a,
-	0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x76, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x61, 0x76, 0x67, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x67, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x67,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x76, 0x0a, 0x06, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x11, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x1f, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x88, 0x01, 0x01,
-	0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x72,
-	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x27,
-	0x0a, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x04, 0x78, 0x70, 0x75, 0x62, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x48, 0x03, 0x52, 0x04, 0x78, 0x70, 0x75,
-	0x62, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
-	0x63, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x78, 0x70, 0x75, 0x62, 0x22, 0x80, 0x01,
-	0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x22, 0x84, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x45, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x09, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x48, 0x03, 0x52, 0x08, 0x7a, 0x65, 0x72, 0x6f,
+	0x43, 0x6f, 0x6e, 0x66, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04,
+	0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x05, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x50, 0x61, 0x69, 0x72, 0x88, 0x01, 0x01, 0x12, 0x22,
+	0x0a, 0x0a, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x72, 0x68, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x06, 0x52, 0x09, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x4d, 0x72, 0x68, 0x88,
+	0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0d, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x08, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x09, 0x52, 0x0f, 0x73, 0x70, 0x6c, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79,
+	0x74, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x69, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f,
+	0x6d, 0x72, 0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x70, 0x6c, 0x69, 0x63,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xe9, 0x01, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x12, 0x13, 0x0a, 0x05,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68,
+	0x6f, 0x75, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x75, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22,
+	0xf3, 0x07, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49,
+	0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
+	0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50,
+	0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x04, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x06, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x69, 0x72, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x70, 0x6d, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x70, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x38,
+	0x0a, 0x16, 0x61, 0x64, 0x64, 0x5f, 0x6d, 0x61, 0x67, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x48, 0x08,
+	0x52, 0x13, 0x61, 0x64, 0x64, 0x4d, 0x61, 0x67, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x48, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x64,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x1d, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x48, 0x0a, 0x52, 0x19, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x43, 0x65,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x50, 0x70, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0b,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x72, 0x74, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65,
+	0x6c, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x70, 0x61, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x70, 0x70, 0x6d, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x61, 0x64, 0x64, 0x5f,
+	0x6d, 0x61, 0x67, 0x69, 0x63, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x69,
+	0x6e, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x63, 0x65, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x70, 0x6d, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x15, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x12, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53, 0x61, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x73,
+	0x61, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x9c, 0x05, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0c, 0x66,
+	0x72, 0x6f, 0x6d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f,
+	0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x05, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e,
+	0x66, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x10,
+	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42, 0x02, 0x18, 0x01, 0x48, 0x07, 0x52, 0x0e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x48, 0x08, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x56, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x69, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76,
+	0x62, 0x79, 0x74, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x22, 0x91, 0x04, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xb1, 0x04, 0x0a, 0x0d, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a,
+	0x10, 0x74, 0x68, 0x65, 0x69, 0x72, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x65, 0x69, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x37, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x08, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52,
+	0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x2f,
+	0x0a, 0x09, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63,
+	0x6c, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6c, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x6c, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x6e, 0x64, 0x22,
+	0xb0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x63, 0x61, 0x70,
+	0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x76, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x76, 0x67, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x76, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x61, 0x76, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x06, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61,
+	0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22,
+	0xe1, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
+	0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x63, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01, 0x48, 0x02, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x04, 0x78, 0x70, 0x75, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x48,
+	0x03, 0x52, 0x04, 0x78, 0x70, 0x75, 0x62, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d,
+	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x6f, 0x72, 0x65,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x73, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x78,
+	0x70, 0x75, 0x62, 0x22, 0x80, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2e, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x45, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0x5c, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x28, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x01, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x6f, 0x6e,
+	0x6c, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x22, 0x50, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x01, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x0d, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22,
+	0xcc, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x35, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x12, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xf3,
+	0x01, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69,
+	0x6e, 0x66, 0x6f, 0x73, 0x22, 0x90, 0x01, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x35, 0x0a, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
+	0x52, 0x12, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x17,
+	0x0a, 0x15, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x5c,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x12, 0x28, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14,
-	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f,
-	0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0f, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x13, 0x0a, 0x11,
-	0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c,
-	0x79, 0x22, 0x50, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x02, 0x69, 0x64,
-	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e,
-	0x64, 0x46, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0xcc, 0x01, 0x0a, 0x1d, 0x4c, 0x69,
-	0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x14, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x12, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xf3, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2f, 0x0a,
-	0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0x91,
-	0x01, 0x0a, 0x16, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x05, 0x74, 0x78, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
-	0x12, 0x19, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x73,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x16, 0x42, 0x75, 0x6d,
+	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b,
+	0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x22, 0x2e, 0x0a, 0x17,
+	0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x95, 0x01, 0x0a,
+	0x18, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x55, 0x74, 0x78, 0x6f, 0x73, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x73,
 	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74,
-	0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79,
-	0x74, 0x65, 0x22, 0x2e, 0x0a, 0x17, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a,
-	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
-	0x49, 0x64, 0x22, 0x6a, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x22, 0x6b,
-	0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x6f, 0x75, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69,
-	0x73, 0x4f, 0x75, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x61, 0x0a, 0x1e, 0x4c,
-	0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a,
-	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5b,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x74, 0x78,
+	0x6f, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76,
+	0x62, 0x79, 0x74, 0x65, 0x22, 0x6a, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64,
+	0x22, 0x6b, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x6f, 0x75,
+	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x4f, 0x75, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x61, 0x0a,
+	0x1e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3f, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x5b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x25, 0x0a,
+	0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0d,
+	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x41,
+	0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x0d, 0x69, 0x73, 0x53, 0x77, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76,
+	0x62, 0x79, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c,
+	0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x12, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
+	0x22, 0x66, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x73,
+	0x62, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x3b, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x73, 0x62, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x73, 0x62, 0x74, 0x22, 0x41, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x73, 0x61,
-	0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c,
-	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0d,
-	0x69, 0x73, 0x53, 0x77, 0x61, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79,
-	0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x69, 0x73, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x12, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x26,
-	0x0a, 0x14, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x06, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64,
-	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
-	0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x35,
-	0x0a, 0x07, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x07, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x5f, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x72, 0x6d, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
-	0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x39, 0x0a, 0x1b, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x38, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x22, 0x41, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x6c,
-	0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6e, 0x65, 0x77, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e,
-	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d,
-	0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d,
-	0x6f, 0x6e, 0x69, 0x63, 0x22, 0x60, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x08,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x6e,
-	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x35, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x2a, 0x25, 0x0a,
-	0x0e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x08, 0x0a, 0x04, 0x52, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49,
-	0x54, 0x45, 0x10, 0x01, 0x2a, 0x62, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e,
-	0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x09,
-	0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52,
-	0x56, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52,
-	0x45, 0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41,
-	0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x1d, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x54, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a,
-	0x04, 0x4c, 0x42, 0x54, 0x43, 0x10, 0x01, 0x2a, 0x31, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x55, 0x42, 0x4d, 0x41, 0x52, 0x49, 0x4e, 0x45,
-	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12,
-	0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x2d, 0x0a, 0x0c, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c,
-	0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12,
-	0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x0f, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43,
-	0x4b, 0x55, 0x50, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10,
-	0x02, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d,
-	0x43, 0x4f, 0x4e, 0x53, 0x4f, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x32,
-	0xd8, 0x16, 0x0a, 0x05, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
-	0x88, 0x02, 0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x51,
-	0x75, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73,
-	0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a,
-	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x73, 0x62, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x73, 0x62, 0x74, 0x73, 0x22, 0x26, 0x0a, 0x14, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x31, 0x0a, 0x15, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x06, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x12, 0x2b,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x07, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22,
+	0x5f, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x39, 0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x22, 0x38, 0x0a, 0x1c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x22, 0x41, 0x0a, 0x1b, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6e,
+	0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22, 0x18, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x60,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x08, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x22, 0x35, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x2a, 0x25, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x45, 0x41,
+	0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x01, 0x2a, 0x62,
+	0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x43, 0x43,
+	0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45, 0x44,
+	0x10, 0x05, 0x2a, 0x1d, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x07,
+	0x0a, 0x03, 0x42, 0x54, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x42, 0x54, 0x43, 0x10,
+	0x01, 0x2a, 0x31, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
+	0x09, 0x53, 0x55, 0x42, 0x4d, 0x41, 0x52, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x10, 0x02, 0x2a, 0x2d, 0x0a, 0x0c, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54,
+	0x4f, 0x10, 0x02, 0x2a, 0x54, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x43,
+	0x4c, 0x41, 0x49, 0x4d, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x53, 0x4f, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x42, 0x0a, 0x1a, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00,
+	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x44, 0x10, 0x02, 0x32, 0xc3, 0x20,
+	0x0a, 0x05, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02,
+	0x01, 0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x61, 0x69, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x77, 0x61,
+	0x70, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
 	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53,
-	0x77, 0x61, 0x70, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6c, 0x61,
-	0x69, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12,
-	0x43, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x03, 0x88, 0x02, 0x01, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77,
-	0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02,
-	0x01, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
-	0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4c, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77,
-	0x61, 0x70, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x47, 0x0a,
+	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12,
+	0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x12, 0x20, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3c, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x66, 0x66, 0x65,
+	0x72, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x66, 0x66, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
+	0x0c, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x1d, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x4f, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x12, 0x4d, 0x0a,
 	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e,
 	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
 	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62,
@@ -7625,89 +9873,150 @@ var file_boltzrpc_proto_rawDesc = []byte{
 	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c,
 	0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
-	0x0f, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75,
-	0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
-	0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x1b,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x56,
+	0x0a, 0x0f, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d,
+	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x12, 0x47, 0x0a, 0x0e, 0x53, 0x69,
+	0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x12, 0x1f, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x73, 0x62, 0x74, 0x12, 0x57, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x50, 0x73, 0x62, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
 	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x53,
-	0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65,
-	0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x04,
+	0x53, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x65, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x4a,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e,
+	0x61, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x4a, 0x0a,
-	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65,
-	0x6e, 0x61, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x0c, 0x42,
-	0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72,
-	0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x20, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77,
-	0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65,
-	0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x18, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x65, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x25, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x65, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x25,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x0c, 0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x42, 0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42,
+	0x61, 0x6b, 0x65, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e,
+	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x20,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2f,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x32, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -7722,257 +10031,327 @@ func file_boltzrpc_proto_rawDescGZIP() []byte {
 	return file_boltzrpc_proto_rawDescData
 }
 
-var file_boltzrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_boltzrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_boltzrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_boltzrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_boltzrpc_proto_goTypes = []interface{}{
-	(MacaroonAction)(0),                    // 0: boltzrpc.MacaroonAction
-	(SwapState)(0),                         // 1: boltzrpc.SwapState
-	(Currency)(0),                          // 2: boltzrpc.Currency
-	(SwapType)(0),                          // 3: boltzrpc.SwapType
-	(IncludeSwaps)(0),                      // 4: boltzrpc.IncludeSwaps
-	(TransactionType)(0),                   // 5: boltzrpc.TransactionType
-	(*CreateTenantRequest)(nil),            // 6: boltzrpc.CreateTenantRequest
-	(*ListTenantsRequest)(nil),             // 7: boltzrpc.ListTenantsRequest
-	(*ListTenantsResponse)(nil),            // 8: boltzrpc.ListTenantsResponse
-	(*GetTenantRequest)(nil),               // 9: boltzrpc.GetTenantRequest
-	(*RemoveTenantRequest)(nil),            // 10: boltzrpc.RemoveTenantRequest
-	(*Tenant)(nil),                         // 11: boltzrpc.Tenant
-	(*MacaroonPermissions)(nil),            // 12: boltzrpc.MacaroonPermissions
-	(*BakeMacaroonRequest)(nil),            // 13: boltzrpc.BakeMacaroonRequest
-	(*BakeMacaroonResponse)(nil),           // 14: boltzrpc.BakeMacaroonResponse
-	(*Pair)(nil),                           // 15: boltzrpc.Pair
-	(*SwapInfo)(nil),                       // 16: boltzrpc.SwapInfo
-	(*GetPairInfoRequest)(nil),             // 17: boltzrpc.GetPairInfoRequest
-	(*PairInfo)(nil),                       // 18: boltzrpc.PairInfo
-	(*GetSwapQuoteRequest)(nil),            // 19: boltzrpc.GetSwapQuoteRequest
-	(*GetSwapQuoteResponse)(nil),           // 20: boltzrpc.GetSwapQuoteResponse
-	(*ChannelCreationInfo)(nil),            // 21: boltzrpc.ChannelCreationInfo
-	(*CombinedChannelSwapInfo)(nil),        // 22: boltzrpc.CombinedChannelSwapInfo
-	(*ReverseSwapInfo)(nil),                // 23: boltzrpc.ReverseSwapInfo
-	(*BlockHeights)(nil),                   // 24: boltzrpc.BlockHeights
-	(*GetInfoRequest)(nil),                 // 25: boltzrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),                // 26: boltzrpc.GetInfoResponse
-	(*Limits)(nil),                         // 27: boltzrpc.Limits
-	(*SwapFees)(nil),                       // 28: boltzrpc.SwapFees
-	(*GetPairsResponse)(nil),               // 29: boltzrpc.GetPairsResponse
-	(*MinerFees)(nil),                      // 30: boltzrpc.MinerFees
-	(*Fees)(nil),                           // 31: boltzrpc.Fees
-	(*GetServiceInfoRequest)(nil),          // 32: boltzrpc.GetServiceInfoRequest
-	(*GetServiceInfoResponse)(nil),         // 33: boltzrpc.GetServiceInfoResponse
-	(*AnySwapInfo)(nil),                    // 34: boltzrpc.AnySwapInfo
-	(*ListSwapsRequest)(nil),               // 35: boltzrpc.ListSwapsRequest
-	(*ListSwapsResponse)(nil),              // 36: boltzrpc.ListSwapsResponse
-	(*GetStatsRequest)(nil),                // 37: boltzrpc.GetStatsRequest
-	(*GetStatsResponse)(nil),               // 38: boltzrpc.GetStatsResponse
-	(*RefundSwapRequest)(nil),              // 39: boltzrpc.RefundSwapRequest
-	(*ClaimSwapsRequest)(nil),              // 40: boltzrpc.ClaimSwapsRequest
-	(*ClaimSwapsResponse)(nil),             // 41: boltzrpc.ClaimSwapsResponse
-	(*GetSwapInfoRequest)(nil),             // 42: boltzrpc.GetSwapInfoRequest
-	(*GetSwapInfoResponse)(nil),            // 43: boltzrpc.GetSwapInfoResponse
-	(*DepositRequest)(nil),                 // 44: boltzrpc.DepositRequest
-	(*DepositResponse)(nil),                // 45: boltzrpc.DepositResponse
-	(*CreateSwapRequest)(nil),              // 46: boltzrpc.CreateSwapRequest
-	(*CreateSwapResponse)(nil),             // 47: boltzrpc.CreateSwapResponse
-	(*CreateChannelRequest)(nil),           // 48: boltzrpc.CreateChannelRequest
-	(*CreateReverseSwapRequest)(nil),       // 49: boltzrpc.CreateReverseSwapRequest
-	(*CreateReverseSwapResponse)(nil),      // 50: boltzrpc.CreateReverseSwapResponse
-	(*CreateChainSwapRequest)(nil),         // 51: boltzrpc.CreateChainSwapRequest
-	(*ChainSwapInfo)(nil),                  // 52: boltzrpc.ChainSwapInfo
-	(*ChainSwapData)(nil),                  // 53: boltzrpc.ChainSwapData
-	(*ChannelId)(nil),                      // 54: boltzrpc.ChannelId
-	(*LightningChannel)(nil),               // 55: boltzrpc.LightningChannel
-	(*SwapStats)(nil),                      // 56: boltzrpc.SwapStats
-	(*Budget)(nil),                         // 57: boltzrpc.Budget
-	(*WalletCredentials)(nil),              // 58: boltzrpc.WalletCredentials
-	(*WalletParams)(nil),                   // 59: boltzrpc.WalletParams
-	(*ImportWalletRequest)(nil),            // 60: boltzrpc.ImportWalletRequest
-	(*CreateWalletRequest)(nil),            // 61: boltzrpc.CreateWalletRequest
-	(*CreateWalletResponse)(nil),           // 62: boltzrpc.CreateWalletResponse
-	(*ImportWalletResponse)(nil),           // 63: boltzrpc.ImportWalletResponse
-	(*GetWalletsRequest)(nil),              // 64: boltzrpc.GetWalletsRequest
-	(*GetWalletRequest)(nil),               // 65: boltzrpc.GetWalletRequest
-	(*WalletSendFee)(nil),                  // 66: boltzrpc.WalletSendFee
-	(*ListWalletTransactionsRequest)(nil),  // 67: boltzrpc.ListWalletTransactionsRequest
-	(*WalletTransaction)(nil),              // 68: boltzrpc.WalletTransaction
-	(*BumpTransactionRequest)(nil),         // 69: boltzrpc.BumpTransactionRequest
-	(*BumpTransactionResponse)(nil),        // 70: boltzrpc.BumpTransactionResponse
-	(*TransactionInfo)(nil),                // 71: boltzrpc.TransactionInfo
-	(*TransactionOutput)(nil),              // 72: boltzrpc.TransactionOutput
-	(*ListWalletTransactionsResponse)(nil), // 73: boltzrpc.ListWalletTransactionsResponse
-	(*GetWalletCredentialsRequest)(nil),    // 74: boltzrpc.GetWalletCredentialsRequest
-	(*RemoveWalletRequest)(nil),            // 75: boltzrpc.RemoveWalletRequest
-	(*WalletSendRequest)(nil),              // 76: boltzrpc.WalletSendRequest
-	(*WalletSendResponse)(nil),             // 77: boltzrpc.WalletSendResponse
-	(*WalletReceiveRequest)(nil),           // 78: boltzrpc.WalletReceiveRequest
-	(*WalletReceiveResponse)(nil),          // 79: boltzrpc.WalletReceiveResponse
-	(*Wallet)(nil),                         // 80: boltzrpc.Wallet
-	(*Wallets)(nil),                        // 81: boltzrpc.Wallets
-	(*Balance)(nil),                        // 82: boltzrpc.Balance
-	(*RemoveWalletResponse)(nil),           // 83: boltzrpc.RemoveWalletResponse
-	(*UnlockRequest)(nil),                  // 84: boltzrpc.UnlockRequest
-	(*VerifyWalletPasswordRequest)(nil),    // 85: boltzrpc.VerifyWalletPasswordRequest
-	(*VerifyWalletPasswordResponse)(nil),   // 86: boltzrpc.VerifyWalletPasswordResponse
-	(*ChangeWalletPasswordRequest)(nil),    // 87: boltzrpc.ChangeWalletPasswordRequest
-	(*GetSwapMnemonicRequest)(nil),         // 88: boltzrpc.GetSwapMnemonicRequest
-	(*GetSwapMnemonicResponse)(nil),        // 89: boltzrpc.GetSwapMnemonicResponse
-	(*SetSwapMnemonicRequest)(nil),         // 90: boltzrpc.SetSwapMnemonicRequest
-	(*SetSwapMnemonicResponse)(nil),        // 91: boltzrpc.SetSwapMnemonicResponse
-	(*emptypb.Empty)(nil),                  // 92: google.protobuf.Empty
+	(MacaroonAction)(0),                        // 0: boltzrpc.MacaroonAction
+	(SwapState)(0),                             // 1: boltzrpc.SwapState
+	(Currency)(0),                              // 2: boltzrpc.Currency
+	(SwapType)(0),                              // 3: boltzrpc.SwapType
+	(IncludeSwaps)(0),                          // 4: boltzrpc.IncludeSwaps
+	(TransactionType)(0),                       // 5: boltzrpc.TransactionType
+	(WalletTransactionEventType)(0),            // 6: boltzrpc.WalletTransactionEventType
+	(*CreateTenantRequest)(nil),                // 7: boltzrpc.CreateTenantRequest
+	(*ListTenantsRequest)(nil),                 // 8: boltzrpc.ListTenantsRequest
+	(*ListTenantsResponse)(nil),                // 9: boltzrpc.ListTenantsResponse
+	(*GetTenantRequest)(nil),                   // 10: boltzrpc.GetTenantRequest
+	(*RemoveTenantRequest)(nil),                // 11: boltzrpc.RemoveTenantRequest
+	(*SpendingPolicy)(nil),                     // 12: boltzrpc.SpendingPolicy
+	(*ListSpendingPoliciesRequest)(nil),        // 13: boltzrpc.ListSpendingPoliciesRequest
+	(*ListSpendingPoliciesResponse)(nil),       // 14: boltzrpc.ListSpendingPoliciesResponse
+	(*RemoveSpendingPolicyRequest)(nil),        // 15: boltzrpc.RemoveSpendingPolicyRequest
+	(*AllowedAddress)(nil),                     // 16: boltzrpc.AllowedAddress
+	(*AddAllowedAddressRequest)(nil),           // 17: boltzrpc.AddAllowedAddressRequest
+	(*ListAllowedAddressesRequest)(nil),        // 18: boltzrpc.ListAllowedAddressesRequest
+	(*ListAllowedAddressesResponse)(nil),       // 19: boltzrpc.ListAllowedAddressesResponse
+	(*RemoveAllowedAddressRequest)(nil),        // 20: boltzrpc.RemoveAllowedAddressRequest
+	(*CreateOfferRequest)(nil),                 // 21: boltzrpc.CreateOfferRequest
+	(*OfferPayment)(nil),                       // 22: boltzrpc.OfferPayment
+	(*Offer)(nil),                              // 23: boltzrpc.Offer
+	(*ListOffersRequest)(nil),                  // 24: boltzrpc.ListOffersRequest
+	(*ListOffersResponse)(nil),                 // 25: boltzrpc.ListOffersResponse
+	(*DisableOfferRequest)(nil),                // 26: boltzrpc.DisableOfferRequest
+	(*Tenant)(nil),                             // 27: boltzrpc.Tenant
+	(*MacaroonPermissions)(nil),                // 28: boltzrpc.MacaroonPermissions
+	(*BakeMacaroonRequest)(nil),                // 29: boltzrpc.BakeMacaroonRequest
+	(*BakeMacaroonResponse)(nil),               // 30: boltzrpc.BakeMacaroonResponse
+	(*Pair)(nil),                               // 31: boltzrpc.Pair
+	(*SwapInfo)(nil),                           // 32: boltzrpc.SwapInfo
+	(*GetPairInfoRequest)(nil),                 // 33: boltzrpc.GetPairInfoRequest
+	(*RoutingFeeEstimate)(nil),                 // 34: boltzrpc.RoutingFeeEstimate
+	(*PairInfo)(nil),                           // 35: boltzrpc.PairInfo
+	(*GetSwapQuoteRequest)(nil),                // 36: boltzrpc.GetSwapQuoteRequest
+	(*GetSwapQuoteResponse)(nil),               // 37: boltzrpc.GetSwapQuoteResponse
+	(*ChannelCreationInfo)(nil),                // 38: boltzrpc.ChannelCreationInfo
+	(*CombinedChannelSwapInfo)(nil),            // 39: boltzrpc.CombinedChannelSwapInfo
+	(*ReverseSwapInfo)(nil),                    // 40: boltzrpc.ReverseSwapInfo
+	(*PaymentAttempt)(nil),                     // 41: boltzrpc.PaymentAttempt
+	(*PaymentHop)(nil),                         // 42: boltzrpc.PaymentHop
+	(*ListSwapPaymentsRequest)(nil),            // 43: boltzrpc.ListSwapPaymentsRequest
+	(*ListSwapPaymentsResponse)(nil),           // 44: boltzrpc.ListSwapPaymentsResponse
+	(*BlockHeights)(nil),                       // 45: boltzrpc.BlockHeights
+	(*GetInfoRequest)(nil),                     // 46: boltzrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                    // 47: boltzrpc.GetInfoResponse
+	(*Limits)(nil),                             // 48: boltzrpc.Limits
+	(*SwapFees)(nil),                           // 49: boltzrpc.SwapFees
+	(*GetPairsResponse)(nil),                   // 50: boltzrpc.GetPairsResponse
+	(*MinerFees)(nil),                          // 51: boltzrpc.MinerFees
+	(*Fees)(nil),                               // 52: boltzrpc.Fees
+	(*GetServiceInfoRequest)(nil),              // 53: boltzrpc.GetServiceInfoRequest
+	(*GetServiceInfoResponse)(nil),             // 54: boltzrpc.GetServiceInfoResponse
+	(*AnySwapInfo)(nil),                        // 55: boltzrpc.AnySwapInfo
+	(*ListSwapsRequest)(nil),                   // 56: boltzrpc.ListSwapsRequest
+	(*ListSwapsResponse)(nil),                  // 57: boltzrpc.ListSwapsResponse
+	(*GetStatsRequest)(nil),                    // 58: boltzrpc.GetStatsRequest
+	(*GetStatsResponse)(nil),                   // 59: boltzrpc.GetStatsResponse
+	(*RefundSwapRequest)(nil),                  // 60: boltzrpc.RefundSwapRequest
+	(*ClaimSwapsRequest)(nil),                  // 61: boltzrpc.ClaimSwapsRequest
+	(*ClaimSwapsResponse)(nil),                 // 62: boltzrpc.ClaimSwapsResponse
+	(*GetSwapInfoRequest)(nil),                 // 63: boltzrpc.GetSwapInfoRequest
+	(*GetSwapInfoResponse)(nil),                // 64: boltzrpc.GetSwapInfoResponse
+	(*DepositRequest)(nil),                     // 65: boltzrpc.DepositRequest
+	(*DepositResponse)(nil),                    // 66: boltzrpc.DepositResponse
+	(*CreateSwapRequest)(nil),                  // 67: boltzrpc.CreateSwapRequest
+	(*CreateSwapResponse)(nil),                 // 68: boltzrpc.CreateSwapResponse
+	(*CreateChannelRequest)(nil),               // 69: boltzrpc.CreateChannelRequest
+	(*CreateReverseSwapRequest)(nil),           // 70: boltzrpc.CreateReverseSwapRequest
+	(*CreateReverseSwapResponse)(nil),          // 71: boltzrpc.CreateReverseSwapResponse
+	(*CreateChainSwapRequest)(nil),             // 72: boltzrpc.CreateChainSwapRequest
+	(*ChainSwapInfo)(nil),                      // 73: boltzrpc.ChainSwapInfo
+	(*ChainSwapData)(nil),                      // 74: boltzrpc.ChainSwapData
+	(*ChannelId)(nil),                          // 75: boltzrpc.ChannelId
+	(*LightningChannel)(nil),                   // 76: boltzrpc.LightningChannel
+	(*SwapStats)(nil),                          // 77: boltzrpc.SwapStats
+	(*Budget)(nil),                             // 78: boltzrpc.Budget
+	(*WalletCredentials)(nil),                  // 79: boltzrpc.WalletCredentials
+	(*WalletParams)(nil),                       // 80: boltzrpc.WalletParams
+	(*ImportWalletRequest)(nil),                // 81: boltzrpc.ImportWalletRequest
+	(*CreateWalletRequest)(nil),                // 82: boltzrpc.CreateWalletRequest
+	(*CreateWalletResponse)(nil),               // 83: boltzrpc.CreateWalletResponse
+	(*ImportWalletResponse)(nil),               // 84: boltzrpc.ImportWalletResponse
+	(*GetWalletsRequest)(nil),                  // 85: boltzrpc.GetWalletsRequest
+	(*GetWalletRequest)(nil),                   // 86: boltzrpc.GetWalletRequest
+	(*WalletSendFee)(nil),                      // 87: boltzrpc.WalletSendFee
+	(*ListWalletTransactionsRequest)(nil),      // 88: boltzrpc.ListWalletTransactionsRequest
+	(*WalletTransaction)(nil),                  // 89: boltzrpc.WalletTransaction
+	(*SubscribeWalletTransactionsRequest)(nil), // 90: boltzrpc.SubscribeWalletTransactionsRequest
+	(*WalletTransactionEvent)(nil),             // 91: boltzrpc.WalletTransactionEvent
+	(*BumpTransactionRequest)(nil),             // 92: boltzrpc.BumpTransactionRequest
+	(*BumpTransactionResponse)(nil),            // 93: boltzrpc.BumpTransactionResponse
+	(*ConsolidateWalletRequest)(nil),           // 94: boltzrpc.ConsolidateWalletRequest
+	(*TransactionInfo)(nil),                    // 95: boltzrpc.TransactionInfo
+	(*TransactionOutput)(nil),                  // 96: boltzrpc.TransactionOutput
+	(*ListWalletTransactionsResponse)(nil),     // 97: boltzrpc.ListWalletTransactionsResponse
+	(*GetWalletCredentialsRequest)(nil),        // 98: boltzrpc.GetWalletCredentialsRequest
+	(*RemoveWalletRequest)(nil),                // 99: boltzrpc.RemoveWalletRequest
+	(*WalletSendRequest)(nil),                  // 100: boltzrpc.WalletSendRequest
+	(*WalletSendResponse)(nil),                 // 101: boltzrpc.WalletSendResponse
+	(*WalletPsbt)(nil),                         // 102: boltzrpc.WalletPsbt
+	(*SignWalletPsbtRequest)(nil),              // 103: boltzrpc.SignWalletPsbtRequest
+	(*FinalizeWalletPsbtRequest)(nil),          // 104: boltzrpc.FinalizeWalletPsbtRequest
+	(*WalletReceiveRequest)(nil),               // 105: boltzrpc.WalletReceiveRequest
+	(*WalletReceiveResponse)(nil),              // 106: boltzrpc.WalletReceiveResponse
+	(*Wallet)(nil),                             // 107: boltzrpc.Wallet
+	(*Wallets)(nil),                            // 108: boltzrpc.Wallets
+	(*Balance)(nil),                            // 109: boltzrpc.Balance
+	(*RemoveWalletResponse)(nil),               // 110: boltzrpc.RemoveWalletResponse
+	(*UnlockRequest)(nil),                      // 111: boltzrpc.UnlockRequest
+	(*VerifyWalletPasswordRequest)(nil),        // 112: boltzrpc.VerifyWalletPasswordRequest
+	(*VerifyWalletPasswordResponse)(nil),       // 113: boltzrpc.VerifyWalletPasswordResponse
+	(*ChangeWalletPasswordRequest)(nil),        // 114: boltzrpc.ChangeWalletPasswordRequest
+	(*GetSwapMnemonicRequest)(nil),             // 115: boltzrpc.GetSwapMnemonicRequest
+	(*GetSwapMnemonicResponse)(nil),            // 116: boltzrpc.GetSwapMnemonicResponse
+	(*SetSwapMnemonicRequest)(nil),             // 117: boltzrpc.SetSwapMnemonicRequest
+	(*SetSwapMnemonicResponse)(nil),            // 118: boltzrpc.SetSwapMnemonicResponse
+	(*emptypb.Empty)(nil),                      // 119: google.protobuf.Empty
 }
 var file_boltzrpc_proto_depIdxs = []int32{
-	11,  // 0: boltzrpc.ListTenantsResponse.tenants:type_name -> boltzrpc.Tenant
-	0,   // 1: boltzrpc.MacaroonPermissions.action:type_name -> boltzrpc.MacaroonAction
-	12,  // 2: boltzrpc.BakeMacaroonRequest.permissions:type_name -> boltzrpc.MacaroonPermissions
-	2,   // 3: boltzrpc.Pair.from:type_name -> boltzrpc.Currency
-	2,   // 4: boltzrpc.Pair.to:type_name -> boltzrpc.Currency
-	15,  // 5: boltzrpc.SwapInfo.pair:type_name -> boltzrpc.Pair
-	1,   // 6: boltzrpc.SwapInfo.state:type_name -> boltzrpc.SwapState
-	54,  // 7: boltzrpc.SwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
-	3,   // 8: boltzrpc.GetPairInfoRequest.type:type_name -> boltzrpc.SwapType
-	15,  // 9: boltzrpc.GetPairInfoRequest.pair:type_name -> boltzrpc.Pair
-	15,  // 10: boltzrpc.PairInfo.pair:type_name -> boltzrpc.Pair
-	28,  // 11: boltzrpc.PairInfo.fees:type_name -> boltzrpc.SwapFees
-	27,  // 12: boltzrpc.PairInfo.limits:type_name -> boltzrpc.Limits
-	3,   // 13: boltzrpc.GetSwapQuoteRequest.type:type_name -> boltzrpc.SwapType
-	15,  // 14: boltzrpc.GetSwapQuoteRequest.pair:type_name -> boltzrpc.Pair
-	18,  // 15: boltzrpc.GetSwapQuoteResponse.pair_info:type_name -> boltzrpc.PairInfo
-	16,  // 16: boltzrpc.CombinedChannelSwapInfo.swap:type_name -> boltzrpc.SwapInfo
-	21,  // 17: boltzrpc.CombinedChannelSwapInfo.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
-	1,   // 18: boltzrpc.ReverseSwapInfo.state:type_name -> boltzrpc.SwapState
-	15,  // 19: boltzrpc.ReverseSwapInfo.pair:type_name -> boltzrpc.Pair
-	54,  // 20: boltzrpc.ReverseSwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
-	24,  // 21: boltzrpc.GetInfoResponse.block_heights:type_name -> boltzrpc.BlockHeights
-	11,  // 22: boltzrpc.GetInfoResponse.tenant:type_name -> boltzrpc.Tenant
-	18,  // 23: boltzrpc.GetPairsResponse.submarine:type_name -> boltzrpc.PairInfo
-	18,  // 24: boltzrpc.GetPairsResponse.reverse:type_name -> boltzrpc.PairInfo
-	18,  // 25: boltzrpc.GetPairsResponse.chain:type_name -> boltzrpc.PairInfo
-	30,  // 26: boltzrpc.Fees.miner:type_name -> boltzrpc.MinerFees
-	31,  // 27: boltzrpc.GetServiceInfoResponse.fees:type_name -> boltzrpc.Fees
-	27,  // 28: boltzrpc.GetServiceInfoResponse.limits:type_name -> boltzrpc.Limits
-	3,   // 29: boltzrpc.AnySwapInfo.type:type_name -> boltzrpc.SwapType
-	15,  // 30: boltzrpc.AnySwapInfo.pair:type_name -> boltzrpc.Pair
-	1,   // 31: boltzrpc.AnySwapInfo.state:type_name -> boltzrpc.SwapState
-	2,   // 32: boltzrpc.ListSwapsRequest.from:type_name -> boltzrpc.Currency
-	2,   // 33: boltzrpc.ListSwapsRequest.to:type_name -> boltzrpc.Currency
-	1,   // 34: boltzrpc.ListSwapsRequest.state:type_name -> boltzrpc.SwapState
-	4,   // 35: boltzrpc.ListSwapsRequest.include:type_name -> boltzrpc.IncludeSwaps
-	16,  // 36: boltzrpc.ListSwapsResponse.swaps:type_name -> boltzrpc.SwapInfo
-	22,  // 37: boltzrpc.ListSwapsResponse.channel_creations:type_name -> boltzrpc.CombinedChannelSwapInfo
-	23,  // 38: boltzrpc.ListSwapsResponse.reverse_swaps:type_name -> boltzrpc.ReverseSwapInfo
-	52,  // 39: boltzrpc.ListSwapsResponse.chain_swaps:type_name -> boltzrpc.ChainSwapInfo
-	34,  // 40: boltzrpc.ListSwapsResponse.all_swaps:type_name -> boltzrpc.AnySwapInfo
-	4,   // 41: boltzrpc.GetStatsRequest.include:type_name -> boltzrpc.IncludeSwaps
-	56,  // 42: boltzrpc.GetStatsResponse.stats:type_name -> boltzrpc.SwapStats
-	16,  // 43: boltzrpc.GetSwapInfoResponse.swap:type_name -> boltzrpc.SwapInfo
-	21,  // 44: boltzrpc.GetSwapInfoResponse.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
-	23,  // 45: boltzrpc.GetSwapInfoResponse.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
-	52,  // 46: boltzrpc.GetSwapInfoResponse.chain_swap:type_name -> boltzrpc.ChainSwapInfo
-	15,  // 47: boltzrpc.CreateSwapRequest.pair:type_name -> boltzrpc.Pair
-	18,  // 48: boltzrpc.CreateSwapRequest.accepted_pair:type_name -> boltzrpc.PairInfo
-	15,  // 49: boltzrpc.CreateReverseSwapRequest.pair:type_name -> boltzrpc.Pair
-	18,  // 50: boltzrpc.CreateReverseSwapRequest.accepted_pair:type_name -> boltzrpc.PairInfo
-	15,  // 51: boltzrpc.CreateChainSwapRequest.pair:type_name -> boltzrpc.Pair
-	18,  // 52: boltzrpc.CreateChainSwapRequest.accepted_pair:type_name -> boltzrpc.PairInfo
-	15,  // 53: boltzrpc.ChainSwapInfo.pair:type_name -> boltzrpc.Pair
-	1,   // 54: boltzrpc.ChainSwapInfo.state:type_name -> boltzrpc.SwapState
-	53,  // 55: boltzrpc.ChainSwapInfo.from_data:type_name -> boltzrpc.ChainSwapData
-	53,  // 56: boltzrpc.ChainSwapInfo.to_data:type_name -> boltzrpc.ChainSwapData
-	2,   // 57: boltzrpc.ChainSwapData.currency:type_name -> boltzrpc.Currency
-	54,  // 58: boltzrpc.LightningChannel.id:type_name -> boltzrpc.ChannelId
-	2,   // 59: boltzrpc.WalletParams.currency:type_name -> boltzrpc.Currency
-	58,  // 60: boltzrpc.ImportWalletRequest.credentials:type_name -> boltzrpc.WalletCredentials
-	59,  // 61: boltzrpc.ImportWalletRequest.params:type_name -> boltzrpc.WalletParams
-	59,  // 62: boltzrpc.CreateWalletRequest.params:type_name -> boltzrpc.WalletParams
-	80,  // 63: boltzrpc.CreateWalletResponse.wallet:type_name -> boltzrpc.Wallet
-	2,   // 64: boltzrpc.GetWalletsRequest.currency:type_name -> boltzrpc.Currency
-	72,  // 65: boltzrpc.WalletTransaction.outputs:type_name -> boltzrpc.TransactionOutput
-	71,  // 66: boltzrpc.WalletTransaction.infos:type_name -> boltzrpc.TransactionInfo
-	5,   // 67: boltzrpc.TransactionInfo.type:type_name -> boltzrpc.TransactionType
-	68,  // 68: boltzrpc.ListWalletTransactionsResponse.transactions:type_name -> boltzrpc.WalletTransaction
-	2,   // 69: boltzrpc.Wallet.currency:type_name -> boltzrpc.Currency
-	82,  // 70: boltzrpc.Wallet.balance:type_name -> boltzrpc.Balance
-	80,  // 71: boltzrpc.Wallets.wallets:type_name -> boltzrpc.Wallet
-	25,  // 72: boltzrpc.Boltz.GetInfo:input_type -> boltzrpc.GetInfoRequest
-	32,  // 73: boltzrpc.Boltz.GetServiceInfo:input_type -> boltzrpc.GetServiceInfoRequest
-	17,  // 74: boltzrpc.Boltz.GetPairInfo:input_type -> boltzrpc.GetPairInfoRequest
-	92,  // 75: boltzrpc.Boltz.GetPairs:input_type -> google.protobuf.Empty
-	19,  // 76: boltzrpc.Boltz.GetSwapQuote:input_type -> boltzrpc.GetSwapQuoteRequest
-	35,  // 77: boltzrpc.Boltz.ListSwaps:input_type -> boltzrpc.ListSwapsRequest
-	37,  // 78: boltzrpc.Boltz.GetStats:input_type -> boltzrpc.GetStatsRequest
-	39,  // 79: boltzrpc.Boltz.RefundSwap:input_type -> boltzrpc.RefundSwapRequest
-	40,  // 80: boltzrpc.Boltz.ClaimSwaps:input_type -> boltzrpc.ClaimSwapsRequest
-	42,  // 81: boltzrpc.Boltz.GetSwapInfo:input_type -> boltzrpc.GetSwapInfoRequest
-	42,  // 82: boltzrpc.Boltz.GetSwapInfoStream:input_type -> boltzrpc.GetSwapInfoRequest
-	44,  // 83: boltzrpc.Boltz.Deposit:input_type -> boltzrpc.DepositRequest
-	46,  // 84: boltzrpc.Boltz.CreateSwap:input_type -> boltzrpc.CreateSwapRequest
-	48,  // 85: boltzrpc.Boltz.CreateChannel:input_type -> boltzrpc.CreateChannelRequest
-	49,  // 86: boltzrpc.Boltz.CreateReverseSwap:input_type -> boltzrpc.CreateReverseSwapRequest
-	51,  // 87: boltzrpc.Boltz.CreateChainSwap:input_type -> boltzrpc.CreateChainSwapRequest
-	61,  // 88: boltzrpc.Boltz.CreateWallet:input_type -> boltzrpc.CreateWalletRequest
-	60,  // 89: boltzrpc.Boltz.ImportWallet:input_type -> boltzrpc.ImportWalletRequest
-	64,  // 90: boltzrpc.Boltz.GetWallets:input_type -> boltzrpc.GetWalletsRequest
-	65,  // 91: boltzrpc.Boltz.GetWallet:input_type -> boltzrpc.GetWalletRequest
-	76,  // 92: boltzrpc.Boltz.GetWalletSendFee:input_type -> boltzrpc.WalletSendRequest
-	67,  // 93: boltzrpc.Boltz.ListWalletTransactions:input_type -> boltzrpc.ListWalletTransactionsRequest
-	69,  // 94: boltzrpc.Boltz.BumpTransaction:input_type -> boltzrpc.BumpTransactionRequest
-	74,  // 95: boltzrpc.Boltz.GetWalletCredentials:input_type -> boltzrpc.GetWalletCredentialsRequest
-	75,  // 96: boltzrpc.Boltz.RemoveWallet:input_type -> boltzrpc.RemoveWalletRequest
-	76,  // 97: boltzrpc.Boltz.WalletSend:input_type -> boltzrpc.WalletSendRequest
-	78,  // 98: boltzrpc.Boltz.WalletReceive:input_type -> boltzrpc.WalletReceiveRequest
-	92,  // 99: boltzrpc.Boltz.Stop:input_type -> google.protobuf.Empty
-	84,  // 100: boltzrpc.Boltz.Unlock:input_type -> boltzrpc.UnlockRequest
-	85,  // 101: boltzrpc.Boltz.VerifyWalletPassword:input_type -> boltzrpc.VerifyWalletPasswordRequest
-	87,  // 102: boltzrpc.Boltz.ChangeWalletPassword:input_type -> boltzrpc.ChangeWalletPasswordRequest
-	6,   // 103: boltzrpc.Boltz.CreateTenant:input_type -> boltzrpc.CreateTenantRequest
-	7,   // 104: boltzrpc.Boltz.ListTenants:input_type -> boltzrpc.ListTenantsRequest
-	9,   // 105: boltzrpc.Boltz.GetTenant:input_type -> boltzrpc.GetTenantRequest
-	10,  // 106: boltzrpc.Boltz.RemoveTenant:input_type -> boltzrpc.RemoveTenantRequest
-	13,  // 107: boltzrpc.Boltz.BakeMacaroon:input_type -> boltzrpc.BakeMacaroonRequest
-	88,  // 108: boltzrpc.Boltz.GetSwapMnemonic:input_type -> boltzrpc.GetSwapMnemonicRequest
-	90,  // 109: boltzrpc.Boltz.SetSwapMnemonic:input_type -> boltzrpc.SetSwapMnemonicRequest
-	26,  // 110: boltzrpc.Boltz.GetInfo:output_type -> boltzrpc.GetInfoResponse
-	33,  // 111: boltzrpc.Boltz.GetServiceInfo:output_type -> boltzrpc.GetServiceInfoResponse
-	18,  // 112: boltzrpc.Boltz.GetPairInfo:output_type -> boltzrpc.PairInfo
-	29,  // 113: boltzrpc.Boltz.GetPairs:output_type -> boltzrpc.GetPairsResponse
-	20,  // 114: boltzrpc.Boltz.GetSwapQuote:output_type -> boltzrpc.GetSwapQuoteResponse
-	36,  // 115: boltzrpc.Boltz.ListSwaps:output_type -> boltzrpc.ListSwapsResponse
-	38,  // 116: boltzrpc.Boltz.GetStats:output_type -> boltzrpc.GetStatsResponse
-	43,  // 117: boltzrpc.Boltz.RefundSwap:output_type -> boltzrpc.GetSwapInfoResponse
-	41,  // 118: boltzrpc.Boltz.ClaimSwaps:output_type -> boltzrpc.ClaimSwapsResponse
-	43,  // 119: boltzrpc.Boltz.GetSwapInfo:output_type -> boltzrpc.GetSwapInfoResponse
-	43,  // 120: boltzrpc.Boltz.GetSwapInfoStream:output_type -> boltzrpc.GetSwapInfoResponse
-	45,  // 121: boltzrpc.Boltz.Deposit:output_type -> boltzrpc.DepositResponse
-	47,  // 122: boltzrpc.Boltz.CreateSwap:output_type -> boltzrpc.CreateSwapResponse
-	47,  // 123: boltzrpc.Boltz.CreateChannel:output_type -> boltzrpc.CreateSwapResponse
-	50,  // 124: boltzrpc.Boltz.CreateReverseSwap:output_type -> boltzrpc.CreateReverseSwapResponse
-	52,  // 125: boltzrpc.Boltz.CreateChainSwap:output_type -> boltzrpc.ChainSwapInfo
-	62,  // 126: boltzrpc.Boltz.CreateWallet:output_type -> boltzrpc.CreateWalletResponse
-	80,  // 127: boltzrpc.Boltz.ImportWallet:output_type -> boltzrpc.Wallet
-	81,  // 128: boltzrpc.Boltz.GetWallets:output_type -> boltzrpc.Wallets
-	80,  // 129: boltzrpc.Boltz.GetWallet:output_type -> boltzrpc.Wallet
-	66,  // 130: boltzrpc.Boltz.GetWalletSendFee:output_type -> boltzrpc.WalletSendFee
-	73,  // 131: boltzrpc.Boltz.ListWalletTransactions:output_type -> boltzrpc.ListWalletTransactionsResponse
-	70,  // 132: boltzrpc.Boltz.BumpTransaction:output_type -> boltzrpc.BumpTransactionResponse
-	58,  // 133: boltzrpc.Boltz.GetWalletCredentials:output_type -> boltzrpc.WalletCredentials
-	83,  // 134: boltzrpc.Boltz.RemoveWallet:output_type -> boltzrpc.RemoveWalletResponse
-	77,  // 135: boltzrpc.Boltz.WalletSend:output_type -> boltzrpc.WalletSendResponse
-	79,  // 136: boltzrpc.Boltz.WalletReceive:output_type -> boltzrpc.WalletReceiveResponse
-	92,  // 137: boltzrpc.Boltz.Stop:output_type -> google.protobuf.Empty
-	92,  // 138: boltzrpc.Boltz.Unlock:output_type -> google.protobuf.Empty
-	86,  // 139: boltzrpc.Boltz.VerifyWalletPassword:output_type -> boltzrpc.VerifyWalletPasswordResponse
-	92,  // 140: boltzrpc.Boltz.ChangeWalletPassword:output_type -> google.protobuf.Empty
-	11,  // 141: boltzrpc.Boltz.CreateTenant:output_type -> boltzrpc.Tenant
-	8,   // 142: boltzrpc.Boltz.ListTenants:output_type -> boltzrpc.ListTenantsResponse
-	11,  // 143: boltzrpc.Boltz.GetTenant:output_type -> boltzrpc.Tenant
-	92,  // 144: boltzrpc.Boltz.RemoveTenant:output_type -> google.protobuf.Empty
-	14,  // 145: boltzrpc.Boltz.BakeMacaroon:output_type -> boltzrpc.BakeMacaroonResponse
-	89,  // 146: boltzrpc.Boltz.GetSwapMnemonic:output_type -> boltzrpc.GetSwapMnemonicResponse
-	91,  // 147: boltzrpc.Boltz.SetSwapMnemonic:output_type -> boltzrpc.SetSwapMnemonicResponse
-	110, // [110:148] is the sub-list for method output_type
-	72,  // [72:110] is the sub-list for method input_type
-	72,  // [72:72] is the sub-list for extension type_name
-	72,  // [72:72] is the sub-list for extension extendee
-	0,   // [0:72] is the sub-list for field type_name
+	27,  // 0: boltzrpc.ListTenantsResponse.tenants:type_name -> boltzrpc.Tenant
+	12,  // 1: boltzrpc.ListSpendingPoliciesResponse.policies:type_name -> boltzrpc.SpendingPolicy
+	16,  // 2: boltzrpc.ListAllowedAddressesResponse.addresses:type_name -> boltzrpc.AllowedAddress
+	22,  // 3: boltzrpc.Offer.payments:type_name -> boltzrpc.OfferPayment
+	23,  // 4: boltzrpc.ListOffersResponse.offers:type_name -> boltzrpc.Offer
+	0,   // 5: boltzrpc.MacaroonPermissions.action:type_name -> boltzrpc.MacaroonAction
+	28,  // 6: boltzrpc.BakeMacaroonRequest.permissions:type_name -> boltzrpc.MacaroonPermissions
+	2,   // 7: boltzrpc.Pair.from:type_name -> boltzrpc.Currency
+	2,   // 8: boltzrpc.Pair.to:type_name -> boltzrpc.Currency
+	31,  // 9: boltzrpc.SwapInfo.pair:type_name -> boltzrpc.Pair
+	1,   // 10: boltzrpc.SwapInfo.state:type_name -> boltzrpc.SwapState
+	75,  // 11: boltzrpc.SwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
+	41,  // 12: boltzrpc.SwapInfo.payment_attempts:type_name -> boltzrpc.PaymentAttempt
+	3,   // 13: boltzrpc.GetPairInfoRequest.type:type_name -> boltzrpc.SwapType
+	31,  // 14: boltzrpc.GetPairInfoRequest.pair:type_name -> boltzrpc.Pair
+	31,  // 15: boltzrpc.PairInfo.pair:type_name -> boltzrpc.Pair
+	49,  // 16: boltzrpc.PairInfo.fees:type_name -> boltzrpc.SwapFees
+	48,  // 17: boltzrpc.PairInfo.limits:type_name -> boltzrpc.Limits
+	34,  // 18: boltzrpc.PairInfo.routing_fee:type_name -> boltzrpc.RoutingFeeEstimate
+	3,   // 19: boltzrpc.GetSwapQuoteRequest.type:type_name -> boltzrpc.SwapType
+	31,  // 20: boltzrpc.GetSwapQuoteRequest.pair:type_name -> boltzrpc.Pair
+	35,  // 21: boltzrpc.GetSwapQuoteResponse.pair_info:type_name -> boltzrpc.PairInfo
+	34,  // 22: boltzrpc.GetSwapQuoteResponse.routing_fee:type_name -> boltzrpc.RoutingFeeEstimate
+	32,  // 23: boltzrpc.CombinedChannelSwapInfo.swap:type_name -> boltzrpc.SwapInfo
+	38,  // 24: boltzrpc.CombinedChannelSwapInfo.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	1,   // 25: boltzrpc.ReverseSwapInfo.state:type_name -> boltzrpc.SwapState
+	31,  // 26: boltzrpc.ReverseSwapInfo.pair:type_name -> boltzrpc.Pair
+	75,  // 27: boltzrpc.ReverseSwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
+	41,  // 28: boltzrpc.ReverseSwapInfo.payment_attempts:type_name -> boltzrpc.PaymentAttempt
+	42,  // 29: boltzrpc.PaymentAttempt.hops:type_name -> boltzrpc.PaymentHop
+	75,  // 30: boltzrpc.PaymentHop.chan_id:type_name -> boltzrpc.ChannelId
+	41,  // 31: boltzrpc.ListSwapPaymentsResponse.payments:type_name -> boltzrpc.PaymentAttempt
+	45,  // 32: boltzrpc.GetInfoResponse.block_heights:type_name -> boltzrpc.BlockHeights
+	27,  // 33: boltzrpc.GetInfoResponse.tenant:type_name -> boltzrpc.Tenant
+	35,  // 34: boltzrpc.GetPairsResponse.submarine:type_name -> boltzrpc.PairInfo
+	35,  // 35: boltzrpc.GetPairsResponse.reverse:type_name -> boltzrpc.PairInfo
+	35,  // 36: boltzrpc.GetPairsResponse.chain:type_name -> boltzrpc.PairInfo
+	51,  // 37: boltzrpc.Fees.miner:type_name -> boltzrpc.MinerFees
+	52,  // 38: boltzrpc.GetServiceInfoResponse.fees:type_name -> boltzrpc.Fees
+	48,  // 39: boltzrpc.GetServiceInfoResponse.limits:type_name -> boltzrpc.Limits
+	3,   // 40: boltzrpc.AnySwapInfo.type:type_name -> boltzrpc.SwapType
+	31,  // 41: boltzrpc.AnySwapInfo.pair:type_name -> boltzrpc.Pair
+	1,   // 42: boltzrpc.AnySwapInfo.state:type_name -> boltzrpc.SwapState
+	2,   // 43: boltzrpc.ListSwapsRequest.from:type_name -> boltzrpc.Currency
+	2,   // 44: boltzrpc.ListSwapsRequest.to:type_name -> boltzrpc.Currency
+	1,   // 45: boltzrpc.ListSwapsRequest.state:type_name -> boltzrpc.SwapState
+	4,   // 46: boltzrpc.ListSwapsRequest.include:type_name -> boltzrpc.IncludeSwaps
+	32,  // 47: boltzrpc.ListSwapsResponse.swaps:type_name -> boltzrpc.SwapInfo
+	39,  // 48: boltzrpc.ListSwapsResponse.channel_creations:type_name -> boltzrpc.CombinedChannelSwapInfo
+	40,  // 49: boltzrpc.ListSwapsResponse.reverse_swaps:type_name -> boltzrpc.ReverseSwapInfo
+	73,  // 50: boltzrpc.ListSwapsResponse.chain_swaps:type_name -> boltzrpc.ChainSwapInfo
+	55,  // 51: boltzrpc.ListSwapsResponse.all_swaps:type_name -> boltzrpc.AnySwapInfo
+	4,   // 52: boltzrpc.GetStatsRequest.include:type_name -> boltzrpc.IncludeSwaps
+	77,  // 53: boltzrpc.GetStatsResponse.stats:type_name -> boltzrpc.SwapStats
+	32,  // 54: boltzrpc.GetSwapInfoResponse.swap:type_name -> boltzrpc.SwapInfo
+	38,  // 55: boltzrpc.GetSwapInfoResponse.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	40,  // 56: boltzrpc.GetSwapInfoResponse.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
+	73,  // 57: boltzrpc.GetSwapInfoResponse.chain_swap:type_name -> boltzrpc.ChainSwapInfo
+	31,  // 58: boltzrpc.CreateSwapRequest.pair:type_name -> boltzrpc.Pair
+	35,  // 59: boltzrpc.CreateSwapRequest.accepted_pair:type_name -> boltzrpc.PairInfo
+	31,  // 60: boltzrpc.CreateReverseSwapRequest.pair:type_name -> boltzrpc.Pair
+	35,  // 61: boltzrpc.CreateReverseSwapRequest.accepted_pair:type_name -> boltzrpc.PairInfo
+	31,  // 62: boltzrpc.CreateChainSwapRequest.pair:type_name -> boltzrpc.Pair
+	35,  // 63: boltzrpc.CreateChainSwapRequest.accepted_pair:type_name -> boltzrpc.PairInfo
+	31,  // 64: boltzrpc.ChainSwapInfo.pair:type_name -> boltzrpc.Pair
+	1,   // 65: boltzrpc.ChainSwapInfo.state:type_name -> boltzrpc.SwapState
+	74,  // 66: boltzrpc.ChainSwapInfo.from_data:type_name -> boltzrpc.ChainSwapData
+	74,  // 67: boltzrpc.ChainSwapInfo.to_data:type_name -> boltzrpc.ChainSwapData
+	2,   // 68: boltzrpc.ChainSwapData.currency:type_name -> boltzrpc.Currency
+	75,  // 69: boltzrpc.LightningChannel.id:type_name -> boltzrpc.ChannelId
+	2,   // 70: boltzrpc.WalletParams.currency:type_name -> boltzrpc.Currency
+	79,  // 71: boltzrpc.ImportWalletRequest.credentials:type_name -> boltzrpc.WalletCredentials
+	80,  // 72: boltzrpc.ImportWalletRequest.params:type_name -> boltzrpc.WalletParams
+	80,  // 73: boltzrpc.CreateWalletRequest.params:type_name -> boltzrpc.WalletParams
+	107, // 74: boltzrpc.CreateWalletResponse.wallet:type_name -> boltzrpc.Wallet
+	2,   // 75: boltzrpc.GetWalletsRequest.currency:type_name -> boltzrpc.Currency
+	96,  // 76: boltzrpc.WalletTransaction.outputs:type_name -> boltzrpc.TransactionOutput
+	95,  // 77: boltzrpc.WalletTransaction.infos:type_name -> boltzrpc.TransactionInfo
+	6,   // 78: boltzrpc.WalletTransactionEvent.type:type_name -> boltzrpc.WalletTransactionEventType
+	89,  // 79: boltzrpc.WalletTransactionEvent.transaction:type_name -> boltzrpc.WalletTransaction
+	5,   // 80: boltzrpc.TransactionInfo.type:type_name -> boltzrpc.TransactionType
+	89,  // 81: boltzrpc.ListWalletTransactionsResponse.transactions:type_name -> boltzrpc.WalletTransaction
+	2,   // 82: boltzrpc.Wallet.currency:type_name -> boltzrpc.Currency
+	109, // 83: boltzrpc.Wallet.balance:type_name -> boltzrpc.Balance
+	107, // 84: boltzrpc.Wallets.wallets:type_name -> boltzrpc.Wallet
+	46,  // 85: boltzrpc.Boltz.GetInfo:input_type -> boltzrpc.GetInfoRequest
+	53,  // 86: boltzrpc.Boltz.GetServiceInfo:input_type -> boltzrpc.GetServiceInfoRequest
+	33,  // 87: boltzrpc.Boltz.GetPairInfo:input_type -> boltzrpc.GetPairInfoRequest
+	119, // 88: boltzrpc.Boltz.GetPairs:input_type -> google.protobuf.Empty
+	36,  // 89: boltzrpc.Boltz.GetSwapQuote:input_type -> boltzrpc.GetSwapQuoteRequest
+	56,  // 90: boltzrpc.Boltz.ListSwaps:input_type -> boltzrpc.ListSwapsRequest
+	58,  // 91: boltzrpc.Boltz.GetStats:input_type -> boltzrpc.GetStatsRequest
+	60,  // 92: boltzrpc.Boltz.RefundSwap:input_type -> boltzrpc.RefundSwapRequest
+	61,  // 93: boltzrpc.Boltz.ClaimSwaps:input_type -> boltzrpc.ClaimSwapsRequest
+	63,  // 94: boltzrpc.Boltz.GetSwapInfo:input_type -> boltzrpc.GetSwapInfoRequest
+	43,  // 95: boltzrpc.Boltz.ListSwapPayments:input_type -> boltzrpc.ListSwapPaymentsRequest
+	63,  // 96: boltzrpc.Boltz.GetSwapInfoStream:input_type -> boltzrpc.GetSwapInfoRequest
+	65,  // 97: boltzrpc.Boltz.Deposit:input_type -> boltzrpc.DepositRequest
+	67,  // 98: boltzrpc.Boltz.CreateSwap:input_type -> boltzrpc.CreateSwapRequest
+	69,  // 99: boltzrpc.Boltz.CreateChannel:input_type -> boltzrpc.CreateChannelRequest
+	70,  // 100: boltzrpc.Boltz.CreateReverseSwap:input_type -> boltzrpc.CreateReverseSwapRequest
+	72,  // 101: boltzrpc.Boltz.CreateChainSwap:input_type -> boltzrpc.CreateChainSwapRequest
+	21,  // 102: boltzrpc.Boltz.CreateOffer:input_type -> boltzrpc.CreateOfferRequest
+	24,  // 103: boltzrpc.Boltz.ListOffers:input_type -> boltzrpc.ListOffersRequest
+	26,  // 104: boltzrpc.Boltz.DisableOffer:input_type -> boltzrpc.DisableOfferRequest
+	82,  // 105: boltzrpc.Boltz.CreateWallet:input_type -> boltzrpc.CreateWalletRequest
+	81,  // 106: boltzrpc.Boltz.ImportWallet:input_type -> boltzrpc.ImportWalletRequest
+	85,  // 107: boltzrpc.Boltz.GetWallets:input_type -> boltzrpc.GetWalletsRequest
+	86,  // 108: boltzrpc.Boltz.GetWallet:input_type -> boltzrpc.GetWalletRequest
+	100, // 109: boltzrpc.Boltz.GetWalletSendFee:input_type -> boltzrpc.WalletSendRequest
+	88,  // 110: boltzrpc.Boltz.ListWalletTransactions:input_type -> boltzrpc.ListWalletTransactionsRequest
+	90,  // 111: boltzrpc.Boltz.SubscribeWalletTransactions:input_type -> boltzrpc.SubscribeWalletTransactionsRequest
+	92,  // 112: boltzrpc.Boltz.BumpTransaction:input_type -> boltzrpc.BumpTransactionRequest
+	94,  // 113: boltzrpc.Boltz.ConsolidateWallet:input_type -> boltzrpc.ConsolidateWalletRequest
+	98,  // 114: boltzrpc.Boltz.GetWalletCredentials:input_type -> boltzrpc.GetWalletCredentialsRequest
+	99,  // 115: boltzrpc.Boltz.RemoveWallet:input_type -> boltzrpc.RemoveWalletRequest
+	100, // 116: boltzrpc.Boltz.WalletSend:input_type -> boltzrpc.WalletSendRequest
+	105, // 117: boltzrpc.Boltz.WalletReceive:input_type -> boltzrpc.WalletReceiveRequest
+	100, // 118: boltzrpc.Boltz.CreateWalletPsbt:input_type -> boltzrpc.WalletSendRequest
+	103, // 119: boltzrpc.Boltz.SignWalletPsbt:input_type -> boltzrpc.SignWalletPsbtRequest
+	104, // 120: boltzrpc.Boltz.FinalizeWalletPsbt:input_type -> boltzrpc.FinalizeWalletPsbtRequest
+	119, // 121: boltzrpc.Boltz.Stop:input_type -> google.protobuf.Empty
+	111, // 122: boltzrpc.Boltz.Unlock:input_type -> boltzrpc.UnlockRequest
+	112, // 123: boltzrpc.Boltz.VerifyWalletPassword:input_type -> boltzrpc.VerifyWalletPasswordRequest
+	114, // 124: boltzrpc.Boltz.ChangeWalletPassword:input_type -> boltzrpc.ChangeWalletPasswordRequest
+	7,   // 125: boltzrpc.Boltz.CreateTenant:input_type -> boltzrpc.CreateTenantRequest
+	8,   // 126: boltzrpc.Boltz.ListTenants:input_type -> boltzrpc.ListTenantsRequest
+	10,  // 127: boltzrpc.Boltz.GetTenant:input_type -> boltzrpc.GetTenantRequest
+	11,  // 128: boltzrpc.Boltz.RemoveTenant:input_type -> boltzrpc.RemoveTenantRequest
+	12,  // 129: boltzrpc.Boltz.SetSpendingPolicy:input_type -> boltzrpc.SpendingPolicy
+	13,  // 130: boltzrpc.Boltz.ListSpendingPolicies:input_type -> boltzrpc.ListSpendingPoliciesRequest
+	15,  // 131: boltzrpc.Boltz.RemoveSpendingPolicy:input_type -> boltzrpc.RemoveSpendingPolicyRequest
+	17,  // 132: boltzrpc.Boltz.AddAllowedAddress:input_type -> boltzrpc.AddAllowedAddressRequest
+	18,  // 133: boltzrpc.Boltz.ListAllowedAddresses:input_type -> boltzrpc.ListAllowedAddressesRequest
+	20,  // 134: boltzrpc.Boltz.RemoveAllowedAddress:input_type -> boltzrpc.RemoveAllowedAddressRequest
+	29,  // 135: boltzrpc.Boltz.BakeMacaroon:input_type -> boltzrpc.BakeMacaroonRequest
+	115, // 136: boltzrpc.Boltz.GetSwapMnemonic:input_type -> boltzrpc.GetSwapMnemonicRequest
+	117, // 137: boltzrpc.Boltz.SetSwapMnemonic:input_type -> boltzrpc.SetSwapMnemonicRequest
+	47,  // 138: boltzrpc.Boltz.GetInfo:output_type -> boltzrpc.GetInfoResponse
+	54,  // 139: boltzrpc.Boltz.GetServiceInfo:output_type -> boltzrpc.GetServiceInfoResponse
+	35,  // 140: boltzrpc.Boltz.GetPairInfo:output_type -> boltzrpc.PairInfo
+	50,  // 141: boltzrpc.Boltz.GetPairs:output_type -> boltzrpc.GetPairsResponse
+	37,  // 142: boltzrpc.Boltz.GetSwapQuote:output_type -> boltzrpc.GetSwapQuoteResponse
+	57,  // 143: boltzrpc.Boltz.ListSwaps:output_type -> boltzrpc.ListSwapsResponse
+	59,  // 144: boltzrpc.Boltz.GetStats:output_type -> boltzrpc.GetStatsResponse
+	64,  // 145: boltzrpc.Boltz.RefundSwap:output_type -> boltzrpc.GetSwapInfoResponse
+	62,  // 146: boltzrpc.Boltz.ClaimSwaps:output_type -> boltzrpc.ClaimSwapsResponse
+	64,  // 147: boltzrpc.Boltz.GetSwapInfo:output_type -> boltzrpc.GetSwapInfoResponse
+	44,  // 148: boltzrpc.Boltz.ListSwapPayments:output_type -> boltzrpc.ListSwapPaymentsResponse
+	64,  // 149: boltzrpc.Boltz.GetSwapInfoStream:output_type -> boltzrpc.GetSwapInfoResponse
+	66,  // 150: boltzrpc.Boltz.Deposit:output_type -> boltzrpc.DepositResponse
+	68,  // 151: boltzrpc.Boltz.CreateSwap:output_type -> boltzrpc.CreateSwapResponse
+	68,  // 152: boltzrpc.Boltz.CreateChannel:output_type -> boltzrpc.CreateSwapResponse
+	71,  // 153: boltzrpc.Boltz.CreateReverseSwap:output_type -> boltzrpc.CreateReverseSwapResponse
+	73,  // 154: boltzrpc.Boltz.CreateChainSwap:output_type -> boltzrpc.ChainSwapInfo
+	23,  // 155: boltzrpc.Boltz.CreateOffer:output_type -> boltzrpc.Offer
+	25,  // 156: boltzrpc.Boltz.ListOffers:output_type -> boltzrpc.ListOffersResponse
+	23,  // 157: boltzrpc.Boltz.DisableOffer:output_type -> boltzrpc.Offer
+	83,  // 158: boltzrpc.Boltz.CreateWallet:output_type -> boltzrpc.CreateWalletResponse
+	107, // 159: boltzrpc.Boltz.ImportWallet:output_type -> boltzrpc.Wallet
+	108, // 160: boltzrpc.Boltz.GetWallets:output_type -> boltzrpc.Wallets
+	107, // 161: boltzrpc.Boltz.GetWallet:output_type -> boltzrpc.Wallet
+	87,  // 162: boltzrpc.Boltz.GetWalletSendFee:output_type -> boltzrpc.WalletSendFee
+	97,  // 163: boltzrpc.Boltz.ListWalletTransactions:output_type -> boltzrpc.ListWalletTransactionsResponse
+	91,  // 164: boltzrpc.Boltz.SubscribeWalletTransactions:output_type -> boltzrpc.WalletTransactionEvent
+	93,  // 165: boltzrpc.Boltz.BumpTransaction:output_type -> boltzrpc.BumpTransactionResponse
+	101, // 166: boltzrpc.Boltz.ConsolidateWallet:output_type -> boltzrpc.WalletSendResponse
+	79,  // 167: boltzrpc.Boltz.GetWalletCredentials:output_type -> boltzrpc.WalletCredentials
+	110, // 168: boltzrpc.Boltz.RemoveWallet:output_type -> boltzrpc.RemoveWalletResponse
+	101, // 169: boltzrpc.Boltz.WalletSend:output_type -> boltzrpc.WalletSendResponse
+	106, // 170: boltzrpc.Boltz.WalletReceive:output_type -> boltzrpc.WalletReceiveResponse
+	102, // 171: boltzrpc.Boltz.CreateWalletPsbt:output_type -> boltzrpc.WalletPsbt
+	102, // 172: boltzrpc.Boltz.SignWalletPsbt:output_type -> boltzrpc.WalletPsbt
+	101, // 173: boltzrpc.Boltz.FinalizeWalletPsbt:output_type -> boltzrpc.WalletSendResponse
+	119, // 174: boltzrpc.Boltz.Stop:output_type -> google.protobuf.Empty
+	119, // 175: boltzrpc.Boltz.Unlock:output_type -> google.protobuf.Empty
+	113, // 176: boltzrpc.Boltz.VerifyWalletPassword:output_type -> boltzrpc.VerifyWalletPasswordResponse
+	119, // 177: boltzrpc.Boltz.ChangeWalletPassword:output_type -> google.protobuf.Empty
+	27,  // 178: boltzrpc.Boltz.CreateTenant:output_type -> boltzrpc.Tenant
+	9,   // 179: boltzrpc.Boltz.ListTenants:output_type -> boltzrpc.ListTenantsResponse
+	27,  // 180: boltzrpc.Boltz.GetTenant:output_type -> boltzrpc.Tenant
+	119, // 181: boltzrpc.Boltz.RemoveTenant:output_type -> google.protobuf.Empty
+	12,  // 182: boltzrpc.Boltz.SetSpendingPolicy:output_type -> boltzrpc.SpendingPolicy
+	14,  // 183: boltzrpc.Boltz.ListSpendingPolicies:output_type -> boltzrpc.ListSpendingPoliciesResponse
+	119, // 184: boltzrpc.Boltz.RemoveSpendingPolicy:output_type -> google.protobuf.Empty
+	16,  // 185: boltzrpc.Boltz.AddAllowedAddress:output_type -> boltzrpc.AllowedAddress
+	19,  // 186: boltzrpc.Boltz.ListAllowedAddresses:output_type -> boltzrpc.ListAllowedAddressesResponse
+	119, // 187: boltzrpc.Boltz.RemoveAllowedAddress:output_type -> google.protobuf.Empty
+	30,  // 188: boltzrpc.Boltz.BakeMacaroon:output_type -> boltzrpc.BakeMacaroonResponse
+	116, // 189: boltzrpc.Boltz.GetSwapMnemonic:output_type -> boltzrpc.GetSwapMnemonicResponse
+	118, // 190: boltzrpc.Boltz.SetSwapMnemonic:output_type -> boltzrpc.SetSwapMnemonicResponse
+	138, // [138:191] is the sub-list for method output_type
+	85,  // [85:138] is the sub-list for method input_type
+	85,  // [85:85] is the sub-list for extension type_name
+	85,  // [85:85] is the sub-list for extension extendee
+	0,   // [0:85] is the sub-list for field type_name
 }
 
 func init() { file_boltzrpc_proto_init() }
@@ -7993,8 +10372,248 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTenantsRequest); i {
+		file_boltzrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTenantsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTenantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpendingPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSpendingPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSpendingPoliciesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSpendingPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAllowedAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowedAddressesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAllowedAddressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveAllowedAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOfferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfferPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOffersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOffersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableOfferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tenant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MacaroonPermissions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8005,8 +10624,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListTenantsResponse); i {
+		file_boltzrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeMacaroonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8017,8 +10636,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTenantRequest); i {
+		file_boltzrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BakeMacaroonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8029,8 +10648,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTenantRequest); i {
+		file_boltzrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8041,8 +10660,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tenant); i {
+		file_boltzrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8053,8 +10672,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MacaroonPermissions); i {
+		file_boltzrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPairInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8065,8 +10684,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BakeMacaroonRequest); i {
+		file_boltzrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoutingFeeEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8077,8 +10696,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BakeMacaroonResponse); i {
+		file_boltzrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PairInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8089,8 +10708,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pair); i {
+		file_boltzrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSwapQuoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8101,8 +10720,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapInfo); i {
+		file_boltzrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSwapQuoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8113,8 +10732,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPairInfoRequest); i {
+		file_boltzrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelCreationInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8125,8 +10744,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PairInfo); i {
+		file_boltzrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinedChannelSwapInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8137,8 +10756,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSwapQuoteRequest); i {
+		file_boltzrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseSwapInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8149,8 +10768,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSwapQuoteResponse); i {
+		file_boltzrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentAttempt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8161,8 +10780,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreationInfo); i {
+		file_boltzrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentHop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8173,8 +10792,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombinedChannelSwapInfo); i {
+		file_boltzrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapPaymentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8185,8 +10804,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReverseSwapInfo); i {
+		file_boltzrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapPaymentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8197,7 +10816,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockHeights); i {
 			case 0:
 				return &v.state
@@ -8209,7 +10828,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
@@ -8221,7 +10840,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
@@ -8233,7 +10852,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Limits); i {
 			case 0:
 				return &v.state
@@ -8245,7 +10864,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SwapFees); i {
 			case 0:
 				return &v.state
@@ -8257,7 +10876,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPairsResponse); i {
 			case 0:
 				return &v.state
@@ -8269,7 +10888,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MinerFees); i {
 			case 0:
 				return &v.state
@@ -8281,7 +10900,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Fees); i {
 			case 0:
 				return &v.state
@@ -8293,7 +10912,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetServiceInfoRequest); i {
 			case 0:
 				return &v.state
@@ -8305,7 +10924,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetServiceInfoResponse); i {
 			case 0:
 				return &v.state
@@ -8317,7 +10936,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AnySwapInfo); i {
 			case 0:
 				return &v.state
@@ -8329,7 +10948,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSwapsRequest); i {
 			case 0:
 				return &v.state
@@ -8341,7 +10960,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListSwapsResponse); i {
 			case 0:
 				return &v.state
@@ -8353,7 +10972,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatsRequest); i {
 			case 0:
 				return &v.state
@@ -8365,7 +10984,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatsResponse); i {
 			case 0:
 				return &v.state
@@ -8377,7 +10996,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefundSwapRequest); i {
 			case 0:
 				return &v.state
@@ -8389,7 +11008,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimSwapsRequest); i {
 			case 0:
 				return &v.state
@@ -8401,7 +11020,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimSwapsResponse); i {
 			case 0:
 				return &v.state
@@ -8413,7 +11032,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwapInfoRequest); i {
 			case 0:
 				return &v.state
@@ -8425,7 +11044,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwapInfoResponse); i {
 			case 0:
 				return &v.state
@@ -8437,7 +11056,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositRequest); i {
 			case 0:
 				return &v.state
@@ -8449,7 +11068,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositResponse); i {
 			case 0:
 				return &v.state
@@ -8461,7 +11080,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSwapRequest); i {
 			case 0:
 				return &v.state
@@ -8473,7 +11092,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSwapResponse); i {
 			case 0:
 				return &v.state
@@ -8485,7 +11104,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateChannelRequest); i {
 			case 0:
 				return &v.state
@@ -8497,7 +11116,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateReverseSwapRequest); i {
 			case 0:
 				return &v.state
@@ -8509,7 +11128,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateReverseSwapResponse); i {
 			case 0:
 				return &v.state
@@ -8521,7 +11140,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateChainSwapRequest); i {
 			case 0:
 				return &v.state
@@ -8533,7 +11152,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChainSwapInfo); i {
 			case 0:
 				return &v.state
@@ -8545,7 +11164,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChainSwapData); i {
 			case 0:
 				return &v.state
@@ -8557,7 +11176,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelId); i {
 			case 0:
 				return &v.state
@@ -8569,7 +11188,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LightningChannel); i {
 			case 0:
 				return &v.state
@@ -8581,7 +11200,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SwapStats); i {
 			case 0:
 				return &v.state
@@ -8593,7 +11212,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Budget); i {
 			case 0:
 				return &v.state
@@ -8605,7 +11224,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletCredentials); i {
 			case 0:
 				return &v.state
@@ -8617,7 +11236,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletParams); i {
 			case 0:
 				return &v.state
@@ -8629,7 +11248,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportWalletRequest); i {
 			case 0:
 				return &v.state
@@ -8641,7 +11260,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateWalletRequest); i {
 			case 0:
 				return &v.state
@@ -8653,7 +11272,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateWalletResponse); i {
 			case 0:
 				return &v.state
@@ -8665,7 +11284,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportWalletResponse); i {
 			case 0:
 				return &v.state
@@ -8677,7 +11296,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletsRequest); i {
 			case 0:
 				return &v.state
@@ -8689,7 +11308,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletRequest); i {
 			case 0:
 				return &v.state
@@ -8701,7 +11320,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletSendFee); i {
 			case 0:
 				return &v.state
@@ -8713,7 +11332,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListWalletTransactionsRequest); i {
 			case 0:
 				return &v.state
@@ -8725,7 +11344,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletTransaction); i {
 			case 0:
 				return &v.state
@@ -8737,7 +11356,31 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeWalletTransactionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletTransactionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BumpTransactionRequest); i {
 			case 0:
 				return &v.state
@@ -8749,7 +11392,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BumpTransactionResponse); i {
 			case 0:
 				return &v.state
@@ -8761,7 +11404,19 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConsolidateWalletRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionInfo); i {
 			case 0:
 				return &v.state
@@ -8773,7 +11428,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TransactionOutput); i {
 			case 0:
 				return &v.state
@@ -8785,7 +11440,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListWalletTransactionsResponse); i {
 			case 0:
 				return &v.state
@@ -8797,7 +11452,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletCredentialsRequest); i {
 			case 0:
 				return &v.state
@@ -8809,7 +11464,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveWalletRequest); i {
 			case 0:
 				return &v.state
@@ -8821,7 +11476,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletSendRequest); i {
 			case 0:
 				return &v.state
@@ -8833,7 +11488,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletSendResponse); i {
 			case 0:
 				return &v.state
@@ -8845,7 +11500,43 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletPsbt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignWalletPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinalizeWalletPsbtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletReceiveRequest); i {
 			case 0:
 				return &v.state
@@ -8857,7 +11548,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletReceiveResponse); i {
 			case 0:
 				return &v.state
@@ -8869,7 +11560,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Wallet); i {
 			case 0:
 				return &v.state
@@ -8881,7 +11572,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Wallets); i {
 			case 0:
 				return &v.state
@@ -8893,7 +11584,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Balance); i {
 			case 0:
 				return &v.state
@@ -8905,7 +11596,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveWalletResponse); i {
 			case 0:
 				return &v.state
@@ -8917,7 +11608,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnlockRequest); i {
 			case 0:
 				return &v.state
@@ -8929,7 +11620,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyWalletPasswordRequest); i {
 			case 0:
 				return &v.state
@@ -8941,7 +11632,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyWalletPasswordResponse); i {
 			case 0:
 				return &v.state
@@ -8953,7 +11644,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeWalletPasswordRequest); i {
 			case 0:
 				return &v.state
@@ -8965,7 +11656,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwapMnemonicRequest); i {
 			case 0:
 				return &v.state
@@ -8977,7 +11668,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwapMnemonicResponse); i {
 			case 0:
 				return &v.state
@@ -8989,7 +11680,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetSwapMnemonicRequest); i {
 			case 0:
 				return &v.state
@@ -9001,7 +11692,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetSwapMnemonicResponse); i {
 			case 0:
 				return &v.state
@@ -9014,48 +11705,67 @@ func file_boltzrpc_proto_init() {
 			}
 		}
 	}
-	file_boltzrpc_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[8].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_boltzrpc_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[22].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[28].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*GetSwapQuoteRequest_SendAmount)(nil),
 		(*GetSwapQuoteRequest_ReceiveAmount)(nil),
 	}
-	file_boltzrpc_proto_msgTypes[17].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[18].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[20].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[28].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[29].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[33].OneofWrappers = []interface{}{
+	file_boltzrpc_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[36].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[40].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*RefundSwapRequest_Address)(nil),
 		(*RefundSwapRequest_WalletId)(nil),
 	}
-	file_boltzrpc_proto_msgTypes[34].OneofWrappers = []interface{}{
+	file_boltzrpc_proto_msgTypes[54].OneofWrappers = []interface{}{
 		(*ClaimSwapsRequest_Address)(nil),
 		(*ClaimSwapsRequest_WalletId)(nil),
 	}
-	file_boltzrpc_proto_msgTypes[36].OneofWrappers = []interface{}{
+	file_boltzrpc_proto_msgTypes[56].OneofWrappers = []interface{}{
 		(*GetSwapInfoRequest_SwapId)(nil),
 		(*GetSwapInfoRequest_PaymentHash)(nil),
 	}
-	file_boltzrpc_proto_msgTypes[40].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[43].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[44].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[45].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[46].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[47].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[52].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[53].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[58].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[59].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[61].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[63].OneofWrappers = []interface{}{
+	file_boltzrpc_proto_msgTypes[60].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[64].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[65].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[72].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[78].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[79].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[81].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[83].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[85].OneofWrappers = []interface{}{
 		(*BumpTransactionRequest_TxId)(nil),
 		(*BumpTransactionRequest_SwapId)(nil),
 	}
-	file_boltzrpc_proto_msgTypes[65].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[68].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[70].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[84].OneofWrappers = []interface{}{
+	file_boltzrpc_proto_msgTypes[87].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[88].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[91].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[93].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[110].OneofWrappers = []interface{}{
 		(*SetSwapMnemonicRequest_Existing)(nil),
 		(*SetSwapMnemonicRequest_Generate)(nil),
 	}
@@ -9064,8 +11774,8 @@ func file_boltzrpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_boltzrpc_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   86,
+			NumEnums:      7,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,44 +20,59 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Boltz_GetInfo_FullMethodName                = "/boltzrpc.Boltz/GetInfo"
-	Boltz_GetServiceInfo_FullMethodName         = "/boltzrpc.Boltz/GetServiceInfo"
-	Boltz_GetPairInfo_FullMethodName            = "/boltzrpc.Boltz/GetPairInfo"
-	Boltz_GetPairs_FullMethodName               = "/boltzrpc.Boltz/GetPairs"
-	Boltz_GetSwapQuote_FullMethodName           = "/boltzrpc.Boltz/GetSwapQuote"
-	Boltz_ListSwaps_FullMethodName              = "/boltzrpc.Boltz/ListSwaps"
-	Boltz_GetStats_FullMethodName               = "/boltzrpc.Boltz/GetStats"
-	Boltz_RefundSwap_FullMethodName             = "/boltzrpc.Boltz/RefundSwap"
-	Boltz_ClaimSwaps_FullMethodName             = "/boltzrpc.Boltz/ClaimSwaps"
-	Boltz_GetSwapInfo_FullMethodName            = "/boltzrpc.Boltz/GetSwapInfo"
-	Boltz_GetSwapInfoStream_FullMethodName      = "/boltzrpc.Boltz/GetSwapInfoStream"
-	Boltz_Deposit_FullMethodName                = "/boltzrpc.Boltz/Deposit"
-	Boltz_CreateSwap_FullMethodName             = "/boltzrpc.Boltz/CreateSwap"
-	Boltz_CreateChannel_FullMethodName          = "/boltzrpc.Boltz/CreateChannel"
-	Boltz_CreateReverseSwap_FullMethodName      = "/boltzrpc.Boltz/CreateReverseSwap"
-	Boltz_CreateChainSwap_FullMethodName        = "/boltzrpc.Boltz/CreateChainSwap"
-	Boltz_CreateWallet_FullMethodName           = "/boltzrpc.Boltz/CreateWallet"
-	Boltz_ImportWallet_FullMethodName           = "/boltzrpc.Boltz/ImportWallet"
-	Boltz_GetWallets_FullMethodName             = "/boltzrpc.Boltz/GetWallets"
-	Boltz_GetWallet_FullMethodName              = "/boltzrpc.Boltz/GetWallet"
-	Boltz_GetWalletSendFee_FullMethodName       = "/boltzrpc.Boltz/GetWalletSendFee"
-	Boltz_ListWalletTransactions_FullMethodName = "/boltzrpc.Boltz/ListWalletTransactions"
-	Boltz_BumpTransaction_FullMethodName        = "/boltzrpc.Boltz/BumpTransaction"
-	Boltz_GetWalletCredentials_FullMethodName   = "/boltzrpc.Boltz/GetWalletCredentials"
-	Boltz_RemoveWallet_FullMethodName           = "/boltzrpc.Boltz/RemoveWallet"
-	Boltz_WalletSend_FullMethodName             = "/boltzrpc.Boltz/WalletSend"
-	Boltz_WalletReceive_FullMethodName          = "/boltzrpc.Boltz/WalletReceive"
-	Boltz_Stop_FullMethodName                   = "/boltzrpc.Boltz/Stop"
-	Boltz_Unlock_FullMethodName                 = "/boltzrpc.Boltz/Unlock"
-	Boltz_VerifyWalletPassword_FullMethodName   = "/boltzrpc.Boltz/VerifyWalletPassword"
-	Boltz_ChangeWalletPassword_FullMethodName   = "/boltzrpc.Boltz/ChangeWalletPassword"
-	Boltz_CreateTenant_FullMethodName           = "/boltzrpc.Boltz/CreateTenant"
-	Boltz_ListTenants_FullMethodName            = "/boltzrpc.Boltz/ListTenants"
-	Boltz_GetTenant_FullMethodName              = "/boltzrpc.Boltz/GetTenant"
-	Boltz_RemoveTenant_FullMethodName           = "/boltzrpc.Boltz/RemoveTenant"
-	Boltz_BakeMacaroon_FullMethodName           = "/boltzrpc.Boltz/BakeMacaroon"
-	Boltz_GetSwapMnemonic_FullMethodName        = "/boltzrpc.Boltz/GetSwapMnemonic"
-	Boltz_SetSwapMnemonic_FullMethodName        = "/boltzrpc.Boltz/SetSwapMnemonic"
+	Boltz_GetInfo_FullMethodName                     = "/boltzrpc.Boltz/GetInfo"
+	Boltz_GetServiceInfo_FullMethodName              = "/boltzrpc.Boltz/GetServiceInfo"
+	Boltz_GetPairInfo_FullMethodName                 = "/boltzrpc.Boltz/GetPairInfo"
+	Boltz_GetPairs_FullMethodName                    = "/boltzrpc.Boltz/GetPairs"
+	Boltz_GetSwapQuote_FullMethodName                = "/boltzrpc.Boltz/GetSwapQuote"
+	Boltz_ListSwaps_FullMethodName                   = "/boltzrpc.Boltz/ListSwaps"
+	Boltz_GetStats_FullMethodName                    = "/boltzrpc.Boltz/GetStats"
+	Boltz_RefundSwap_FullMethodName                  = "/boltzrpc.Boltz/RefundSwap"
+	Boltz_ClaimSwaps_FullMethodName                  = "/boltzrpc.Boltz/ClaimSwaps"
+	Boltz_GetSwapInfo_FullMethodName                 = "/boltzrpc.Boltz/GetSwapInfo"
+	Boltz_ListSwapPayments_FullMethodName            = "/boltzrpc.Boltz/ListSwapPayments"
+	Boltz_GetSwapInfoStream_FullMethodName           = "/boltzrpc.Boltz/GetSwapInfoStream"
+	Boltz_Deposit_FullMethodName                     = "/boltzrpc.Boltz/Deposit"
+	Boltz_CreateSwap_FullMethodName                  = "/boltzrpc.Boltz/CreateSwap"
+	Boltz_CreateChannel_FullMethodName               = "/boltzrpc.Boltz/CreateChannel"
+	Boltz_CreateReverseSwap_FullMethodName           = "/boltzrpc.Boltz/CreateReverseSwap"
+	Boltz_CreateChainSwap_FullMethodName             = "/boltzrpc.Boltz/CreateChainSwap"
+	Boltz_CreateOffer_FullMethodName                 = "/boltzrpc.Boltz/CreateOffer"
+	Boltz_ListOffers_FullMethodName                  = "/boltzrpc.Boltz/ListOffers"
+	Boltz_DisableOffer_FullMethodName                = "/boltzrpc.Boltz/DisableOffer"
+	Boltz_CreateWallet_FullMethodName                = "/boltzrpc.Boltz/CreateWallet"
+	Boltz_ImportWallet_FullMethodName                = "/boltzrpc.Boltz/ImportWallet"
+	Boltz_GetWallets_FullMethodName                  = "/boltzrpc.Boltz/GetWallets"
+	Boltz_GetWallet_FullMethodName                   = "/boltzrpc.Boltz/GetWallet"
+	Boltz_GetWalletSendFee_FullMethodName            = "/boltzrpc.Boltz/GetWalletSendFee"
+	Boltz_ListWalletTransactions_FullMethodName      = "/boltzrpc.Boltz/ListWalletTransactions"
+	Boltz_SubscribeWalletTransactions_FullMethodName = "/boltzrpc.Boltz/SubscribeWalletTransactions"
+	Boltz_BumpTransaction_FullMethodName             = "/boltzrpc.Boltz/BumpTransaction"
+	Boltz_ConsolidateWallet_FullMethodName           = "/boltzrpc.Boltz/ConsolidateWallet"
+	Boltz_GetWalletCredentials_FullMethodName        = "/boltzrpc.Boltz/GetWalletCredentials"
+	Boltz_RemoveWallet_FullMethodName                = "/boltzrpc.Boltz/RemoveWallet"
+	Boltz_WalletSend_FullMethodName                  = "/boltzrpc.Boltz/WalletSend"
+	Boltz_WalletReceive_FullMethodName               = "/boltzrpc.Boltz/WalletReceive"
+	Boltz_CreateWalletPsbt_FullMethodName            = "/boltzrpc.Boltz/CreateWalletPsbt"
+	Boltz_SignWalletPsbt_FullMethodName              = "/boltzrpc.Boltz/SignWalletPsbt"
+	Boltz_FinalizeWalletPsbt_FullMethodName          = "/boltzrpc.Boltz/FinalizeWalletPsbt"
+	Boltz_Stop_FullMethodName                        = "/boltzrpc.Boltz/Stop"
+	Boltz_Unlock_FullMethodName                      = "/boltzrpc.Boltz/Unlock"
+	Boltz_VerifyWalletPassword_FullMethodName        = "/boltzrpc.Boltz/VerifyWalletPassword"
+	Boltz_ChangeWalletPassword_FullMethodName        = "/boltzrpc.Boltz/ChangeWalletPassword"
+	Boltz_CreateTenant_FullMethodName                = "/boltzrpc.Boltz/CreateTenant"
+	Boltz_ListTenants_FullMethodName                 = "/boltzrpc.Boltz/ListTenants"
+	Boltz_GetTenant_FullMethodName                   = "/boltzrpc.Boltz/GetTenant"
+	Boltz_RemoveTenant_FullMethodName                = "/boltzrpc.Boltz/RemoveTenant"
+	Boltz_SetSpendingPolicy_FullMethodName           = "/boltzrpc.Boltz/SetSpendingPolicy"
+	Boltz_ListSpendingPolicies_FullMethodName        = "/boltzrpc.Boltz/ListSpendingPolicies"
+	Boltz_RemoveSpendingPolicy_FullMethodName        = "/boltzrpc.Boltz/RemoveSpendingPolicy"
+	Boltz_AddAllowedAddress_FullMethodName           = "/boltzrpc.Boltz/AddAllowedAddress"
+	Boltz_ListAllowedAddresses_FullMethodName        = "/boltzrpc.Boltz/ListAllowedAddresses"
+	Boltz_RemoveAllowedAddress_FullMethodName        = "/boltzrpc.Boltz/RemoveAllowedAddress"
+	Boltz_BakeMacaroon_FullMethodName                = "/boltzrpc.Boltz/BakeMacaroon"
+	Boltz_GetSwapMnemonic_FullMethodName             = "/boltzrpc.Boltz/GetSwapMnemonic"
+	Boltz_SetSwapMnemonic_FullMethodName             = "/boltzrpc.Boltz/SetSwapMnemonic"
 )
 
 // BoltzClient is the client API for Boltz service.
@@ -90,6 +105,11 @@ type BoltzClient interface {
 	ClaimSwaps(ctx context.Context, in *ClaimSwapsRequest, opts ...grpc.CallOption) (*ClaimSwapsResponse, error)
 	// Gets all available information about a swap from the database.
 	GetSwapInfo(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (*GetSwapInfoResponse, error)
+	// Lists the lightning payments of swaps: every attempt of the daemon to pay the invoice of a reverse swap
+	// and the settlements of the invoices of submarine swaps, the latest first.
+	// An attempt is a single payment call to the lightning node, which can be split into multiple HTLCs. Only the route
+	// of one of those HTLCs is stored and only LND reports routes at all.
+	ListSwapPayments(ctx context.Context, in *ListSwapPaymentsRequest, opts ...grpc.CallOption) (*ListSwapPaymentsResponse, error)
 	// Returns the entire history of the swap if is still pending and streams updates in real time.
 	// If the swap id is empty or "*" updates for all swaps will be streamed.
 	GetSwapInfoStream(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (Boltz_GetSwapInfoStreamClient, error)
@@ -113,6 +133,13 @@ type BoltzClient interface {
 	// Creates a new chain swap from one chain to another. If `accept_zero_conf` is set to true in the request, the daemon
 	// will not wait until the lockup transaction from Boltz is confirmed in a block, but will claim it instantly.
 	CreateChainSwap(ctx context.Context, in *CreateChainSwapRequest, opts ...grpc.CallOption) (*ChainSwapInfo, error)
+	// Creates a reusable BOLT12 offer on a lightning node. Every payment to the offer is swapped into the configured
+	// wallet with a reverse swap that is paid by the node which received the payment. Only supported by CLN.
+	CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*Offer, error)
+	// Lists the offers created by the daemon and the payments they received.
+	ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error)
+	// Disables an offer so that it can not be paid anymore.
+	DisableOffer(ctx context.Context, in *DisableOfferRequest, opts ...grpc.CallOption) (*Offer, error)
 	// Creates a new liquid wallet and returns the mnemonic.
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
 	// Imports an existing wallet.
@@ -126,9 +153,18 @@ type BoltzClient interface {
 	GetWalletSendFee(ctx context.Context, in *WalletSendRequest, opts ...grpc.CallOption) (*WalletSendFee, error)
 	// Returns recent transactions from a wallet.
 	ListWalletTransactions(ctx context.Context, in *ListWalletTransactionsRequest, opts ...grpc.CallOption) (*ListWalletTransactionsResponse, error)
+	// Streams transactions of a wallet, or all wallets of the tenant if no id is specified, as they are discovered, confirmed or replaced.
+	// Changes are detected during the periodic wallet sync, so events are delayed by up to one sync interval.
+	// If the client does not keep up with the events, the stream is ended with a `RESOURCE_EXHAUSTED` error
+	// and the client has to re-sync with `ListWalletTransactions` before subscribing again.
+	SubscribeWalletTransactions(ctx context.Context, in *SubscribeWalletTransactionsRequest, opts ...grpc.CallOption) (Boltz_SubscribeWalletTransactionsClient, error)
 	// Increase the fee of a transaction using RBF.
 	// The transaction has to belong to one of the clients wallets.
+	// Transactions of the wallet of the lightning node are bumped with a child transaction (CPFP) instead,
+	// in which case the id of the transaction does not change.
 	BumpTransaction(ctx context.Context, in *BumpTransactionRequest, opts ...grpc.CallOption) (*BumpTransactionResponse, error)
+	// Consolidates the largest confirmed utxos of a wallet into a single output of the same wallet.
+	ConsolidateWallet(ctx context.Context, in *ConsolidateWalletRequest, opts ...grpc.CallOption) (*WalletSendResponse, error)
 	// Returns the credentials of a wallet. The password will be required if the wallet is encrypted.
 	GetWalletCredentials(ctx context.Context, in *GetWalletCredentialsRequest, opts ...grpc.CallOption) (*WalletCredentials, error)
 	// Removes a wallet.
@@ -137,6 +173,14 @@ type BoltzClient interface {
 	WalletSend(ctx context.Context, in *WalletSendRequest, opts ...grpc.CallOption) (*WalletSendResponse, error)
 	// Get a new address of the wallet.
 	WalletReceive(ctx context.Context, in *WalletReceiveRequest, opts ...grpc.CallOption) (*WalletReceiveResponse, error)
+	// Creates a PSBT (PSET for liquid) spending from a wallet and adds the signatures of the keys held by the daemon.
+	// Used for wallets which require signatures of other cosigners, like multisig wallets.
+	// The returned PSBT has to be signed by the remaining cosigners and passed to `FinalizeWalletPsbt`.
+	CreateWalletPsbt(ctx context.Context, in *WalletSendRequest, opts ...grpc.CallOption) (*WalletPsbt, error)
+	// Adds the signatures of the keys held by the daemon to a PSBT spending from a wallet.
+	SignWalletPsbt(ctx context.Context, in *SignWalletPsbtRequest, opts ...grpc.CallOption) (*WalletPsbt, error)
+	// Combines the given PSBTs of a wallet, finalizes the resulting transaction and broadcasts it.
+	FinalizeWalletPsbt(ctx context.Context, in *FinalizeWalletPsbtRequest, opts ...grpc.CallOption) (*WalletSendResponse, error)
 	// Gracefully stops the daemon.
 	Stop(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Unlocks the server. This will be required on startup if there are any encrypted wallets.
@@ -153,6 +197,20 @@ type BoltzClient interface {
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*Tenant, error)
 	// Removes a tenant. Only allowed if the tenant has no associated wallets.
 	RemoveTenant(ctx context.Context, in *RemoveTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Creates or replaces a spending policy of a tenant. Policies are enforced for `WalletSend`, `CreateSwap` with `send_from_internal`,
+	// `CreateChainSwap` with a `from_wallet_id` and `CreateReverseSwap` paid by the lightning node.
+	SetSpendingPolicy(ctx context.Context, in *SpendingPolicy, opts ...grpc.CallOption) (*SpendingPolicy, error)
+	// Returns the spending policies of a tenant, or of all tenants if called with an unrestricted macaroon and no tenant specified.
+	ListSpendingPolicies(ctx context.Context, in *ListSpendingPoliciesRequest, opts ...grpc.CallOption) (*ListSpendingPoliciesResponse, error)
+	// Removes a spending policy of a tenant.
+	RemoveSpendingPolicy(ctx context.Context, in *RemoveSpendingPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Adds an address to the allowlist of a tenant. The address can only be used once the address cooldown of the tenants policies passed.
+	// Requires an admin macaroon, so that tenants can not allow addresses for themselves.
+	AddAllowedAddress(ctx context.Context, in *AddAllowedAddressRequest, opts ...grpc.CallOption) (*AllowedAddress, error)
+	// Returns the allowlist of the tenant.
+	ListAllowedAddresses(ctx context.Context, in *ListAllowedAddressesRequest, opts ...grpc.CallOption) (*ListAllowedAddressesResponse, error)
+	// Removes an address from the allowlist of a tenant. Requires an admin macaroon.
+	RemoveAllowedAddress(ctx context.Context, in *RemoveAllowedAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Bakes a new macaroon with the specified permissions.
 	// The macaroon can also be restricted to a specific tenant. In this case,
 	// - any swap or wallet created with the returned macaroon will belong to this tenant and can not be accessed by other tenants.
@@ -263,6 +321,15 @@ func (c *boltzClient) GetSwapInfo(ctx context.Context, in *GetSwapInfoRequest, o
 	return out, nil
 }
 
+func (c *boltzClient) ListSwapPayments(ctx context.Context, in *ListSwapPaymentsRequest, opts ...grpc.CallOption) (*ListSwapPaymentsResponse, error) {
+	out := new(ListSwapPaymentsResponse)
+	err := c.cc.Invoke(ctx, Boltz_ListSwapPayments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) GetSwapInfoStream(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (Boltz_GetSwapInfoStreamClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Boltz_ServiceDesc.Streams[0], Boltz_GetSwapInfoStream_FullMethodName, opts...)
 	if err != nil {
@@ -342,6 +409,33 @@ func (c *boltzClient) CreateChainSwap(ctx context.Context, in *CreateChainSwapRe
 	return out, nil
 }
 
+func (c *boltzClient) CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*Offer, error) {
+	out := new(Offer)
+	err := c.cc.Invoke(ctx, Boltz_CreateOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ListOffers(ctx context.Context, in *ListOffersRequest, opts ...grpc.CallOption) (*ListOffersResponse, error) {
+	out := new(ListOffersResponse)
+	err := c.cc.Invoke(ctx, Boltz_ListOffers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) DisableOffer(ctx context.Context, in *DisableOfferRequest, opts ...grpc.CallOption) (*Offer, error) {
+	out := new(Offer)
+	err := c.cc.Invoke(ctx, Boltz_DisableOffer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
 	out := new(CreateWalletResponse)
 	err := c.cc.Invoke(ctx, Boltz_CreateWallet_FullMethodName, in, out, opts...)
@@ -396,6 +490,38 @@ func (c *boltzClient) ListWalletTransactions(ctx context.Context, in *ListWallet
 	return out, nil
 }
 
+func (c *boltzClient) SubscribeWalletTransactions(ctx context.Context, in *SubscribeWalletTransactionsRequest, opts ...grpc.CallOption) (Boltz_SubscribeWalletTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Boltz_ServiceDesc.Streams[1], Boltz_SubscribeWalletTransactions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boltzSubscribeWalletTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Boltz_SubscribeWalletTransactionsClient interface {
+	Recv() (*WalletTransactionEvent, error)
+	grpc.ClientStream
+}
+
+type boltzSubscribeWalletTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *boltzSubscribeWalletTransactionsClient) Recv() (*WalletTransactionEvent, error) {
+	m := new(WalletTransactionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *boltzClient) BumpTransaction(ctx context.Context, in *BumpTransactionRequest, opts ...grpc.CallOption) (*BumpTransactionResponse, error) {
 	out := new(BumpTransactionResponse)
 	err := c.cc.Invoke(ctx, Boltz_BumpTransaction_FullMethodName, in, out, opts...)
@@ -405,6 +531,15 @@ func (c *boltzClient) BumpTransaction(ctx context.Context, in *BumpTransactionRe
 	return out, nil
 }
 
+func (c *boltzClient) ConsolidateWallet(ctx context.Context, in *ConsolidateWalletRequest, opts ...grpc.CallOption) (*WalletSendResponse, error) {
+	out := new(WalletSendResponse)
+	err := c.cc.Invoke(ctx, Boltz_ConsolidateWallet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) GetWalletCredentials(ctx context.Context, in *GetWalletCredentialsRequest, opts ...grpc.CallOption) (*WalletCredentials, error) {
 	out := new(WalletCredentials)
 	err := c.cc.Invoke(ctx, Boltz_GetWalletCredentials_FullMethodName, in, out, opts...)
@@ -441,6 +576,33 @@ func (c *boltzClient) WalletReceive(ctx context.Context, in *WalletReceiveReques
 	return out, nil
 }
 
+func (c *boltzClient) CreateWalletPsbt(ctx context.Context, in *WalletSendRequest, opts ...grpc.CallOption) (*WalletPsbt, error) {
+	out := new(WalletPsbt)
+	err := c.cc.Invoke(ctx, Boltz_CreateWalletPsbt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) SignWalletPsbt(ctx context.Context, in *SignWalletPsbtRequest, opts ...grpc.CallOption) (*WalletPsbt, error) {
+	out := new(WalletPsbt)
+	err := c.cc.Invoke(ctx, Boltz_SignWalletPsbt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) FinalizeWalletPsbt(ctx context.Context, in *FinalizeWalletPsbtRequest, opts ...grpc.CallOption) (*WalletSendResponse, error) {
+	out := new(WalletSendResponse)
+	err := c.cc.Invoke(ctx, Boltz_FinalizeWalletPsbt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) Stop(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Boltz_Stop_FullMethodName, in, out, opts...)
@@ -513,6 +675,60 @@ func (c *boltzClient) RemoveTenant(ctx context.Context, in *RemoveTenantRequest,
 	return out, nil
 }
 
+func (c *boltzClient) SetSpendingPolicy(ctx context.Context, in *SpendingPolicy, opts ...grpc.CallOption) (*SpendingPolicy, error) {
+	out := new(SpendingPolicy)
+	err := c.cc.Invoke(ctx, Boltz_SetSpendingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ListSpendingPolicies(ctx context.Context, in *ListSpendingPoliciesRequest, opts ...grpc.CallOption) (*ListSpendingPoliciesResponse, error) {
+	out := new(ListSpendingPoliciesResponse)
+	err := c.cc.Invoke(ctx, Boltz_ListSpendingPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) RemoveSpendingPolicy(ctx context.Context, in *RemoveSpendingPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Boltz_RemoveSpendingPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) AddAllowedAddress(ctx context.Context, in *AddAllowedAddressRequest, opts ...grpc.CallOption) (*AllowedAddress, error) {
+	out := new(AllowedAddress)
+	err := c.cc.Invoke(ctx, Boltz_AddAllowedAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) ListAllowedAddresses(ctx context.Context, in *ListAllowedAddressesRequest, opts ...grpc.CallOption) (*ListAllowedAddressesResponse, error) {
+	out := new(ListAllowedAddressesResponse)
+	err := c.cc.Invoke(ctx, Boltz_ListAllowedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) RemoveAllowedAddress(ctx context.Context, in *RemoveAllowedAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Boltz_RemoveAllowedAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
 	out := new(BakeMacaroonResponse)
 	err := c.cc.Invoke(ctx, Boltz_BakeMacaroon_FullMethodName, in, out, opts...)
@@ -570,6 +786,11 @@ type BoltzServer interface {
 	ClaimSwaps(context.Context, *ClaimSwapsRequest) (*ClaimSwapsResponse, error)
 	// Gets all available information about a swap from the database.
 	GetSwapInfo(context.Context, *GetSwapInfoRequest) (*GetSwapInfoResponse, error)
+	// Lists the lightning payments of swaps: every attempt of the daemon to pay the invoice of a reverse swap
+	// and the settlements of the invoices of submarine swaps, the latest first.
+	// An attempt is a single payment call to the lightning node, which can be split into multiple HTLCs. Only the route
+	// of one of those HTLCs is stored and only LND reports routes at all.
+	ListSwapPayments(context.Context, *ListSwapPaymentsRequest) (*ListSwapPaymentsResponse, error)
 	// Returns the entire history of the swap if is still pending and streams updates in real time.
 	// If the swap id is empty or "*" updates for all swaps will be streamed.
 	GetSwapInfoStream(*GetSwapInfoRequest, Boltz_GetSwapInfoStreamServer) error
@@ -593,6 +814,13 @@ type BoltzServer interface {
 	// Creates a new chain swap from one chain to another. If `accept_zero_conf` is set to true in the request, the daemon
 	// will not wait until the lockup transaction from Boltz is confirmed in a block, but will claim it instantly.
 	CreateChainSwap(context.Context, *CreateChainSwapRequest) (*ChainSwapInfo, error)
+	// Creates a reusable BOLT12 offer on a lightning node. Every payment to the offer is swapped into the configured
+	// wallet with a reverse swap that is paid by the node which received the payment. Only supported by CLN.
+	CreateOffer(context.Context, *CreateOfferRequest) (*Offer, error)
+	// Lists the offers created by the daemon and the payments they received.
+	ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error)
+	// Disables an offer so that it can not be paid anymore.
+	DisableOffer(context.Context, *DisableOfferRequest) (*Offer, error)
 	// Creates a new liquid wallet and returns the mnemonic.
 	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
 	// Imports an existing wallet.
@@ -606,9 +834,18 @@ type BoltzServer interface {
 	GetWalletSendFee(context.Context, *WalletSendRequest) (*WalletSendFee, error)
 	// Returns recent transactions from a wallet.
 	ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error)
+	// Streams transactions of a wallet, or all wallets of the tenant if no id is specified, as they are discovered, confirmed or replaced.
+	// Changes are detected during the periodic wallet sync, so events are delayed by up to one sync interval.
+	// If the client does not keep up with the events, the stream is ended with a `RESOURCE_EXHAUSTED` error
+	// and the client has to re-sync with `ListWalletTransactions` before subscribing again.
+	SubscribeWalletTransactions(*SubscribeWalletTransactionsRequest, Boltz_SubscribeWalletTransactionsServer) error
 	// Increase the fee of a transaction using RBF.
 	// The transaction has to belong to one of the clients wallets.
+	// Transactions of the wallet of the lightning node are bumped with a child transaction (CPFP) instead,
+	// in which case the id of the transaction does not change.
 	BumpTransaction(context.Context, *BumpTransactionRequest) (*BumpTransactionResponse, error)
+	// Consolidates the largest confirmed utxos of a wallet into a single output of the same wallet.
+	ConsolidateWallet(context.Context, *ConsolidateWalletRequest) (*WalletSendResponse, error)
 	// Returns the credentials of a wallet. The password will be required if the wallet is encrypted.
 	GetWalletCredentials(context.Context, *GetWalletCredentialsRequest) (*WalletCredentials, error)
 	// Removes a wallet.
@@ -617,6 +854,14 @@ type BoltzServer interface {
 	WalletSend(context.Context, *WalletSendRequest) (*WalletSendResponse, error)
 	// Get a new address of the wallet.
 	WalletReceive(context.Context, *WalletReceiveRequest) (*WalletReceiveResponse, error)
+	// Creates a PSBT (PSET for liquid) spending from a wallet and adds the signatures of the keys held by the daemon.
+	// Used for wallets which require signatures of other cosigners, like multisig wallets.
+	// The returned PSBT has to be signed by the remaining cosigners and passed to `FinalizeWalletPsbt`.
+	CreateWalletPsbt(context.Context, *WalletSendRequest) (*WalletPsbt, error)
+	// Adds the signatures of the keys held by the daemon to a PSBT spending from a wallet.
+	SignWalletPsbt(context.Context, *SignWalletPsbtRequest) (*WalletPsbt, error)
+	// Combines the given PSBTs of a wallet, finalizes the resulting transaction and broadcasts it.
+	FinalizeWalletPsbt(context.Context, *FinalizeWalletPsbtRequest) (*WalletSendResponse, error)
 	// Gracefully stops the daemon.
 	Stop(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// Unlocks the server. This will be required on startup if there are any encrypted wallets.
@@ -633,6 +878,20 @@ type BoltzServer interface {
 	GetTenant(context.Context, *GetTenantRequest) (*Tenant, error)
 	// Removes a tenant. Only allowed if the tenant has no associated wallets.
 	RemoveTenant(context.Context, *RemoveTenantRequest) (*emptypb.Empty, error)
+	// Creates or replaces a spending policy of a tenant. Policies are enforced for `WalletSend`, `CreateSwap` with `send_from_internal`,
+	// `CreateChainSwap` with a `from_wallet_id` and `CreateReverseSwap` paid by the lightning node.
+	SetSpendingPolicy(context.Context, *SpendingPolicy) (*SpendingPolicy, error)
+	// Returns the spending policies of a tenant, or of all tenants if called with an unrestricted macaroon and no tenant specified.
+	ListSpendingPolicies(context.Context, *ListSpendingPoliciesRequest) (*ListSpendingPoliciesResponse, error)
+	// Removes a spending policy of a tenant.
+	RemoveSpendingPolicy(context.Context, *RemoveSpendingPolicyRequest) (*emptypb.Empty, error)
+	// Adds an address to the allowlist of a tenant. The address can only be used once the address cooldown of the tenants policies passed.
+	// Requires an admin macaroon, so that tenants can not allow addresses for themselves.
+	AddAllowedAddress(context.Context, *AddAllowedAddressRequest) (*AllowedAddress, error)
+	// Returns the allowlist of the tenant.
+	ListAllowedAddresses(context.Context, *ListAllowedAddressesRequest) (*ListAllowedAddressesResponse, error)
+	// Removes an address from the allowlist of a tenant. Requires an admin macaroon.
+	RemoveAllowedAddress(context.Context, *RemoveAllowedAddressRequest) (*emptypb.Empty, error)
 	// Bakes a new macaroon with the specified permissions.
 	// The macaroon can also be restricted to a specific tenant. In this case,
 	// - any swap or wallet created with the returned macaroon will belong to this tenant and can not be accessed by other tenants.
@@ -679,6 +938,9 @@ func (UnimplementedBoltzServer) ClaimSwaps(context.Context, *ClaimSwapsRequest)
 func (UnimplementedBoltzServer) GetSwapInfo(context.Context, *GetSwapInfoRequest) (*GetSwapInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSwapInfo not implemented")
 }
+func (UnimplementedBoltzServer) ListSwapPayments(context.Context, *ListSwapPaymentsRequest) (*ListSwapPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSwapPayments not implemented")
+}
 func (UnimplementedBoltzServer) GetSwapInfoStream(*GetSwapInfoRequest, Boltz_GetSwapInfoStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetSwapInfoStream not implemented")
 }
@@ -697,6 +959,15 @@ func (UnimplementedBoltzServer) CreateReverseSwap(context.Context, *CreateRevers
 func (UnimplementedBoltzServer) CreateChainSwap(context.Context, *CreateChainSwapRequest) (*ChainSwapInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateChainSwap not implemented")
 }
+func (UnimplementedBoltzServer) CreateOffer(context.Context, *CreateOfferRequest) (*Offer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOffer not implemented")
+}
+func (UnimplementedBoltzServer) ListOffers(context.Context, *ListOffersRequest) (*ListOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOffers not implemented")
+}
+func (UnimplementedBoltzServer) DisableOffer(context.Context, *DisableOfferRequest) (*Offer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableOffer not implemented")
+}
 func (UnimplementedBoltzServer) CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
 }
@@ -715,9 +986,15 @@ func (UnimplementedBoltzServer) GetWalletSendFee(context.Context, *WalletSendReq
 func (UnimplementedBoltzServer) ListWalletTransactions(context.Context, *ListWalletTransactionsRequest) (*ListWalletTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWalletTransactions not implemented")
 }
+func (UnimplementedBoltzServer) SubscribeWalletTransactions(*SubscribeWalletTransactionsRequest, Boltz_SubscribeWalletTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeWalletTransactions not implemented")
+}
 func (UnimplementedBoltzServer) BumpTransaction(context.Context, *BumpTransactionRequest) (*BumpTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BumpTransaction not implemented")
 }
+func (UnimplementedBoltzServer) ConsolidateWallet(context.Context, *ConsolidateWalletRequest) (*WalletSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsolidateWallet not implemented")
+}
 func (UnimplementedBoltzServer) GetWalletCredentials(context.Context, *GetWalletCredentialsRequest) (*WalletCredentials, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWalletCredentials not implemented")
 }
@@ -730,6 +1007,15 @@ func (UnimplementedBoltzServer) WalletSend(context.Context, *WalletSendRequest)
 func (UnimplementedBoltzServer) WalletReceive(context.Context, *WalletReceiveRequest) (*WalletReceiveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WalletReceive not implemented")
 }
+func (UnimplementedBoltzServer) CreateWalletPsbt(context.Context, *WalletSendRequest) (*WalletPsbt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWalletPsbt not implemented")
+}
+func (UnimplementedBoltzServer) SignWalletPsbt(context.Context, *SignWalletPsbtRequest) (*WalletPsbt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignWalletPsbt not implemented")
+}
+func (UnimplementedBoltzServer) FinalizeWalletPsbt(context.Context, *FinalizeWalletPsbtRequest) (*WalletSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeWalletPsbt not implemented")
+}
 func (UnimplementedBoltzServer) Stop(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
@@ -754,6 +1040,24 @@ func (UnimplementedBoltzServer) GetTenant(context.Context, *GetTenantRequest) (*
 func (UnimplementedBoltzServer) RemoveTenant(context.Context, *RemoveTenantRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveTenant not implemented")
 }
+func (UnimplementedBoltzServer) SetSpendingPolicy(context.Context, *SpendingPolicy) (*SpendingPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSpendingPolicy not implemented")
+}
+func (UnimplementedBoltzServer) ListSpendingPolicies(context.Context, *ListSpendingPoliciesRequest) (*ListSpendingPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpendingPolicies not implemented")
+}
+func (UnimplementedBoltzServer) RemoveSpendingPolicy(context.Context, *RemoveSpendingPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSpendingPolicy not implemented")
+}
+func (UnimplementedBoltzServer) AddAllowedAddress(context.Context, *AddAllowedAddressRequest) (*AllowedAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedAddress not implemented")
+}
+func (UnimplementedBoltzServer) ListAllowedAddresses(context.Context, *ListAllowedAddressesRequest) (*ListAllowedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllowedAddresses not implemented")
+}
+func (UnimplementedBoltzServer) RemoveAllowedAddress(context.Context, *RemoveAllowedAddressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedAddress not implemented")
+}
 func (UnimplementedBoltzServer) BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BakeMacaroon not implemented")
 }
@@ -956,6 +1260,24 @@ func _Boltz_GetSwapInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_ListSwapPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSwapPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ListSwapPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_ListSwapPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ListSwapPayments(ctx, req.(*ListSwapPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_GetSwapInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetSwapInfoRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1067,6 +1389,60 @@ func _Boltz_CreateChainSwap_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).CreateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_CreateOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).CreateOffer(ctx, req.(*CreateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ListOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ListOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_ListOffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ListOffers(ctx, req.(*ListOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_DisableOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).DisableOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_DisableOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).DisableOffer(ctx, req.(*DisableOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWalletRequest)
 	if err := dec(in); err != nil {
@@ -1175,6 +1551,27 @@ func _Boltz_ListWalletTransactions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_SubscribeWalletTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeWalletTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoltzServer).SubscribeWalletTransactions(m, &boltzSubscribeWalletTransactionsServer{stream})
+}
+
+type Boltz_SubscribeWalletTransactionsServer interface {
+	Send(*WalletTransactionEvent) error
+	grpc.ServerStream
+}
+
+type boltzSubscribeWalletTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *boltzSubscribeWalletTransactionsServer) Send(m *WalletTransactionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Boltz_BumpTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BumpTransactionRequest)
 	if err := dec(in); err != nil {
@@ -1193,6 +1590,24 @@ func _Boltz_BumpTransaction_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_ConsolidateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsolidateWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ConsolidateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_ConsolidateWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ConsolidateWallet(ctx, req.(*ConsolidateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_GetWalletCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetWalletCredentialsRequest)
 	if err := dec(in); err != nil {
@@ -1265,6 +1680,60 @@ func _Boltz_WalletReceive_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_CreateWalletPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).CreateWalletPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_CreateWalletPsbt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).CreateWalletPsbt(ctx, req.(*WalletSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_SignWalletPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignWalletPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).SignWalletPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_SignWalletPsbt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).SignWalletPsbt(ctx, req.(*SignWalletPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_FinalizeWalletPsbt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeWalletPsbtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).FinalizeWalletPsbt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_FinalizeWalletPsbt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).FinalizeWalletPsbt(ctx, req.(*FinalizeWalletPsbtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_Stop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1409,6 +1878,114 @@ func _Boltz_RemoveTenant_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_SetSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendingPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).SetSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_SetSpendingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).SetSpendingPolicy(ctx, req.(*SpendingPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ListSpendingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSpendingPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ListSpendingPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_ListSpendingPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ListSpendingPolicies(ctx, req.(*ListSpendingPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_RemoveSpendingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSpendingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).RemoveSpendingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_RemoveSpendingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).RemoveSpendingPolicy(ctx, req.(*RemoveSpendingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_AddAllowedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAllowedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).AddAllowedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_AddAllowedAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).AddAllowedAddress(ctx, req.(*AddAllowedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_ListAllowedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllowedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ListAllowedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_ListAllowedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ListAllowedAddresses(ctx, req.(*ListAllowedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_RemoveAllowedAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAllowedAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).RemoveAllowedAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_RemoveAllowedAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).RemoveAllowedAddress(ctx, req.(*RemoveAllowedAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BakeMacaroonRequest)
 	if err := dec(in); err != nil {
@@ -1510,6 +2087,10 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSwapInfo",
 			Handler:    _Boltz_GetSwapInfo_Handler,
 		},
+		{
+			MethodName: "ListSwapPayments",
+			Handler:    _Boltz_ListSwapPayments_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Boltz_Deposit_Handler,
@@ -1530,6 +2111,18 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateChainSwap",
 			Handler:    _Boltz_CreateChainSwap_Handler,
 		},
+		{
+			MethodName: "CreateOffer",
+			Handler:    _Boltz_CreateOffer_Handler,
+		},
+		{
+			MethodName: "ListOffers",
+			Handler:    _Boltz_ListOffers_Handler,
+		},
+		{
+			MethodName: "DisableOffer",
+			Handler:    _Boltz_DisableOffer_Handler,
+		},
 		{
 			MethodName: "CreateWallet",
 			Handler:    _Boltz_CreateWallet_Handler,
@@ -1558,6 +2151,10 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BumpTransaction",
 			Handler:    _Boltz_BumpTransaction_Handler,
 		},
+		{
+			MethodName: "ConsolidateWallet",
+			Handler:    _Boltz_ConsolidateWallet_Handler,
+		},
 		{
 			MethodName: "GetWalletCredentials",
 			Handler:    _Boltz_GetWalletCredentials_Handler,
@@ -1574,6 +2171,18 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WalletReceive",
 			Handler:    _Boltz_WalletReceive_Handler,
 		},
+		{
+			MethodName: "CreateWalletPsbt",
+			Handler:    _Boltz_CreateWalletPsbt_Handler,
+		},
+		{
+			MethodName: "SignWalletPsbt",
+			Handler:    _Boltz_SignWalletPsbt_Handler,
+		},
+		{
+			MethodName: "FinalizeWalletPsbt",
+			Handler:    _Boltz_FinalizeWalletPsbt_Handler,
+		},
 		{
 			MethodName: "Stop",
 			Handler:    _Boltz_Stop_Handler,
@@ -1606,6 +2215,30 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveTenant",
 			Handler:    _Boltz_RemoveTenant_Handler,
 		},
+		{
+			MethodName: "SetSpendingPolicy",
+			Handler:    _Boltz_SetSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "ListSpendingPolicies",
+			Handler:    _Boltz_ListSpendingPolicies_Handler,
+		},
+		{
+			MethodName: "RemoveSpendingPolicy",
+			Handler:    _Boltz_RemoveSpendingPolicy_Handler,
+		},
+		{
+			MethodName: "AddAllowedAddress",
+			Handler:    _Boltz_AddAllowedAddress_Handler,
+		},
+		{
+			MethodName: "ListAllowedAddresses",
+			Handler:    _Boltz_ListAllowedAddresses_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedAddress",
+			Handler:    _Boltz_RemoveAllowedAddress_Handler,
+		},
 		{
 			MethodName: "BakeMacaroon",
 			Handler:    _Boltz_BakeMacaroon_Handler,
@@ -1625,6 +2258,11 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Boltz_GetSwapInfoStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeWalletTransactions",
+			Handler:       _Boltz_SubscribeWalletTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "boltzrpc.proto",
 }
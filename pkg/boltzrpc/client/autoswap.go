@@ -8,6 +8,7 @@ import (
 
 	"github.com/BoltzExchange/boltz-client/v2/pkg/boltzrpc/autoswaprpc"
 	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
@@ -41,53 +42,108 @@ func (autoSwap *AutoSwap) GetConfig() (*autoswaprpc.Config, error) {
 }
 
 func (autoSwap *AutoSwap) GetLightningConfig() (*autoswaprpc.LightningConfig, error) {
+	return autoSwap.GetLightningNodeConfig("")
+}
+
+// GetLightningNodeConfig returns the lightning config of the node with the given name, an empty name refers to the main node
+func (autoSwap *AutoSwap) GetLightningNodeConfig(node string) (*autoswaprpc.LightningConfig, error) {
 	config, err := autoSwap.GetConfig()
 	if err != nil {
 		return nil, err
 	}
-	if config.Lightning == nil {
-		return nil, errors.New("lightning config not set")
+	for _, lightningConfig := range config.Lightning {
+		if lightningConfig.LightningNode == node {
+			return lightningConfig, nil
+		}
+	}
+	if node != "" {
+		return nil, fmt.Errorf("lightning config of node %s not set", node)
 	}
-	return config.Lightning[0], nil
+	return nil, errors.New("lightning config not set")
 }
 
 func (autoSwap *AutoSwap) GetChainConfig() (*autoswaprpc.ChainConfig, error) {
+	return autoSwap.GetChainRuleConfig("")
+}
+
+// GetChainRuleConfig returns the config of the chain rule with the given name, an empty name refers to the default rule
+func (autoSwap *AutoSwap) GetChainRuleConfig(name string) (*autoswaprpc.ChainConfig, error) {
 	config, err := autoSwap.GetConfig()
 	if err != nil {
 		return nil, err
 	}
-	if config.Chain == nil {
-		return nil, errors.New("chain config not set")
+	for _, chainConfig := range config.Chain {
+		if chainConfig.Name == name {
+			return chainConfig, nil
+		}
+	}
+	if name != "" {
+		return nil, fmt.Errorf("chain rule %s not set", name)
 	}
-	return config.Chain[0], nil
+	return nil, errors.New("chain config not set")
 }
 
 func (autoSwap *AutoSwap) ReloadConfig() (*autoswaprpc.Config, error) {
 	return autoSwap.Client.ReloadConfig(autoSwap.Ctx, &empty.Empty{})
 }
 
+func (autoSwap *AutoSwap) GetSnapshot() (*autoswaprpc.Snapshot, error) {
+	return autoSwap.Client.GetSnapshot(autoSwap.Ctx, &autoswaprpc.GetSnapshotRequest{})
+}
+
+func (autoSwap *AutoSwap) Simulate(request *autoswaprpc.SimulateRequest) (*autoswaprpc.SimulateResponse, error) {
+	return autoSwap.Client.Simulate(autoSwap.Ctx, request)
+}
+
+func (autoSwap *AutoSwap) ListAutoSwapEvents(request *autoswaprpc.ListAutoSwapEventsRequest) (*autoswaprpc.ListAutoSwapEventsResponse, error) {
+	return autoSwap.Client.ListAutoSwapEvents(autoSwap.Ctx, request)
+}
+
+func (autoSwap *AutoSwap) ListPendingApprovals() (*autoswaprpc.ListPendingApprovalsResponse, error) {
+	return autoSwap.Client.ListPendingApprovals(autoSwap.Ctx, &autoswaprpc.ListPendingApprovalsRequest{})
+}
+
+func (autoSwap *AutoSwap) ApproveRecommendation(id uint64) (*autoswaprpc.ApproveRecommendationResponse, error) {
+	return autoSwap.Client.ApproveRecommendation(autoSwap.Ctx, &autoswaprpc.ApproveRecommendationRequest{Id: id})
+}
+
+func (autoSwap *AutoSwap) RejectRecommendation(id uint64) error {
+	_, err := autoSwap.Client.RejectRecommendation(autoSwap.Ctx, &autoswaprpc.RejectRecommendationRequest{Id: id})
+	return err
+}
+
 func (autoSwap *AutoSwap) SetConfigValue(swapper AutoSwapType, key string, value any) (*autoswaprpc.Config, error) {
 	if swapper == LnAutoSwap {
-		config := &autoswaprpc.LightningConfig{}
-		mask, err := setValue(config, key, value)
-		if err != nil {
-			return nil, err
-		}
-		return autoSwap.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
-			Config:    config,
-			FieldMask: mask,
-		})
+		return autoSwap.SetLightningNodeConfigValue("", key, value)
 	} else {
-		config := &autoswaprpc.ChainConfig{}
-		mask, err := setValue(config, key, value)
-		if err != nil {
-			return nil, err
-		}
-		return autoSwap.UpdateChainConfig(&autoswaprpc.UpdateChainConfigRequest{
-			Config:    config,
-			FieldMask: mask,
-		})
+		return autoSwap.SetChainRuleConfigValue("", key, value)
+	}
+}
+
+func (autoSwap *AutoSwap) SetLightningNodeConfigValue(node string, key string, value any) (*autoswaprpc.Config, error) {
+	config := &autoswaprpc.LightningConfig{}
+	mask, err := setValue(config, key, value)
+	if err != nil {
+		return nil, err
+	}
+	config.LightningNode = node
+	return autoSwap.UpdateLightningConfig(&autoswaprpc.UpdateLightningConfigRequest{
+		Config:    config,
+		FieldMask: mask,
+	})
+}
+
+func (autoSwap *AutoSwap) SetChainRuleConfigValue(name string, key string, value any) (*autoswaprpc.Config, error) {
+	config := &autoswaprpc.ChainConfig{}
+	mask, err := setValue(config, key, value)
+	if err != nil {
+		return nil, err
 	}
+	config.Name = name
+	return autoSwap.UpdateChainConfig(&autoswaprpc.UpdateChainConfigRequest{
+		Config:    config,
+		FieldMask: mask,
+	})
 }
 
 func (autoSwap *AutoSwap) SetLightningConfigValue(key string, value any) (*autoswaprpc.Config, error) {
@@ -118,6 +174,21 @@ func setValue(message protoreflect.ProtoMessage, name string, value any) (*field
 	stringValue := fmt.Sprint(value)
 	var setValue any
 
+	// lists and messages are given as json, e.g. `[{"peerId": "..."}]`
+	if field.IsList() || field.Kind() == protoreflect.MessageKind {
+		parsed := message.ProtoReflect().New()
+		raw := fmt.Sprintf(`{"%s": %s}`, field.JSONName(), stringValue)
+		if err := protojson.Unmarshal([]byte(raw), parsed.Interface()); err != nil {
+			return nil, fmt.Errorf("invalid json: %w", err)
+		}
+		if parsed.Has(field) {
+			message.ProtoReflect().Set(field, parsed.Get(field))
+		} else {
+			message.ProtoReflect().Clear(field)
+		}
+		return fieldmaskpb.New(message, string(field.Name()))
+	}
+
 	switch field.Kind() {
 	case protoreflect.Int32Kind, protoreflect.Int64Kind:
 		value, err := strconv.ParseInt(stringValue, 10, 64)
@@ -172,14 +243,29 @@ func (autoSwap *AutoSwap) UpdateChainConfig(request *autoswaprpc.UpdateChainConf
 }
 
 func (autoSwap *AutoSwap) ResetConfig(swapper AutoSwapType) (*autoswaprpc.Config, error) {
-	reset := true
 	if swapper == LnAutoSwap {
-		return autoSwap.Client.UpdateLightningConfig(autoSwap.Ctx, &autoswaprpc.UpdateLightningConfigRequest{Reset_: &reset})
+		return autoSwap.ResetLightningNode("")
 	} else {
-		return autoSwap.Client.UpdateChainConfig(autoSwap.Ctx, &autoswaprpc.UpdateChainConfigRequest{Reset_: &reset})
+		return autoSwap.ResetChainRule("")
 	}
 }
 
+func (autoSwap *AutoSwap) ResetLightningNode(node string) (*autoswaprpc.Config, error) {
+	reset := true
+	return autoSwap.Client.UpdateLightningConfig(autoSwap.Ctx, &autoswaprpc.UpdateLightningConfigRequest{
+		Config: &autoswaprpc.LightningConfig{LightningNode: node},
+		Reset_: &reset,
+	})
+}
+
+func (autoSwap *AutoSwap) ResetChainRule(name string) (*autoswaprpc.Config, error) {
+	reset := true
+	return autoSwap.Client.UpdateChainConfig(autoSwap.Ctx, &autoswaprpc.UpdateChainConfigRequest{
+		Config: &autoswaprpc.ChainConfig{Name: name},
+		Reset_: &reset,
+	})
+}
+
 func (autoSwap *AutoSwap) Enable() (any, error) {
 	return autoSwap.SetLightningConfigValue("enabled", true)
 }
@@ -71,6 +71,10 @@ func (boltz *Boltz) GetSwapInfo(id string) (*boltzrpc.GetSwapInfoResponse, error
 	})
 }
 
+func (boltz *Boltz) ListSwapPayments(request *boltzrpc.ListSwapPaymentsRequest) (*boltzrpc.ListSwapPaymentsResponse, error) {
+	return boltz.Client.ListSwapPayments(boltz.Ctx, request)
+}
+
 func (boltz *Boltz) GetSwapInfoByPaymentHash(paymentHash []byte) (*boltzrpc.GetSwapInfoResponse, error) {
 	return boltz.Client.GetSwapInfo(boltz.Ctx, &boltzrpc.GetSwapInfoRequest{
 		Identifier: &boltzrpc.GetSwapInfoRequest_PaymentHash{PaymentHash: paymentHash},
@@ -107,6 +111,10 @@ func (boltz *Boltz) GetWallets(currency *boltzrpc.Currency, includeReadonly bool
 	return boltz.Client.GetWallets(boltz.Ctx, &boltzrpc.GetWalletsRequest{Currency: currency, IncludeReadonly: &includeReadonly})
 }
 
+func (boltz *Boltz) SubscribeWalletTransactions(request *boltzrpc.SubscribeWalletTransactionsRequest) (boltzrpc.Boltz_SubscribeWalletTransactionsClient, error) {
+	return boltz.Client.SubscribeWalletTransactions(boltz.Ctx, request)
+}
+
 func (boltz *Boltz) ListWalletTransactions(request *boltzrpc.ListWalletTransactionsRequest) (*boltzrpc.ListWalletTransactionsResponse, error) {
 	return boltz.Client.ListWalletTransactions(boltz.Ctx, request)
 }
@@ -115,6 +123,10 @@ func (boltz *Boltz) BumpTransaction(request *boltzrpc.BumpTransactionRequest) (*
 	return boltz.Client.BumpTransaction(boltz.Ctx, request)
 }
 
+func (boltz *Boltz) ConsolidateWallet(request *boltzrpc.ConsolidateWalletRequest) (*boltzrpc.WalletSendResponse, error) {
+	return boltz.Client.ConsolidateWallet(boltz.Ctx, request)
+}
+
 func (boltz *Boltz) ImportWallet(params *boltzrpc.WalletParams, credentials *boltzrpc.WalletCredentials) (*boltzrpc.Wallet, error) {
 	return boltz.Client.ImportWallet(boltz.Ctx, &boltzrpc.ImportWalletRequest{Params: params, Credentials: credentials})
 }
@@ -145,6 +157,18 @@ func (boltz *Boltz) WalletReceive(id uint64) (*boltzrpc.WalletReceiveResponse, e
 	return boltz.Client.WalletReceive(boltz.Ctx, &boltzrpc.WalletReceiveRequest{Id: id})
 }
 
+func (boltz *Boltz) CreateWalletPsbt(request *boltzrpc.WalletSendRequest) (*boltzrpc.WalletPsbt, error) {
+	return boltz.Client.CreateWalletPsbt(boltz.Ctx, request)
+}
+
+func (boltz *Boltz) SignWalletPsbt(id uint64, psbt string) (*boltzrpc.WalletPsbt, error) {
+	return boltz.Client.SignWalletPsbt(boltz.Ctx, &boltzrpc.SignWalletPsbtRequest{Id: id, Psbt: psbt})
+}
+
+func (boltz *Boltz) FinalizeWalletPsbt(id uint64, psbts []string) (*boltzrpc.WalletSendResponse, error) {
+	return boltz.Client.FinalizeWalletPsbt(boltz.Ctx, &boltzrpc.FinalizeWalletPsbtRequest{Id: id, Psbts: psbts})
+}
+
 func (boltz *Boltz) Stop() error {
 	_, err := boltz.Client.Stop(boltz.Ctx, &empty.Empty{})
 	return err
@@ -190,6 +214,44 @@ func (boltz *Boltz) RemoveTenant(name string) error {
 	return err
 }
 
+func (boltz *Boltz) SetSpendingPolicy(policy *boltzrpc.SpendingPolicy) (*boltzrpc.SpendingPolicy, error) {
+	return boltz.Client.SetSpendingPolicy(boltz.Ctx, policy)
+}
+
+func (boltz *Boltz) ListSpendingPolicies(tenantId *uint64) (*boltzrpc.ListSpendingPoliciesResponse, error) {
+	return boltz.Client.ListSpendingPolicies(boltz.Ctx, &boltzrpc.ListSpendingPoliciesRequest{TenantId: tenantId})
+}
+
+func (boltz *Boltz) RemoveSpendingPolicy(request *boltzrpc.RemoveSpendingPolicyRequest) error {
+	_, err := boltz.Client.RemoveSpendingPolicy(boltz.Ctx, request)
+	return err
+}
+
+func (boltz *Boltz) CreateOffer(request *boltzrpc.CreateOfferRequest) (*boltzrpc.Offer, error) {
+	return boltz.Client.CreateOffer(boltz.Ctx, request)
+}
+
+func (boltz *Boltz) ListOffers(request *boltzrpc.ListOffersRequest) (*boltzrpc.ListOffersResponse, error) {
+	return boltz.Client.ListOffers(boltz.Ctx, request)
+}
+
+func (boltz *Boltz) DisableOffer(id string) (*boltzrpc.Offer, error) {
+	return boltz.Client.DisableOffer(boltz.Ctx, &boltzrpc.DisableOfferRequest{Id: id})
+}
+
+func (boltz *Boltz) AddAllowedAddress(request *boltzrpc.AddAllowedAddressRequest) (*boltzrpc.AllowedAddress, error) {
+	return boltz.Client.AddAllowedAddress(boltz.Ctx, request)
+}
+
+func (boltz *Boltz) ListAllowedAddresses(tenantId *uint64) (*boltzrpc.ListAllowedAddressesResponse, error) {
+	return boltz.Client.ListAllowedAddresses(boltz.Ctx, &boltzrpc.ListAllowedAddressesRequest{TenantId: tenantId})
+}
+
+func (boltz *Boltz) RemoveAllowedAddress(request *boltzrpc.RemoveAllowedAddressRequest) error {
+	_, err := boltz.Client.RemoveAllowedAddress(boltz.Ctx, request)
+	return err
+}
+
 func (boltz *Boltz) BakeMacaroon(request *boltzrpc.BakeMacaroonRequest) (*boltzrpc.BakeMacaroonResponse, error) {
 	return boltz.Client.BakeMacaroon(boltz.Ctx, request)
 }